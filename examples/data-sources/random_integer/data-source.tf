@@ -0,0 +1,12 @@
+# A new value is chosen every time Terraform reads the data source, such as
+# during each plan, so the cooldown varies between runs.
+data "random_integer" "cooldown" {
+  min = 240
+  max = 360
+}
+
+resource "aws_autoscaling_group" "example" {
+  default_cooldown = data.random_integer.cooldown.result
+
+  # ... and other aws_autoscaling_group arguments ...
+}
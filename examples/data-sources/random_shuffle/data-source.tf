@@ -0,0 +1,11 @@
+# A new order is chosen every time Terraform reads the data source, such as
+# during each plan, so the availability zone used first changes on each run.
+data "random_shuffle" "az" {
+  input = ["us-west-1a", "us-west-1c", "us-west-1d", "us-west-1e"]
+}
+
+resource "aws_elb" "example" {
+  availability_zones = data.random_shuffle.az.result
+
+  # ... and other aws_elb arguments ...
+}
@@ -0,0 +1,8 @@
+# The following example shows how to generate a pet name for a
+# short-lived preview environment, which is the same on every run
+# for the same branch, without storing it in state.
+
+ephemeral "random_pet" "preview" {
+  prefix = "preview"
+  seed   = var.branch_name
+}
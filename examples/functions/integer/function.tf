@@ -0,0 +1,12 @@
+variable "account_id" {
+  type = string
+}
+
+locals {
+  availability_zones = ["us-west-2a", "us-west-2b", "us-west-2c"]
+}
+
+# The same availability zone is chosen for the same account on every plan.
+output "availability_zone" {
+  value = local.availability_zones[provider::random::integer(0, length(local.availability_zones) - 1, var.account_id)]
+}
@@ -0,0 +1,9 @@
+variable "branch" {
+  type = string
+}
+
+# A name for the preview environment of each branch, which is the same on
+# every plan for the same branch.
+output "preview_environment" {
+  value = "preview-${provider::random::petname(2, "-", var.branch)}"
+}
@@ -0,0 +1,5 @@
+# The same permutation of the availability zones is returned on every plan,
+# without storing it in state.
+output "availability_zones" {
+  value = provider::random::shuffle(["us-west-1a", "us-west-1b", "us-west-1c"], "my-service")
+}
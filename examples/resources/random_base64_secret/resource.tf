@@ -0,0 +1,12 @@
+# The following example shows how to generate a 256-bit HMAC key for signing
+# JSON Web Tokens, encoded using the URL-safe base64 alphabet.
+
+resource "random_base64_secret" "jwt" {
+  length   = 32
+  url_safe = true
+}
+
+resource "aws_secretsmanager_secret_version" "jwt" {
+  secret_id     = aws_secretsmanager_secret.jwt.id
+  secret_string = random_base64_secret.jwt.result
+}
@@ -0,0 +1,19 @@
+resource "random_choice" "az" {
+  input = ["us-west-1a", "us-west-1c", "us-west-1d"]
+}
+
+resource "aws_instance" "example" {
+  # Place the instance in one of the given availability zones, chosen at
+  # random.
+  availability_zone = random_choice.az.result
+
+  # ... and other aws_instance arguments ...
+}
+
+resource "random_choice" "instance_type" {
+  input = ["t3.small", "t3.medium", "t3.large"]
+
+  # Choose t3.small half of the time, and the other instance types a quarter
+  # of the time each.
+  weights = [2, 1, 1]
+}
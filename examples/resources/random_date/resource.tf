@@ -0,0 +1,17 @@
+# Generate a birth date for a synthetic test user.
+resource "random_date" "birth_date" {
+  min    = "1950-01-01T00:00:00Z"
+  max    = "2005-12-31T23:59:59Z"
+  format = "date"
+}
+
+# Stagger the rotation of a credential across the first week of the year, so
+# that the credentials of many services are not all rotated at once.
+resource "random_date" "rotation_anchor" {
+  min = "2025-01-01T00:00:00Z"
+  max = "2025-01-07T23:59:59Z"
+
+  keepers = {
+    service = "billing"
+  }
+}
@@ -0,0 +1,16 @@
+# The following example shows how to derive separate encryption and signing
+# keys from a single randomly generated secret.
+
+resource "random_bytes" "master" {
+  length = 32
+}
+
+resource "random_derived_key" "encryption" {
+  secret = random_bytes.master.base64
+  info   = "encryption"
+}
+
+resource "random_derived_key" "signing" {
+  secret = random_bytes.master.base64
+  info   = "signing"
+}
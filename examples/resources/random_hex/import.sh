@@ -0,0 +1,3 @@
+# Random Hex can be imported by specifying the value of the hexadecimal string.
+# Uppercase values are imported with upper set to true.
+terraform import random_hex.salt 6a1f3c9e
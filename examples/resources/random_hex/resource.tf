@@ -0,0 +1,16 @@
+# The following example shows how to generate a 32 character salt, which is
+# regenerated each time the application version changes.
+
+resource "random_hex" "salt" {
+  keepers = {
+    version = var.app_version
+  }
+
+  length = 32
+}
+
+resource "aws_ssm_parameter" "salt" {
+  name  = "/app/salt"
+  type  = "String"
+  value = random_hex.salt.result
+}
@@ -5,5 +5,10 @@
 # Example with no prefix:
 terraform import random_id.server p-9hUg
 
-# Example with prefix (prefix is separated by a ,):
-$ terraform import random_id.server my-prefix-,p-9hUg
\ No newline at end of file
+# Example with prefix (prefix is separated by the last ,):
+$ terraform import random_id.server my-prefix-,p-9hUg
+
+# The bytes can instead be given as the unprefixed hex, after hex:, with or
+# without a prefix:
+terraform import random_id.server hex:a7ef6152
+terraform import random_id.server my-prefix-,hex:a7ef6152
@@ -0,0 +1,15 @@
+# The following example shows how to assign stable addresses to the network
+# interfaces of instances, which remain the same whenever the instances are
+# replaced, but cannot be predicted without the secret key.
+
+resource "random_bytes" "address_key" {
+  length = 32
+}
+
+resource "random_ipv6_interface_id" "web" {
+  count = 3
+
+  prefix     = "2001:db8:0:1::/64"
+  secret_key = random_bytes.address_key.base64
+  interface  = "web-${count.index}"
+}
@@ -0,0 +1,12 @@
+# The following example shows how to generate recovery codes for a break-glass
+# account, which are stored so that they can be handed to an operator if the
+# account's second factor is lost.
+
+resource "random_recovery_codes" "break_glass" {
+  quantity = 8
+}
+
+resource "aws_secretsmanager_secret_version" "break_glass" {
+  secret_id     = aws_secretsmanager_secret.break_glass.id
+  secret_string = join("\n", random_recovery_codes.break_glass.results)
+}
@@ -0,0 +1,12 @@
+# The following example shows how to generate an API token for a service
+# account, which secret scanners can recognise by the "acme" prefix and
+# validate using the CRC-32 checksum segment.
+
+resource "random_rsa_like_token" "service_account" {
+  prefix = "acme"
+}
+
+resource "aws_secretsmanager_secret_version" "service_account" {
+  secret_id     = aws_secretsmanager_secret.service_account.id
+  secret_string = random_rsa_like_token.service_account.result
+}
@@ -0,0 +1,18 @@
+# The following example shows how to choose two subnets, keeping each
+# availability zone paired with the ID of the subnet in that zone.
+
+resource "random_sample_map" "subnets" {
+  input = {
+    "us-west-1a" = "subnet-0a1b2c3d"
+    "us-west-1b" = "subnet-1b2c3d4e"
+    "us-west-1c" = "subnet-2c3d4e5f"
+  }
+  result_count = 2
+}
+
+resource "aws_elb" "example" {
+  # Place the ELB in the chosen subnets.
+  subnets = values(random_sample_map.subnets.result)
+
+  # ... and other aws_elb arguments ...
+}
@@ -10,3 +10,43 @@ resource "aws_elb" "example" {
 
   # ... and other aws_elb arguments ...
 }
+
+resource "random_shuffle" "hosts" {
+  input  = ["host-1", "host-2", "host-3", "host-4", "host-5"]
+  chunks = 2
+}
+
+output "maintenance_batches" {
+  # Two batches of hosts, in a random order, with three hosts in the first
+  # batch and two in the second.
+  value = random_shuffle.hosts.result_chunks
+}
+
+resource "random_shuffle" "subnets" {
+  input_map = {
+    "us-west-1a" = "subnet-0a1b2c3d"
+    "us-west-1c" = "subnet-4e5f6a7b"
+    "us-west-1d" = "subnet-8c9d0e1f"
+  }
+}
+
+output "subnet_ids" {
+  # The subnets in the same random order as their availability zones in
+  # random_shuffle.subnets.result.
+  value = random_shuffle.subnets.result_values
+}
+
+resource "random_shuffle" "subnet_definitions" {
+  input_objects = [
+    { name = "app-a", cidr = "10.0.0.0/24" },
+    { name = "app-b", cidr = "10.0.1.0/24" },
+    { name = "app-c", cidr = "10.0.2.0/24" },
+  ]
+  result_count = 2
+}
+
+output "chosen_subnets" {
+  # Two of the subnet definitions, in a random order, without shuffling
+  # their indexes separately.
+  value = random_shuffle.subnet_definitions.result_objects
+}
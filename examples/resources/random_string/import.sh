@@ -1,2 +1,5 @@
 # Random String can be imported by specifying the value of the string.
-terraform import random_string.test test
\ No newline at end of file
+terraform import random_string.test test
+
+# Attribute values can be supplied along with the string as a JSON object.
+terraform import random_string.test '{"result": "test", "length": 16, "lower": false}'
\ No newline at end of file
@@ -2,4 +2,9 @@
 # value with a value interpolated from the random provider without
 # experiencing diffs.
 
-terraform import random_uuid.main aabbccdd-eeff-0011-2233-445566778899
\ No newline at end of file
+terraform import random_uuid.main aabbccdd-eeff-0011-2233-445566778899
+
+# UUIDs in uppercase, enclosed in braces or in URN form, as exported by
+# Windows and Active Directory tooling, are normalized to lowercase:
+terraform import random_uuid.main '{AABBCCDD-EEFF-0011-2233-445566778899}'
+terraform import random_uuid.main urn:uuid:aabbccdd-eeff-0011-2233-445566778899
@@ -0,0 +1,18 @@
+# Sample a request size which is usually close to 512 KiB, but occasionally
+# much smaller or larger, for a load testing job.
+resource "random_weighted_integer" "request_size_kib" {
+  min          = 1
+  max          = 4096
+  distribution = "normal"
+  mean         = 512
+  stddev       = 256
+}
+
+# Choose which of 50 backends to degrade, favoring the first few, in the same
+# way as popular keys in a cache.
+resource "random_weighted_integer" "degraded_backend" {
+  min          = 0
+  max          = 49
+  distribution = "zipf"
+  exponent     = 1.5
+}
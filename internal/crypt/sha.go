@@ -0,0 +1,200 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package crypt implements the SHA-256 and SHA-512 based password hashing
+// schemes of crypt(3), as specified by Ulrich Drepper, which produce the
+// $5$ and $6$ formats used in /etc/shadow.
+package crypt
+
+import (
+	"crypto/sha256"
+	"crypto/sha512"
+	"hash"
+	"strings"
+)
+
+const (
+	// SaltAlphabet is the set of characters which may appear in a salt.
+	SaltAlphabet = "./0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+
+	// MaxSaltLength is the maximum length of a salt. Longer salts are
+	// truncated.
+	MaxSaltLength = 16
+
+	// defaultRounds is the number of rounds used when the rounds are not
+	// specified in the hash.
+	defaultRounds = 5000
+)
+
+// sha256Permutation and sha512Permutation are the orders in which the bytes
+// of the final digest are encoded, in groups of three.
+var (
+	sha256Permutation = []int{
+		0, 10, 20, 21, 1, 11, 12, 22, 2, 3, 13, 23, 24, 4, 14,
+		15, 25, 5, 6, 16, 26, 27, 7, 17, 18, 28, 8, 9, 19, 29,
+	}
+	sha512Permutation = []int{
+		0, 21, 42, 22, 43, 1, 44, 2, 23, 3, 24, 45, 25, 46, 4,
+		47, 5, 26, 6, 27, 48, 28, 49, 7, 50, 8, 29, 9, 30, 51,
+		31, 52, 10, 53, 11, 32, 12, 33, 54, 34, 55, 13, 56, 14, 35,
+		15, 36, 57, 37, 58, 16, 59, 17, 38, 18, 39, 60, 40, 61, 19,
+		62, 20, 41,
+	}
+)
+
+// SHA256 returns the SHA-256 crypt hash of the password with the salt, in the
+// format $5$<salt>$<hash>, using the default number of rounds.
+func SHA256(password, salt string) string {
+	digest := shaCrypt(sha256.New, password, salt)
+
+	var b strings.Builder
+
+	b.WriteString("$5$")
+	b.WriteString(truncateSalt(salt))
+	b.WriteString("$")
+	encodePermuted(&b, digest, sha256Permutation)
+	encode24(&b, 0, digest[31], digest[30], 3)
+
+	return b.String()
+}
+
+// SHA512 returns the SHA-512 crypt hash of the password with the salt, in the
+// format $6$<salt>$<hash>, using the default number of rounds.
+func SHA512(password, salt string) string {
+	digest := shaCrypt(sha512.New, password, salt)
+
+	var b strings.Builder
+
+	b.WriteString("$6$")
+	b.WriteString(truncateSalt(salt))
+	b.WriteString("$")
+	encodePermuted(&b, digest, sha512Permutation)
+	encode24(&b, 0, 0, digest[63], 2)
+
+	return b.String()
+}
+
+func truncateSalt(salt string) string {
+	if len(salt) > MaxSaltLength {
+		return salt[:MaxSaltLength]
+	}
+
+	return salt
+}
+
+// shaCrypt returns the final digest of the password and salt, computed with
+// the given hash function.
+func shaCrypt(newHash func() hash.Hash, password, salt string) []byte {
+	p := []byte(password)
+	s := []byte(truncateSalt(salt))
+
+	h := newHash()
+	size := h.Size()
+
+	// Digest B is the hash of the password, salt and password.
+	h.Write(p)
+	h.Write(s)
+	h.Write(p)
+	digestB := h.Sum(nil)
+
+	// Digest A is the hash of the password and salt, followed by as many
+	// bytes of digest B as the length of the password, followed by digest B
+	// or the password for each bit of the length of the password.
+	h.Reset()
+	h.Write(p)
+	h.Write(s)
+
+	for n := len(p); n > 0; n -= size {
+		h.Write(digestB[:min(n, size)])
+	}
+
+	for n := len(p); n > 0; n >>= 1 {
+		if n&1 != 0 {
+			h.Write(digestB)
+		} else {
+			h.Write(p)
+		}
+	}
+
+	digestA := h.Sum(nil)
+
+	// Sequence P is the hash of the password repeated once for each of its
+	// bytes, repeated to the length of the password.
+	h.Reset()
+
+	for range p {
+		h.Write(p)
+	}
+
+	seqP := repeat(h.Sum(nil), len(p))
+
+	// Sequence S is the hash of the salt repeated 16 plus the first byte of
+	// digest A times, repeated to the length of the salt.
+	h.Reset()
+
+	for i := 0; i < 16+int(digestA[0]); i++ {
+		h.Write(s)
+	}
+
+	seqS := repeat(h.Sum(nil), len(s))
+
+	digest := digestA
+
+	for i := 0; i < defaultRounds; i++ {
+		h.Reset()
+
+		if i%2 != 0 {
+			h.Write(seqP)
+		} else {
+			h.Write(digest)
+		}
+
+		if i%3 != 0 {
+			h.Write(seqS)
+		}
+
+		if i%7 != 0 {
+			h.Write(seqP)
+		}
+
+		if i%2 != 0 {
+			h.Write(digest)
+		} else {
+			h.Write(seqP)
+		}
+
+		digest = h.Sum(nil)
+	}
+
+	return digest
+}
+
+// repeat returns the digest repeated to the given length.
+func repeat(digest []byte, length int) []byte {
+	result := make([]byte, 0, length)
+
+	for len(result) < length {
+		result = append(result, digest[:min(len(digest), length-len(result))]...)
+	}
+
+	return result
+}
+
+// encodePermuted encodes the bytes of the digest in groups of three, in the
+// order given by the permutation.
+func encodePermuted(b *strings.Builder, digest []byte, permutation []int) {
+	for i := 0; i < len(permutation); i += 3 {
+		encode24(b, digest[permutation[i]], digest[permutation[i+1]], digest[permutation[i+2]], 4)
+	}
+}
+
+// encode24 encodes n characters of the 24 bit value formed from three bytes,
+// least significant six bits first.
+func encode24(b *strings.Builder, b2, b1, b0 byte, n int) {
+	w := uint(b2)<<16 | uint(b1)<<8 | uint(b0)
+
+	for i := 0; i < n; i++ {
+		b.WriteByte(SaltAlphabet[w&0x3f])
+		w >>= 6
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package crypt
+
+import (
+	"testing"
+)
+
+func TestSHA(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		hash     func(password, salt string) string
+		password string
+		salt     string
+		expected string
+	}{
+		"sha256": {
+			hash:     SHA256,
+			password: "Hello world!",
+			salt:     "saltstring",
+			expected: "$5$saltstring$5B8vYYiY.CVt1RlTTf8KbXBH3hsxY/GNooZaBBGWEc5",
+		},
+		"sha512": {
+			hash:     SHA512,
+			password: "Hello world!",
+			salt:     "saltstring",
+			expected: "$6$saltstring$svn8UoSVapNtMuq1ukKS4tPQd8iKwSMHWjl/O817G3uBnIFNjnQJuesI68u4OTLiBFdcbYEdFCoEOfaS35inz1",
+		},
+		"sha512-long-salt": {
+			hash:     SHA512,
+			password: "Hello world!",
+			salt:     "saltstringsaltstring",
+			expected: "$6$saltstringsaltst$e.3mR68CqZEpesEX1HlFZT6sEanSOjM/b5UoDyDo00a8syek2cJldMjrbtKP86.FJvzluVR7nc3DNzelAwTxj.",
+		},
+		"sha512-long-password": {
+			hash:     SHA512,
+			password: "we have a short salt string but not a short password",
+			salt:     "short",
+			expected: "$6$short$qmfj2meTBr5G2EAGIJ4vjX7RpefsD4JzpEyTAeEUJdzdxlBS6pe8gdMHm5zFftaFSj/2p2bjBwyVS9ZhWpLZt.",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := testCase.hash(testCase.password, testCase.salt); got != testCase.expected {
+				t.Errorf("expected %q, got: %q", testCase.expected, got)
+			}
+		})
+	}
+}
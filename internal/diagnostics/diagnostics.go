@@ -4,9 +4,14 @@
 package diagnostics
 
 import (
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+
+	"github.com/terraform-providers/terraform-provider-random/internal/random"
 )
 
 const RetryMsg = "Retry the Terraform operation. If the error still occurs or happens regularly, please contact the provider developer with hardware and operating system information.\n\n"
@@ -37,6 +42,35 @@ func HashGenerationError(errMsg string) diag.Diagnostics {
 	return diags
 }
 
+func FIPSBcryptHashWarning() diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.AddWarning(
+		"Bcrypt Hash Not Generated",
+		"The provider is configured with fips = true, and bcrypt is not a FIPS 140 approved algorithm, so the "+
+			"'bcrypt_hash' attribute has not been generated and will be null.\n\n"+
+			"Use the 'pbkdf2_hash' attribute, which is generated using PBKDF2 with HMAC-SHA-256, instead.",
+	)
+
+	return diags
+}
+
+func LegacyHashesWarning() diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.AddAttributeWarning(
+		path.Root("enable_legacy_hashes"),
+		"Legacy Hashes Enabled",
+		"The 'ntlm_hash' attribute is generated as enable_legacy_hashes = true. The NT hash is an unsalted MD4 "+
+			"hash of the password, so any password whose NT hash is disclosed, for example from the Terraform "+
+			"state, should be considered compromised.\n\n"+
+			"Only enable legacy hashes for lab environments, and remove enable_legacy_hashes once the hash is no "+
+			"longer required.",
+	)
+
+	return diags
+}
+
 func RandomnessGenerationError(errMsg string) diag.Diagnostics {
 	var diags diag.Diagnostics
 
@@ -49,3 +83,45 @@ func RandomnessGenerationError(errMsg string) diag.Diagnostics {
 
 	return diags
 }
+
+func EntropyUnavailableError(err error) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	summary := "Entropy Unavailable"
+	detail := "While attempting to generate a random value for this resource, the operating system's random number generator did not provide any random bytes in time.\n\n" +
+		"This can occur early in the boot of a virtual machine or container, before the kernel's random number generator has been initialized, " +
+		"or on platforms whose random number generator is temporarily unavailable. Retry the Terraform operation once the system has finished booting, " +
+		"install an entropy daemon such as rng-tools or haveged, or increase the 'entropy_timeout' argument of the provider configuration.\n\n"
+
+	if errors.Is(err, random.ErrGenerationTimeout) {
+		summary = "Generation Timed Out"
+		detail = "While attempting to generate a random value for this resource, the value was not generated within the 'max_generation_time' of the provider configuration.\n\n" +
+			"This can occur when the operating system's random number generator repeatedly blocks or fails, such as early in the boot of a virtual machine or container. " +
+			"Retry the Terraform operation once the system has finished booting, install an entropy daemon such as rng-tools or haveged, " +
+			"or increase the 'max_generation_time' argument of the provider configuration.\n\n"
+	}
+
+	var entropyErr *random.EntropyError
+
+	if errors.As(err, &entropyErr) {
+		detail += fmt.Sprintf("Entropy Interface: %s\nBytes Requested: %d\nTime Waited: %s\n\n",
+			entropyErr.Interface, entropyErr.BytesRequested, entropyErr.Waited.Round(time.Millisecond))
+	}
+
+	diags.AddError(summary, detail+fmt.Sprintf("Original Error: %s", err))
+
+	return diags
+}
+
+func EncryptionError(errMsg string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.AddError(
+		"Result Encryption Error",
+		"While attempting to encrypt the result with the 'result_encryption_key' of the provider configuration an error occurred.\n\n"+
+			RetryMsg+
+			fmt.Sprintf("Original Error: %s", errMsg),
+	)
+
+	return diags
+}
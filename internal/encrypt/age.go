@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package encrypt
+
+import (
+	"bytes"
+	"fmt"
+
+	"filippo.io/age"
+	"filippo.io/age/armor"
+)
+
+// AgeRecipientPrefix is the human readable part of the Bech32 encoding of an
+// age X25519 recipient, such as
+// age1ql3z7hjy54pw3hyww5ayyfg7zqgvc7w3j2elw8zmrj2kg5sfn9aqmcac8p.
+const AgeRecipientPrefix = "age"
+
+// parseAgeRecipient returns the Bech32 encoded age X25519 recipient.
+func parseAgeRecipient(value string) (*age.X25519Recipient, error) {
+	recipient, err := age.ParseX25519Recipient(value)
+	if err != nil {
+		return nil, fmt.Errorf("the age recipient could not be parsed: %w", err)
+	}
+
+	return recipient, nil
+}
+
+// encryptAge returns the plaintext encrypted to the age X25519 recipient, as
+// an ASCII armored age file. The age library reads its randomness from
+// crypto/rand.
+func encryptAge(recipient *age.X25519Recipient, plaintext []byte) (string, error) {
+	var file bytes.Buffer
+
+	armored := armor.NewWriter(&file)
+
+	w, err := age.Encrypt(armored, recipient)
+	if err != nil {
+		return "", err
+	}
+
+	if _, err := w.Write(plaintext); err != nil {
+		return "", err
+	}
+
+	if err := w.Close(); err != nil {
+		return "", err
+	}
+
+	if err := armored.Close(); err != nil {
+		return "", err
+	}
+
+	return file.String(), nil
+}
@@ -0,0 +1,122 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package encrypt
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"io"
+	"strings"
+	"testing"
+
+	"filippo.io/age"
+	"filippo.io/age/armor"
+)
+
+func TestKeyEncrypt_Age(t *testing.T) {
+	t.Parallel()
+
+	identity, err := age.GenerateX25519Identity()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	key, err := ParsePublicKey(identity.Recipient().String())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	testCases := map[string]string{
+		"short": "password",
+		// The payload is encrypted in chunks of 64 KiB.
+		"chunked":    strings.Repeat("a", 64*1024+1),
+		"full-chunk": strings.Repeat("b", 64*1024),
+	}
+
+	for name, plaintext := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			encrypted, err := key.Encrypt(rand.Reader, []byte(plaintext))
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			r, err := age.Decrypt(armor.NewReader(strings.NewReader(encrypted)), identity)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			got, err := io.ReadAll(r)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if string(got) != plaintext {
+				t.Errorf("expected plaintext of %d bytes, got %d bytes", len(plaintext), len(got))
+			}
+		})
+	}
+}
+
+func TestParsePublicKey(t *testing.T) {
+	t.Parallel()
+
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	pkix, err := x509.MarshalPKIXPublicKey(&privateKey.PublicKey)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	testCases := map[string]struct {
+		value         string
+		expectedError string
+	}{
+		// The example recipient of the age documentation.
+		"age": {
+			value: "age1ql3z7hjy54pw3hyww5ayyfg7zqgvc7w3j2elw8zmrj2kg5sfn9aqmcac8p",
+		},
+		"rsa": {
+			value: string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: pkix})),
+		},
+		"passphrase": {
+			value:         "correct horse battery staple",
+			expectedError: "the public key must be an age recipient beginning with age1, or a PEM encoded RSA public key",
+		},
+		"age-checksum": {
+			value:         "age1ql3z7hjy54pw3hyww5ayyfg7zqgvc7w3j2elw8zmrj2kg5sfn9aqmcac8q",
+			expectedError: `the age recipient could not be parsed: malformed recipient "age1ql3z7hjy54pw3hyww5ayyfg7zqgvc7w3j2elw8zmrj2kg5sfn9aqmcac8q": invalid checksum`,
+		},
+		"age-uppercase": {
+			value:         "age1QL3Z7HJY54PW3HYWW5AYYFG7ZQGVC7W3J2ELW8ZMRJ2KG5SFN9AQMCAC8P",
+			expectedError: `the age recipient could not be parsed: malformed recipient "age1QL3Z7HJY54PW3HYWW5AYYFG7ZQGVC7W3J2ELW8ZMRJ2KG5SFN9AQMCAC8P": mixed case`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := ParsePublicKey(testCase.value)
+
+			if testCase.expectedError == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil || err.Error() != testCase.expectedError {
+				t.Errorf("expected error %q, got: %v", testCase.expectedError, err)
+			}
+		})
+	}
+}
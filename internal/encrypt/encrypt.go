@@ -0,0 +1,215 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package encrypt encrypts generated values with AES-256-GCM, so that they can
+// be forwarded by pipelines without exposing the plaintext. The data key is
+// either derived from a passphrase with PBKDF2, or randomly generated and
+// wrapped with an RSA public key using RSA-OAEP.
+//
+// The encrypted value is a string of fields separated by $, in a format
+// similar to the PHC string format, with binary fields base64 encoded without
+// padding:
+//
+//	$aes-256-gcm$pbkdf2-sha256$i=<iterations>$<salt>$<nonce>$<ciphertext>
+//	$aes-256-gcm$rsa-oaep-sha256$<wrapped key>$<nonce>$<ciphertext>
+//
+// The ciphertext is followed by the 16 byte GCM authentication tag.
+//
+// Values may instead be encrypted to an age X25519 recipient, in which case
+// the encrypted value is an ASCII armored age file, which can be decrypted
+// with the age command line tool and the recipient's identity.
+package encrypt
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"filippo.io/age"
+	"golang.org/x/crypto/pbkdf2"
+)
+
+const (
+	// Algorithm is the identifier of the cipher in the encrypted value.
+	Algorithm = "aes-256-gcm"
+
+	// PassphraseKeyDerivation and PublicKeyWrapping are the identifiers of
+	// the method by which the data key is protected.
+	PassphraseKeyDerivation = "pbkdf2-sha256"
+	PublicKeyWrapping       = "rsa-oaep-sha256"
+
+	// PassphraseIterations is the number of PBKDF2 iterations, following the
+	// OWASP recommendation for PBKDF2 with HMAC-SHA-256.
+	PassphraseIterations = 600000
+
+	// MinPassphraseLength is the minimum length of a passphrase, in bytes.
+	MinPassphraseLength = 12
+
+	// MinPublicKeyBits is the minimum size of an RSA public key.
+	MinPublicKeyBits = 2048
+
+	keyLength  = 32
+	saltLength = 16
+)
+
+// Key is the passphrase, RSA public key or age recipient with which values
+// are encrypted.
+type Key struct {
+	passphrase   string
+	publicKey    *rsa.PublicKey
+	ageRecipient *age.X25519Recipient
+}
+
+// ParseKey returns the Key for the value, which is either a PEM encoded RSA
+// public key, in the PKIX ("PUBLIC KEY") or PKCS #1 ("RSA PUBLIC KEY") format,
+// or otherwise a passphrase.
+func ParseKey(value string) (*Key, error) {
+	if !strings.HasPrefix(strings.TrimSpace(value), "-----BEGIN") {
+		if len(value) < MinPassphraseLength {
+			return nil, fmt.Errorf("the passphrase must be at least %d bytes", MinPassphraseLength)
+		}
+
+		return &Key{passphrase: value}, nil
+	}
+
+	publicKey, err := parseRSAPublicKey(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Key{publicKey: publicKey}, nil
+}
+
+// ParsePublicKey returns the Key for the value, which is either a Bech32
+// encoded age X25519 recipient, beginning with age1, or a PEM encoded RSA
+// public key as accepted by ParseKey. Passphrases are not accepted, so that
+// only the holder of the private key can decrypt the value.
+func ParsePublicKey(value string) (*Key, error) {
+	value = strings.TrimSpace(value)
+
+	if strings.HasPrefix(value, AgeRecipientPrefix+"1") {
+		recipient, err := parseAgeRecipient(value)
+		if err != nil {
+			return nil, err
+		}
+
+		return &Key{ageRecipient: recipient}, nil
+	}
+
+	if !strings.HasPrefix(value, "-----BEGIN") {
+		return nil, errors.New("the public key must be an age recipient beginning with age1, or a PEM encoded RSA public key")
+	}
+
+	publicKey, err := parseRSAPublicKey(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Key{publicKey: publicKey}, nil
+}
+
+// parseRSAPublicKey returns the PEM encoded RSA public key, in the PKIX
+// ("PUBLIC KEY") or PKCS #1 ("RSA PUBLIC KEY") format.
+func parseRSAPublicKey(value string) (*rsa.PublicKey, error) {
+	block, _ := pem.Decode([]byte(strings.TrimSpace(value)))
+	if block == nil {
+		return nil, errors.New("the PEM encoded public key could not be decoded")
+	}
+
+	var publicKey *rsa.PublicKey
+
+	switch block.Type {
+	case "PUBLIC KEY":
+		parsed, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+
+		rsaKey, ok := parsed.(*rsa.PublicKey)
+		if !ok {
+			return nil, fmt.Errorf("the public key must be an RSA key, got: %T", parsed)
+		}
+
+		publicKey = rsaKey
+	case "RSA PUBLIC KEY":
+		parsed, err := x509.ParsePKCS1PublicKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+
+		publicKey = parsed
+	default:
+		return nil, fmt.Errorf("the PEM block must be a PUBLIC KEY or RSA PUBLIC KEY, got: %s", block.Type)
+	}
+
+	if bits := publicKey.N.BitLen(); bits < MinPublicKeyBits {
+		return nil, fmt.Errorf("the RSA public key must be at least %d bits, got: %d", MinPublicKeyBits, bits)
+	}
+
+	return publicKey, nil
+}
+
+// Encrypt returns the encrypted value of the plaintext, with the salt, data
+// key and nonce read from the source of entropy. Values encrypted to an age
+// recipient are encrypted by the age library, which does not use entropy.
+func (k *Key) Encrypt(entropy io.Reader, plaintext []byte) (string, error) {
+	if k.ageRecipient != nil {
+		return encryptAge(k.ageRecipient, plaintext)
+	}
+
+	dataKey := make([]byte, keyLength)
+	fields := []string{"", Algorithm}
+
+	if k.publicKey != nil {
+		if _, err := io.ReadFull(entropy, dataKey); err != nil {
+			return "", err
+		}
+
+		wrapped, err := rsa.EncryptOAEP(sha256.New(), entropy, k.publicKey, dataKey, nil)
+		if err != nil {
+			return "", err
+		}
+
+		fields = append(fields, PublicKeyWrapping, encode(wrapped))
+	} else {
+		salt := make([]byte, saltLength)
+		if _, err := io.ReadFull(entropy, salt); err != nil {
+			return "", err
+		}
+
+		dataKey = pbkdf2.Key([]byte(k.passphrase), salt, PassphraseIterations, keyLength, sha256.New)
+
+		fields = append(fields, PassphraseKeyDerivation, fmt.Sprintf("i=%d", PassphraseIterations), encode(salt))
+	}
+
+	block, err := aes.NewCipher(dataKey)
+	if err != nil {
+		return "", err
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return "", err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := io.ReadFull(entropy, nonce); err != nil {
+		return "", err
+	}
+
+	fields = append(fields, encode(nonce), encode(aead.Seal(nil, nonce, plaintext, nil)))
+
+	return strings.Join(fields, "$"), nil
+}
+
+func encode(b []byte) string {
+	return base64.RawStdEncoding.EncodeToString(b)
+}
@@ -0,0 +1,175 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package encrypt
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"strings"
+	"testing"
+
+	"golang.org/x/crypto/pbkdf2"
+)
+
+// decrypt returns the plaintext of the encrypted value, whose data key is
+// derived from the passphrase or unwrapped with the private key.
+func decrypt(t *testing.T, value, passphrase string, privateKey *rsa.PrivateKey) string {
+	t.Helper()
+
+	fields := strings.Split(value, "$")
+	if len(fields) < 6 || fields[0] != "" || fields[1] != Algorithm {
+		t.Fatalf("unexpected encrypted value: %q", value)
+	}
+
+	decode := func(s string) []byte {
+		b, err := base64.RawStdEncoding.DecodeString(s)
+		if err != nil {
+			t.Fatalf("unexpected error decoding %q: %s", s, err)
+		}
+
+		return b
+	}
+
+	var dataKey []byte
+
+	switch fields[2] {
+	case PassphraseKeyDerivation:
+		if fields[3] != "i=600000" {
+			t.Fatalf("unexpected parameters: %q", fields[3])
+		}
+
+		dataKey = pbkdf2.Key([]byte(passphrase), decode(fields[4]), PassphraseIterations, keyLength, sha256.New)
+		fields = fields[5:]
+	case PublicKeyWrapping:
+		var err error
+
+		dataKey, err = rsa.DecryptOAEP(sha256.New(), nil, privateKey, decode(fields[3]), nil)
+		if err != nil {
+			t.Fatalf("unexpected error unwrapping key: %s", err)
+		}
+
+		fields = fields[4:]
+	default:
+		t.Fatalf("unexpected key protection: %q", fields[2])
+	}
+
+	block, err := aes.NewCipher(dataKey)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	plaintext, err := aead.Open(nil, decode(fields[0]), decode(fields[1]), nil)
+	if err != nil {
+		t.Fatalf("unexpected error decrypting: %s", err)
+	}
+
+	return string(plaintext)
+}
+
+func TestKeyEncrypt(t *testing.T) {
+	t.Parallel()
+
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	pkix, err := x509.MarshalPKIXPublicKey(&privateKey.PublicKey)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	testCases := map[string]string{
+		"passphrase": "correct horse battery staple",
+		"pkix":       string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: pkix})),
+		"pkcs1": string(pem.EncodeToMemory(&pem.Block{
+			Type:  "RSA PUBLIC KEY",
+			Bytes: x509.MarshalPKCS1PublicKey(&privateKey.PublicKey),
+		})),
+	}
+
+	for name, value := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			key, err := ParseKey(value)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			encrypted, err := key.Encrypt(rand.Reader, []byte("password"))
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if strings.Contains(encrypted, "password") {
+				t.Fatalf("expected the plaintext to be encrypted, got: %q", encrypted)
+			}
+
+			if plaintext := decrypt(t, encrypted, value, privateKey); plaintext != "password" {
+				t.Errorf("expected plaintext %q, got: %q", "password", plaintext)
+			}
+		})
+	}
+}
+
+func TestParseKey_Invalid(t *testing.T) {
+	t.Parallel()
+
+	smallKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	testCases := map[string]struct {
+		value         string
+		expectedError string
+	}{
+		"short-passphrase": {
+			value:         "short",
+			expectedError: "the passphrase must be at least 12 bytes",
+		},
+		"invalid-pem": {
+			value:         "-----BEGIN PUBLIC KEY-----\nnot base64\n",
+			expectedError: "the PEM encoded public key could not be decoded",
+		},
+		"private-key": {
+			value: string(pem.EncodeToMemory(&pem.Block{
+				Type:  "RSA PRIVATE KEY",
+				Bytes: x509.MarshalPKCS1PrivateKey(smallKey),
+			})),
+			expectedError: "the PEM block must be a PUBLIC KEY or RSA PUBLIC KEY, got: RSA PRIVATE KEY",
+		},
+		"small-key": {
+			value: string(pem.EncodeToMemory(&pem.Block{
+				Type:  "RSA PUBLIC KEY",
+				Bytes: x509.MarshalPKCS1PublicKey(&smallKey.PublicKey),
+			})),
+			expectedError: "the RSA public key must be at least 2048 bits, got: 1024",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := ParseKey(testCase.value)
+
+			if err == nil || err.Error() != testCase.expectedError {
+				t.Errorf("expected error %q, got: %v", testCase.expectedError, err)
+			}
+		})
+	}
+}
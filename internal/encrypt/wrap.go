@@ -0,0 +1,146 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package encrypt
+
+import (
+	"crypto/aes"
+	"crypto/rsa"
+	"crypto/sha1" //nolint:gosec // SHA-1 is required by the RSAES_OAEP_SHA_1 and RSA_AES_KEY_WRAP_SHA_1 formats.
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+)
+
+// The key wrapping algorithms, named as the wrapping algorithms of AWS KMS key
+// material import. The RSA_AES_KEY_WRAP algorithms are the PKCS #11
+// CKM_RSA_AES_KEY_WRAP mechanism, which Azure Key Vault and most HSMs also
+// accept for the import of keys: an ephemeral AES-256 key is wrapped with
+// RSA-OAEP, followed by the key material wrapped with the AES key using AES Key
+// Wrap with Padding (RFC 5649). The RSAES_OAEP algorithms wrap the key material
+// with RSA-OAEP alone, which limits its length to that of the RSA key less the
+// OAEP padding. OAEP uses the same hash for MGF1.
+const (
+	RSAESOAEPSHA1       = "RSAES_OAEP_SHA_1"
+	RSAESOAEPSHA256     = "RSAES_OAEP_SHA_256"
+	RSAAESKeyWrapSHA1   = "RSA_AES_KEY_WRAP_SHA_1"
+	RSAAESKeyWrapSHA256 = "RSA_AES_KEY_WRAP_SHA_256"
+)
+
+// WrappingAlgorithms are the algorithms accepted by WrapKey.
+var WrappingAlgorithms = []string{
+	RSAESOAEPSHA1,
+	RSAESOAEPSHA256,
+	RSAAESKeyWrapSHA1,
+	RSAAESKeyWrapSHA256,
+}
+
+// kwpIntegrityCheck is the alternative initial value of AES Key Wrap with
+// Padding, which is followed by the length of the key material in bytes.
+var kwpIntegrityCheck = []byte{0xa6, 0x59, 0x59, 0xa6}
+
+// ParseRSAPublicKey returns the PEM encoded RSA public key, in the PKIX
+// ("PUBLIC KEY") or PKCS #1 ("RSA PUBLIC KEY") format, of at least
+// MinPublicKeyBits.
+func ParseRSAPublicKey(value string) (*rsa.PublicKey, error) {
+	return parseRSAPublicKey(value)
+}
+
+// WrapKey returns the key material wrapped with the RSA public key using the
+// wrapping algorithm, reading the ephemeral AES key and the OAEP seed from
+// entropy.
+func WrapKey(entropy io.Reader, publicKey *rsa.PublicKey, algorithm string, key []byte) ([]byte, error) {
+	var (
+		newHash    func() hash.Hash
+		aesKeyWrap bool
+	)
+
+	switch algorithm {
+	case RSAESOAEPSHA1:
+		newHash = sha1.New
+	case RSAESOAEPSHA256:
+		newHash = sha256.New
+	case RSAAESKeyWrapSHA1:
+		newHash, aesKeyWrap = sha1.New, true
+	case RSAAESKeyWrapSHA256:
+		newHash, aesKeyWrap = sha256.New, true
+	default:
+		return nil, fmt.Errorf("unsupported wrapping algorithm: %s", algorithm)
+	}
+
+	if !aesKeyWrap {
+		if maxLength := publicKey.Size() - 2*newHash().Size() - 2; len(key) > maxLength {
+			return nil, fmt.Errorf("%s can wrap at most %d bytes with a %d bit RSA key, got: %d bytes, use %s "+
+				"for longer key material", algorithm, maxLength, publicKey.N.BitLen(), len(key), RSAAESKeyWrapSHA256)
+		}
+
+		return rsa.EncryptOAEP(newHash(), entropy, publicKey, key, nil)
+	}
+
+	aesKey := make([]byte, keyLength)
+	if _, err := io.ReadFull(entropy, aesKey); err != nil {
+		return nil, err
+	}
+
+	wrappedAESKey, err := rsa.EncryptOAEP(newHash(), entropy, publicKey, aesKey, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	wrappedKey, err := wrapKeyWithPadding(aesKey, key)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(wrappedAESKey, wrappedKey...), nil
+}
+
+// wrapKeyWithPadding returns the key material wrapped with the key encryption
+// key using AES Key Wrap with Padding, as specified by RFC 5649.
+func wrapKeyWithPadding(kek, key []byte) ([]byte, error) {
+	if len(key) == 0 {
+		return nil, errors.New("the key material must not be empty")
+	}
+
+	block, err := aes.NewCipher(kek)
+	if err != nil {
+		return nil, err
+	}
+
+	// The key material is padded with zeros to a multiple of the 64-bit
+	// semiblock, following the integrity check and its length.
+	padded := make([]byte, 8+(len(key)+7)/8*8)
+	copy(padded, kwpIntegrityCheck)
+	binary.BigEndian.PutUint32(padded[4:8], uint32(len(key)))
+	copy(padded[8:], key)
+
+	// A single semiblock of key material is encrypted with the integrity check
+	// as one AES block.
+	if len(padded) == 16 {
+		block.Encrypt(padded, padded)
+
+		return padded, nil
+	}
+
+	// Otherwise the key material is wrapped with the integrity check as the
+	// initial value, as specified by RFC 3394.
+	n := len(padded)/8 - 1
+	b := make([]byte, 16)
+
+	for j := 0; j < 6; j++ {
+		for i := 1; i <= n; i++ {
+			copy(b, padded[:8])
+			copy(b[8:], padded[i*8:(i+1)*8])
+			block.Encrypt(b, b)
+
+			t := uint64(n*j + i)
+			binary.BigEndian.PutUint64(padded[:8], binary.BigEndian.Uint64(b[:8])^t)
+			copy(padded[i*8:(i+1)*8], b[8:])
+		}
+	}
+
+	return padded, nil
+}
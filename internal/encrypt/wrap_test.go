@@ -0,0 +1,193 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package encrypt
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1" //nolint:gosec // SHA-1 is required by the RSAES_OAEP_SHA_1 and RSA_AES_KEY_WRAP_SHA_1 formats.
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"hash"
+	"strings"
+	"testing"
+)
+
+// unwrapKeyWithPadding returns the key material unwrapped with the key
+// encryption key using AES Key Wrap with Padding, as specified by RFC 5649.
+func unwrapKeyWithPadding(t *testing.T, kek, wrapped []byte) []byte {
+	t.Helper()
+
+	block, err := aes.NewCipher(kek)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	padded := bytes.Clone(wrapped)
+
+	if len(padded) == 16 {
+		block.Decrypt(padded, padded)
+	} else {
+		n := len(padded)/8 - 1
+		b := make([]byte, 16)
+
+		for j := 5; j >= 0; j-- {
+			for i := n; i >= 1; i-- {
+				binary.BigEndian.PutUint64(b, binary.BigEndian.Uint64(padded[:8])^uint64(n*j+i))
+				copy(b[8:], padded[i*8:(i+1)*8])
+				block.Decrypt(b, b)
+
+				copy(padded[:8], b[:8])
+				copy(padded[i*8:(i+1)*8], b[8:])
+			}
+		}
+	}
+
+	if !bytes.Equal(padded[:4], kwpIntegrityCheck) {
+		t.Fatalf("unexpected integrity check: %x", padded[:4])
+	}
+
+	return padded[8 : 8+binary.BigEndian.Uint32(padded[4:8])]
+}
+
+func TestWrapKeyWithPadding(t *testing.T) {
+	t.Parallel()
+
+	// The test vectors of RFC 5649, section 6.
+	kek := "5840df6e29b02af1ab493b705bf16ea1ae8338f4dcc176a8"
+
+	testCases := map[string]struct {
+		key      string
+		expected string
+	}{
+		"20-octets": {
+			key:      "c37b7e6492584340bed12207808941155068f738",
+			expected: "138bdeaa9b8fa7fc61f97742e72248ee5ae6ae5360d1ae6a5f54f373fa543b6a",
+		},
+		"7-octets": {
+			key:      "466f7250617369",
+			expected: "afbeb0f07dfbf5419200f2ccb50bb24f",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			kekBytes, _ := hex.DecodeString(kek)
+			keyBytes, _ := hex.DecodeString(testCase.key)
+
+			wrapped, err := wrapKeyWithPadding(kekBytes, keyBytes)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got := hex.EncodeToString(wrapped); got != testCase.expected {
+				t.Errorf("expected %s, got: %s", testCase.expected, got)
+			}
+
+			if unwrapped := unwrapKeyWithPadding(t, kekBytes, wrapped); !bytes.Equal(unwrapped, keyBytes) {
+				t.Errorf("expected unwrapped key %x, got: %x", keyBytes, unwrapped)
+			}
+		})
+	}
+}
+
+func TestWrapKey(t *testing.T) {
+	t.Parallel()
+
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	testCases := map[string]struct {
+		newHash    func() hash.Hash
+		aesKeyWrap bool
+	}{
+		RSAESOAEPSHA1:       {newHash: sha1.New},
+		RSAESOAEPSHA256:     {newHash: sha256.New},
+		RSAAESKeyWrapSHA1:   {newHash: sha1.New, aesKeyWrap: true},
+		RSAAESKeyWrapSHA256: {newHash: sha256.New, aesKeyWrap: true},
+	}
+
+	for algorithm, testCase := range testCases {
+		t.Run(algorithm, func(t *testing.T) {
+			t.Parallel()
+
+			wrapped, err := WrapKey(rand.Reader, &privateKey.PublicKey, algorithm, key)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			rsaCiphertext := wrapped
+
+			if testCase.aesKeyWrap {
+				// The RSA-OAEP ciphertext is the length of the RSA modulus, and
+				// the wrapped key material follows it.
+				rsaCiphertext = wrapped[:privateKey.Size()]
+			}
+
+			unwrapped, err := rsa.DecryptOAEP(testCase.newHash(), nil, privateKey, rsaCiphertext, nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if testCase.aesKeyWrap {
+				unwrapped = unwrapKeyWithPadding(t, unwrapped, wrapped[privateKey.Size():])
+			}
+
+			if !bytes.Equal(unwrapped, key) {
+				t.Errorf("expected unwrapped key %x, got: %x", key, unwrapped)
+			}
+		})
+	}
+}
+
+func TestWrapKey_Invalid(t *testing.T) {
+	t.Parallel()
+
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	testCases := map[string]struct {
+		algorithm     string
+		key           []byte
+		expectedError string
+	}{
+		"unsupported-algorithm": {
+			algorithm:     "RSA_AES_KEY_WRAP_SHA_512",
+			key:           make([]byte, 32),
+			expectedError: "unsupported wrapping algorithm: RSA_AES_KEY_WRAP_SHA_512",
+		},
+		"oaep-too-long": {
+			algorithm: RSAESOAEPSHA256,
+			key:       make([]byte, 191),
+			expectedError: "RSAES_OAEP_SHA_256 can wrap at most 190 bytes with a 2048 bit RSA key, got: 191 bytes, " +
+				"use RSA_AES_KEY_WRAP_SHA_256 for longer key material",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := WrapKey(rand.Reader, &privateKey.PublicKey, testCase.algorithm, testCase.key)
+
+			if err == nil || !strings.Contains(err.Error(), testCase.expectedError) {
+				t.Errorf("expected error %q, got: %v", testCase.expectedError, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,122 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package metrics counts the work done by the provider process, such as the
+// number of random values generated, so that applies which create thousands of
+// random resources can be profiled. The counters are process-wide, and are
+// written as JSON when the provider stops if the provider is run in debug mode
+// with a metrics file:
+//
+//	{
+//	  "generations": {"crypto/rand": 1200, "derived": 40},
+//	  "entropy_bytes": 65536,
+//	  "bcrypt_hashes": 300,
+//	  "bcrypt_duration_ms": 21000
+//	}
+package metrics
+
+import (
+	"encoding/json"
+	"io"
+	"maps"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+var (
+	generationsMu sync.Mutex
+	generations   = map[string]int64{}
+
+	entropyBytes atomic.Int64
+	bcryptHashes atomic.Int64
+	bcryptNanos  atomic.Int64
+)
+
+// Snapshot is the value of the counters at a point in time.
+type Snapshot struct {
+	// Generations is the number of random values generated by resources, data
+	// sources and ephemeral resources, keyed by the source of randomness, such
+	// as crypto/rand.
+	Generations map[string]int64 `json:"generations"`
+
+	// EntropyBytes is the number of bytes read from the source of entropy,
+	// including those buffered but not yet consumed.
+	EntropyBytes int64 `json:"entropy_bytes"`
+
+	// BcryptHashes is the number of bcrypt hashes generated.
+	BcryptHashes int64 `json:"bcrypt_hashes"`
+
+	// BcryptDurationMS is the total time spent generating bcrypt hashes, in
+	// milliseconds, excluding the time spent waiting for a hashing worker.
+	BcryptDurationMS int64 `json:"bcrypt_duration_ms"`
+}
+
+// RecordGeneration counts the generation of a random value from the source of
+// randomness.
+func RecordGeneration(source string) {
+	generationsMu.Lock()
+	defer generationsMu.Unlock()
+
+	generations[source]++
+}
+
+// RecordBcrypt counts the generation of a bcrypt hash which took the duration.
+func RecordBcrypt(duration time.Duration) {
+	bcryptHashes.Add(1)
+	bcryptNanos.Add(int64(duration))
+}
+
+// NewEntropyReader returns a reader which reads from the given reader, counting
+// the bytes read as entropy. The interface of the underlying reader is
+// preserved for diagnostics.
+func NewEntropyReader(reader io.Reader) io.Reader {
+	return &entropyReader{reader: reader}
+}
+
+type entropyReader struct {
+	reader io.Reader
+}
+
+func (r *entropyReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+
+	entropyBytes.Add(int64(n))
+
+	return n, err
+}
+
+// Interface returns the interface from which the underlying reader reads
+// entropy, or an empty string if it is not known.
+func (r *entropyReader) Interface() string {
+	if iface, ok := r.reader.(interface{ Interface() string }); ok {
+		return iface.Interface()
+	}
+
+	return ""
+}
+
+// Read returns the current value of the counters.
+func Read() Snapshot {
+	generationsMu.Lock()
+	defer generationsMu.Unlock()
+
+	return Snapshot{
+		Generations:      maps.Clone(generations),
+		EntropyBytes:     entropyBytes.Load(),
+		BcryptHashes:     bcryptHashes.Load(),
+		BcryptDurationMS: time.Duration(bcryptNanos.Load()).Milliseconds(),
+	}
+}
+
+// WriteFile writes the current value of the counters to the file at the path
+// as indented JSON, replacing the file if it exists.
+func WriteFile(path string) error {
+	data, err := json.MarshalIndent(Read(), "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, append(data, '\n'), 0o600)
+}
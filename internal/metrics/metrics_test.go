@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package metrics
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// The counters are process-wide, so the tests compare the difference between
+// snapshots and do not run in parallel.
+
+func TestRecord(t *testing.T) {
+	before := Read()
+
+	RecordGeneration("crypto/rand")
+	RecordGeneration("crypto/rand")
+	RecordGeneration("derived")
+	RecordBcrypt(1500 * time.Millisecond)
+
+	after := Read()
+
+	if got := after.Generations["crypto/rand"] - before.Generations["crypto/rand"]; got != 2 {
+		t.Errorf("expected 2 crypto/rand generations, got %d", got)
+	}
+
+	if got := after.Generations["derived"] - before.Generations["derived"]; got != 1 {
+		t.Errorf("expected 1 derived generation, got %d", got)
+	}
+
+	if got := after.BcryptHashes - before.BcryptHashes; got != 1 {
+		t.Errorf("expected 1 bcrypt hash, got %d", got)
+	}
+
+	if got := after.BcryptDurationMS - before.BcryptDurationMS; got < 1499 || got > 1500 {
+		t.Errorf("expected 1500ms bcrypt duration, got %d", got)
+	}
+}
+
+type interfaceReader struct {
+	*bytes.Reader
+}
+
+func (r interfaceReader) Interface() string {
+	return "test"
+}
+
+func TestNewEntropyReader(t *testing.T) {
+	before := Read()
+
+	reader := NewEntropyReader(interfaceReader{bytes.NewReader(make([]byte, 24))})
+
+	if _, err := reader.Read(make([]byte, 16)); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got := Read().EntropyBytes - before.EntropyBytes; got != 16 {
+		t.Errorf("expected 16 entropy bytes, got %d", got)
+	}
+
+	iface, ok := reader.(interface{ Interface() string })
+	if !ok || iface.Interface() != "test" {
+		t.Errorf("expected the interface of the underlying reader to be preserved")
+	}
+}
+
+func TestWriteFile(t *testing.T) {
+	RecordGeneration("crypto/rand")
+
+	path := filepath.Join(t.TempDir(), "metrics.json")
+
+	if err := WriteFile(path); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var got Snapshot
+
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got.Generations["crypto/rand"] < 1 {
+		t.Errorf("expected crypto/rand generations to be written, got: %s", data)
+	}
+}
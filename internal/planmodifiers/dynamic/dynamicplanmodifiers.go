@@ -0,0 +1,50 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dynamicplanmodifiers
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+)
+
+// UseStateForUnknownIncludingNull returns a plan modifier that copies a known
+// prior state value, including null, into the planned value. Unlike
+// dynamicplanmodifier.UseStateForUnknown, a null prior state value is also
+// preserved, which prevents computed attributes that were introduced after a
+// resource was created from showing as unknown during in-place updates.
+func UseStateForUnknownIncludingNull() planmodifier.Dynamic {
+	return useStateForUnknownIncludingNullModifier{}
+}
+
+type useStateForUnknownIncludingNullModifier struct{}
+
+// Description returns a human-readable description of the plan modifier.
+func (m useStateForUnknownIncludingNullModifier) Description(_ context.Context) string {
+	return "Once set, the value of this attribute in state will not change."
+}
+
+// MarkdownDescription returns a markdown description of the plan modifier.
+func (m useStateForUnknownIncludingNullModifier) MarkdownDescription(_ context.Context) string {
+	return "Once set, the value of this attribute in state will not change."
+}
+
+func (m useStateForUnknownIncludingNullModifier) PlanModifyDynamic(ctx context.Context, req planmodifier.DynamicRequest, resp *planmodifier.DynamicResponse) {
+	// Do nothing if there is no state (resource is being created).
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	// Do nothing if there is a known planned value.
+	if !req.PlanValue.IsUnknown() {
+		return
+	}
+
+	// Do nothing if there is an unknown configuration value, otherwise interpolation gets messed up.
+	if req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	resp.PlanValue = req.StateValue
+}
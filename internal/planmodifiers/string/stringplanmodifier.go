@@ -42,3 +42,43 @@ func RequiresReplaceUnlessEmptyStringToNull() stringplanmodifier.RequiresReplace
 		resp.RequiresReplace = false
 	}
 }
+
+// UseStateForUnknownIncludingNull returns a plan modifier that copies a known
+// prior state value, including null, into the planned value. Unlike
+// stringplanmodifier.UseStateForUnknown, a null prior state value is also
+// preserved, which prevents computed attributes that were introduced after a
+// resource was created from showing as unknown during in-place updates.
+func UseStateForUnknownIncludingNull() planmodifier.String {
+	return useStateForUnknownIncludingNullModifier{}
+}
+
+type useStateForUnknownIncludingNullModifier struct{}
+
+// Description returns a human-readable description of the plan modifier.
+func (m useStateForUnknownIncludingNullModifier) Description(_ context.Context) string {
+	return "Once set, the value of this attribute in state will not change."
+}
+
+// MarkdownDescription returns a markdown description of the plan modifier.
+func (m useStateForUnknownIncludingNullModifier) MarkdownDescription(_ context.Context) string {
+	return "Once set, the value of this attribute in state will not change."
+}
+
+func (m useStateForUnknownIncludingNullModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	// Do nothing if there is no state (resource is being created).
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	// Do nothing if there is a known planned value.
+	if !req.PlanValue.IsUnknown() {
+		return
+	}
+
+	// Do nothing if there is an unknown configuration value, otherwise interpolation gets messed up.
+	if req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	resp.PlanValue = req.StateValue
+}
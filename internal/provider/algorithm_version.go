@@ -0,0 +1,62 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// algorithmVersionAttribute returns the schema for the algorithm_version
+// attribute of resources whose seeded results must be reproducible across
+// provider versions, given the current version of the algorithm of the
+// resource. The earlier versions must remain supported.
+func algorithmVersionAttribute(current int64) schema.Int64Attribute {
+	return schema.Int64Attribute{
+		Description: fmt.Sprintf("The version of the algorithm with which the result is generated. When not "+
+			"set, the current version, `%d`, is used and recorded in state. A change to the provider which "+
+			"would generate a different result for the same `seed` increments the current version, so to "+
+			"reproduce a seeded result when the resource is replaced by a later provider version, set this "+
+			"to the version recorded in state. Setting an earlier version than the current version returns "+
+			"a warning, as the earlier algorithm is emulated.", current),
+		Optional: true,
+		Computed: true,
+		PlanModifiers: []planmodifier.Int64{
+			int64planmodifier.UseStateForUnknown(),
+			int64planmodifier.RequiresReplace(),
+		},
+		Validators: []validator.Int64{
+			int64validator.Between(1, current),
+		},
+	}
+}
+
+// algorithmVersionWarning returns a warning if the configured algorithm
+// version is earlier than the current version.
+func algorithmVersionWarning(version types.Int64, current int64) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if version.IsNull() || version.IsUnknown() || version.ValueInt64() >= current {
+		return diags
+	}
+
+	diags.AddAttributeWarning(
+		path.Root("algorithm_version"),
+		"Earlier Algorithm Version",
+		fmt.Sprintf("Attribute algorithm_version is %d, so the result is generated by emulating an earlier "+
+			"algorithm than the current version, %d. This reproduces results generated with the same seed by "+
+			"earlier provider versions. Remove algorithm_version, or set it to %d, when reproducing those "+
+			"results is no longer needed.", version.ValueInt64(), current, current),
+	)
+
+	return diags
+}
@@ -0,0 +1,45 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestAlgorithmVersionWarning(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		version         types.Int64
+		expectedWarning bool
+	}{
+		"null": {
+			version: types.Int64Null(),
+		},
+		"unknown": {
+			version: types.Int64Unknown(),
+		},
+		"current": {
+			version: types.Int64Value(2),
+		},
+		"earlier": {
+			version:         types.Int64Value(1),
+			expectedWarning: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := algorithmVersionWarning(testCase.version, 2)
+
+			if got := diags.WarningsCount() > 0; got != testCase.expectedWarning {
+				t.Errorf("expected warning %t, got: %v", testCase.expectedWarning, diags)
+			}
+		})
+	}
+}
@@ -0,0 +1,128 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	// regenerateOnKeeperChange regenerates the value when keepers change,
+	// which is the behaviour when regenerate is not set.
+	regenerateOnKeeperChange = "on_keeper_change"
+
+	// regenerateNever keeps the value when keepers change.
+	regenerateNever = "never"
+
+	// regenerateAlways regenerates the value on every apply.
+	regenerateAlways = "always"
+)
+
+func regenerateAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: "When the bytes are regenerated. With `on_keeper_change`, the bytes are regenerated when " +
+			"`keepers` change. With `never`, changes to `keepers` are updated in place, and `keepers_hash` " +
+			"changes without the bytes being regenerated. With `always`, the resource is replaced on every " +
+			"apply, so that new bytes are generated each time, as an ephemeral resource would, while the " +
+			"bytes remain available in state to other resources; this cannot be combined with " +
+			"`lifecycle_guard`. Changes to `length` always regenerate the bytes. Changing this value does not " +
+			"replace the resource, other than to `always`. Default value is `on_keeper_change`.",
+		Optional: true,
+		Validators: []validator.String{
+			stringvalidator.OneOf(regenerateOnKeeperChange, regenerateNever, regenerateAlways),
+		},
+	}
+}
+
+// regenerateKeepersPlanModifier returns a plan modifier for the keepers
+// attribute which requires replacement as RequiresReplace does, unless
+// regenerate is never.
+func regenerateKeepersPlanModifier() planmodifier.Map {
+	return regenerateKeepersModifier{}
+}
+
+type regenerateKeepersModifier struct{}
+
+func (m regenerateKeepersModifier) Description(ctx context.Context) string {
+	return m.MarkdownDescription(ctx)
+}
+
+func (m regenerateKeepersModifier) MarkdownDescription(context.Context) string {
+	return "If the value of this attribute changes, Terraform will destroy and recreate the resource, unless " +
+		"regenerate is never."
+}
+
+func (m regenerateKeepersModifier) PlanModifyMap(ctx context.Context, req planmodifier.MapRequest, resp *planmodifier.MapResponse) {
+	// Do nothing if the resource is being created or destroyed.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var regenerate types.String
+
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("regenerate"), &regenerate)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// An unknown value is treated as on_keeper_change, so that the value is
+	// never kept when it may need regenerating.
+	if regenerate.ValueString() == regenerateNever {
+		return
+	}
+
+	mapplanmodifier.RequiresReplace().PlanModifyMap(ctx, req, resp)
+}
+
+// regenerateOnApply replaces the resource in every plan which updates it when
+// regenerate is always, by marking the generated values as unknown. The paths
+// which require replacement are ignored by Terraform unless their planned
+// value differs from the prior state, so regenerate itself cannot be used.
+func regenerateOnApply(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, generated ...path.Path) {
+	// Do nothing if the resource is being destroyed.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var (
+		regenerate     types.String
+		lifecycleGuard types.Bool
+	)
+
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("regenerate"), &regenerate)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("lifecycle_guard"), &lifecycleGuard)...)
+	if resp.Diagnostics.HasError() || regenerate.ValueString() != regenerateAlways {
+		return
+	}
+
+	if lifecycleGuard.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("regenerate"),
+			"Invalid Attribute Combination",
+			"Attribute regenerate cannot be \"always\" when lifecycle_guard is enabled, as the resource would "+
+				"be replaced on every apply.",
+		)
+		return
+	}
+
+	// A resource being created is generated regardless.
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	for _, p := range generated {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, p, types.StringUnknown())...)
+	}
+
+	resp.RequiresReplace = append(resp.RequiresReplace, generated...)
+}
@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/base64"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/terraform-providers/terraform-provider-random/internal/encrypt"
+	"github.com/terraform-providers/terraform-provider-random/internal/random"
+	"github.com/terraform-providers/terraform-provider-random/internal/validators"
+)
+
+// defaultWrappingAlgorithm is the wrapping algorithm of random_bytes when
+// wrapping_algorithm is not set, which can wrap key material of any length.
+const defaultWrappingAlgorithm = encrypt.RSAAESKeyWrapSHA256
+
+func wrappingPublicKeyAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: "The PEM encoded RSA public key of at least 2048 bits, such as the wrapping key downloaded " +
+			"from AWS KMS or the key exchange key of Azure Key Vault, with which the bytes are wrapped in " +
+			"`wrapped_key`, so that they can be imported into a KMS or HSM without passing through sensitive " +
+			"outputs. Changing this value wraps the bytes with the new key without replacing the resource.",
+		Optional: true,
+		Validators: []validator.String{
+			validators.RSAPublicKey(),
+		},
+	}
+}
+
+func wrappingAlgorithmAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: "The algorithm with which the bytes are wrapped, named as the wrapping algorithms of AWS KMS " +
+			"key import. The `RSA_AES_KEY_WRAP_SHA_1` and `RSA_AES_KEY_WRAP_SHA_256` algorithms are the PKCS #11 " +
+			"`CKM_RSA_AES_KEY_WRAP` mechanism, which wraps an ephemeral AES-256 key with RSA-OAEP and the bytes " +
+			"with AES Key Wrap with Padding (RFC 5649). The `RSAES_OAEP_SHA_1` and `RSAES_OAEP_SHA_256` " +
+			"algorithms wrap the bytes with RSA-OAEP alone, which limits `length` to 190 bytes for a 2048 bit " +
+			"key with SHA-256. Azure Key Vault expects `RSA_AES_KEY_WRAP_SHA_1`. Defaults to `RSA_AES_KEY_WRAP_SHA_256`. " +
+			"Changing this value wraps the bytes again without replacing the resource.",
+		Optional: true,
+		Validators: []validator.String{
+			stringvalidator.OneOf(encrypt.WrappingAlgorithms...),
+			stringvalidator.AlsoRequires(path.MatchRoot("wrapping_public_key")),
+		},
+	}
+}
+
+// wrappedKeyAttribute returns the schema for the wrapped_key attribute. As
+// with wrapped_result, the value is not sensitive, as it can only be unwrapped
+// with the private key of wrapping_public_key.
+func wrappedKeyAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: "The bytes wrapped with `wrapping_public_key` using `wrapping_algorithm`, presented in base64 " +
+			"string format. For the `RSA_AES_KEY_WRAP` algorithms this is the RSA-OAEP wrapped AES key followed " +
+			"by the AES wrapped bytes, as AWS KMS and Azure Key Vault expect for the import of key material. " +
+			"This value is `null` unless `wrapping_public_key` is set.",
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			wrappedValueModifier{key: "wrapping_public_key", parameters: []string{"wrapping_algorithm"}},
+		},
+	}
+}
+
+// wrapBytes returns the bytes wrapped with the wrapping public key, encoded in
+// base64, or null if no wrapping public key is set.
+func wrapBytes(publicKey, algorithm types.String, entropy *random.Source, bytes []byte) (types.String, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if publicKey.IsNull() {
+		return types.StringNull(), nil
+	}
+
+	wrappingAlgorithm := defaultWrappingAlgorithm
+	if !algorithm.IsNull() {
+		wrappingAlgorithm = algorithm.ValueString()
+	}
+
+	key, err := encrypt.ParseRSAPublicKey(publicKey.ValueString())
+	if err == nil {
+		var wrapped []byte
+
+		wrapped, err = encrypt.WrapKey(entropy, key, wrappingAlgorithm, bytes)
+		if err == nil {
+			return types.StringValue(base64.StdEncoding.EncodeToString(wrapped)), nil
+		}
+	}
+
+	diags.AddAttributeError(
+		path.Root("wrapping_public_key"),
+		"Key Wrapping Error",
+		"While attempting to wrap the bytes with the 'wrapping_public_key' an error occurred.\n\n"+
+			"Original Error: "+err.Error(),
+	)
+
+	return types.StringNull(), diags
+}
@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// charsetPresets are the alphabets of charset_preset, keyed by name.
+var charsetPresets = map[string]string{
+	// The Bitcoin alphabet, which omits 0, O, I and l, as they are easily
+	// confused.
+	"base58": "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz",
+	// The RFC 4648 base 32 alphabet.
+	"base32":       "ABCDEFGHIJKLMNOPQRSTUVWXYZ234567",
+	"hex":          "0123456789abcdef",
+	"alphanumeric": "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789",
+}
+
+// charsetPresetConflicts are the attributes which configure the character
+// classes, which cannot be configured with charset_preset.
+var charsetPresetConflicts = []string{
+	"special",
+	"upper",
+	"lower",
+	"number",
+	"numeric",
+	"min_numeric",
+	"min_upper",
+	"min_lower",
+	"min_special",
+	"override_special",
+	"override_special_list",
+}
+
+// charsetPresetAttribute returns the schema for the charset_preset attribute,
+// which configures the characters of the result with a single attribute. The
+// attribute also conflicts with the given attributes, which are specific to
+// the resource.
+func charsetPresetAttribute(conflictsWith ...path.Expression) schema.StringAttribute {
+	for _, name := range charsetPresetConflicts {
+		conflictsWith = append(conflictsWith, path.MatchRoot(name))
+	}
+
+	return schema.StringAttribute{
+		Description: "Choose the characters of the result from a common alphabet, in place of the character " +
+			"class arguments. Valid values are `alphanumeric` (`A-Z`, `a-z` and `0-9`), `base32` (the RFC 4648 " +
+			"alphabet `A-Z` and `2-7`), `base58` (the Bitcoin alphabet, which omits `0`, `O`, `I` and `l` as " +
+			"they are easily confused) and `hex` (`0-9` and `a-f`). Conflicts with `special`, `upper`, " +
+			"`lower`, `number`, `numeric`, `override_special`, `override_special_list` and the `min_*` " +
+			"arguments.",
+		Optional: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+		Validators: []validator.String{
+			stringvalidator.OneOf("alphanumeric", "base32", "base58", "hex"),
+			stringvalidator.ConflictsWith(conflictsWith...),
+		},
+	}
+}
+
+// charsetPresetChars returns the alphabet of the charset_preset value, or an
+// empty string if it is null or unknown.
+func charsetPresetChars(preset types.String) string {
+	return charsetPresets[preset.ValueString()]
+}
@@ -0,0 +1,43 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash/crc32"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// checksumAttribute returns the schema for a checksum attribute. The value is
+// calculated from the generated value, so resources created by earlier provider
+// versions have the value populated during refresh.
+func checksumAttribute(algorithm, of string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: fmt.Sprintf("The %s checksum of %s, encoded as lowercase hexadecimal digits, for systems "+
+			"which require a digest of the value, such as for content-addressable names.", algorithm, of),
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+// checksums returns the hex encoded CRC-32 (IEEE), SHA-1 and SHA-256 checksums
+// of the data.
+func checksums(data []byte) (types.String, types.String, types.String) {
+	crc := crc32.ChecksumIEEE(data)
+	sha1Sum := sha1.Sum(data)
+	sha256Sum := sha256.Sum256(data)
+
+	return types.StringValue(fmt.Sprintf("%08x", crc)),
+		types.StringValue(hex.EncodeToString(sha1Sum[:])),
+		types.StringValue(hex.EncodeToString(sha256Sum[:]))
+}
@@ -0,0 +1,52 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+)
+
+func TestChecksums(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		data           string
+		expectedCRC32  string
+		expectedSHA1   string
+		expectedSHA256 string
+	}{
+		"empty": {
+			data:           "",
+			expectedCRC32:  "00000000",
+			expectedSHA1:   "da39a3ee5e6b4b0d3255bfef95601890afd80709",
+			expectedSHA256: "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+		},
+		"abc": {
+			data:           "abc",
+			expectedCRC32:  "352441c2",
+			expectedSHA1:   "a9993e364706816aba3e25717850c26c9cd0d89d",
+			expectedSHA256: "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			crc, sha1Sum, sha256Sum := checksums([]byte(testCase.data))
+
+			if crc.ValueString() != testCase.expectedCRC32 {
+				t.Errorf("expected crc32 %q, got: %q", testCase.expectedCRC32, crc.ValueString())
+			}
+
+			if sha1Sum.ValueString() != testCase.expectedSHA1 {
+				t.Errorf("expected sha1 %q, got: %q", testCase.expectedSHA1, sha1Sum.ValueString())
+			}
+
+			if sha256Sum.ValueString() != testCase.expectedSHA256 {
+				t.Errorf("expected sha256 %q, got: %q", testCase.expectedSHA256, sha256Sum.ValueString())
+			}
+		})
+	}
+}
@@ -0,0 +1,140 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/terraform-providers/terraform-provider-random/internal/random"
+)
+
+var (
+	_ datasource.DataSource                   = (*integerDataSource)(nil)
+	_ datasource.DataSourceWithConfigure      = (*integerDataSource)(nil)
+	_ datasource.DataSourceWithValidateConfig = (*integerDataSource)(nil)
+)
+
+func NewIntegerDataSource() datasource.DataSource {
+	return &integerDataSource{}
+}
+
+type integerDataSource struct {
+	entropy *random.Source
+}
+
+func (d *integerDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_integer"
+}
+
+func (d *integerDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	d.entropy = providerEntropy(req.ProviderData)
+}
+
+func (d *integerDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = integerDataSourceSchema()
+}
+
+func (d *integerDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data integerDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The configuration may have contained unknown values during validation.
+	resp.Diagnostics.Append(validateIntegerRange(data.Min, data.Max)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The data source does not record an algorithm version, so its seeded
+	// results are always generated with the legacy algorithm to remain stable.
+	result, err := randomInteger(d.entropy, int(data.Min.ValueInt64()), int(data.Max.ValueInt64()), data.Seed.ValueString(), integerLegacyAlgorithmVersion)
+	if err != nil {
+		resp.Diagnostics.Append(entropyDiagnostics(err)...)
+		return
+	}
+
+	data.Result = types.Int64Value(int64(result))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// ValidateConfig ensures that the range is not empty.
+func (d *integerDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data integerDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateIntegerRange(data.Min, data.Max)...)
+}
+
+// validateIntegerRange ensures that the minimum value is less than or equal to
+// the maximum value, when both are known.
+func validateIntegerRange(minVal, maxVal types.Int64) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if minVal.IsNull() || minVal.IsUnknown() || maxVal.IsNull() || maxVal.IsUnknown() {
+		return diags
+	}
+
+	if maxVal.ValueInt64() < minVal.ValueInt64() {
+		diags.AddAttributeError(
+			path.Root("max"),
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute max value must be greater than or equal to min (%d), got: %d", minVal.ValueInt64(), maxVal.ValueInt64()),
+		)
+	}
+
+	return diags
+}
+
+func integerDataSourceSchema() schema.Schema {
+	return schema.Schema{
+		Description: "The data source `random_integer` generates a random value from a given range, described " +
+			"by the `min` and `max` attributes of a given resource. Unlike the `random_integer` resource, the " +
+			"result is not stored, so a new value is generated every time Terraform reads the data source, such " +
+			"as during each plan or refresh. This is useful to jitter values, such as autoscaling cooldowns, on " +
+			"every run. Set `seed` to produce the same value on every run.",
+		Attributes: map[string]schema.Attribute{
+			"min": schema.Int64Attribute{
+				Description: "The minimum inclusive value of the range.",
+				Required:    true,
+			},
+			"max": schema.Int64Attribute{
+				Description: "The maximum inclusive value of the range.",
+				Required:    true,
+			},
+			"seed": schema.StringAttribute{
+				Description: "A custom seed to always produce the same value.",
+				Optional:    true,
+			},
+			"result": schema.Int64Attribute{
+				Description: "The random integer result.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+type integerDataSourceModel struct {
+	Min    types.Int64  `tfsdk:"min"`
+	Max    types.Int64  `tfsdk:"max"`
+	Seed   types.String `tfsdk:"seed"`
+	Result types.Int64  `tfsdk:"result"`
+}
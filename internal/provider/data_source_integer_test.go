@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+// The seeded result matches that of the random_integer resource, as both use
+// the same generation algorithm.
+func TestAccDataSourceInteger(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `data "random_integer" "test" {
+							min  = 1
+							max  = 3
+							seed = "12345"
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("data.random_integer.test", tfjsonpath.New("result"), knownvalue.Int64Exact(3)),
+				},
+			},
+		},
+	})
+}
+
+func TestAccDataSourceInteger_Unseeded(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `data "random_integer" "test" {
+							min = 7
+							max = 7
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("data.random_integer.test", tfjsonpath.New("result"), knownvalue.Int64Exact(7)),
+				},
+			},
+		},
+	})
+}
+
+func TestAccDataSourceInteger_Range_Invalid(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `data "random_integer" "test" {
+							min = 10
+							max = 1
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute max value must be greater than or equal to min \(10\), got: 1`),
+			},
+		},
+	})
+}
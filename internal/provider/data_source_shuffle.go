@@ -0,0 +1,152 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/terraform-providers/terraform-provider-random/internal/random"
+)
+
+var (
+	_ datasource.DataSource                   = (*shuffleDataSource)(nil)
+	_ datasource.DataSourceWithConfigure      = (*shuffleDataSource)(nil)
+	_ datasource.DataSourceWithValidateConfig = (*shuffleDataSource)(nil)
+)
+
+func NewShuffleDataSource() datasource.DataSource {
+	return &shuffleDataSource{}
+}
+
+type shuffleDataSource struct {
+	entropy *random.Source
+}
+
+func (d *shuffleDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_shuffle"
+}
+
+func (d *shuffleDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	d.entropy = providerEntropy(req.ProviderData)
+}
+
+func (d *shuffleDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = shuffleDataSourceSchema()
+}
+
+func (d *shuffleDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data shuffleDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	inputElements := data.Input.Elements()
+	resultCount := int64(len(inputElements))
+
+	if !data.ResultCount.IsNull() {
+		resultCount = data.ResultCount.ValueInt64()
+	}
+
+	resultElements, err := shuffleElements(d.entropy, inputElements, resultCount, data.Seed.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(entropyDiagnostics(err)...)
+		return
+	}
+
+	result, diags := types.ListValue(types.StringType, resultElements)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Result = result
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// ValidateConfig warns when results were requested from an empty input list, as the result will be empty.
+func (d *shuffleDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data shuffleDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Input.IsNull() || data.Input.IsUnknown() || data.ResultCount.IsNull() || data.ResultCount.IsUnknown() {
+		return
+	}
+
+	if resultCount := data.ResultCount.ValueInt64(); len(data.Input.Elements()) == 0 && resultCount > 0 {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("result_count"),
+			"Result Will Be Empty",
+			fmt.Sprintf("Attribute result_count is %d, but the input list is empty, so the result will contain "+
+				"no elements.", resultCount),
+		)
+	}
+}
+
+func shuffleDataSourceSchema() schema.Schema {
+	return schema.Schema{
+		Description: "The data source `random_shuffle` generates a random permutation of a list of strings " +
+			"given as an argument. Unlike the `random_shuffle` resource, the result is not stored, so a new " +
+			"permutation is generated every time Terraform reads the data source, such as during each plan. " +
+			"This is useful when a different order is explicitly wanted for every run, for instance to spread " +
+			"deployments across availability zones. Set `seed` to produce the same permutation on every run.",
+		Attributes: map[string]schema.Attribute{
+			"seed": schema.StringAttribute{
+				Description: "Arbitrary string with which to seed the random number generator, in order to " +
+					"produce the same permutation of the list every time the data source is read.\n" +
+					"\n" +
+					"**Important:** Even with an identical seed, it is not guaranteed that the same permutation " +
+					"will be produced across different versions of Terraform.",
+				Optional: true,
+			},
+			"input": schema.ListAttribute{
+				Description: "The list of strings to shuffle.",
+				ElementType: types.StringType,
+				Required:    true,
+			},
+			"result_count": schema.Int64Attribute{
+				Description: "The number of results to return. Defaults to the number of items in the " +
+					"`input` list. If fewer items are requested, some elements will be excluded from the " +
+					"result. If more items are requested, items will be repeated in the result but not more " +
+					"frequently than the number of items in the input list. If the input list is empty, the " +
+					"result is always empty. The minimum value is 0.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"result": schema.ListAttribute{
+				Description: "Random permutation of the list of strings given in `input`. The number of " +
+					"elements is determined by `result_count` if set, or the number of elements in `input`.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+type shuffleDataSourceModel struct {
+	Seed        types.String `tfsdk:"seed"`
+	Input       types.List   `tfsdk:"input"`
+	ResultCount types.Int64  `tfsdk:"result_count"`
+	Result      types.List   `tfsdk:"result"`
+}
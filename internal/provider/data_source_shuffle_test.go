@@ -0,0 +1,108 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+// The seeded results match those of the random_shuffle resource, as both use
+// the same permutation algorithm.
+func TestAccDataSourceShuffle(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `data "random_shuffle" "test" {
+							input = ["a", "b", "c", "d", "e"]
+							seed  = "-"
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("data.random_shuffle.test", tfjsonpath.New("result"),
+						knownvalue.ListExact(
+							[]knownvalue.Check{
+								knownvalue.StringExact("a"),
+								knownvalue.StringExact("c"),
+								knownvalue.StringExact("b"),
+								knownvalue.StringExact("e"),
+								knownvalue.StringExact("d"),
+							},
+						),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestAccDataSourceShuffle_Unseeded(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `data "random_shuffle" "test" {
+							input = ["us-west-1a", "us-west-1b", "us-west-1c"]
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("data.random_shuffle.test", tfjsonpath.New("result"),
+						knownvalue.SetExact(
+							[]knownvalue.Check{
+								knownvalue.StringExact("us-west-1a"),
+								knownvalue.StringExact("us-west-1b"),
+								knownvalue.StringExact("us-west-1c"),
+							},
+						),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestAccDataSourceShuffle_ResultCount(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `data "random_shuffle" "test" {
+							input        = ["a", "b", "c", "d", "e"]
+							result_count = 12
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("data.random_shuffle.test", tfjsonpath.New("result"), knownvalue.ListSizeExact(12)),
+				},
+			},
+			{
+				Config: `data "random_shuffle" "test" {
+							input        = []
+							result_count = 2
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("data.random_shuffle.test", tfjsonpath.New("result"), knownvalue.ListSizeExact(0)),
+				},
+			},
+		},
+	})
+}
+
+func TestAccDataSourceShuffle_ResultCount_Invalid(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `data "random_shuffle" "test" {
+							input        = ["a", "b"]
+							result_count = -1
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute result_count value must be at least 0, got: -1`),
+			},
+		},
+	})
+}
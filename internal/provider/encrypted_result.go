@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/terraform-providers/terraform-provider-random/internal/diagnostics"
+	"github.com/terraform-providers/terraform-provider-random/internal/encrypt"
+	stringplanmodifiers "github.com/terraform-providers/terraform-provider-random/internal/planmodifiers/string"
+	"github.com/terraform-providers/terraform-provider-random/internal/random"
+)
+
+// encryptedResultAttribute returns the schema for the encrypted_result
+// attribute. The value is not sensitive, as it can only be decrypted with the
+// passphrase or private key, so that it can be passed to systems which do not
+// handle sensitive values.
+func encryptedResultAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: "The result encrypted with AES-256-GCM, in the format " +
+			"`$aes-256-gcm$pbkdf2-sha256$i=<iterations>$<salt>$<nonce>$<ciphertext>` when the key is derived from " +
+			"a passphrase, or `$aes-256-gcm$rsa-oaep-sha256$<wrapped key>$<nonce>$<ciphertext>` when the key is " +
+			"wrapped with an RSA public key, with each field base64 encoded without padding. Only generated when " +
+			"the provider is configured with `result_encryption_key`.",
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifiers.UseStateForUnknownIncludingNull(),
+		},
+	}
+}
+
+// encryptResult returns the result encrypted with the key, or null if the
+// provider was not configured with result_encryption_key.
+func encryptResult(key *encrypt.Key, entropy *random.Source, result string) (types.String, diag.Diagnostics) {
+	if key == nil {
+		return types.StringNull(), nil
+	}
+
+	encrypted, err := key.Encrypt(entropy, []byte(result))
+	if err != nil {
+		return types.StringNull(), diagnostics.EncryptionError(err.Error())
+	}
+
+	return types.StringValue(encrypted), nil
+}
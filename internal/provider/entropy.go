@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/terraform-providers/terraform-provider-random/internal/diagnostics"
+	"github.com/terraform-providers/terraform-provider-random/internal/random"
+)
+
+// entropyBitsAttribute returns the schema for the entropy_bits attribute. The
+// value is calculated from the other attributes in state, so resources created
+// by earlier provider versions have the value populated during refresh.
+func entropyBitsAttribute(description string) schema.Float64Attribute {
+	return schema.Float64Attribute{
+		Description: description + " This can be used in a `postcondition` to assert a minimum strength.",
+		Computed:    true,
+		PlanModifiers: []planmodifier.Float64{
+			float64planmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+// bytesEntropyBits returns the entropy of the given number of random bytes.
+func bytesEntropyBits(length types.Int64) types.Float64 {
+	if length.IsNull() || length.IsUnknown() {
+		return types.Float64Null()
+	}
+
+	return types.Float64Value(float64(length.ValueInt64() * 8))
+}
+
+// entropyDiagnostics returns the diagnostics of an error reading from the
+// source of entropy, such as when a random number generator is seeded.
+func entropyDiagnostics(err error) diag.Diagnostics {
+	if errors.Is(err, random.ErrEntropyUnavailable) {
+		return diagnostics.EntropyUnavailableError(err)
+	}
+
+	return diagnostics.RandomReadError(err.Error())
+}
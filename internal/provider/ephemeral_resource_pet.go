@@ -0,0 +1,175 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/terraform-providers/terraform-provider-random/internal/diagnostics"
+	"github.com/terraform-providers/terraform-provider-random/internal/random"
+)
+
+var (
+	_ ephemeral.EphemeralResource              = (*petEphemeralResource)(nil)
+	_ ephemeral.EphemeralResourceWithConfigure = (*petEphemeralResource)(nil)
+)
+
+// petSeedDeriveInfo is the HKDF info string used when deriving an ephemeral
+// random_pet name from its seed.
+const petSeedDeriveInfo = "terraform-provider-random ephemeral random_pet"
+
+// The default length and separator of an ephemeral random_pet, which are the
+// same as those of the random_pet resource.
+const (
+	petDefaultLength    = 2
+	petDefaultSeparator = "-"
+)
+
+func NewPetEphemeralResource() ephemeral.EphemeralResource {
+	return &petEphemeralResource{}
+}
+
+type petEphemeralResource struct {
+	entropy *random.Source
+}
+
+func (r *petEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pet"
+}
+
+func (r *petEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = petEphemeralResourceSchema()
+}
+
+func (r *petEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, _ *ephemeral.ConfigureResponse) {
+	r.entropy = providerEntropy(req.ProviderData)
+}
+
+// Open generates the pet name, which is chosen with entropy read from the
+// provider's source of entropy, or derived from the seed if one is configured.
+func (r *petEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data petEphemeralResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	length := int64(petDefaultLength)
+	if !data.Length.IsNull() {
+		length = data.Length.ValueInt64()
+	}
+
+	separator := petDefaultSeparator
+	if !data.Separator.IsNull() {
+		separator = data.Separator.ValueString()
+	}
+
+	entropy := r.entropy
+	source := randomSourceCrypto
+
+	if seed := data.Seed.ValueString(); seed != "" {
+		entropy = random.NewDerivedSource(seed, petSeedDeriveInfo)
+		source = randomSourceDerived
+	}
+
+	done := logGeneration(ctx, source, map[string]any{
+		"length": length,
+	})
+
+	words, err := petDeterministicWords(entropy, petWordLists(), int(length))
+	done()
+
+	if errors.Is(err, random.ErrEntropyUnavailable) {
+		resp.Diagnostics.Append(diagnostics.EntropyUnavailableError(err)...)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.RandomReadError(err.Error())...)
+		return
+	}
+
+	pet := strings.Join(words, separator)
+
+	if prefix := data.Prefix.ValueString(); prefix != "" {
+		pet = prefix + separator + pet
+	}
+
+	wordValues := make([]attr.Value, len(words))
+	for i, word := range words {
+		wordValues[i] = types.StringValue(word)
+	}
+
+	data.Length = types.Int64Value(length)
+	data.Separator = types.StringValue(separator)
+	data.Result = types.StringValue(pet)
+	data.Words = types.ListValueMust(types.StringType, wordValues)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+func petEphemeralResourceSchema() schema.Schema {
+	return schema.Schema{
+		Description: "The ephemeral resource `random_pet` generates random pet names, such as for short-lived " +
+			"preview environments, without storing the name in the plan or state. A new name is generated " +
+			"every time Terraform opens the ephemeral resource, such as during each plan and apply, so names " +
+			"may collide with those of earlier runs. Set `seed` to generate the same name on every run.",
+		Attributes: map[string]schema.Attribute{
+			"length": schema.Int64Attribute{
+				Description: "The length (in words) of the pet name. Default value is `2`.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"prefix": schema.StringAttribute{
+				Description: "A string to prefix the name with.",
+				Optional:    true,
+			},
+			"separator": schema.StringAttribute{
+				Description: "The character to separate words in the pet name. Default value is `-`.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"seed": schema.StringAttribute{
+				Description: "A custom seed from which the pet name is derived, so that the same name is " +
+					"generated on every run, such as from the name of a branch. The name is not random, so " +
+					"it can be derived again by anyone who knows the seed.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"result": schema.StringAttribute{
+				Description: "The random pet name.",
+				Computed:    true,
+			},
+			"words": schema.ListAttribute{
+				Description: "The words of the pet name, excluding the prefix, in the order in which they appear.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+type petEphemeralResourceModel struct {
+	Length    types.Int64  `tfsdk:"length"`
+	Prefix    types.String `tfsdk:"prefix"`
+	Separator types.String `tfsdk:"separator"`
+	Seed      types.String `tfsdk:"seed"`
+	Result    types.String `tfsdk:"result"`
+	Words     types.List   `tfsdk:"words"`
+}
@@ -0,0 +1,129 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestPetEphemeralResource_Open(t *testing.T) {
+	t.Parallel()
+
+	open := func(t *testing.T, config map[string]tftypes.Value) petEphemeralResourceModel {
+		t.Helper()
+
+		ctx := context.Background()
+		petSchema := petEphemeralResourceSchema()
+		objectType := petSchema.Type().TerraformType(ctx).(tftypes.Object)
+
+		values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+		for name, attributeType := range objectType.AttributeTypes {
+			values[name] = tftypes.NewValue(attributeType, nil)
+		}
+
+		for name, value := range config {
+			values[name] = value
+		}
+
+		raw := tftypes.NewValue(objectType, values)
+		req := ephemeral.OpenRequest{Config: tfsdk.Config{Schema: petSchema, Raw: raw}}
+		resp := &ephemeral.OpenResponse{Result: tfsdk.EphemeralResultData{Schema: petSchema, Raw: raw}}
+
+		(&petEphemeralResource{}).Open(ctx, req, resp)
+
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("unexpected error: %v", resp.Diagnostics)
+		}
+
+		var data petEphemeralResourceModel
+
+		if diags := resp.Result.Get(ctx, &data); diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+
+		return data
+	}
+
+	t.Run("defaults", func(t *testing.T) {
+		t.Parallel()
+
+		data := open(t, nil)
+
+		if !regexp.MustCompile(`^[a-z]+-[a-z]+$`).MatchString(data.Result.ValueString()) {
+			t.Errorf("expected a two word name, got: %q", data.Result.ValueString())
+		}
+
+		if data.Length.ValueInt64() != petDefaultLength || data.Separator.ValueString() != petDefaultSeparator {
+			t.Errorf("expected the default length and separator, got: %s and %s", data.Length, data.Separator)
+		}
+	})
+
+	t.Run("seed", func(t *testing.T) {
+		t.Parallel()
+
+		config := map[string]tftypes.Value{
+			"length": tftypes.NewValue(tftypes.Number, 3),
+			"prefix": tftypes.NewValue(tftypes.String, "preview"),
+			"seed":   tftypes.NewValue(tftypes.String, "feature/login"),
+		}
+
+		first := open(t, config)
+		second := open(t, config)
+
+		if first.Result.ValueString() != second.Result.ValueString() {
+			t.Errorf("expected the same name for the same seed, got: %q and %q", first.Result.ValueString(), second.Result.ValueString())
+		}
+
+		if !regexp.MustCompile(`^preview-[a-z]+-[a-z]+-[a-z]+$`).MatchString(first.Result.ValueString()) {
+			t.Errorf("expected a prefixed three word name, got: %q", first.Result.ValueString())
+		}
+
+		if len(first.Words.Elements()) != 3 {
+			t.Errorf("expected 3 words, got: %s", first.Words)
+		}
+	})
+}
+
+func TestAccEphemeralResourcePet(t *testing.T) {
+	factories := protoV6ProviderFactories()
+	factories["echo"] = echoprovider.NewProviderServer()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV6ProviderFactories: factories,
+		Steps: []resource.TestStep{
+			{
+				Config: `ephemeral "random_pet" "test" {
+							length    = 3
+							separator = "_"
+							seed      = "feature/login"
+						}
+
+						provider "echo" {
+							data = ephemeral.random_pet.test
+						}
+
+						resource "echo" "test" {}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("result"), knownvalue.StringRegexp(regexp.MustCompile(`^[a-z]+_[a-z]+_[a-z]+$`))),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("words"), knownvalue.ListSizeExact(3)),
+				},
+			},
+		},
+	})
+}
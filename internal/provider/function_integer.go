@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	"github.com/terraform-providers/terraform-provider-random/internal/random"
+)
+
+var _ function.Function = (*integerFunction)(nil)
+
+func NewIntegerFunction() function.Function {
+	return &integerFunction{}
+}
+
+type integerFunction struct{}
+
+func (f *integerFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "integer"
+}
+
+func (f *integerFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Derive an integer in a range from a seed",
+		Description: "Returns an integer in the inclusive range between `min` and `max` derived from the seed, so " +
+			"that the same arguments always return the same integer, including across provider versions. This " +
+			"spreads resources consistently without storing anything in state, such as by using an account ID " +
+			"as the seed to choose the index of an availability zone.\n\n" +
+			"The integer is chosen with SplitMix64, whose state is initialised with the first 8 bytes, read as a " +
+			"big-endian integer, of the SHA-256 digest of the seed, as `random_integer` does at " +
+			"`algorithm_version` `2`, so a non-empty seed returns the same integer as a `random_integer` with the " +
+			"same `min`, `max` and `seed`. Unlike the resource, the integer is computed during plan wherever the " +
+			"function is called, and so changes whenever its arguments change.",
+		Parameters: []function.Parameter{
+			function.Int64Parameter{
+				Name:        "min",
+				Description: "The minimum inclusive value of the range.",
+			},
+			function.Int64Parameter{
+				Name:        "max",
+				Description: "The maximum inclusive value of the range, which must be at least `min`.",
+			},
+			function.StringParameter{
+				Name:        "seed",
+				Description: "The seed from which the integer is derived.",
+			},
+		},
+		Return: function.Int64Return{},
+	}
+}
+
+func (f *integerFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var (
+		minVal, maxVal int64
+		seed           string
+	)
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &minVal, &maxVal, &seed))
+	if resp.Error != nil {
+		return
+	}
+
+	if maxVal < minVal {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("The maximum value must be at least the minimum value (%d), got: %d.", minVal, maxVal))
+		return
+	}
+
+	// The number of integers in the range must be representable as an int64,
+	// as it is with random_integer.
+	if uint64(maxVal-minVal) >= math.MaxInt64 {
+		resp.Error = function.NewArgumentFuncError(1, "The range between the minimum and maximum values must contain fewer than 2^63 integers.")
+		return
+	}
+
+	result := random.NewSplitMix64(seed).Int63n(maxVal-minVal+1) + minVal
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
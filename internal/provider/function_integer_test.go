@@ -0,0 +1,136 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"math"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+// TestIntegerFunction_Run guards against changes to the integer derived from a
+// seed, which must remain the same across provider versions and match that of
+// random_integer.
+func TestIntegerFunction_Run(t *testing.T) {
+	t.Parallel()
+
+	randomIntegerResult, err := randomInteger(nil, 1, 100, "reproducible", integerAlgorithmVersion)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	testCases := map[string]struct {
+		min, max    int64
+		seed        string
+		expected    int64
+		expectError bool
+	}{
+		"random-integer": {
+			min:      1,
+			max:      100,
+			seed:     "reproducible",
+			expected: int64(randomIntegerResult),
+		},
+		"golden": {
+			min:      1,
+			max:      100,
+			seed:     "reproducible",
+			expected: 58,
+		},
+		"single": {
+			min:      7,
+			max:      7,
+			seed:     "reproducible",
+			expected: 7,
+		},
+		"max-less-than-min": {
+			min:         2,
+			max:         1,
+			seed:        "reproducible",
+			expectError: true,
+		},
+		"range-too-large": {
+			min:         math.MinInt64,
+			max:         math.MaxInt64,
+			seed:        "reproducible",
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{
+					types.Int64Value(testCase.min),
+					types.Int64Value(testCase.max),
+					types.StringValue(testCase.seed),
+				}),
+			}
+			resp := &function.RunResponse{
+				Result: function.NewResultData(types.Int64Unknown()),
+			}
+
+			(&integerFunction{}).Run(context.Background(), req, resp)
+
+			if (resp.Error != nil) != testCase.expectError {
+				t.Fatalf("expected error %t, got: %v", testCase.expectError, resp.Error)
+			}
+
+			if testCase.expectError {
+				return
+			}
+
+			if got := resp.Result.Value(); !got.Equal(types.Int64Value(testCase.expected)) {
+				t.Errorf("expected %d, got: %s", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestAccFunctionInteger(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `output "test" {
+							value = provider::random::integer(1, 100, "reproducible")
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.Int64Exact(58)),
+				},
+			},
+		},
+	})
+}
+
+func TestAccFunctionInteger_Invalid(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `output "test" {
+							value = provider::random::integer(2, 1, "reproducible")
+						}`,
+				ExpectError: regexp.MustCompile(`The maximum value must be at least the minimum value \(2\), got: 1`),
+			},
+		},
+	})
+}
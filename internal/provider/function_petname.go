@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	"github.com/terraform-providers/terraform-provider-random/internal/random"
+)
+
+var _ function.Function = (*petnameFunction)(nil)
+
+func NewPetnameFunction() function.Function {
+	return &petnameFunction{}
+}
+
+type petnameFunction struct{}
+
+func (f *petnameFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "petname"
+}
+
+func (f *petnameFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Generate a pet name from a seed",
+		Description: "Returns a pet name of the given number of words joined by the separator, such as " +
+			"`quickly-happy-walrus`, for throwaway names in expressions such as those of test fixtures and " +
+			"preview environments.\n\n" +
+			"Terraform requires a function to return the same result whenever it is called with the same " +
+			"arguments, so the words are derived from the optional seed rather than chosen randomly: pass a seed " +
+			"such as the name of a branch or workspace to generate a distinct name for each, or omit it to " +
+			"generate the same name on every call with the same number of words. The words are derived in the " +
+			"same way as those of the ephemeral `random_pet` with the same `seed` and `length`. Unlike the " +
+			"`random_pet` resource, nothing is stored in state; use the resource for a random name which is " +
+			"kept until its `keepers` change.",
+		Parameters: []function.Parameter{
+			function.Int64Parameter{
+				Name:        "words",
+				Description: "The number of words of the pet name, which must be at least 1.",
+			},
+			function.StringParameter{
+				Name:        "separator",
+				Description: "The separator between the words of the pet name.",
+			},
+		},
+		VariadicParameter: function.StringParameter{
+			Name:        "seed",
+			Description: "The seed from which the words are derived. At most one seed may be given.",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *petnameFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var (
+		words     int64
+		separator string
+		seeds     []string
+	)
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &words, &separator, &seeds))
+	if resp.Error != nil {
+		return
+	}
+
+	if words < 1 {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("The number of words must be at least 1, got: %d.", words))
+		return
+	}
+
+	if len(seeds) > 1 {
+		resp.Error = function.NewArgumentFuncError(3, fmt.Sprintf("At most one seed may be given, got: %d.", len(seeds)))
+		return
+	}
+
+	var seed string
+	if len(seeds) == 1 {
+		seed = seeds[0]
+	}
+
+	names, err := petDeterministicWords(random.NewDerivedSource(seed, petSeedDeriveInfo), petWordLists(), int(words))
+	if err != nil {
+		resp.Error = function.NewFuncError(fmt.Sprintf("The pet name could not be derived from the seed: %s.", err))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, strings.Join(names, separator)))
+}
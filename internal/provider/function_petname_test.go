@@ -0,0 +1,150 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+// TestPetnameFunction_Run guards against changes to the name derived from a
+// seed, which must remain the same across provider versions.
+func TestPetnameFunction_Run(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		words       int64
+		separator   string
+		seeds       []string
+		expected    string
+		expectError bool
+	}{
+		"seed": {
+			words:     3,
+			separator: "-",
+			seeds:     []string{"feature-login"},
+			expected:  "typically-related-horse",
+		},
+		"no-seed": {
+			words:     3,
+			separator: "-",
+			expected:  "visually-quiet-bluejay",
+		},
+		"separator": {
+			words:     3,
+			separator: "_",
+			seeds:     []string{"feature-login"},
+			expected:  "typically_related_horse",
+		},
+		"zero-words": {
+			words:       0,
+			separator:   "-",
+			expectError: true,
+		},
+		"two-seeds": {
+			words:       2,
+			separator:   "-",
+			seeds:       []string{"a", "b"},
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			seedTypes := make([]attr.Type, len(testCase.seeds))
+			seedValues := make([]attr.Value, len(testCase.seeds))
+
+			for i, seed := range testCase.seeds {
+				seedTypes[i] = types.StringType
+				seedValues[i] = types.StringValue(seed)
+			}
+
+			req := function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{
+					types.Int64Value(testCase.words),
+					types.StringValue(testCase.separator),
+					types.TupleValueMust(seedTypes, seedValues),
+				}),
+			}
+			resp := &function.RunResponse{
+				Result: function.NewResultData(types.StringUnknown()),
+			}
+
+			(&petnameFunction{}).Run(context.Background(), req, resp)
+
+			if (resp.Error != nil) != testCase.expectError {
+				t.Fatalf("expected error %t, got: %v", testCase.expectError, resp.Error)
+			}
+
+			if testCase.expectError {
+				return
+			}
+
+			if got := resp.Result.Value(); !got.Equal(types.StringValue(testCase.expected)) {
+				t.Errorf("expected %q, got: %s", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestAccFunctionPetname(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `output "test" {
+							value = provider::random::petname(3, "-", "feature-login")
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.StringExact("typically-related-horse")),
+				},
+			},
+			{
+				Config: `output "test" {
+							value = provider::random::petname(1, "-")
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.StringRegexp(regexp.MustCompile(`^[a-z]+$`))),
+				},
+			},
+		},
+	})
+}
+
+func TestAccFunctionPetname_Invalid(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `output "test" {
+							value = provider::random::petname(0, "-")
+						}`,
+				ExpectError: regexp.MustCompile(`The number of words must be at least 1, got: 0`),
+			},
+			{
+				Config: `output "test" {
+							value = provider::random::petname(2, "-", "a", "b")
+						}`,
+				ExpectError: regexp.MustCompile(`At most one seed may be given, got: 2`),
+			},
+		},
+	})
+}
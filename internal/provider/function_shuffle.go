@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/terraform-providers/terraform-provider-random/internal/random"
+)
+
+var _ function.Function = (*shuffleFunction)(nil)
+
+func NewShuffleFunction() function.Function {
+	return &shuffleFunction{}
+}
+
+type shuffleFunction struct{}
+
+func (f *shuffleFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "shuffle"
+}
+
+func (f *shuffleFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Shuffle a list with a seed",
+		Description: "Returns a permutation of a list, chosen with the seed, so that the same list and seed always " +
+			"return the same permutation, including across provider versions. The permutation is chosen by a " +
+			"Fisher-Yates shuffle with SplitMix64, whose state is initialised with the first 8 bytes, read as a " +
+			"big-endian integer, of the SHA-256 digest of the seed.\n\n" +
+			"Unlike the `random_shuffle` resource, nothing is stored in state: the permutation is computed " +
+			"during plan wherever the function is called, and so changes whenever the list or seed changes. Use " +
+			"the resource when a permutation must be kept until its `keepers` change, or when no seed is wanted. " +
+			"The function returns a different permutation than the resource for the same seed.",
+		Parameters: []function.Parameter{
+			function.DynamicParameter{
+				Name:        "list",
+				Description: "The list to shuffle, whose elements may be of any type. A tuple is shuffled as a list.",
+			},
+			function.StringParameter{
+				Name:        "seed",
+				Description: "The seed with which the permutation is chosen.",
+			},
+		},
+		Return: function.DynamicReturn{},
+	}
+}
+
+func (f *shuffleFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var (
+		list types.Dynamic
+		seed string
+	)
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &list, &seed))
+	if resp.Error != nil {
+		return
+	}
+
+	elements, ok := shuffleObjects(list)
+	if !ok {
+		resp.Error = function.NewArgumentFuncError(0, "The list to shuffle must be a list or tuple.")
+		return
+	}
+
+	shuffled := slices.Clone(elements)
+
+	random.NewSplitMix64(seed).Shuffle(len(shuffled), func(i, j int) {
+		shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
+	})
+
+	var result attr.Value
+
+	switch value := list.UnderlyingValue().(type) {
+	case types.List:
+		result = types.ListValueMust(value.ElementType(ctx), shuffled)
+	case types.Tuple:
+		elementTypes := make([]attr.Type, len(shuffled))
+
+		for i, element := range shuffled {
+			elementTypes[i] = element.Type(ctx)
+		}
+
+		result = types.TupleValueMust(elementTypes, shuffled)
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, types.DynamicValue(result)))
+}
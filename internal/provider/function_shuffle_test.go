@@ -0,0 +1,154 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"math/big"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+// TestShuffleFunction_Run guards against changes to the permutation returned
+// for a seed, which must remain the same across provider versions.
+func TestShuffleFunction_Run(t *testing.T) {
+	t.Parallel()
+
+	stringValues := func(values ...string) []attr.Value {
+		elements := make([]attr.Value, len(values))
+
+		for i, value := range values {
+			elements[i] = types.StringValue(value)
+		}
+
+		return elements
+	}
+
+	stringTypes := func(n int) []attr.Type {
+		elementTypes := make([]attr.Type, n)
+
+		for i := range elementTypes {
+			elementTypes[i] = types.StringType
+		}
+
+		return elementTypes
+	}
+
+	testCases := map[string]struct {
+		list     types.Dynamic
+		seed     string
+		expected types.Dynamic
+	}{
+		"list": {
+			list:     types.DynamicValue(types.ListValueMust(types.StringType, stringValues("us-west-1a", "us-west-1b", "us-west-1c", "us-west-1d"))),
+			seed:     "azs",
+			expected: types.DynamicValue(types.ListValueMust(types.StringType, stringValues("us-west-1b", "us-west-1d", "us-west-1c", "us-west-1a"))),
+		},
+		"tuple": {
+			list: types.DynamicValue(types.TupleValueMust(
+				[]attr.Type{types.StringType, types.NumberType, types.StringType, types.BoolType},
+				[]attr.Value{types.StringValue("a"), types.NumberValue(big.NewFloat(1)), types.StringValue("c"), types.BoolValue(true)},
+			)),
+			seed: "azs",
+			expected: types.DynamicValue(types.TupleValueMust(
+				[]attr.Type{types.NumberType, types.BoolType, types.StringType, types.StringType},
+				[]attr.Value{types.NumberValue(big.NewFloat(1)), types.BoolValue(true), types.StringValue("c"), types.StringValue("a")},
+			)),
+		},
+		"empty": {
+			list:     types.DynamicValue(types.TupleValueMust(stringTypes(0), stringValues())),
+			seed:     "azs",
+			expected: types.DynamicValue(types.TupleValueMust(stringTypes(0), stringValues())),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{testCase.list, types.StringValue(testCase.seed)}),
+			}
+			resp := &function.RunResponse{
+				Result: function.NewResultData(types.DynamicUnknown()),
+			}
+
+			(&shuffleFunction{}).Run(context.Background(), req, resp)
+
+			if resp.Error != nil {
+				t.Fatalf("unexpected error: %s", resp.Error)
+			}
+
+			if got := resp.Result.Value(); !got.Equal(testCase.expected) {
+				t.Errorf("expected %s, got: %s", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestAccFunctionShuffle(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `output "test" {
+							value = provider::random::shuffle(["us-west-1a", "us-west-1b", "us-west-1c", "us-west-1d"], "azs")
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.TupleExact([]knownvalue.Check{
+						knownvalue.StringExact("us-west-1b"),
+						knownvalue.StringExact("us-west-1d"),
+						knownvalue.StringExact("us-west-1c"),
+						knownvalue.StringExact("us-west-1a"),
+					})),
+				},
+			},
+			{
+				Config: `output "test" {
+							value = provider::random::shuffle([
+								{ name = "a", cidr = "10.0.0.0/24" },
+								{ name = "b", cidr = "10.0.1.0/24" },
+							], "subnets")[*].name
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.TupleSizeExact(2)),
+				},
+			},
+		},
+	})
+}
+
+func TestAccFunctionShuffle_Invalid(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `output "test" {
+							value = provider::random::shuffle({ a = "b" }, "seed")
+						}`,
+				ExpectError: regexp.MustCompile(`The list to shuffle must be a list or tuple`),
+			},
+			{
+				Config: `output "test" {
+							value = provider::random::shuffle(["a", "b"], null)
+						}`,
+				ExpectError: regexp.MustCompile(`Invalid function argument`),
+			},
+		},
+	})
+}
@@ -0,0 +1,117 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func hexChunkSizeAttribute() schema.Int64Attribute {
+	return schema.Int64Attribute{
+		Description: "The number of hexadecimal characters in each element of `hex_chunks`, such as `64`, for " +
+			"systems which limit the length of lines. Changing this value splits the existing bytes again " +
+			"without replacing the resource. The minimum value is 1.",
+		Optional: true,
+		Validators: []validator.Int64{
+			int64validator.AtLeast(1),
+		},
+	}
+}
+
+// hexChunksAttribute returns the schema for the hex_chunks attribute, whose
+// value is planned from the bytes in state, so that changing hex_chunk_size
+// updates the value in place.
+func hexChunksAttribute(sensitive bool, hexFromState hexFromStateFunc) schema.ListAttribute {
+	return schema.ListAttribute{
+		Description: "The generated bytes presented in lowercase hexadecimal digits, split into chunks of " +
+			"`hex_chunk_size` characters, the last of which may be shorter. This value is `null` when " +
+			"`hex_chunk_size` is not set.",
+		ElementType: types.StringType,
+		Computed:    true,
+		Sensitive:   sensitive,
+		PlanModifiers: []planmodifier.List{
+			hexChunksModifier{hexFromState: hexFromState},
+		},
+	}
+}
+
+// hexFromStateFunc returns the unprefixed hexadecimal encoding of the bytes
+// in the state of a resource, which is unknown if it cannot be determined.
+type hexFromStateFunc func(ctx context.Context, state tfsdk.State) (types.String, diag.Diagnostics)
+
+// hexChunks returns the hexadecimal string split into chunks of the given
+// size, or null if the size is not set.
+func hexChunks(hexStr types.String, size types.Int64) types.List {
+	if size.IsNull() {
+		return types.ListNull(types.StringType)
+	}
+
+	if size.IsUnknown() || hexStr.IsNull() || hexStr.IsUnknown() {
+		return types.ListUnknown(types.StringType)
+	}
+
+	value := hexStr.ValueString()
+	chunkSize := int(size.ValueInt64())
+	chunks := make([]attr.Value, 0, (len(value)+chunkSize-1)/chunkSize)
+
+	for i := 0; i < len(value); i += chunkSize {
+		chunks = append(chunks, types.StringValue(value[i:min(i+chunkSize, len(value))]))
+	}
+
+	return types.ListValueMust(types.StringType, chunks)
+}
+
+type hexChunksModifier struct {
+	hexFromState hexFromStateFunc
+}
+
+func (m hexChunksModifier) Description(ctx context.Context) string {
+	return m.MarkdownDescription(ctx)
+}
+
+func (m hexChunksModifier) MarkdownDescription(context.Context) string {
+	return "The chunks of the bytes in state, split by the planned hex_chunk_size."
+}
+
+func (m hexChunksModifier) PlanModifyList(ctx context.Context, req planmodifier.ListRequest, resp *planmodifier.ListResponse) {
+	// Do nothing if the resource is being destroyed.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var size types.Int64
+
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("hex_chunk_size"), &size)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if size.IsNull() {
+		resp.PlanValue = types.ListNull(types.StringType)
+		return
+	}
+
+	// The bytes are generated when the resource is created.
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	hexStr, diags := m.hexFromState(ctx, req.State)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.PlanValue = hexChunks(hexStr, size)
+}
@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestHexChunks(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		hex      types.String
+		size     types.Int64
+		expected types.List
+	}{
+		"null-size": {
+			hex:      types.StringValue("0123456789"),
+			size:     types.Int64Null(),
+			expected: types.ListNull(types.StringType),
+		},
+		"unknown-size": {
+			hex:      types.StringValue("0123456789"),
+			size:     types.Int64Unknown(),
+			expected: types.ListUnknown(types.StringType),
+		},
+		"unknown-hex": {
+			hex:      types.StringUnknown(),
+			size:     types.Int64Value(4),
+			expected: types.ListUnknown(types.StringType),
+		},
+		"exact": {
+			hex:  types.StringValue("01234567"),
+			size: types.Int64Value(4),
+			expected: types.ListValueMust(types.StringType, []attr.Value{
+				types.StringValue("0123"),
+				types.StringValue("4567"),
+			}),
+		},
+		"remainder": {
+			hex:  types.StringValue("0123456789"),
+			size: types.Int64Value(4),
+			expected: types.ListValueMust(types.StringType, []attr.Value{
+				types.StringValue("0123"),
+				types.StringValue("4567"),
+				types.StringValue("89"),
+			}),
+		},
+		"larger-than-hex": {
+			hex:  types.StringValue("0123"),
+			size: types.Int64Value(64),
+			expected: types.ListValueMust(types.StringType, []attr.Value{
+				types.StringValue("0123"),
+			}),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := hexChunks(testCase.hex, testCase.size)
+
+			if diff := cmp.Diff(testCase.expected, got); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
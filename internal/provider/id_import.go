@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// idImportHexPrefix marks an imported random_id value which is encoded in
+// hexadecimal, as the unprefixed hex attribute is, rather than in base64url.
+// The colon cannot occur in base64url, so the encodings are unambiguous.
+const idImportHexPrefix = "hex:"
+
+// idImportUsage describes the accepted formats of a random_id import id.
+const idImportUsage = "Expected an import id of the form {b64_url}, {prefix},{b64_url}, hex:{hex} or " +
+	"{prefix},hex:{hex}, where {b64_url} and {hex} are the encodings of the bytes without the prefix. The " +
+	"prefix is separated from the value by its last comma, so the prefix may itself contain commas."
+
+// parseIDImport splits a random_id import id into the prefix, which is empty if
+// not given, and the bytes which it encodes.
+func parseIDImport(importID string) (string, []byte, error) {
+	var prefix string
+
+	value := importID
+
+	if sep := strings.LastIndex(importID, ","); sep != -1 {
+		prefix = importID[:sep]
+		value = importID[sep+1:]
+	}
+
+	bytes, err := decodeIDImportValue(value)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return prefix, bytes, nil
+}
+
+// decodeIDImportValue decodes the unprefixed value of a random_id import id,
+// which is either base64url encoded without padding, or hexadecimal encoded
+// after idImportHexPrefix.
+func decodeIDImportValue(value string) ([]byte, error) {
+	if hexValue, ok := strings.CutPrefix(value, idImportHexPrefix); ok {
+		if hexValue == "" {
+			return nil, errors.New("the hexadecimal value is empty")
+		}
+
+		if len(hexValue)%2 != 0 {
+			return nil, fmt.Errorf("the hexadecimal value has an odd number of digits (%d), but each byte is "+
+				"encoded as two digits", len(hexValue))
+		}
+
+		bytes, err := hex.DecodeString(hexValue)
+		if err != nil {
+			return nil, fmt.Errorf("the hexadecimal value could not be decoded: %w", err)
+		}
+
+		return bytes, nil
+	}
+
+	if value == "" {
+		return nil, errors.New("the value is empty")
+	}
+
+	if strings.ContainsAny(value, "+/=") {
+		return nil, fmt.Errorf("the value %q contains characters of standard base64 or padding, but must be "+
+			"encoded as the unprefixed b64_url is, using the characters - and _ without padding", value)
+	}
+
+	bytes, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, fmt.Errorf("the base64url value could not be decoded: %w", err)
+	}
+
+	return bytes, nil
+}
@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestParseIDImport(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		importID       string
+		expectedPrefix string
+		expectedBytes  []byte
+		expectedError  string
+	}{
+		"base64url": {
+			importID:      "p-9hUg",
+			expectedBytes: []byte{0xa7, 0xef, 0x61, 0x52},
+		},
+		"base64url-prefix": {
+			importID:       "my-prefix-,p-9hUg",
+			expectedPrefix: "my-prefix-",
+			expectedBytes:  []byte{0xa7, 0xef, 0x61, 0x52},
+		},
+		"prefix-with-comma": {
+			importID:       "a,b-,p-9hUg",
+			expectedPrefix: "a,b-",
+			expectedBytes:  []byte{0xa7, 0xef, 0x61, 0x52},
+		},
+		"hex": {
+			importID:      "hex:a7ef6152",
+			expectedBytes: []byte{0xa7, 0xef, 0x61, 0x52},
+		},
+		"hex-upper": {
+			importID:      "hex:A7EF6152",
+			expectedBytes: []byte{0xa7, 0xef, 0x61, 0x52},
+		},
+		"hex-prefix": {
+			importID:       "my-prefix-,hex:a7ef6152",
+			expectedPrefix: "my-prefix-",
+			expectedBytes:  []byte{0xa7, 0xef, 0x61, 0x52},
+		},
+		"empty": {
+			importID:      "",
+			expectedError: "the value is empty",
+		},
+		"prefix-only": {
+			importID:      "my-prefix-,",
+			expectedError: "the value is empty",
+		},
+		"base64-std": {
+			importID:      "p+9hUg==",
+			expectedError: "contains characters of standard base64 or padding",
+		},
+		"base64url-invalid": {
+			importID:      "p-9hU",
+			expectedError: "the base64url value could not be decoded",
+		},
+		"hex-empty": {
+			importID:      "hex:",
+			expectedError: "the hexadecimal value is empty",
+		},
+		"hex-odd": {
+			importID:      "hex:a7ef615",
+			expectedError: "odd number of digits (7)",
+		},
+		"hex-invalid": {
+			importID:      "hex:a7ef61zz",
+			expectedError: "the hexadecimal value could not be decoded",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			prefix, got, err := parseIDImport(testCase.importID)
+
+			if testCase.expectedError != "" {
+				if err == nil || !strings.Contains(err.Error(), testCase.expectedError) {
+					t.Fatalf("expected error containing %q, got: %v", testCase.expectedError, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if prefix != testCase.expectedPrefix {
+				t.Errorf("expected prefix %q, got %q", testCase.expectedPrefix, prefix)
+			}
+
+			if !bytes.Equal(got, testCase.expectedBytes) {
+				t.Errorf("expected bytes %x, got %x", testCase.expectedBytes, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,169 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	mapplanmodifiers "github.com/terraform-providers/terraform-provider-random/internal/planmodifiers/map"
+)
+
+const (
+	// keepersModeAny regenerates the value when any keeper changes, which is
+	// the behaviour when keepers_mode is not set.
+	keepersModeAny = "any"
+
+	// keepersModeAll regenerates the value only when every keeper has changed
+	// since the value was generated.
+	keepersModeAll = "all"
+)
+
+func keepersModeAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: "Whether a change to `any` keeper regenerates the value, or only a change to `all` of the " +
+			"keepers with which the value was generated, such as when both the primary and secondary credential " +
+			"of a rotation scheme have changed. The keepers are compared to `keepers_at_generation`, so keepers " +
+			"which change in different applies regenerate the value once every one of them has changed. Keepers " +
+			"which are added, or which had `null` values, are not considered. When set to `all`, other changes " +
+			"to `keepers` are updated in place, and `keepers_hash` changes without the value being regenerated. " +
+			"Changing this value does not replace the resource. Default value is `any`.",
+		Optional: true,
+		Validators: []validator.String{
+			stringvalidator.OneOf(keepersModeAny, keepersModeAll),
+		},
+	}
+}
+
+func keepersAtGenerationAttribute() schema.MapAttribute {
+	return schema.MapAttribute{
+		Description: "The keepers with which the value was last generated, which are compared to the configured " +
+			"keepers when `keepers_mode` is `all`. This value is not changed when `keepers` is updated in place.",
+		ElementType: types.StringType,
+		Computed:    true,
+		PlanModifiers: []planmodifier.Map{
+			keepersAtGenerationModifier{},
+		},
+	}
+}
+
+// keepersAtGenerationModifier is a plan modifier for the keepers_at_generation
+// attribute which keeps the value in state when the resource is updated in
+// place. Resources created by earlier provider versions do not have the value,
+// so the keepers in state are planned instead.
+type keepersAtGenerationModifier struct{}
+
+func (m keepersAtGenerationModifier) Description(ctx context.Context) string {
+	return m.MarkdownDescription(ctx)
+}
+
+func (m keepersAtGenerationModifier) MarkdownDescription(context.Context) string {
+	return "Once set, the value of this attribute in state will not change until the resource is replaced."
+}
+
+func (m keepersAtGenerationModifier) PlanModifyMap(ctx context.Context, req planmodifier.MapRequest, resp *planmodifier.MapResponse) {
+	// Do nothing if the resource is being created or destroyed, or if there
+	// is a known planned value.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() || !req.PlanValue.IsUnknown() {
+		return
+	}
+
+	if !req.StateValue.IsNull() {
+		resp.PlanValue = req.StateValue
+		return
+	}
+
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("keepers"), &resp.PlanValue)...)
+}
+
+// keepersModePlanModifier returns a plan modifier for the keepers attribute
+// which requires replacement as RequiresReplaceIfValuesNotNull does, unless
+// keepers_mode is all and not every keeper in keepers_at_generation has
+// changed.
+func keepersModePlanModifier() planmodifier.Map {
+	return keepersModeModifier{}
+}
+
+type keepersModeModifier struct{}
+
+func (m keepersModeModifier) Description(ctx context.Context) string {
+	return m.MarkdownDescription(ctx)
+}
+
+func (m keepersModeModifier) MarkdownDescription(context.Context) string {
+	return "If any value of this attribute changes, or every value when keepers_mode is all, Terraform will " +
+		"destroy and recreate the resource."
+}
+
+func (m keepersModeModifier) PlanModifyMap(ctx context.Context, req planmodifier.MapRequest, resp *planmodifier.MapResponse) {
+	// Do nothing if the resource is being created or destroyed.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var mode types.String
+
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("keepers_mode"), &mode)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var generation types.Map
+
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("keepers_at_generation"), &generation)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Resources created by earlier provider versions do not have the keepers
+	// with which the value was generated, so the keepers in state are used.
+	if generation.IsNull() {
+		generation = req.StateValue
+	}
+
+	// An unknown mode, or keepers which were previously unset, are treated as
+	// any, so that the value is never kept when it may need regenerating.
+	if mode.ValueString() != keepersModeAll || req.ConfigValue.IsUnknown() || len(nonNullKeepers(generation)) == 0 {
+		mapplanmodifiers.RequiresReplaceIfValuesNotNull().PlanModifyMap(ctx, req, resp)
+		return
+	}
+
+	resp.RequiresReplace = allKeepersChanged(generation, req.ConfigValue)
+}
+
+// allKeepersChanged returns true if every keeper with a value when the value
+// was generated has a different value in the configuration, which includes keepers which were
+// removed, set to null or are unknown.
+func allKeepersChanged(generation, config types.Map) bool {
+	configValues := config.Elements()
+
+	for key, generationValue := range nonNullKeepers(generation) {
+		configValue, ok := configValues[key]
+
+		if ok && !configValue.IsUnknown() && configValue.Equal(generationValue) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// nonNullKeepers returns the keepers which have values.
+func nonNullKeepers(keepers types.Map) map[string]types.String {
+	values := make(map[string]types.String, len(keepers.Elements()))
+
+	for key, value := range keepers.Elements() {
+		if s, ok := value.(types.String); ok && !s.IsNull() {
+			values[key] = s
+		}
+	}
+
+	return values
+}
@@ -0,0 +1,200 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestAllKeepersChanged(t *testing.T) {
+	t.Parallel()
+
+	keepers := func(values map[string]attr.Value) types.Map {
+		return types.MapValueMust(types.StringType, values)
+	}
+
+	generation := keepers(map[string]attr.Value{
+		"primary":   types.StringValue("a"),
+		"secondary": types.StringValue("b"),
+		"unset":     types.StringNull(),
+	})
+
+	testCases := map[string]struct {
+		config   types.Map
+		expected bool
+	}{
+		"unchanged": {
+			config:   generation,
+			expected: false,
+		},
+		"one-changed": {
+			config: keepers(map[string]attr.Value{
+				"primary":   types.StringValue("c"),
+				"secondary": types.StringValue("b"),
+			}),
+			expected: false,
+		},
+		"one-changed-other-added": {
+			config: keepers(map[string]attr.Value{
+				"primary":   types.StringValue("c"),
+				"secondary": types.StringValue("b"),
+				"tertiary":  types.StringValue("d"),
+			}),
+			expected: false,
+		},
+		"all-changed": {
+			config: keepers(map[string]attr.Value{
+				"primary":   types.StringValue("c"),
+				"secondary": types.StringValue("d"),
+				"unset":     types.StringNull(),
+			}),
+			expected: true,
+		},
+		"one-changed-one-removed": {
+			config: keepers(map[string]attr.Value{
+				"primary": types.StringValue("c"),
+			}),
+			expected: true,
+		},
+		"one-changed-one-null": {
+			config: keepers(map[string]attr.Value{
+				"primary":   types.StringValue("c"),
+				"secondary": types.StringNull(),
+			}),
+			expected: true,
+		},
+		"one-changed-one-unknown": {
+			config: keepers(map[string]attr.Value{
+				"primary":   types.StringValue("c"),
+				"secondary": types.StringUnknown(),
+			}),
+			expected: true,
+		},
+		"null": {
+			config:   types.MapNull(types.StringType),
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := allKeepersChanged(generation, testCase.config); got != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestKeepersModeModifier(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	idSchema := idSchemaV1()
+	objectType := idSchema.Type().TerraformType(ctx).(tftypes.Object)
+	keepersType := tftypes.Map{ElementType: tftypes.String}
+
+	keepers := func(primary, secondary string) tftypes.Value {
+		return tftypes.NewValue(keepersType, map[string]tftypes.Value{
+			"primary":   tftypes.NewValue(tftypes.String, primary),
+			"secondary": tftypes.NewValue(tftypes.String, secondary),
+		})
+	}
+
+	// idValue returns a random_id value with keepers_mode all and the given
+	// keepers, and every other attribute null.
+	idValue := func(keepers, keepersAtGeneration tftypes.Value) tftypes.Value {
+		values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+		for name, attributeType := range objectType.AttributeTypes {
+			values[name] = tftypes.NewValue(attributeType, nil)
+		}
+
+		values["keepers_mode"] = tftypes.NewValue(tftypes.String, keepersModeAll)
+		values["keepers"] = keepers
+		values["keepers_at_generation"] = keepersAtGeneration
+
+		return tftypes.NewValue(objectType, values)
+	}
+
+	testCases := map[string]struct {
+		state           tftypes.Value
+		config          tftypes.Value
+		expectedReplace bool
+	}{
+		"one-changed": {
+			state:  idValue(keepers("a", "b"), keepers("a", "b")),
+			config: keepers("c", "b"),
+		},
+		"all-changed": {
+			state:           idValue(keepers("a", "b"), keepers("a", "b")),
+			config:          keepers("c", "d"),
+			expectedReplace: true,
+		},
+		// The primary keeper was changed in an earlier apply, and the id was
+		// updated in place, so changing the secondary keeper changes the last
+		// of the keepers with which the id was generated.
+		"staggered": {
+			state:           idValue(keepers("c", "b"), keepers("a", "b")),
+			config:          keepers("c", "d"),
+			expectedReplace: true,
+		},
+		"staggered-reverted": {
+			state:  idValue(keepers("c", "b"), keepers("a", "b")),
+			config: keepers("a", "d"),
+		},
+		// Resources created by earlier provider versions do not have
+		// keepers_at_generation, so the keepers in state are compared.
+		"earlier-provider-version": {
+			state:  idValue(keepers("c", "b"), tftypes.NewValue(keepersType, nil)),
+			config: keepers("c", "d"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			state := tfsdk.State{Schema: idSchema, Raw: testCase.state}
+			plan := tfsdk.Plan{Schema: idSchema, Raw: idValue(testCase.config, tftypes.NewValue(keepersType, tftypes.UnknownValue))}
+
+			var config, stateKeepers types.Map
+
+			diags := plan.GetAttribute(ctx, path.Root("keepers"), &config)
+			diags.Append(state.GetAttribute(ctx, path.Root("keepers"), &stateKeepers)...)
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			req := planmodifier.MapRequest{
+				Path:        path.Root("keepers"),
+				Config:      tfsdk.Config{Schema: idSchema, Raw: plan.Raw},
+				ConfigValue: config,
+				Plan:        plan,
+				PlanValue:   config,
+				State:       state,
+				StateValue:  stateKeepers,
+			}
+			resp := &planmodifier.MapResponse{PlanValue: config}
+
+			keepersModePlanModifier().PlanModifyMap(ctx, req, resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", resp.Diagnostics)
+			}
+
+			if resp.RequiresReplace != testCase.expectedReplace {
+				t.Errorf("expected RequiresReplace %t, got %t", testCase.expectedReplace, resp.RequiresReplace)
+			}
+		})
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// identityModel is the resource identity of resources which are identified
+// solely by their id attribute.
+type identityModel struct {
+	ID types.String `tfsdk:"id"`
+}
+
+// identitySchema returns the identity schema for resources which are
+// identified solely by their id attribute.
+func identitySchema(description string) identityschema.Schema {
+	return identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       description,
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+// setIdentityFromState sets the resource identity using the id attribute in
+// state. Read calls this so that resources created by provider versions which
+// did not support identity have their identity populated during refresh.
+func setIdentityFromState(ctx context.Context, state tfsdk.State, identity *tfsdk.ResourceIdentity) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var id types.String
+
+	diags.Append(state.GetAttribute(ctx, path.Root("id"), &id)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(identity.Set(ctx, identityModel{ID: id})...)
+
+	return diags
+}
+
+// setIdentity sets the resource identity to the given id.
+func setIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity, id types.String) diag.Diagnostics {
+	return identity.Set(ctx, identityModel{ID: id})
+}
+
+// importID returns the import identifier, or the id attribute of the resource
+// identity when importing by identity, which requires Terraform 1.12 or later.
+func importID(ctx context.Context, req resource.ImportStateRequest) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if req.ID != "" {
+		return req.ID, diags
+	}
+
+	var data identityModel
+
+	diags.Append(req.Identity.Get(ctx, &data)...)
+
+	return data.ID.ValueString(), diags
+}
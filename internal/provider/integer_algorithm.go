@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"github.com/terraform-providers/terraform-provider-random/internal/random"
+)
+
+const (
+	// integerAlgorithmVersion is the version of the algorithm with which new
+	// random_integer resources generate seeded results, which is incremented
+	// whenever a change to the provider changes the result generated for the
+	// same seed.
+	integerAlgorithmVersion = 2
+
+	// integerLegacyAlgorithmVersion is the version of the algorithm with
+	// which resources created by provider versions which did not record
+	// algorithm_version generated their result. The random_integer data
+	// source also uses this version, so that its seeded results are
+	// unchanged.
+	integerLegacyAlgorithmVersion = 1
+)
+
+// integerRand is a random number generator from which integers are chosen,
+// which is implemented by the generator of math/rand, SplitMix64 and
+// random.Rand.
+type integerRand interface {
+	Int63n(n int64) int64
+	Shuffle(n int, swap func(i, j int))
+}
+
+// newIntegerRand returns the random number generator of the algorithm version
+// for the seed. Version 1 seeds the generator of math/rand with the CRC-64
+// checksum of the seed, whose output for a seed may change between versions of
+// Go, and version 2 uses SplitMix64, whose output for a seed is fixed. Without
+// a seed, integers are chosen by rejection sampling from the source of
+// entropy, as the result need not be reproducible, and integerRandErr must be
+// checked once they have been chosen.
+func newIntegerRand(entropy *random.Source, seed string, version int64) (integerRand, error) {
+	if seed == "" {
+		return entropy.Rand(), nil
+	}
+
+	if version == integerLegacyAlgorithmVersion {
+		return entropy.NewRand(seed)
+	}
+
+	return random.NewSplitMix64(seed), nil
+}
+
+// integerRandErr returns the first error reading the source of entropy of the
+// random number generator, if it reads one.
+func integerRandErr(rand integerRand) error {
+	if rand, ok := rand.(interface{ Err() error }); ok {
+		return rand.Err()
+	}
+
+	return nil
+}
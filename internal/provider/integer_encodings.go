@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"math/big"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func resultHexAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: "The result in lowercase hexadecimal, without a prefix or leading zeros, such as `1f4` for " +
+			"500. A negative result begins with `-`.",
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+func resultBase62Attribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: "The result in base 62, without leading zeros, using the digits `0-9`, then `a-z`, then " +
+			"`A-Z`, such as `84` for 500, as a compact form for names. The largest 64-bit integer is 11 " +
+			"characters. A negative result begins with `-`.",
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+// integerHex returns the hexadecimal representation of the result.
+func integerHex(result int64) types.String {
+	return types.StringValue(strconv.FormatInt(result, 16))
+}
+
+// integerBase62 returns the base 62 representation of the result, with the
+// digits of big.Int, which follow the lowercase letters with the uppercase
+// letters.
+func integerBase62(result int64) types.String {
+	return types.StringValue(big.NewInt(result).Text(62))
+}
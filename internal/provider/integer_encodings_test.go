@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"math"
+	"testing"
+)
+
+func TestIntegerEncodings(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		result         int64
+		expectedHex    string
+		expectedBase62 string
+	}{
+		"zero": {
+			result:         0,
+			expectedHex:    "0",
+			expectedBase62: "0",
+		},
+		"positive": {
+			result:         500,
+			expectedHex:    "1f4",
+			expectedBase62: "84",
+		},
+		"uppercase-digits": {
+			result:         3843,
+			expectedHex:    "f03",
+			expectedBase62: "ZZ",
+		},
+		"negative": {
+			result:         -61,
+			expectedHex:    "-3d",
+			expectedBase62: "-Z",
+		},
+		"max-int64": {
+			result:         math.MaxInt64,
+			expectedHex:    "7fffffffffffffff",
+			expectedBase62: "aZl8N0y58M7",
+		},
+		"min-int64": {
+			result:         math.MinInt64,
+			expectedHex:    "-8000000000000000",
+			expectedBase62: "-aZl8N0y58M8",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := integerHex(testCase.result).ValueString(); got != testCase.expectedHex {
+				t.Errorf("expected hex %q, got: %q", testCase.expectedHex, got)
+			}
+
+			if got := integerBase62(testCase.result).ValueString(); got != testCase.expectedBase62 {
+				t.Errorf("expected base62 %q, got: %q", testCase.expectedBase62, got)
+			}
+		})
+	}
+}
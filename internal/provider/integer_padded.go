@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// integerMaxPadWidth is the maximum width of result_padded, which is the
+// number of characters of the smallest 64-bit integer.
+const integerMaxPadWidth = 20
+
+func padWidthAttribute() schema.Int64Attribute {
+	return schema.Int64Attribute{
+		Description: "The minimum number of characters of `result_padded`, including the sign of negative " +
+			"results, which is padded with leading zeros. Changing this value updates `result_padded` without " +
+			"generating a new result.",
+		Optional: true,
+		Validators: []validator.Int64{
+			int64validator.Between(1, integerMaxPadWidth),
+		},
+	}
+}
+
+func resultPaddedAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: "The string representation of the result, padded with leading zeros to `pad_width` " +
+			"characters, such as `007`, for naming conventions which require a fixed width. The value is not " +
+			"padded if `pad_width` is not set.",
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			resultPaddedPlanModifier(),
+		},
+	}
+}
+
+// integerPadded returns the decimal representation of the result, padded with
+// leading zeros to the pad width. The sign of a negative result precedes the
+// zeros and counts toward the width.
+func integerPadded(result int64, padWidth types.Int64) types.String {
+	return types.StringValue(fmt.Sprintf("%0*d", int(padWidth.ValueInt64()), result))
+}
+
+// resultPaddedPlanModifier returns a plan modifier for the result_padded
+// attribute which plans the padded result in state, so that changing
+// pad_width updates the value in place.
+func resultPaddedPlanModifier() planmodifier.String {
+	return resultPaddedModifier{}
+}
+
+type resultPaddedModifier struct{}
+
+func (m resultPaddedModifier) Description(ctx context.Context) string {
+	return m.MarkdownDescription(ctx)
+}
+
+func (m resultPaddedModifier) MarkdownDescription(context.Context) string {
+	return "The result in state, padded to pad_width."
+}
+
+func (m resultPaddedModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	// Do nothing if the resource is being created or destroyed, as the
+	// result is generated when the resource is created.
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var padWidth types.Int64
+
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("pad_width"), &padWidth)...)
+	if resp.Diagnostics.HasError() || padWidth.IsUnknown() {
+		return
+	}
+
+	var result types.Int64
+
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("result"), &result)...)
+	if resp.Diagnostics.HasError() || result.IsNull() || result.IsUnknown() {
+		return
+	}
+
+	resp.PlanValue = integerPadded(result.ValueInt64(), padWidth)
+}
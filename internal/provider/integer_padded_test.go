@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"math"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestIntegerPadded(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		result   int64
+		padWidth types.Int64
+		expected string
+	}{
+		"unpadded": {
+			result:   7,
+			padWidth: types.Int64Null(),
+			expected: "7",
+		},
+		"padded": {
+			result:   7,
+			padWidth: types.Int64Value(3),
+			expected: "007",
+		},
+		"wider-than-width": {
+			result:   12345,
+			padWidth: types.Int64Value(3),
+			expected: "12345",
+		},
+		"negative": {
+			result:   -7,
+			padWidth: types.Int64Value(3),
+			expected: "-07",
+		},
+		"min-int64": {
+			result:   math.MinInt64,
+			padWidth: types.Int64Value(integerMaxPadWidth),
+			expected: "-9223372036854775808",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := integerPadded(testCase.result, testCase.padWidth)
+
+			if got.ValueString() != testCase.expected {
+				t.Errorf("expected %q, got: %q", testCase.expected, got.ValueString())
+			}
+		})
+	}
+}
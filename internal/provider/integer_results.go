@@ -0,0 +1,301 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	listplanmodifiers "github.com/terraform-providers/terraform-provider-random/internal/planmodifiers/list"
+	"github.com/terraform-providers/terraform-provider-random/internal/random"
+)
+
+// integerExcludeRegexp matches an element of exclude, which is either a single
+// integer or an inclusive range of integers separated by a hyphen, such as
+// 1000-2000 or -10--5.
+var integerExcludeRegexp = regexp.MustCompile(`^(-?[0-9]+)(?:-(-?[0-9]+))?$`)
+
+// integerMaxQuantity is the maximum number of integers in results, which bounds
+// the memory used to generate them and the size of the state.
+const integerMaxQuantity = 10000
+
+func quantityAttribute() schema.Int64Attribute {
+	return schema.Int64Attribute{
+		Description: fmt.Sprintf("The number of integers to generate in `results`, each chosen from the range, "+
+			"such as to allocate a number of VLAN IDs or ASNs from a shared pool. When set, `result` is the first "+
+			"element of `results`. Must be between 1 and %d.", integerMaxQuantity),
+		Optional: true,
+		PlanModifiers: []planmodifier.Int64{
+			int64planmodifier.RequiresReplace(),
+		},
+		Validators: []validator.Int64{
+			int64validator.Between(1, integerMaxQuantity),
+		},
+	}
+}
+
+func uniqueAttribute() schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Description: "Ensure no integer occurs more than once in `results`, so `quantity` must not exceed the " +
+			"number of integers in the range which are not excluded. Requires `quantity`. Default value is " +
+			"`false`.",
+		Optional: true,
+		PlanModifiers: []planmodifier.Bool{
+			boolplanmodifier.RequiresReplace(),
+		},
+		Validators: []validator.Bool{
+			boolvalidator.AlsoRequires(path.MatchRoot("quantity")),
+		},
+	}
+}
+
+func excludeAttribute() schema.ListAttribute {
+	return schema.ListAttribute{
+		Description: "Integers within the range which are never chosen, each either a single integer, such " +
+			"as `\"22\"`, or an inclusive range of integers separated by a hyphen, such as `\"1000-2000\"` or " +
+			"`\"-10--5\"`. Applies to both `result` and `results`.",
+		ElementType: types.StringType,
+		Optional:    true,
+		PlanModifiers: []planmodifier.List{
+			listplanmodifier.RequiresReplace(),
+		},
+		Validators: []validator.List{
+			listvalidator.SizeAtLeast(1),
+			listvalidator.ValueStringsAre(
+				stringvalidator.RegexMatches(integerExcludeRegexp, "must be an integer, or two integers separated by a hyphen"),
+			),
+		},
+	}
+}
+
+func resultsAttribute() schema.ListAttribute {
+	return schema.ListAttribute{
+		Description: "The random integers generated when `quantity` is set, in the order they were chosen. " +
+			"Null if `quantity` is not set.",
+		ElementType: types.Int64Type,
+		Computed:    true,
+		PlanModifiers: []planmodifier.List{
+			listplanmodifiers.UseStateForUnknownIncludingNull(),
+		},
+	}
+}
+
+// integerInterval is an inclusive range of integers.
+type integerInterval struct {
+	min, max int64
+}
+
+// size returns the number of integers in the interval, which does not fit in
+// an int64 for the range of every int64.
+func (i integerInterval) size() uint64 {
+	return uint64(i.max-i.min) + 1
+}
+
+// parseIntegerExclude returns the intervals of the elements of exclude, which
+// must match integerExcludeRegexp.
+func parseIntegerExclude(exclude []string) ([]integerInterval, error) {
+	intervals := make([]integerInterval, 0, len(exclude))
+
+	for _, element := range exclude {
+		match := integerExcludeRegexp.FindStringSubmatch(element)
+		if match == nil {
+			return nil, fmt.Errorf("%q must be an integer, or two integers separated by a hyphen", element)
+		}
+
+		start, err := strconv.ParseInt(match[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a 64-bit integer", match[1])
+		}
+
+		end := start
+
+		if match[2] != "" {
+			end, err = strconv.ParseInt(match[2], 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("%q is not a 64-bit integer", match[2])
+			}
+		}
+
+		if start > end {
+			return nil, fmt.Errorf("%q must not begin with an integer greater than the one it ends with", element)
+		}
+
+		intervals = append(intervals, integerInterval{min: start, max: end})
+	}
+
+	return intervals, nil
+}
+
+// integerAllowedIntervals returns the intervals of the integers in the
+// inclusive range between the minimum and maximum values which are not
+// excluded, in ascending order.
+func integerAllowedIntervals(minVal, maxVal int64, exclude []integerInterval) []integerInterval {
+	sorted := append([]integerInterval(nil), exclude...)
+
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].min < sorted[j].min
+	})
+
+	var allowed []integerInterval
+
+	next := minVal
+
+	for _, excluded := range sorted {
+		if excluded.max < next {
+			continue
+		}
+
+		if excluded.min > maxVal {
+			break
+		}
+
+		if excluded.min > next {
+			allowed = append(allowed, integerInterval{min: next, max: excluded.min - 1})
+		}
+
+		if excluded.max >= maxVal {
+			return allowed
+		}
+
+		next = excluded.max + 1
+	}
+
+	return append(allowed, integerInterval{min: next, max: maxVal})
+}
+
+// integerCount returns the number of integers in the intervals, or false if
+// the number does not fit in an int64.
+func integerCount(intervals []integerInterval) (int64, bool) {
+	var count uint64
+
+	for _, interval := range intervals {
+		size := interval.size()
+
+		if size == 0 || count+size > math.MaxInt64 {
+			return 0, false
+		}
+
+		count += size
+	}
+
+	return int64(count), true
+}
+
+// integerAt returns the integer at the index of the integers in the intervals,
+// in ascending order.
+func integerAt(intervals []integerInterval, index int64) int64 {
+	for _, interval := range intervals {
+		if size := int64(interval.size()); index >= size {
+			index -= size
+			continue
+		}
+
+		return interval.min + index
+	}
+
+	panic("index out of range of the intervals")
+}
+
+// randomIntegers returns the given quantity of random integers from the
+// intervals, which always has the same value for the same non-empty seed.
+// When unique is true, no integer is chosen more than once.
+func randomIntegers(entropy *random.Source, intervals []integerInterval, quantity int64, unique bool, seed string, version int64) ([]int64, error) {
+	// The quantity is validated in the schema, but may not be known until
+	// apply.
+	if quantity < 1 || quantity > integerMaxQuantity {
+		return nil, fmt.Errorf("quantity must be between 1 and %d, got: %d", integerMaxQuantity, quantity)
+	}
+
+	count, ok := integerCount(intervals)
+	if !ok {
+		return nil, errors.New("the range contains too many integers, reduce the range between min and max")
+	}
+
+	if count == 0 {
+		return nil, errors.New("every integer in the range is excluded")
+	}
+
+	if unique && quantity > count {
+		return nil, fmt.Errorf("quantity (%d) must not exceed the number of integers in the range which are not "+
+			"excluded (%d) when unique is true", quantity, count)
+	}
+
+	rand, err := newIntegerRand(entropy, seed, version)
+	if err != nil {
+		return nil, err
+	}
+	results := make([]int64, 0, quantity)
+
+	if !unique {
+		for int64(len(results)) < quantity {
+			results = append(results, integerAt(intervals, rand.Int63n(count)))
+		}
+
+		if err := integerRandErr(rand); err != nil {
+			return nil, err
+		}
+
+		return results, nil
+	}
+
+	// Floyd's algorithm chooses a uniformly random subset of the indexes
+	// without enumerating every index, as the range may be very large. The
+	// order in which the indexes are chosen is not uniformly random, so they
+	// are shuffled.
+	chosen := make(map[int64]bool, quantity)
+
+	for j := count - quantity; j < count; j++ {
+		index := rand.Int63n(j + 1)
+
+		if chosen[index] {
+			index = j
+		}
+
+		chosen[index] = true
+		results = append(results, index)
+	}
+
+	rand.Shuffle(len(results), func(i, j int) {
+		results[i], results[j] = results[j], results[i]
+	})
+
+	if err := integerRandErr(rand); err != nil {
+		return nil, err
+	}
+
+	for i, index := range results {
+		results[i] = integerAt(intervals, index)
+	}
+
+	return results, nil
+}
+
+// integerResultsValue returns the results as a list value.
+func integerResultsValue(results []int64) types.List {
+	elements := make([]attr.Value, len(results))
+
+	for i, result := range results {
+		elements[i] = types.Int64Value(result)
+	}
+
+	return types.ListValueMust(types.Int64Type, elements)
+}
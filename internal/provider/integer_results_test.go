@@ -0,0 +1,221 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"reflect"
+	"testing"
+	"testing/iotest"
+
+	"github.com/terraform-providers/terraform-provider-random/internal/random"
+)
+
+func TestParseIntegerExclude(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		exclude     []string
+		expected    []integerInterval
+		expectedErr bool
+	}{
+		"single": {
+			exclude:  []string{"22"},
+			expected: []integerInterval{{min: 22, max: 22}},
+		},
+		"range": {
+			exclude:  []string{"1000-2000"},
+			expected: []integerInterval{{min: 1000, max: 2000}},
+		},
+		"negative": {
+			exclude:  []string{"-10--5", "-3"},
+			expected: []integerInterval{{min: -10, max: -5}, {min: -3, max: -3}},
+		},
+		"descending": {
+			exclude:     []string{"2000-1000"},
+			expectedErr: true,
+		},
+		"overflow": {
+			exclude:     []string{"9223372036854775808"},
+			expectedErr: true,
+		},
+		"invalid": {
+			exclude:     []string{"1,2"},
+			expectedErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := parseIntegerExclude(testCase.exclude)
+
+			if testCase.expectedErr {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !reflect.DeepEqual(got, testCase.expected) {
+				t.Errorf("expected %v, got: %v", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestIntegerAllowedIntervals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		min, max int64
+		exclude  []integerInterval
+		expected []integerInterval
+	}{
+		"none": {
+			min:      1,
+			max:      10,
+			expected: []integerInterval{{min: 1, max: 10}},
+		},
+		"middle": {
+			min:      1,
+			max:      10,
+			exclude:  []integerInterval{{min: 4, max: 6}},
+			expected: []integerInterval{{min: 1, max: 3}, {min: 7, max: 10}},
+		},
+		"overlapping-unsorted": {
+			min:      1,
+			max:      10,
+			exclude:  []integerInterval{{min: 5, max: 8}, {min: 3, max: 6}, {min: 1, max: 1}},
+			expected: []integerInterval{{min: 2, max: 2}, {min: 9, max: 10}},
+		},
+		"outside": {
+			min:      1,
+			max:      10,
+			exclude:  []integerInterval{{min: -5, max: 0}, {min: 11, max: 20}},
+			expected: []integerInterval{{min: 1, max: 10}},
+		},
+		"all": {
+			min:     1,
+			max:     10,
+			exclude: []integerInterval{{min: 0, max: 20}},
+		},
+		"max-int64": {
+			min:      math.MaxInt64 - 2,
+			max:      math.MaxInt64,
+			exclude:  []integerInterval{{min: math.MaxInt64 - 1, max: math.MaxInt64 - 1}},
+			expected: []integerInterval{{min: math.MaxInt64 - 2, max: math.MaxInt64 - 2}, {min: math.MaxInt64, max: math.MaxInt64}},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := integerAllowedIntervals(testCase.min, testCase.max, testCase.exclude)
+
+			if !reflect.DeepEqual(got, testCase.expected) {
+				t.Errorf("expected %v, got: %v", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestRandomIntegers(t *testing.T) {
+	t.Parallel()
+
+	intervals := integerAllowedIntervals(1, 100, []integerInterval{{min: 10, max: 89}})
+
+	t.Run("unique", func(t *testing.T) {
+		t.Parallel()
+
+		entropy := random.NewSource(rand.New(rand.NewSource(1)))
+
+		got, err := randomIntegers(entropy, intervals, 20, true, "", integerAlgorithmVersion)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		seen := make(map[int64]bool, len(got))
+
+		for _, value := range got {
+			if value < 1 || value > 100 || (value >= 10 && value <= 89) {
+				t.Errorf("expected a value outside of the excluded range, got: %d", value)
+			}
+
+			if seen[value] {
+				t.Errorf("expected unique values, got %d more than once in: %v", value, got)
+			}
+
+			seen[value] = true
+		}
+
+		if len(got) != 20 {
+			t.Errorf("expected 20 values, got: %d", len(got))
+		}
+	})
+
+	t.Run("seeded", func(t *testing.T) {
+		t.Parallel()
+
+		first, err := randomIntegers(nil, intervals, 5, true, "vlans", integerAlgorithmVersion)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		second, err := randomIntegers(nil, intervals, 5, true, "vlans", integerAlgorithmVersion)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if !reflect.DeepEqual(first, second) {
+			t.Errorf("expected the same values for the same seed, got: %v and %v", first, second)
+		}
+	})
+
+	t.Run("unreadable", func(t *testing.T) {
+		t.Parallel()
+
+		errRead := errors.New("read error")
+		entropy := random.NewSource(iotest.ErrReader(errRead))
+
+		for _, unique := range []bool{false, true} {
+			if _, err := randomIntegers(entropy, intervals, 5, unique, "", integerAlgorithmVersion); !errors.Is(err, errRead) {
+				t.Errorf("expected the error of the source, got: %v", err)
+			}
+		}
+	})
+
+	t.Run("unique-too-many", func(t *testing.T) {
+		t.Parallel()
+
+		if _, err := randomIntegers(nil, intervals, 21, true, "vlans", integerAlgorithmVersion); err == nil {
+			t.Fatal("expected error, got none")
+		}
+	})
+
+	t.Run("quantity-too-large", func(t *testing.T) {
+		t.Parallel()
+
+		if _, err := randomIntegers(nil, intervals, integerMaxQuantity+1, false, "vlans", integerAlgorithmVersion); err == nil {
+			t.Fatal("expected error, got none")
+		}
+	})
+
+	t.Run("full-range", func(t *testing.T) {
+		t.Parallel()
+
+		if _, err := randomIntegers(nil, []integerInterval{{min: math.MinInt64, max: math.MaxInt64}}, 1, false, "vlans", integerAlgorithmVersion); err == nil {
+			t.Fatal("expected error, got none")
+		}
+	})
+}
@@ -0,0 +1,270 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/terraform-providers/terraform-provider-random/internal/encrypt"
+	stringplanmodifiers "github.com/terraform-providers/terraform-provider-random/internal/planmodifiers/string"
+	"github.com/terraform-providers/terraform-provider-random/internal/publish"
+	"github.com/terraform-providers/terraform-provider-random/internal/random"
+)
+
+// providerData is supplied to resources via their Configure method.
+type providerData struct {
+	version           string
+	fips              bool
+	entropy           *random.Source
+	encryptionKey     *encrypt.Key
+	petNames          *petNamePool
+	publisher         publish.Publisher
+	uuidNamespace     []byte
+	maxGenerationTime time.Duration
+}
+
+// providerVersion returns the provider version from the data supplied to
+// Configure. An empty string is returned if the provider has not been
+// configured.
+func providerVersion(data any) string {
+	d, ok := data.(*providerData)
+	if !ok || d == nil {
+		return ""
+	}
+
+	return d.version
+}
+
+// providerFIPS returns true if the provider was configured to restrict hashing
+// to FIPS 140 approved algorithms.
+func providerFIPS(data any) bool {
+	d, ok := data.(*providerData)
+	if !ok || d == nil {
+		return false
+	}
+
+	return d.fips
+}
+
+// providerEntropy returns the source of entropy from which resources generate
+// random values. A nil Source, which reads from the cryptographic random number
+// generator, is returned if the provider has not been configured.
+func providerEntropy(data any) *random.Source {
+	d, ok := data.(*providerData)
+	if !ok || d == nil {
+		return nil
+	}
+
+	return d.entropy
+}
+
+// providerEncryptionKey returns the key with which resources encrypt their
+// result, or nil if the provider was not configured with
+// result_encryption_key.
+func providerEncryptionKey(data any) *encrypt.Key {
+	d, ok := data.(*providerData)
+	if !ok || d == nil {
+		return nil
+	}
+
+	return d.encryptionKey
+}
+
+// providerPetNamePool returns the pool of pet names generated in each
+// unique_within namespace, or nil if the provider has not been configured.
+func providerPetNamePool(data any) *petNamePool {
+	d, ok := data.(*providerData)
+	if !ok || d == nil {
+		return nil
+	}
+
+	return d.petNames
+}
+
+// providerUUIDNamespace returns the namespace of deterministic random_uuid
+// values, or nil if the provider was not configured with uuid_namespace.
+func providerUUIDNamespace(data any) []byte {
+	d, ok := data.(*providerData)
+	if !ok || d == nil {
+		return nil
+	}
+
+	return d.uuidNamespace
+}
+
+// providerMaxGenerationTime returns the maximum time for which random_password
+// generates its results, or zero if the provider has not been configured.
+func providerMaxGenerationTime(data any) time.Duration {
+	d, ok := data.(*providerData)
+	if !ok || d == nil {
+		return 0
+	}
+
+	return d.maxGenerationTime
+}
+
+// providerPublisher returns the publisher the provider was constructed with,
+// which receives the secrets generated by resources which support publishing,
+// or nil if there is none.
+func providerPublisher(data any) publish.Publisher {
+	d, ok := data.(*providerData)
+	if !ok || d == nil {
+		return nil
+	}
+
+	return d.publisher
+}
+
+// lifecycleValues returns the created_at and provider_version values which
+// are recorded in state when a resource generates a new random value.
+func lifecycleValues(version string) (types.String, types.String) {
+	return types.StringValue(time.Now().UTC().Format(time.RFC3339)), types.StringValue(version)
+}
+
+func createdAtAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: "The time, in RFC3339 format, at which the random value was generated. This value is " +
+			"`null` for resources which were imported, or created by a provider version which did not " +
+			"record this information.",
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifiers.UseStateForUnknownIncludingNull(),
+		},
+	}
+}
+
+func providerVersionAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: "The version of the provider which generated the random value. This value is `null` " +
+			"for resources which were imported, or created by a provider version which did not record " +
+			"this information.",
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifiers.UseStateForUnknownIncludingNull(),
+		},
+	}
+}
+
+// keepersHash returns the hex encoded SHA-256 hash of the JSON encoding of the
+// keepers, excluding keys with null values, which do not trigger replacement.
+// Null keepers have the same hash as empty keepers. The hash is unknown if any
+// value is unknown.
+func keepersHash(keepers types.Map) types.String {
+	if keepers.IsUnknown() {
+		return types.StringUnknown()
+	}
+
+	for _, value := range keepers.Elements() {
+		if value.IsUnknown() {
+			return types.StringUnknown()
+		}
+	}
+
+	encoded, err := keepersJSON(keepers)
+	if err != nil {
+		return types.StringUnknown()
+	}
+
+	sum := sha256.Sum256(encoded)
+
+	return types.StringValue(hex.EncodeToString(sum[:]))
+}
+
+// keepersJSON returns the JSON encoding of the known keepers, excluding keys
+// with null values, from which keepers_hash and the deterministic values of
+// resources are derived.
+func keepersJSON(keepers types.Map) ([]byte, error) {
+	values := make(map[string]string, len(keepers.Elements()))
+
+	for key, value := range keepers.Elements() {
+		if s, ok := value.(types.String); ok && !s.IsNull() && !s.IsUnknown() {
+			values[key] = s.ValueString()
+		}
+	}
+
+	// Map keys are sorted when encoded, so the encoding is stable.
+	return json.Marshal(values)
+}
+
+func keepersHashAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: "A hex encoded SHA-256 hash of `keepers`, which is known during plan and changes whenever " +
+			"a change to `keepers` regenerates the random value. Keys with `null` values are excluded, and the " +
+			"hash of unset `keepers` is that of an empty map. This can be used to propagate the rotation of " +
+			"the random value into the names or tags of other resources.",
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			keepersHashPlanModifier(),
+		},
+	}
+}
+
+// refreshKeepersHash sets keepers_hash in state, if null, for resources
+// created by earlier provider versions.
+func refreshKeepersHash(ctx context.Context, state *tfsdk.State) diag.Diagnostics {
+	var (
+		diags       diag.Diagnostics
+		keepers     types.Map
+		currentHash types.String
+	)
+
+	diags.Append(state.GetAttribute(ctx, path.Root("keepers_hash"), &currentHash)...)
+	if diags.HasError() || !currentHash.IsNull() {
+		return diags
+	}
+
+	diags.Append(state.GetAttribute(ctx, path.Root("keepers"), &keepers)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	logRefresh(ctx, "keepers_hash")
+
+	diags.Append(state.SetAttribute(ctx, path.Root("keepers_hash"), keepersHash(keepers))...)
+
+	return diags
+}
+
+// keepersHashPlanModifier returns a plan modifier for the keepers_hash
+// attribute which plans the hash of the planned keepers.
+func keepersHashPlanModifier() planmodifier.String {
+	return keepersHashModifier{}
+}
+
+type keepersHashModifier struct{}
+
+func (m keepersHashModifier) Description(ctx context.Context) string {
+	return m.MarkdownDescription(ctx)
+}
+
+func (m keepersHashModifier) MarkdownDescription(context.Context) string {
+	return "The hash of the planned keepers."
+}
+
+func (m keepersHashModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	// Do nothing if the resource is being destroyed.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var keepers types.Map
+
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("keepers"), &keepers)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.PlanValue = keepersHash(keepers)
+}
@@ -0,0 +1,381 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+func lifecycleGuardAttribute() schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Description: "When `true`, any plan which would replace the resource, and so regenerate the random " +
+			"value, fails with an error unless `allow_regeneration_token` is also changed in the same plan. " +
+			"This protects values such as production secrets from accidental changes to `keepers` or other " +
+			"arguments. Replacement requested with the `-replace` option or by tainting the resource is not " +
+			"planned by the provider, so cannot be prevented. Changing this value does not replace the resource.",
+		Optional: true,
+	}
+}
+
+func allowRegenerationTokenAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: "An arbitrary string, such as a date or change ticket number, which must be changed, to a " +
+			"value known during plan, to allow the replacement of a resource with `lifecycle_guard` enabled. " +
+			"Changing this value does not replace the resource.",
+		Optional: true,
+	}
+}
+
+// guardRegeneration adds an error to the response if the plan replaces a
+// resource with lifecycle_guard enabled, in either the prior state or the
+// plan, without changing allow_regeneration_token.
+//
+// The RequiresReplace paths of the attribute plan modifiers are not available
+// to the resource-level ModifyPlan, so the plan modifiers of the top-level
+// attributes are run again to determine which would replace the resource.
+func guardRegeneration(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Do nothing if the resource is being created or destroyed.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var planGuard, stateGuard types.Bool
+
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("lifecycle_guard"), &planGuard)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("lifecycle_guard"), &stateGuard)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !planGuard.ValueBool() && !stateGuard.ValueBool() {
+		return
+	}
+
+	var planToken, stateToken types.String
+
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("allow_regeneration_token"), &planToken)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("allow_regeneration_token"), &stateToken)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !planToken.IsUnknown() && !planToken.Equal(stateToken) {
+		return
+	}
+
+	replacePaths, diags := replacementPaths(ctx, req)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || len(replacePaths) == 0 {
+		return
+	}
+
+	names := make([]string, len(replacePaths))
+	for i, p := range replacePaths {
+		names[i] = p.String()
+	}
+
+	slices.Sort(names)
+
+	resp.Diagnostics.AddError(
+		"Regeneration Prevented",
+		fmt.Sprintf("The plan would replace the resource, regenerating its random value, because of changes to "+
+			"%s, but lifecycle_guard is enabled. Revert the changes, or change allow_regeneration_token to a value "+
+			"known during plan to allow the replacement.", strings.Join(names, ", ")),
+	)
+}
+
+// replacementPaths returns the paths of the top-level attributes and blocks
+// whose plan modifiers require the replacement of the resource. The plan
+// modifiers of nested attributes are not run, as no resource uses them to
+// require replacement.
+func replacementPaths(ctx context.Context, req resource.ModifyPlanRequest) (path.Paths, diag.Diagnostics) {
+	var (
+		diags diag.Diagnostics
+		paths path.Paths
+	)
+
+	schemaObjects := make(map[string]any, len(req.Plan.Schema.GetAttributes())+len(req.Plan.Schema.GetBlocks()))
+
+	for name, attribute := range req.Plan.Schema.GetAttributes() {
+		schemaObjects[name] = attribute
+	}
+
+	for name, block := range req.Plan.Schema.GetBlocks() {
+		schemaObjects[name] = block
+	}
+
+	for name, schemaObject := range schemaObjects {
+		p := path.Root(name)
+
+		replace, replaceDiags := schemaObjectRequiresReplace(ctx, req, p, schemaObject)
+		diags.Append(replaceDiags...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		if replace {
+			paths.Append(p)
+		}
+	}
+
+	return paths, diags
+}
+
+// schemaObjectRequiresReplace runs the plan modifiers of the attribute or
+// block at the path, and returns whether any requires the replacement of the
+// resource. Attributes and blocks are matched by the method returning their
+// plan modifiers, so that every type of attribute and nested attribute or
+// block is supported. Any other type has no plan modifiers which the
+// framework runs, and is skipped.
+func schemaObjectRequiresReplace(ctx context.Context, req resource.ModifyPlanRequest, p path.Path, schemaObject any) (bool, diag.Diagnostics) {
+	switch o := schemaObject.(type) {
+	case interface{ BoolPlanModifiers() []planmodifier.Bool }:
+		return requiresReplace(ctx, req, p, o.BoolPlanModifiers(), func(m planmodifier.Bool, configValue, planValue, stateValue types.Bool) bool {
+			resp := &planmodifier.BoolResponse{PlanValue: planValue}
+			m.PlanModifyBool(ctx, planmodifier.BoolRequest{
+				Path:           p,
+				PathExpression: p.Expression(),
+				Config:         req.Config,
+				ConfigValue:    configValue,
+				Plan:           req.Plan,
+				PlanValue:      planValue,
+				State:          req.State,
+				StateValue:     stateValue,
+				Private:        req.Private,
+			}, resp)
+			return resp.RequiresReplace
+		})
+	case interface{ DynamicPlanModifiers() []planmodifier.Dynamic }:
+		return requiresReplace(ctx, req, p, o.DynamicPlanModifiers(), func(m planmodifier.Dynamic, configValue, planValue, stateValue types.Dynamic) bool {
+			resp := &planmodifier.DynamicResponse{PlanValue: planValue}
+			m.PlanModifyDynamic(ctx, planmodifier.DynamicRequest{
+				Path:           p,
+				PathExpression: p.Expression(),
+				Config:         req.Config,
+				ConfigValue:    configValue,
+				Plan:           req.Plan,
+				PlanValue:      planValue,
+				State:          req.State,
+				StateValue:     stateValue,
+				Private:        req.Private,
+			}, resp)
+			return resp.RequiresReplace
+		})
+	case interface{ Float32PlanModifiers() []planmodifier.Float32 }:
+		return requiresReplace(ctx, req, p, o.Float32PlanModifiers(), func(m planmodifier.Float32, configValue, planValue, stateValue types.Float32) bool {
+			resp := &planmodifier.Float32Response{PlanValue: planValue}
+			m.PlanModifyFloat32(ctx, planmodifier.Float32Request{
+				Path:           p,
+				PathExpression: p.Expression(),
+				Config:         req.Config,
+				ConfigValue:    configValue,
+				Plan:           req.Plan,
+				PlanValue:      planValue,
+				State:          req.State,
+				StateValue:     stateValue,
+				Private:        req.Private,
+			}, resp)
+			return resp.RequiresReplace
+		})
+	case interface{ Float64PlanModifiers() []planmodifier.Float64 }:
+		return requiresReplace(ctx, req, p, o.Float64PlanModifiers(), func(m planmodifier.Float64, configValue, planValue, stateValue types.Float64) bool {
+			resp := &planmodifier.Float64Response{PlanValue: planValue}
+			m.PlanModifyFloat64(ctx, planmodifier.Float64Request{
+				Path:           p,
+				PathExpression: p.Expression(),
+				Config:         req.Config,
+				ConfigValue:    configValue,
+				Plan:           req.Plan,
+				PlanValue:      planValue,
+				State:          req.State,
+				StateValue:     stateValue,
+				Private:        req.Private,
+			}, resp)
+			return resp.RequiresReplace
+		})
+	case interface{ Int32PlanModifiers() []planmodifier.Int32 }:
+		return requiresReplace(ctx, req, p, o.Int32PlanModifiers(), func(m planmodifier.Int32, configValue, planValue, stateValue types.Int32) bool {
+			resp := &planmodifier.Int32Response{PlanValue: planValue}
+			m.PlanModifyInt32(ctx, planmodifier.Int32Request{
+				Path:           p,
+				PathExpression: p.Expression(),
+				Config:         req.Config,
+				ConfigValue:    configValue,
+				Plan:           req.Plan,
+				PlanValue:      planValue,
+				State:          req.State,
+				StateValue:     stateValue,
+				Private:        req.Private,
+			}, resp)
+			return resp.RequiresReplace
+		})
+	case interface{ Int64PlanModifiers() []planmodifier.Int64 }:
+		return requiresReplace(ctx, req, p, o.Int64PlanModifiers(), func(m planmodifier.Int64, configValue, planValue, stateValue types.Int64) bool {
+			resp := &planmodifier.Int64Response{PlanValue: planValue}
+			m.PlanModifyInt64(ctx, planmodifier.Int64Request{
+				Path:           p,
+				PathExpression: p.Expression(),
+				Config:         req.Config,
+				ConfigValue:    configValue,
+				Plan:           req.Plan,
+				PlanValue:      planValue,
+				State:          req.State,
+				StateValue:     stateValue,
+				Private:        req.Private,
+			}, resp)
+			return resp.RequiresReplace
+		})
+	case interface{ ListPlanModifiers() []planmodifier.List }:
+		return requiresReplace(ctx, req, p, o.ListPlanModifiers(), func(m planmodifier.List, configValue, planValue, stateValue types.List) bool {
+			resp := &planmodifier.ListResponse{PlanValue: planValue}
+			m.PlanModifyList(ctx, planmodifier.ListRequest{
+				Path:           p,
+				PathExpression: p.Expression(),
+				Config:         req.Config,
+				ConfigValue:    configValue,
+				Plan:           req.Plan,
+				PlanValue:      planValue,
+				State:          req.State,
+				StateValue:     stateValue,
+				Private:        req.Private,
+			}, resp)
+			return resp.RequiresReplace
+		})
+	case interface{ MapPlanModifiers() []planmodifier.Map }:
+		return requiresReplace(ctx, req, p, o.MapPlanModifiers(), func(m planmodifier.Map, configValue, planValue, stateValue types.Map) bool {
+			resp := &planmodifier.MapResponse{PlanValue: planValue}
+			m.PlanModifyMap(ctx, planmodifier.MapRequest{
+				Path:           p,
+				PathExpression: p.Expression(),
+				Config:         req.Config,
+				ConfigValue:    configValue,
+				Plan:           req.Plan,
+				PlanValue:      planValue,
+				State:          req.State,
+				StateValue:     stateValue,
+				Private:        req.Private,
+			}, resp)
+			return resp.RequiresReplace
+		})
+	case interface{ NumberPlanModifiers() []planmodifier.Number }:
+		return requiresReplace(ctx, req, p, o.NumberPlanModifiers(), func(m planmodifier.Number, configValue, planValue, stateValue types.Number) bool {
+			resp := &planmodifier.NumberResponse{PlanValue: planValue}
+			m.PlanModifyNumber(ctx, planmodifier.NumberRequest{
+				Path:           p,
+				PathExpression: p.Expression(),
+				Config:         req.Config,
+				ConfigValue:    configValue,
+				Plan:           req.Plan,
+				PlanValue:      planValue,
+				State:          req.State,
+				StateValue:     stateValue,
+				Private:        req.Private,
+			}, resp)
+			return resp.RequiresReplace
+		})
+	case interface{ ObjectPlanModifiers() []planmodifier.Object }:
+		return requiresReplace(ctx, req, p, o.ObjectPlanModifiers(), func(m planmodifier.Object, configValue, planValue, stateValue types.Object) bool {
+			resp := &planmodifier.ObjectResponse{PlanValue: planValue}
+			m.PlanModifyObject(ctx, planmodifier.ObjectRequest{
+				Path:           p,
+				PathExpression: p.Expression(),
+				Config:         req.Config,
+				ConfigValue:    configValue,
+				Plan:           req.Plan,
+				PlanValue:      planValue,
+				State:          req.State,
+				StateValue:     stateValue,
+				Private:        req.Private,
+			}, resp)
+			return resp.RequiresReplace
+		})
+	case interface{ SetPlanModifiers() []planmodifier.Set }:
+		return requiresReplace(ctx, req, p, o.SetPlanModifiers(), func(m planmodifier.Set, configValue, planValue, stateValue types.Set) bool {
+			resp := &planmodifier.SetResponse{PlanValue: planValue}
+			m.PlanModifySet(ctx, planmodifier.SetRequest{
+				Path:           p,
+				PathExpression: p.Expression(),
+				Config:         req.Config,
+				ConfigValue:    configValue,
+				Plan:           req.Plan,
+				PlanValue:      planValue,
+				State:          req.State,
+				StateValue:     stateValue,
+				Private:        req.Private,
+			}, resp)
+			return resp.RequiresReplace
+		})
+	case interface{ StringPlanModifiers() []planmodifier.String }:
+		return requiresReplace(ctx, req, p, o.StringPlanModifiers(), func(m planmodifier.String, configValue, planValue, stateValue types.String) bool {
+			resp := &planmodifier.StringResponse{PlanValue: planValue}
+			m.PlanModifyString(ctx, planmodifier.StringRequest{
+				Path:           p,
+				PathExpression: p.Expression(),
+				Config:         req.Config,
+				ConfigValue:    configValue,
+				Plan:           req.Plan,
+				PlanValue:      planValue,
+				State:          req.State,
+				StateValue:     stateValue,
+				Private:        req.Private,
+			}, resp)
+			return resp.RequiresReplace
+		})
+	default:
+		tflog.Debug(ctx, "Skipping attribute without plan modifiers when checking lifecycle_guard", map[string]any{
+			"attribute_path": p.String(),
+			"attribute_type": fmt.Sprintf("%T", schemaObject),
+		})
+
+		return false, nil
+	}
+}
+
+// requiresReplace reads the config, plan and state values of the attribute at
+// the path, and returns whether modify, which runs a plan modifier with the
+// values, returns true for any of the plan modifiers.
+func requiresReplace[M any, V attr.Value](ctx context.Context, req resource.ModifyPlanRequest, p path.Path, modifiers []M, modify func(m M, configValue, planValue, stateValue V) bool) (bool, diag.Diagnostics) {
+	if len(modifiers) == 0 {
+		return false, nil
+	}
+
+	var configValue, planValue, stateValue V
+
+	diags := getAttributeValues(ctx, req, p, &configValue, &planValue, &stateValue)
+	if diags.HasError() {
+		return false, diags
+	}
+
+	for _, m := range modifiers {
+		if modify(m, configValue, planValue, stateValue) {
+			return true, diags
+		}
+	}
+
+	return false, diags
+}
+
+// getAttributeValues reads the config, plan and state values of the
+// attribute at the path into the targets.
+func getAttributeValues(ctx context.Context, req resource.ModifyPlanRequest, p path.Path, configValue, planValue, stateValue any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.Append(req.Config.GetAttribute(ctx, p, configValue)...)
+	diags.Append(req.Plan.GetAttribute(ctx, p, planValue)...)
+	diags.Append(req.State.GetAttribute(ctx, p, stateValue)...)
+
+	return diags
+}
@@ -0,0 +1,331 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/numberplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestGuardRegeneration(t *testing.T) {
+	t.Parallel()
+
+	hexModel := func(keeper string, guard types.Bool, token types.String) hexModelV0 {
+		keepers := types.MapValueMust(types.StringType, map[string]attr.Value{
+			"key": types.StringValue(keeper),
+		})
+
+		return hexModelV0{
+			ID:                     types.StringValue("f00d"),
+			Keepers:                keepers,
+			KeepersHash:            keepersHash(keepers),
+			Length:                 types.Int64Value(4),
+			Upper:                  types.BoolValue(false),
+			Result:                 types.StringValue("f00d"),
+			CreatedAt:              types.StringNull(),
+			ProviderVersion:        types.StringNull(),
+			LifecycleGuard:         guard,
+			AllowRegenerationToken: token,
+		}
+	}
+
+	testCases := map[string]struct {
+		state         hexModelV0
+		plan          hexModelV0
+		expectedError string
+	}{
+		"guard-disabled": {
+			state: hexModel("a", types.BoolNull(), types.StringNull()),
+			plan:  hexModel("b", types.BoolNull(), types.StringNull()),
+		},
+		"guard-unchanged": {
+			state: hexModel("a", types.BoolValue(true), types.StringNull()),
+			plan:  hexModel("a", types.BoolValue(true), types.StringNull()),
+		},
+		"guard-removed": {
+			state: hexModel("a", types.BoolValue(true), types.StringNull()),
+			plan:  hexModel("a", types.BoolNull(), types.StringNull()),
+		},
+		"guard-replace": {
+			state:         hexModel("a", types.BoolValue(true), types.StringValue("1")),
+			plan:          hexModel("b", types.BoolValue(true), types.StringValue("1")),
+			expectedError: "Regeneration Prevented",
+		},
+		"guard-removed-replace": {
+			state:         hexModel("a", types.BoolValue(true), types.StringNull()),
+			plan:          hexModel("b", types.BoolNull(), types.StringNull()),
+			expectedError: "Regeneration Prevented",
+		},
+		"guard-replace-token-changed": {
+			state: hexModel("a", types.BoolValue(true), types.StringValue("1")),
+			plan:  hexModel("b", types.BoolValue(true), types.StringValue("2")),
+		},
+		"guard-replace-token-set": {
+			state: hexModel("a", types.BoolValue(true), types.StringNull()),
+			plan:  hexModel("b", types.BoolValue(true), types.StringValue("1")),
+		},
+		"guard-replace-token-unknown": {
+			state:         hexModel("a", types.BoolValue(true), types.StringValue("1")),
+			plan:          hexModel("b", types.BoolValue(true), types.StringUnknown()),
+			expectedError: "Regeneration Prevented",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			hexSchema := hexSchemaV0()
+			nullValue := tftypes.NewValue(hexSchema.Type().TerraformType(ctx), nil)
+
+			state := tfsdk.State{Schema: hexSchema, Raw: nullValue}
+			plan := tfsdk.Plan{Schema: hexSchema, Raw: nullValue}
+
+			diags := state.Set(ctx, &testCase.state)
+			diags.Append(plan.Set(ctx, &testCase.plan)...)
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			req := resource.ModifyPlanRequest{
+				Config: tfsdk.Config{Schema: hexSchema, Raw: plan.Raw},
+				Plan:   plan,
+				State:  state,
+			}
+			resp := &resource.ModifyPlanResponse{Plan: plan}
+
+			guardRegeneration(ctx, req, resp)
+
+			if testCase.expectedError == "" {
+				if resp.Diagnostics.HasError() {
+					t.Errorf("unexpected error: %v", resp.Diagnostics)
+				}
+
+				return
+			}
+
+			if resp.Diagnostics.ErrorsCount() != 1 || resp.Diagnostics.Errors()[0].Summary() != testCase.expectedError {
+				t.Errorf("expected error %q, got: %v", testCase.expectedError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestGuardRegeneration_InputObjects(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	shuffleSchema := shuffleSchemaV1()
+	objectType := shuffleSchema.Type().TerraformType(ctx).(tftypes.Object)
+
+	// shuffleValue returns a random_shuffle value with lifecycle_guard enabled
+	// and the given input_objects, and every other attribute null.
+	shuffleValue := func(inputObjects ...string) tftypes.Value {
+		values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+		for name, attributeType := range objectType.AttributeTypes {
+			values[name] = tftypes.NewValue(attributeType, nil)
+		}
+
+		elements := make([]tftypes.Value, len(inputObjects))
+		for i, inputObject := range inputObjects {
+			elements[i] = tftypes.NewValue(tftypes.String, inputObject)
+		}
+
+		values["id"] = tftypes.NewValue(tftypes.String, "-")
+		values["lifecycle_guard"] = tftypes.NewValue(tftypes.Bool, true)
+		values["input_objects"] = tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, elements)
+
+		return tftypes.NewValue(objectType, values)
+	}
+
+	testCases := map[string]struct {
+		state         tftypes.Value
+		plan          tftypes.Value
+		expectedError string
+	}{
+		"unchanged": {
+			state: shuffleValue("a", "b"),
+			plan:  shuffleValue("a", "b"),
+		},
+		"changed": {
+			state:         shuffleValue("a", "b"),
+			plan:          shuffleValue("a", "c"),
+			expectedError: "Regeneration Prevented",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			plan := tfsdk.Plan{Schema: shuffleSchema, Raw: testCase.plan}
+
+			req := resource.ModifyPlanRequest{
+				Config: tfsdk.Config{Schema: shuffleSchema, Raw: testCase.plan},
+				Plan:   plan,
+				State:  tfsdk.State{Schema: shuffleSchema, Raw: testCase.state},
+			}
+			resp := &resource.ModifyPlanResponse{Plan: plan}
+
+			guardRegeneration(ctx, req, resp)
+
+			if testCase.expectedError == "" {
+				if resp.Diagnostics.HasError() {
+					t.Errorf("unexpected error: %v", resp.Diagnostics)
+				}
+
+				return
+			}
+
+			if resp.Diagnostics.ErrorsCount() != 1 || resp.Diagnostics.Errors()[0].Summary() != testCase.expectedError {
+				t.Errorf("expected error %q, got: %v", testCase.expectedError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+// TestReplacementPaths_Schemas verifies that the plan modifiers of every
+// attribute and block in the schema of every resource can be run again when
+// checking whether lifecycle_guard prevents the replacement of the resource.
+func TestReplacementPaths_Schemas(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	for _, newResource := range New("test")().Resources(ctx) {
+		r := newResource()
+
+		metadataResp := &resource.MetadataResponse{}
+		r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "random"}, metadataResp)
+
+		schemaResp := &resource.SchemaResponse{}
+		r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+		t.Run(metadataResp.TypeName, func(t *testing.T) {
+			t.Parallel()
+
+			objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+			values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+			for name, attributeType := range objectType.AttributeTypes {
+				values[name] = tftypes.NewValue(attributeType, nil)
+			}
+
+			if _, ok := schemaResp.Schema.Attributes["lifecycle_guard"]; ok {
+				values["lifecycle_guard"] = tftypes.NewValue(tftypes.Bool, true)
+			}
+
+			value := tftypes.NewValue(objectType, values)
+
+			req := resource.ModifyPlanRequest{
+				Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: value},
+				Plan:   tfsdk.Plan{Schema: schemaResp.Schema, Raw: value},
+				State:  tfsdk.State{Schema: schemaResp.Schema, Raw: value},
+			}
+
+			paths, diags := replacementPaths(ctx, req)
+
+			if diags.HasError() {
+				t.Errorf("unexpected error: %v", diags)
+			}
+
+			if len(paths) != 0 {
+				t.Errorf("expected no replacement of an unchanged resource, got: %v", paths)
+			}
+		})
+	}
+}
+
+// TestReplacementPaths_AttributeTypes verifies that the plan modifiers of
+// attribute and block types which no resource uses yet are run.
+func TestReplacementPaths_AttributeTypes(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"set": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"list_nested": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"value": schema.StringAttribute{Optional: true},
+					},
+				},
+				Optional: true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"number": schema.NumberAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.Number{
+					numberplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+
+	objectType := testSchema.Type().TerraformType(ctx).(tftypes.Object)
+	listNestedType := objectType.AttributeTypes["list_nested"].(tftypes.List)
+
+	testValue := func(set, listNested string) tftypes.Value {
+		return tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"set": tftypes.NewValue(objectType.AttributeTypes["set"], []tftypes.Value{
+				tftypes.NewValue(tftypes.String, set),
+			}),
+			"list_nested": tftypes.NewValue(listNestedType, []tftypes.Value{
+				tftypes.NewValue(listNestedType.ElementType, map[string]tftypes.Value{
+					"value": tftypes.NewValue(tftypes.String, listNested),
+				}),
+			}),
+			"number": tftypes.NewValue(tftypes.Number, 1),
+		})
+	}
+
+	plan := testValue("b", "d")
+
+	req := resource.ModifyPlanRequest{
+		Config: tfsdk.Config{Schema: testSchema, Raw: plan},
+		Plan:   tfsdk.Plan{Schema: testSchema, Raw: plan},
+		State:  tfsdk.State{Schema: testSchema, Raw: testValue("a", "c")},
+	}
+
+	paths, diags := replacementPaths(ctx, req)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	expected := path.Paths{path.Root("list_nested"), path.Root("set")}
+
+	slices.SortFunc(paths, func(a, b path.Path) int {
+		return strings.Compare(a.String(), b.String())
+	})
+
+	if !slices.EqualFunc(paths, expected, path.Path.Equal) {
+		t.Errorf("expected %v, got: %v", expected, paths)
+	}
+}
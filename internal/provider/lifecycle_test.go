@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestKeepersHash(t *testing.T) {
+	t.Parallel()
+
+	// The SHA-256 hash of "{}".
+	emptyHash := types.StringValue("44136fa355b3678a1146ad16f7e8649e94fb4fc21fe77e8310c060f61caaff8a")
+
+	testCases := map[string]struct {
+		keepers  types.Map
+		expected types.String
+	}{
+		"null": {
+			keepers:  types.MapNull(types.StringType),
+			expected: emptyHash,
+		},
+		"empty": {
+			keepers:  types.MapValueMust(types.StringType, map[string]attr.Value{}),
+			expected: emptyHash,
+		},
+		"null-values": {
+			keepers: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"key": types.StringNull(),
+			}),
+			expected: emptyHash,
+		},
+		"values": {
+			keepers: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"b": types.StringValue("2"),
+				"a": types.StringValue("1"),
+				"c": types.StringNull(),
+			}),
+			// The SHA-256 hash of `{"a":"1","b":"2"}`.
+			expected: types.StringValue("21f76dfbfe6dfe21f762080ef484112cf2952974cef30741fd1931e1c6d92112"),
+		},
+		"unknown": {
+			keepers:  types.MapUnknown(types.StringType),
+			expected: types.StringUnknown(),
+		},
+		"unknown-values": {
+			keepers: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"key": types.StringUnknown(),
+			}),
+			expected: types.StringUnknown(),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := keepersHash(testCase.keepers); !got.Equal(testCase.expected) {
+				t.Errorf("expected %s, got: %s", testCase.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/terraform-providers/terraform-provider-random/internal/metrics"
+)
+
+// The sources of randomness recorded in the random_source log field, so that
+// generator usage can be audited with TF_LOG=DEBUG.
+const (
+	// randomSourceCrypto is the operating system's cryptographically secure
+	// random number generator.
+	randomSourceCrypto = "crypto/rand"
+	// randomSourceMath is a pseudo-random number generator seeded from the
+	// provider's source of entropy.
+	randomSourceMath = "math/rand"
+	// randomSourceSeeded is a pseudo-random number generator seeded with the
+	// configured seed, which always produces the same values.
+	randomSourceSeeded = "math/rand (seeded)"
+	// randomSourceDerived is a key derivation function applied to configured
+	// values, which always produces the same values. The function is recorded
+	// in the algorithm log field.
+	randomSourceDerived = "derived"
+)
+
+// pseudoRandomSource returns the random_source log field value for a
+// generator created by random.Source.NewRand with the given seed.
+func pseudoRandomSource(seed string) string {
+	if seed != "" {
+		return randomSourceSeeded
+	}
+
+	return randomSourceMath
+}
+
+// sampledRandomSource returns the random_source log field value for a
+// generator which chooses integers by rejection sampling from the provider's
+// source of entropy when the seed is empty, such as random.Rand.
+func sampledRandomSource(seed string) string {
+	if seed != "" {
+		return randomSourceSeeded
+	}
+
+	return randomSourceCrypto
+}
+
+// logGeneration logs the generation of a new random value at debug level,
+// with the source of randomness and the given fields, and returns a function
+// to be deferred which logs the duration of the generation. The resource type
+// and request identifiers are added to every log entry by the framework. The
+// generation is also counted in the provider metrics.
+func logGeneration(ctx context.Context, source string, fields map[string]any) func() {
+	metrics.RecordGeneration(source)
+
+	ctx = tflog.SetField(ctx, "random_source", source)
+
+	for k, v := range fields {
+		ctx = tflog.SetField(ctx, k, v)
+	}
+
+	start := time.Now()
+
+	tflog.Debug(ctx, "Generating random value")
+
+	return func() {
+		tflog.Debug(ctx, "Generated random value", map[string]any{
+			"duration_ms": time.Since(start).Milliseconds(),
+		})
+	}
+}
+
+// logImport logs the import of an existing value at debug level, with the
+// given fields. The imported value itself must not be included, as it may be
+// sensitive.
+func logImport(ctx context.Context, fields map[string]any) {
+	tflog.Debug(ctx, "Importing existing value", fields)
+}
+
+// logStateUpgrade logs the upgrade of resource state between schema versions
+// at debug level.
+func logStateUpgrade(ctx context.Context, fromVersion, toVersion int64) {
+	tflog.Debug(ctx, "Upgrading resource state", map[string]any{
+		"from_schema_version": fromVersion,
+		"to_schema_version":   toVersion,
+	})
+}
+
+// logRefresh logs the population of attributes during refresh, for resources
+// created by earlier provider versions, at debug level.
+func logRefresh(ctx context.Context, attributes ...string) {
+	tflog.Debug(ctx, "Populating attributes not recorded by an earlier provider version", map[string]any{
+		"attributes": attributes,
+	})
+}
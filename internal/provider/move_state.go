@@ -0,0 +1,281 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.ResourceWithMoveState = (*bytesResource)(nil)
+	_ resource.ResourceWithMoveState = (*passwordResource)(nil)
+	_ resource.ResourceWithMoveState = (*stringResource)(nil)
+)
+
+// randomProviderSource is the source address of this provider, without the
+// hostname, which may differ when the provider is installed from a mirror.
+const randomProviderSource = "hashicorp/random"
+
+// MoveState moves the state of a random_string to a random_password, using a
+// moved block, without generating a new result. Requires Terraform 1.8 or
+// later.
+func (r *passwordResource) MoveState(context.Context) []resource.StateMover {
+	sourceSchema := stringSchemaV3()
+
+	return []resource.StateMover{
+		{
+			SourceSchema: &sourceSchema,
+			StateMover:   r.moveStringState,
+		},
+	}
+}
+
+func (r *passwordResource) moveStringState(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+	if !isMoveFrom(req, "random_string") {
+		return
+	}
+
+	resp.Diagnostics.Append(validateMoveSchemaVersion(req, stringSchemaV3().Version)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var source stringModelV3
+
+	resp.Diagnostics.Append(req.SourceState.Get(ctx, &source)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	target := passwordModelV4{
+		stringSharedModel:  source.stringSharedModel,
+		ID:                 types.StringValue("none"),
+		MinLength:          types.Int64Null(),
+		MaxLength:          types.Int64Null(),
+		PinnedPrefix:       types.StringNull(),
+		NoLeadingNumeric:   types.BoolNull(),
+		NoLeadingSpecial:   types.BoolNull(),
+		NoTrailingNumeric:  types.BoolNull(),
+		NoTrailingSpecial:  types.BoolNull(),
+		MaxRepeat:          types.Int64Null(),
+		Distinct:           types.BoolNull(),
+		Groups:             types.ObjectNull(passwordGroupsAttrTypes),
+		Preset:             types.StringNull(),
+		EnablePreview:      types.BoolNull(),
+		ResultPreview:      types.StringNull(),
+		EnableLegacyHashes: types.BoolNull(),
+		NTLMHash:           types.StringNull(),
+		GenerateBcryptHash: types.BoolValue(true),
+		PBKDF2Hash:         types.StringNull(),
+		CountResults:       types.Int64Null(),
+		Results:            types.ListNull(types.StringType),
+		BcryptHashes:       types.ListNull(types.StringType),
+		PHCHash:            types.ObjectNull(phcHashAttrTypes),
+		RecipientPublicKey: types.StringNull(),
+		WrappedResult:      types.StringNull(),
+		Fingerprint:        passwordFingerprint(source.Result.ValueString()),
+		EncryptedResult:    source.EncryptedResult,
+	}
+
+	target.KeepersHash = keepersHash(source.Keepers)
+
+	resp.Diagnostics.Append(r.setHashes(&target, target.Result.ValueString())...)
+
+	target.EntropyBits = target.entropyBits(ctx)
+	target.Spec = target.spec(ctx)
+
+	resp.Diagnostics.Append(resp.TargetState.Set(ctx, &target)...)
+}
+
+// MoveState moves the state of a random_password to a random_string, using a
+// moved block, without generating a new result. Requires Terraform 1.8 or
+// later.
+func (r *stringResource) MoveState(context.Context) []resource.StateMover {
+	sourceSchema := passwordSchemaV4()
+
+	return []resource.StateMover{
+		{
+			SourceSchema: &sourceSchema,
+			StateMover:   r.movePasswordState,
+		},
+	}
+}
+
+func (r *stringResource) movePasswordState(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+	if !isMoveFrom(req, "random_password") {
+		return
+	}
+
+	resp.Diagnostics.Append(validateMoveSchemaVersion(req, passwordSchemaV4().Version)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var source passwordModelV4
+
+	resp.Diagnostics.Append(req.SourceState.Get(ctx, &source)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the first result would be retained, so the remaining results would
+	// be silently lost.
+	if !source.CountResults.IsNull() {
+		resp.Diagnostics.AddError(
+			"Unable to Move Resource State",
+			"A random_password with count_results cannot be moved to a random_string, which generates a single "+
+				"result. Remove count_results from the random_password before moving it.",
+		)
+		return
+	}
+
+	target := stringModelV3{
+		stringSharedModel: source.stringSharedModel,
+		ID:                source.Result,
+		LengthUnit:        types.StringNull(),
+		Normalization:     types.StringNull(),
+		DNSLabel:          types.BoolNull(),
+		GrowInPlace:       types.BoolNull(),
+		MustMatch:         types.StringNull(),
+		EncryptedResult:   source.EncryptedResult,
+	}
+
+	target.KeepersHash = keepersHash(source.Keepers)
+
+	target.EntropyBits = target.entropyBits()
+	target.Spec = target.spec()
+
+	resp.Diagnostics.AddWarning(
+		"Moved Password Is Not Sensitive",
+		"The result of random_string is not marked as sensitive, so the moved password may be displayed in plan "+
+			"output and logs. Use random_password for values which must remain secret.",
+	)
+
+	resp.Diagnostics.Append(resp.TargetState.Set(ctx, &target)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setIdentity(ctx, resp.TargetIdentity, target.ID)...)
+}
+
+// MoveState moves the state of a random_id to a random_bytes, using a moved
+// block, without generating new bytes. Requires Terraform 1.8 or later.
+func (r *bytesResource) MoveState(context.Context) []resource.StateMover {
+	sourceSchema := idSchemaV1()
+
+	return []resource.StateMover{
+		{
+			SourceSchema: &sourceSchema,
+			StateMover:   r.moveIDState,
+		},
+	}
+}
+
+func (r *bytesResource) moveIDState(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+	if !isMoveFrom(req, "random_id") {
+		return
+	}
+
+	resp.Diagnostics.Append(validateMoveSchemaVersion(req, idSchemaV1().Version)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var source idModelV1
+
+	resp.Diagnostics.Append(req.SourceState.Get(ctx, &source)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the bytes of the first id would be retained, so the remaining ids
+	// would be silently lost.
+	if !source.CountOutputs.IsNull() {
+		resp.Diagnostics.AddError(
+			"Unable to Move Resource State",
+			"A random_id with count_outputs cannot be moved to a random_bytes, which generates a single value. "+
+				"Remove count_outputs from the random_id before moving it.",
+		)
+		return
+	}
+
+	// The id is the unaffixed base64url encoding of the bytes, from which the
+	// encodings of random_bytes are derived.
+	bytes, err := base64.RawURLEncoding.DecodeString(source.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Move Resource State",
+			"While attempting to move a random_id to a random_bytes, the id could not be decoded.\n\n"+
+				fmt.Sprintf("Original Error: %s", err),
+		)
+		return
+	}
+
+	target := bytesModelV1{
+		Length:                 types.Int64Value(int64(len(bytes))),
+		Keepers:                source.Keepers,
+		KeepersHash:            keepersHash(source.Keepers),
+		Regenerate:             types.StringNull(),
+		Base64:                 types.StringValue(base64.StdEncoding.EncodeToString(bytes)),
+		Hex:                    types.StringValue(hex.EncodeToString(bytes)),
+		HexChunkSize:           source.HexChunkSize,
+		HexChunks:              source.HexChunks,
+		CreatedAt:              source.CreatedAt,
+		ProviderVersion:        source.ProviderVersion,
+		LifecycleGuard:         source.LifecycleGuard,
+		AllowRegenerationToken: source.AllowRegenerationToken,
+		WrappingPublicKey:      types.StringNull(),
+		WrappingAlgorithm:      types.StringNull(),
+		WrappedKey:             types.StringNull(),
+	}
+
+	// The bytes of an id with a seed are derived rather than random, which
+	// entropy_bits continues to reflect.
+	target.EntropyBits = source.entropyBits()
+	target.Spec = bytesSpec(target.Length, target.EntropyBits)
+
+	if !source.Prefix.IsNull() || !source.Suffix.IsNull() {
+		resp.Diagnostics.AddWarning(
+			"Moved Bytes Are Not Affixed",
+			"The random_id has a prefix or suffix, which random_bytes does not support, so the base64 and hex "+
+				"values of the random_bytes are the encodings of the bytes alone.",
+		)
+	}
+
+	resp.Diagnostics.Append(resp.TargetState.Set(ctx, &target)...)
+}
+
+// isMoveFrom returns true if the state is being moved from the given resource
+// type of this provider.
+func isMoveFrom(req resource.MoveStateRequest, typeName string) bool {
+	return req.SourceTypeName == typeName && strings.HasSuffix(req.SourceProviderAddress, randomProviderSource)
+}
+
+// validateMoveSchemaVersion returns an error diagnostic if the source state
+// was written with an earlier schema version, which must first be upgraded by
+// applying the configuration before the resource is moved. The source state is
+// not populated if it could not be decoded with the current source schema.
+func validateMoveSchemaVersion(req resource.MoveStateRequest, version int64) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if req.SourceSchemaVersion != version || req.SourceState == nil {
+		diags.AddError(
+			"Unable to Move Resource State",
+			fmt.Sprintf("The state of %s has schema version %d, but only schema version %d can be moved. Apply "+
+				"the configuration with this provider version before moving the resource, so that its state is "+
+				"upgraded.", req.SourceTypeName, req.SourceSchemaVersion, version),
+		)
+	}
+
+	return diags
+}
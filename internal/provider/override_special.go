@@ -0,0 +1,82 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// singleCharacterRegexp matches a string consisting of exactly one character.
+var singleCharacterRegexp = regexp.MustCompile(`^(?s:.)$`)
+
+// overrideSpecialListAttribute returns the schema for the override_special_list
+// attribute, which supplies the characters of override_special as a list, so
+// that quotes, backslashes and template sequences need not be escaped.
+func overrideSpecialListAttribute(conflictsWith ...path.Expression) schema.ListAttribute {
+	return schema.ListAttribute{
+		Description: "Supply your own list of special characters to use for string generation as a list of " +
+			"single characters, such as `[\"!\", \"@\", \"-\"]`, rather than as a single string, so that " +
+			"quotes, backslashes and template sequences do not need to be escaped. Duplicate characters are " +
+			"ignored. This behaves as `override_special`, with which it conflicts.",
+		ElementType: types.StringType,
+		Optional:    true,
+		PlanModifiers: []planmodifier.List{
+			listplanmodifier.RequiresReplace(),
+		},
+		Validators: []validator.List{
+			listvalidator.SizeAtLeast(1),
+			listvalidator.ValueStringsAre(
+				stringvalidator.RegexMatches(singleCharacterRegexp, "must be a single character"),
+			),
+			listvalidator.ConflictsWith(append(conflictsWith, path.MatchRoot("override_special"))...),
+		},
+	}
+}
+
+// overrideSpecialValue returns the special characters supplied by either
+// override_special or override_special_list, along with the path of the
+// attribute which supplied them. The characters of the list are joined in
+// order, with duplicate characters removed. The value is unknown if any
+// element of the list is unknown.
+func overrideSpecialValue(overrideSpecial types.String, overrideSpecialList types.List) (types.String, path.Path) {
+	if overrideSpecialList.IsNull() {
+		return overrideSpecial, path.Root("override_special")
+	}
+
+	listPath := path.Root("override_special_list")
+
+	if overrideSpecialList.IsUnknown() {
+		return types.StringUnknown(), listPath
+	}
+
+	var chars strings.Builder
+
+	seen := make(map[string]bool)
+
+	for _, element := range overrideSpecialList.Elements() {
+		char, ok := element.(types.String)
+		if !ok || char.IsUnknown() {
+			return types.StringUnknown(), listPath
+		}
+
+		if char.IsNull() || seen[char.ValueString()] {
+			continue
+		}
+
+		seen[char.ValueString()] = true
+		chars.WriteString(char.ValueString())
+	}
+
+	return types.StringValue(chars.String()), listPath
+}
@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestOverrideSpecialValue(t *testing.T) {
+	t.Parallel()
+
+	list := func(elements ...attr.Value) types.List {
+		return types.ListValueMust(types.StringType, elements)
+	}
+
+	testCases := map[string]struct {
+		overrideSpecial     types.String
+		overrideSpecialList types.List
+		expected            types.String
+		expectedPath        path.Path
+	}{
+		"string": {
+			overrideSpecial:     types.StringValue("!@"),
+			overrideSpecialList: types.ListNull(types.StringType),
+			expected:            types.StringValue("!@"),
+			expectedPath:        path.Root("override_special"),
+		},
+		"null": {
+			overrideSpecial:     types.StringNull(),
+			overrideSpecialList: types.ListNull(types.StringType),
+			expected:            types.StringNull(),
+			expectedPath:        path.Root("override_special"),
+		},
+		"list": {
+			overrideSpecial:     types.StringNull(),
+			overrideSpecialList: list(types.StringValue(`"`), types.StringValue("$"), types.StringValue(`\`)),
+			expected:            types.StringValue(`"$\`),
+			expectedPath:        path.Root("override_special_list"),
+		},
+		"list-duplicates": {
+			overrideSpecial:     types.StringNull(),
+			overrideSpecialList: list(types.StringValue("-"), types.StringValue("€"), types.StringValue("-")),
+			expected:            types.StringValue("-€"),
+			expectedPath:        path.Root("override_special_list"),
+		},
+		"list-unknown": {
+			overrideSpecial:     types.StringNull(),
+			overrideSpecialList: types.ListUnknown(types.StringType),
+			expected:            types.StringUnknown(),
+			expectedPath:        path.Root("override_special_list"),
+		},
+		"list-unknown-element": {
+			overrideSpecial:     types.StringNull(),
+			overrideSpecialList: list(types.StringValue("-"), types.StringUnknown()),
+			expected:            types.StringUnknown(),
+			expectedPath:        path.Root("override_special_list"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, gotPath := overrideSpecialValue(testCase.overrideSpecial, testCase.overrideSpecialList)
+
+			if !got.Equal(testCase.expected) {
+				t.Errorf("expected %s, got: %s", testCase.expected, got)
+			}
+
+			if !gotPath.Equal(testCase.expectedPath) {
+				t.Errorf("expected path %s, got: %s", testCase.expectedPath, gotPath)
+			}
+		})
+	}
+}
@@ -0,0 +1,45 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// fingerprintLength is the number of hexadecimal digits of the SHA-256 hash of
+// the result which form its fingerprint. Only 32 bits of the hash are
+// revealed, which is enough to detect that a deployed secret differs from the
+// result, while many passwords share each fingerprint.
+const fingerprintLength = 8
+
+// fingerprintAttribute returns the schema for the fingerprint attribute, which
+// is not sensitive so that it can be stored alongside the deployed secret.
+func fingerprintAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: "The first 8 lowercase hexadecimal digits of the SHA-256 hash of the result, which is not " +
+			"sensitive, so can be stored as a tag or label of the resource to which the result is deployed, and " +
+			"compared with the fingerprint of the deployed secret to detect drift without revealing the secret. " +
+			"For example, `substr(sha256(secret), 0, 8)` in Terraform, or `printf %s \"$SECRET\" | sha256sum | " +
+			"cut -c1-8` in a shell. The fingerprint is unsalted, so should not be published for results with " +
+			"little entropy, which could be guessed by hashing candidates. When `count_results` is set, this is " +
+			"the fingerprint of `result`, which is the first element of `results`.",
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+// passwordFingerprint returns the fingerprint of the result.
+func passwordFingerprint(result string) types.String {
+	hash := sha256.Sum256([]byte(result))
+
+	return types.StringValue(hex.EncodeToString(hash[:])[:fingerprintLength])
+}
@@ -0,0 +1,44 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestPasswordFingerprint(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		result   string
+		expected types.String
+	}{
+		"ascii": {
+			result:   "password",
+			expected: types.StringValue("5e884898"),
+		},
+		"unicode": {
+			result:   "pässwörd",
+			expected: types.StringValue("46970bef"),
+		},
+		"empty": {
+			result:   "",
+			expected: types.StringValue("e3b0c442"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := passwordFingerprint(testCase.result)
+
+			if !got.Equal(testCase.expected) {
+				t.Errorf("expected %s, got: %s", testCase.expected, got)
+			}
+		})
+	}
+}
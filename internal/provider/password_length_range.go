@@ -0,0 +1,83 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/terraform-providers/terraform-provider-random/internal/random"
+)
+
+// passwordMinLength is the attribute of random_password which, with
+// max_length, chooses the length during apply as an alternative to configuring
+// length.
+var passwordMinLength = path.MatchRoot("min_length")
+
+// validatePasswordLengthRange returns an error diagnostic if max_length is less
+// than min_length, or if min_length is too short for the pinned prefix and the
+// minimum number of characters of each class, so that every length in the
+// range can be generated. Unknown values are not validated.
+func validatePasswordLengthRange(minLength, maxLength types.Int64, pinnedPrefix types.String, mins ...types.Int64) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if minLength.IsNull() || minLength.IsUnknown() {
+		return diags
+	}
+
+	if !maxLength.IsNull() && !maxLength.IsUnknown() && maxLength.ValueInt64() < minLength.ValueInt64() {
+		diags.AddAttributeError(
+			path.Root("max_length"),
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute max_length value must be at least min_length (%d), got: %d",
+				minLength.ValueInt64(), maxLength.ValueInt64()),
+		)
+	}
+
+	if pinnedPrefix.IsUnknown() {
+		return diags
+	}
+
+	var sumOfMins int64
+
+	for _, m := range mins {
+		if m.IsUnknown() {
+			return diags
+		}
+
+		sumOfMins += m.ValueInt64()
+	}
+
+	prefixLength := int64(len(pinnedPrefix.ValueString()))
+
+	if prefixLength > 0 {
+		sumOfMins = max(1, sumOfMins)
+	}
+
+	if minLength.ValueInt64() < prefixLength+sumOfMins {
+		diags.AddAttributeError(
+			path.Root("min_length"),
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute min_length value must be at least the length of pinned_prefix plus the sum of "+
+				"min_upper, min_lower, min_numeric and min_special, which is %d, got: %d",
+				prefixLength+sumOfMins, minLength.ValueInt64()),
+		)
+	}
+
+	return diags
+}
+
+// chooseLength returns a length chosen uniformly from the inclusive range
+// between minLength and maxLength using the source of entropy.
+func chooseLength(entropy *random.Source, minLength, maxLength int64) (int64, error) {
+	offset, err := entropy.Intn(maxLength - minLength + 1)
+	if err != nil {
+		return 0, err
+	}
+
+	return minLength + offset, nil
+}
@@ -0,0 +1,108 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/terraform-providers/terraform-provider-random/internal/random"
+)
+
+func TestValidatePasswordLengthRange(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		minLength    types.Int64
+		maxLength    types.Int64
+		pinnedPrefix types.String
+		mins         []types.Int64
+		expectError  bool
+	}{
+		"valid": {
+			minLength:    types.Int64Value(12),
+			maxLength:    types.Int64Value(16),
+			pinnedPrefix: types.StringNull(),
+			mins:         []types.Int64{types.Int64Value(2), types.Int64Value(2), types.Int64Value(2), types.Int64Value(2)},
+		},
+		"equal": {
+			minLength:    types.Int64Value(12),
+			maxLength:    types.Int64Value(12),
+			pinnedPrefix: types.StringNull(),
+		},
+		"max-less-than-min": {
+			minLength:    types.Int64Value(12),
+			maxLength:    types.Int64Value(11),
+			pinnedPrefix: types.StringNull(),
+			expectError:  true,
+		},
+		"min-less-than-mins": {
+			minLength:    types.Int64Value(7),
+			maxLength:    types.Int64Value(16),
+			pinnedPrefix: types.StringNull(),
+			mins:         []types.Int64{types.Int64Value(2), types.Int64Value(2), types.Int64Value(2), types.Int64Value(2)},
+			expectError:  true,
+		},
+		"min-not-longer-than-prefix": {
+			minLength:    types.Int64Value(4),
+			maxLength:    types.Int64Value(16),
+			pinnedPrefix: types.StringValue("prd-"),
+			expectError:  true,
+		},
+		"min-longer-than-prefix": {
+			minLength:    types.Int64Value(5),
+			maxLength:    types.Int64Value(16),
+			pinnedPrefix: types.StringValue("prd-"),
+		},
+		"unknown-mins": {
+			minLength:    types.Int64Value(1),
+			maxLength:    types.Int64Value(16),
+			pinnedPrefix: types.StringNull(),
+			mins:         []types.Int64{types.Int64Unknown(), types.Int64Value(2)},
+		},
+		"null": {
+			minLength:    types.Int64Null(),
+			maxLength:    types.Int64Null(),
+			pinnedPrefix: types.StringNull(),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := validatePasswordLengthRange(testCase.minLength, testCase.maxLength, testCase.pinnedPrefix, testCase.mins...)
+
+			if diags.HasError() != testCase.expectError {
+				t.Errorf("expected error %t, got: %v", testCase.expectError, diags)
+			}
+		})
+	}
+}
+
+func TestChooseLength(t *testing.T) {
+	t.Parallel()
+
+	entropy := random.NewSource(rand.New(rand.NewSource(1)))
+	observed := make(map[int64]bool)
+
+	for i := 0; i < 1000; i++ {
+		length, err := chooseLength(entropy, 12, 16)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if length < 12 || length > 16 {
+			t.Fatalf("expected length between 12 and 16, got: %d", length)
+		}
+
+		observed[length] = true
+	}
+
+	if len(observed) != 5 {
+		t.Errorf("expected every length between 12 and 16 to be chosen, got: %v", observed)
+	}
+}
@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/hex"
+	"unicode/utf16"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/crypto/md4" //nolint:staticcheck // MD4 is required by the NT hash format.
+)
+
+func enableLegacyHashesAttribute() schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Description: "Generate `ntlm_hash`. This is disabled by default as the NT hash is unsalted and uses " +
+			"MD4, so is trivially cracked, and should only be used for lab environments. A warning is returned " +
+			"while this is enabled. Changing this value generates or removes `ntlm_hash` without replacing the " +
+			"resource.",
+		Optional: true,
+	}
+}
+
+func ntlmHashAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: "The NT hash of the result, which is the MD4 hash of its UTF-16LE encoding, as 32 lowercase " +
+			"hexadecimal digits, for tools which seed Active Directory lab environments from NT hashes. This value " +
+			"is `null` unless `enable_legacy_hashes` is `true`.",
+		Computed:  true,
+		Sensitive: true,
+		PlanModifiers: []planmodifier.String{
+			ntlmHashPlanModifier(),
+		},
+	}
+}
+
+// ntlmHash returns the NT hash of the result, or null if legacy hashes are
+// not enabled.
+func ntlmHash(enable types.Bool, result string) types.String {
+	if !enable.ValueBool() {
+		return types.StringNull()
+	}
+
+	encoded := utf16.Encode([]rune(result))
+	data := make([]byte, 2*len(encoded))
+
+	for i, unit := range encoded {
+		binary.LittleEndian.PutUint16(data[2*i:], unit)
+	}
+
+	h := md4.New()
+	h.Write(data)
+
+	return types.StringValue(hex.EncodeToString(h.Sum(nil)))
+}
+
+// ntlmHashPlanModifier returns a plan modifier for the ntlm_hash attribute
+// which plans the hash of the result in state, so that changing
+// enable_legacy_hashes updates the value in place. The hash is unsalted, so is
+// known during plan.
+func ntlmHashPlanModifier() planmodifier.String {
+	return ntlmHashModifier{}
+}
+
+type ntlmHashModifier struct{}
+
+func (m ntlmHashModifier) Description(ctx context.Context) string {
+	return m.MarkdownDescription(ctx)
+}
+
+func (m ntlmHashModifier) MarkdownDescription(context.Context) string {
+	return "Null when enable_legacy_hashes is not true, otherwise the NT hash of the result in state."
+}
+
+func (m ntlmHashModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	// Do nothing if the resource is being destroyed.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var enable types.Bool
+
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("enable_legacy_hashes"), &enable)...)
+	if resp.Diagnostics.HasError() || enable.IsUnknown() {
+		return
+	}
+
+	if !enable.ValueBool() {
+		resp.PlanValue = types.StringNull()
+		return
+	}
+
+	// The result is generated when the resource is created.
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var result types.String
+
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("result"), &result)...)
+	if resp.Diagnostics.HasError() || result.IsNull() || result.IsUnknown() {
+		return
+	}
+
+	resp.PlanValue = ntlmHash(enable, result.ValueString())
+}
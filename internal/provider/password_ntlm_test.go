@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestNTLMHash(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		enable   types.Bool
+		result   string
+		expected types.String
+	}{
+		"disabled": {
+			enable:   types.BoolNull(),
+			result:   "password",
+			expected: types.StringNull(),
+		},
+		"disabled-false": {
+			enable:   types.BoolValue(false),
+			result:   "password",
+			expected: types.StringNull(),
+		},
+		"ascii": {
+			enable:   types.BoolValue(true),
+			result:   "password",
+			expected: types.StringValue("8846f7eaee8fb117ad06bdd830b7586c"),
+		},
+		"empty": {
+			enable:   types.BoolValue(true),
+			result:   "",
+			expected: types.StringValue("31d6cfe0d16ae931b73c59d7e0c089c0"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := ntlmHash(testCase.enable, testCase.result)
+
+			if !got.Equal(testCase.expected) {
+				t.Errorf("expected %s, got: %s", testCase.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,296 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/pbkdf2"
+
+	"github.com/terraform-providers/terraform-provider-random/internal/random"
+)
+
+const (
+	phcArgon2id     = "argon2id"
+	phcPBKDF2SHA256 = "pbkdf2-sha256"
+
+	// The default argon2id parameters follow the OWASP recommendation of
+	// 19 MiB of memory, 2 iterations and a parallelism of 1.
+	phcArgon2idMemory      = 19456
+	phcArgon2idIterations  = 2
+	phcArgon2idParallelism = 1
+
+	// phcMaxMemory limits the memory of argon2id to 4 GiB, as the hash is
+	// computed by the provider during apply.
+	phcMaxMemory = 4 * 1024 * 1024
+
+	phcSaltLength = 16
+	phcKeyLength  = 32
+)
+
+var phcHashAttrTypes = map[string]attr.Type{
+	"algorithm":   types.StringType,
+	"memory":      types.Int64Type,
+	"iterations":  types.Int64Type,
+	"parallelism": types.Int64Type,
+	"hash":        types.StringType,
+}
+
+type phcHashModel struct {
+	Algorithm   types.String `tfsdk:"algorithm"`
+	Memory      types.Int64  `tfsdk:"memory"`
+	Iterations  types.Int64  `tfsdk:"iterations"`
+	Parallelism types.Int64  `tfsdk:"parallelism"`
+	Hash        types.String `tfsdk:"hash"`
+}
+
+func phcHashBlock() schema.SingleNestedBlock {
+	return schema.SingleNestedBlock{
+		Description: "Generate a hash of the result in the PHC string format, such as " +
+			"`$argon2id$v=19$m=19456,t=2,p=1$<salt>$<hash>`, with the salt and hash base64 encoded without " +
+			"padding, for direct insertion into authentication systems which accept it. The hash is available " +
+			"as `phc_hash.hash`. Changing this value regenerates the hash without replacing the resource.",
+		Attributes: map[string]schema.Attribute{
+			"algorithm": schema.StringAttribute{
+				Description: "The hashing algorithm, which must be set when `phc_hash` is configured. Valid " +
+					"values are `argon2id` and `pbkdf2-sha256`. Only `pbkdf2-sha256` can be used when the provider " +
+					"is configured with `fips = true`.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(phcArgon2id, phcPBKDF2SHA256),
+				},
+			},
+			"memory": schema.Int64Attribute{
+				Description: fmt.Sprintf("The memory used by `argon2id`, in KiB, which must be at least 8 "+
+					"times `parallelism` and at most %d. Only applies to `argon2id`. Default value is `%d`.",
+					phcMaxMemory, phcArgon2idMemory),
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Between(8, phcMaxMemory),
+				},
+			},
+			"iterations": schema.Int64Attribute{
+				Description: fmt.Sprintf("The number of iterations. Default value is `%d` for `argon2id`, and "+
+					"`%d` for `pbkdf2-sha256`.", phcArgon2idIterations, pbkdf2Iterations),
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"parallelism": schema.Int64Attribute{
+				Description: fmt.Sprintf("The number of threads used by `argon2id`. Only applies to "+
+					"`argon2id`. Default value is `%d`.", phcArgon2idParallelism),
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 255),
+				},
+			},
+			"hash": schema.StringAttribute{
+				Description: "The hash of the generated random string in the PHC string format.",
+				Computed:    true,
+				Sensitive:   true,
+				PlanModifiers: []planmodifier.String{
+					phcHashPlanModifier(),
+				},
+			},
+		},
+		// The attributes of a block are validated even when the block is not
+		// configured, so algorithm is required by the block rather than
+		// marked as required.
+		Validators: []validator.Object{
+			objectvalidator.AlsoRequires(path.MatchRelative().AtName("algorithm")),
+		},
+	}
+}
+
+// validatePHCHash ensures that memory and parallelism are only configured for
+// argon2id, and that memory is sufficient for the parallelism.
+func validatePHCHash(ctx context.Context, phcHash types.Object) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if phcHash.IsNull() || phcHash.IsUnknown() {
+		return diags
+	}
+
+	var model phcHashModel
+
+	diags.Append(phcHash.As(ctx, &model, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() || model.Algorithm.IsUnknown() {
+		return diags
+	}
+
+	if model.Algorithm.ValueString() != phcArgon2id {
+		argon2idOnly := map[string]types.Int64{
+			"memory":      model.Memory,
+			"parallelism": model.Parallelism,
+		}
+
+		for _, name := range []string{"memory", "parallelism"} {
+			if !argon2idOnly[name].IsNull() {
+				diags.AddAttributeError(
+					path.Root("phc_hash").AtName(name),
+					"Invalid Attribute Combination",
+					fmt.Sprintf("Attribute phc_hash.%s can only be set when phc_hash.algorithm is %q.", name, phcArgon2id),
+				)
+			}
+		}
+
+		return diags
+	}
+
+	params := model.argon2idParams()
+
+	if !model.Memory.IsUnknown() && !model.Parallelism.IsUnknown() && params.memory < 8*uint32(params.parallelism) {
+		diags.AddAttributeError(
+			path.Root("phc_hash").AtName("memory"),
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute phc_hash.memory must be at least 8 times phc_hash.parallelism (%d), got: %d",
+				8*uint32(params.parallelism), params.memory),
+		)
+	}
+
+	return diags
+}
+
+type argon2idParams struct {
+	memory      uint32
+	iterations  uint32
+	parallelism uint8
+}
+
+// argon2idParams returns the argon2id parameters of the model, with the
+// defaults of those which are not set.
+func (m phcHashModel) argon2idParams() argon2idParams {
+	params := argon2idParams{
+		memory:      phcArgon2idMemory,
+		iterations:  phcArgon2idIterations,
+		parallelism: phcArgon2idParallelism,
+	}
+
+	if !m.Memory.IsNull() {
+		params.memory = uint32(m.Memory.ValueInt64())
+	}
+
+	if !m.Iterations.IsNull() {
+		params.iterations = uint32(m.Iterations.ValueInt64())
+	}
+
+	if !m.Parallelism.IsNull() {
+		params.parallelism = uint8(m.Parallelism.ValueInt64())
+	}
+
+	return params
+}
+
+// generate returns the hash of the string in the PHC string format with the
+// algorithm and parameters of the model, and a salt read from the source of
+// entropy.
+func (m phcHashModel) generate(entropy *random.Source, toHash string) (string, error) {
+	salt, err := entropy.CreateBytes(phcSaltLength)
+	if err != nil {
+		return "", err
+	}
+
+	defer acquireHashWorker()()
+
+	encodedSalt := base64.RawStdEncoding.EncodeToString(salt)
+
+	switch m.Algorithm.ValueString() {
+	case phcArgon2id:
+		params := m.argon2idParams()
+		key := argon2.IDKey([]byte(toHash), salt, params.iterations, params.memory, params.parallelism, phcKeyLength)
+
+		return fmt.Sprintf("$%s$v=%d$m=%d,t=%d,p=%d$%s$%s", phcArgon2id, argon2.Version, params.memory,
+			params.iterations, params.parallelism, encodedSalt, base64.RawStdEncoding.EncodeToString(key)), nil
+	case phcPBKDF2SHA256:
+		iterations := pbkdf2Iterations
+		if !m.Iterations.IsNull() {
+			iterations = int(m.Iterations.ValueInt64())
+		}
+
+		key := pbkdf2.Key([]byte(toHash), salt, iterations, phcKeyLength, sha256.New)
+
+		return fmt.Sprintf("$%s$i=%d$%s$%s", phcPBKDF2SHA256, iterations, encodedSalt,
+			base64.RawStdEncoding.EncodeToString(key)), nil
+	}
+
+	return "", fmt.Errorf("unsupported algorithm %q", m.Algorithm.ValueString())
+}
+
+// phcHashPlanModifier returns a plan modifier for the hash of the phc_hash
+// attribute which uses the prior state value, unless the algorithm or
+// parameters have changed, so that the hash is regenerated during update.
+func phcHashPlanModifier() planmodifier.String {
+	return phcHashModifier{}
+}
+
+type phcHashModifier struct{}
+
+func (m phcHashModifier) Description(ctx context.Context) string {
+	return m.MarkdownDescription(ctx)
+}
+
+func (m phcHashModifier) MarkdownDescription(context.Context) string {
+	return "The value in state will not change unless the algorithm or parameters change."
+}
+
+func (m phcHashModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	// Do nothing if the resource is being created or destroyed, or the hash
+	// has not been generated.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() || req.StateValue.IsNull() {
+		return
+	}
+
+	var planObject, stateObject types.Object
+
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("phc_hash"), &planObject)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("phc_hash"), &stateObject)...)
+	if resp.Diagnostics.HasError() || planObject.IsNull() || planObject.IsUnknown() || stateObject.IsNull() {
+		return
+	}
+
+	var plan, state phcHashModel
+
+	resp.Diagnostics.Append(planObject.As(ctx, &plan, basetypes.ObjectAsOptions{})...)
+	resp.Diagnostics.Append(stateObject.As(ctx, &state, basetypes.ObjectAsOptions{})...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Algorithm.Equal(state.Algorithm) && plan.Memory.Equal(state.Memory) &&
+		plan.Iterations.Equal(state.Iterations) && plan.Parallelism.Equal(state.Parallelism) {
+		resp.PlanValue = req.StateValue
+	}
+}
+
+// phcHashUnknown returns whether the hash of phc_hash is unknown, so is to be
+// generated.
+func phcHashUnknown(ctx context.Context, phcHash types.Object) bool {
+	if phcHash.IsNull() || phcHash.IsUnknown() {
+		return false
+	}
+
+	var model phcHashModel
+
+	if diags := phcHash.As(ctx, &model, basetypes.ObjectAsOptions{}); diags.HasError() {
+		return false
+	}
+
+	return model.Hash.IsUnknown()
+}
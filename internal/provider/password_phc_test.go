@@ -0,0 +1,157 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/pbkdf2"
+
+	"github.com/terraform-providers/terraform-provider-random/internal/random"
+)
+
+// TestPHCHashGenerate verifies that the hashes are in the PHC string format,
+// and that the hash can be recomputed from the encoded parameters and salt.
+func TestPHCHashGenerate(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		model     phcHashModel
+		params    string
+		recompute func(salt []byte) []byte
+	}{
+		"argon2id-defaults": {
+			model: phcHashModel{
+				Algorithm:   types.StringValue(phcArgon2id),
+				Memory:      types.Int64Null(),
+				Iterations:  types.Int64Null(),
+				Parallelism: types.Int64Null(),
+			},
+			params: "v=19$m=19456,t=2,p=1",
+			recompute: func(salt []byte) []byte {
+				return argon2.IDKey([]byte("password"), salt, 2, 19456, 1, phcKeyLength)
+			},
+		},
+		"argon2id": {
+			model: phcHashModel{
+				Algorithm:   types.StringValue(phcArgon2id),
+				Memory:      types.Int64Value(64),
+				Iterations:  types.Int64Value(3),
+				Parallelism: types.Int64Value(4),
+			},
+			params: "v=19$m=64,t=3,p=4",
+			recompute: func(salt []byte) []byte {
+				return argon2.IDKey([]byte("password"), salt, 3, 64, 4, phcKeyLength)
+			},
+		},
+		"pbkdf2-sha256": {
+			model: phcHashModel{
+				Algorithm:   types.StringValue(phcPBKDF2SHA256),
+				Memory:      types.Int64Null(),
+				Iterations:  types.Int64Value(1000),
+				Parallelism: types.Int64Null(),
+			},
+			params: "i=1000",
+			recompute: func(salt []byte) []byte {
+				return pbkdf2.Key([]byte("password"), salt, 1000, phcKeyLength, sha256.New)
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			hash, err := testCase.model.generate(random.NewSource(nil), "password")
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			prefix := fmt.Sprintf("$%s$%s$", testCase.model.Algorithm.ValueString(), testCase.params)
+			if !strings.HasPrefix(hash, prefix) {
+				t.Fatalf("expected hash beginning with %q, got: %q", prefix, hash)
+			}
+
+			parts := strings.Split(strings.TrimPrefix(hash, prefix), "$")
+			if len(parts) != 2 {
+				t.Fatalf("expected the salt and hash following the parameters, got: %q", hash)
+			}
+
+			salt, err := base64.RawStdEncoding.DecodeString(parts[0])
+			if err != nil || len(salt) != phcSaltLength {
+				t.Fatalf("expected a %d byte salt, got: %q", phcSaltLength, parts[0])
+			}
+
+			if expected := base64.RawStdEncoding.EncodeToString(testCase.recompute(salt)); parts[1] != expected {
+				t.Errorf("expected hash %q, got: %q", expected, parts[1])
+			}
+		})
+	}
+}
+
+func TestValidatePHCHash(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		model         phcHashModel
+		expectedError string
+	}{
+		"argon2id": {
+			model: phcHashModel{
+				Algorithm:   types.StringValue(phcArgon2id),
+				Memory:      types.Int64Value(32),
+				Parallelism: types.Int64Value(4),
+			},
+		},
+		"argon2id-insufficient-memory": {
+			model: phcHashModel{
+				Algorithm:   types.StringValue(phcArgon2id),
+				Memory:      types.Int64Value(31),
+				Parallelism: types.Int64Value(4),
+			},
+			expectedError: "Attribute phc_hash.memory must be at least 8 times phc_hash.parallelism (32), got: 31",
+		},
+		"pbkdf2-sha256-memory": {
+			model: phcHashModel{
+				Algorithm: types.StringValue(phcPBKDF2SHA256),
+				Memory:    types.Int64Value(64),
+			},
+			expectedError: `Attribute phc_hash.memory can only be set when phc_hash.algorithm is "argon2id".`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			testCase.model.Hash = types.StringUnknown()
+
+			object, diags := types.ObjectValueFrom(context.Background(), phcHashAttrTypes, testCase.model)
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			diags = validatePHCHash(context.Background(), object)
+
+			if testCase.expectedError == "" {
+				if diags.HasError() {
+					t.Errorf("unexpected error: %v", diags)
+				}
+
+				return
+			}
+
+			if diags.ErrorsCount() != 1 || diags.Errors()[0].Detail() != testCase.expectedError {
+				t.Errorf("expected error %q, got: %v", testCase.expectedError, diags)
+			}
+		})
+	}
+}
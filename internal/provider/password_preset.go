@@ -0,0 +1,162 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// passwordPreset is the documented password policy of a cloud service, which
+// can be selected with the preset attribute of random_password instead of
+// configuring the special characters and minimum number of characters of
+// each class by hand.
+type passwordPreset struct {
+	// minLength and maxLength are the bounds of the length of the password.
+	// A maxLength of 0 means that the length is not limited.
+	minLength int64
+	maxLength int64
+
+	// special is the set of special characters accepted by the service.
+	special string
+
+	minUpper   int64
+	minLower   int64
+	minNumeric int64
+	minSpecial int64
+}
+
+// passwordPresets are the password policies which can be selected with the
+// preset attribute of random_password, keyed by the attribute value.
+var passwordPresets = map[string]passwordPreset{
+	// Amazon RDS master passwords may contain any printable ASCII character
+	// other than /, ", @ and space. The maximum length is that of the MySQL
+	// and MariaDB engines, which is the lowest of the engines other than
+	// Oracle.
+	"aws_rds": {
+		minLength: 8,
+		maxLength: 41,
+		special:   "!#$%&*()-_=+[]{}<>:?",
+	},
+
+	// Azure SQL Database passwords must contain characters from three of the
+	// four classes, so one of each class is required.
+	"azure_sql": {
+		minLength:  8,
+		maxLength:  128,
+		special:    "!@#$%&*()-_=+[]{}<>:?",
+		minUpper:   1,
+		minLower:   1,
+		minNumeric: 1,
+		minSpecial: 1,
+	},
+
+	// Cloud SQL passwords checked with the default complexity policy must
+	// contain characters from every class.
+	"gcp_sql": {
+		minLength:  8,
+		special:    "!#$%&*()-_=+[]{}<>:?",
+		minUpper:   1,
+		minLower:   1,
+		minNumeric: 1,
+		minSpecial: 1,
+	},
+}
+
+// passwordPresetNames returns the names of the password presets, sorted.
+func passwordPresetNames() []string {
+	names := make([]string, 0, len(passwordPresets))
+
+	for name := range passwordPresets {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// preset returns the password preset of the model, and whether a preset is
+// set and known.
+func (m passwordModelV4) preset() (passwordPreset, bool) {
+	if m.Preset.IsNull() || m.Preset.IsUnknown() {
+		return passwordPreset{}, false
+	}
+
+	preset, ok := passwordPresets[m.Preset.ValueString()]
+
+	return preset, ok
+}
+
+// validatePasswordPreset returns an error diagnostic if the length is outside
+// the bounds of the preset or too short for the minimum number of characters
+// of each class it requires, or if a class the preset requires is disabled.
+// Unknown values are not validated.
+func validatePasswordPreset(m passwordModelV4) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	preset, ok := m.preset()
+	if !ok {
+		return diags
+	}
+
+	classes := []struct {
+		name    string
+		enabled types.Bool
+		min     types.Int64
+		preset  int64
+	}{
+		{"upper", m.Upper, m.MinUpper, preset.minUpper},
+		{"lower", m.Lower, m.MinLower, preset.minLower},
+		{"numeric", m.Numeric, m.MinNumeric, preset.minNumeric},
+		{"special", m.Special, m.MinSpecial, preset.minSpecial},
+	}
+
+	var sumOfMins int64
+
+	for _, class := range classes {
+		if class.min.IsUnknown() {
+			return diags
+		}
+
+		if class.preset > 0 && !class.enabled.IsNull() && !class.enabled.IsUnknown() && !class.enabled.ValueBool() {
+			diags.AddAttributeError(
+				path.Root(class.name),
+				"Invalid Attribute Combination",
+				fmt.Sprintf("Attribute %s cannot be false when preset is %q, as the preset requires at least %d "+
+					"%s characters.", class.name, m.Preset.ValueString(), class.preset, class.name),
+			)
+		}
+
+		sumOfMins += max(class.min.ValueInt64(), class.preset)
+	}
+
+	if m.Length.IsNull() || m.Length.IsUnknown() {
+		return diags
+	}
+
+	length := m.Length.ValueInt64()
+	minLength := max(preset.minLength, sumOfMins)
+
+	if length < minLength || (preset.maxLength > 0 && length > preset.maxLength) {
+		bounds := fmt.Sprintf("at least %d", minLength)
+
+		if preset.maxLength > 0 {
+			bounds = fmt.Sprintf("between %d and %d", minLength, preset.maxLength)
+		}
+
+		diags.AddAttributeError(
+			path.Root("length"),
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute length value must be %s when preset is %q, got: %d",
+				bounds, m.Preset.ValueString(), length),
+		)
+	}
+
+	return diags
+}
@@ -0,0 +1,175 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"unicode"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/terraform-providers/terraform-provider-random/internal/random"
+)
+
+// TestPasswordPresets verifies that every password generated with each preset,
+// at the shortest length allowed, satisfies the policy of the service.
+func TestPasswordPresets(t *testing.T) {
+	t.Parallel()
+
+	for name, preset := range passwordPresets {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			model := passwordModelV4{
+				stringSharedModel: stringSharedModel{
+					Length:     types.Int64Value(preset.minLength),
+					Upper:      types.BoolValue(true),
+					Lower:      types.BoolValue(true),
+					Numeric:    types.BoolValue(true),
+					Special:    types.BoolValue(true),
+					MinUpper:   types.Int64Value(0),
+					MinLower:   types.Int64Value(0),
+					MinNumeric: types.Int64Value(0),
+					MinSpecial: types.Int64Value(0),
+				},
+				Preset: types.StringValue(name),
+			}
+
+			if diags := validatePasswordPreset(model); diags.HasError() {
+				t.Fatalf("unexpected validation error: %v", diags)
+			}
+
+			for i := 0; i < 1000; i++ {
+				result, err := random.NewSource(nil).CreateString(model.params(context.Background()))
+				if err != nil {
+					t.Fatalf("unexpected CreateString error: %s", err)
+				}
+
+				var upper, lower, numeric, special int64
+
+				for _, c := range string(result) {
+					switch {
+					case unicode.IsUpper(c):
+						upper++
+					case unicode.IsLower(c):
+						lower++
+					case unicode.IsDigit(c):
+						numeric++
+					case strings.ContainsRune(preset.special, c):
+						special++
+					default:
+						t.Fatalf("unexpected character %q in result: %s", c, result)
+					}
+				}
+
+				if int64(len(result)) != preset.minLength || upper < preset.minUpper || lower < preset.minLower ||
+					numeric < preset.minNumeric || special < preset.minSpecial {
+					t.Fatalf("result does not satisfy preset: %s", result)
+				}
+			}
+		})
+	}
+}
+
+func TestValidatePasswordPreset(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		model       passwordModelV4
+		expectError bool
+	}{
+		"valid": {
+			model: passwordModelV4{
+				stringSharedModel: stringSharedModel{
+					Length: types.Int64Value(16),
+				},
+				Preset: types.StringValue("azure_sql"),
+			},
+		},
+		"too-short": {
+			model: passwordModelV4{
+				stringSharedModel: stringSharedModel{
+					Length: types.Int64Value(7),
+				},
+				Preset: types.StringValue("azure_sql"),
+			},
+			expectError: true,
+		},
+		"too-long": {
+			model: passwordModelV4{
+				stringSharedModel: stringSharedModel{
+					Length: types.Int64Value(42),
+				},
+				Preset: types.StringValue("aws_rds"),
+			},
+			expectError: true,
+		},
+		"no-maximum": {
+			model: passwordModelV4{
+				stringSharedModel: stringSharedModel{
+					Length: types.Int64Value(256),
+				},
+				Preset: types.StringValue("gcp_sql"),
+			},
+		},
+		"mins-exceed-length": {
+			model: passwordModelV4{
+				stringSharedModel: stringSharedModel{
+					Length:   types.Int64Value(8),
+					MinUpper: types.Int64Value(6),
+				},
+				Preset: types.StringValue("gcp_sql"),
+			},
+			expectError: true,
+		},
+		"required-class-disabled": {
+			model: passwordModelV4{
+				stringSharedModel: stringSharedModel{
+					Length:  types.Int64Value(16),
+					Special: types.BoolValue(false),
+				},
+				Preset: types.StringValue("gcp_sql"),
+			},
+			expectError: true,
+		},
+		"optional-class-disabled": {
+			model: passwordModelV4{
+				stringSharedModel: stringSharedModel{
+					Length:  types.Int64Value(16),
+					Special: types.BoolValue(false),
+				},
+				Preset: types.StringValue("aws_rds"),
+			},
+		},
+		"unknown-length": {
+			model: passwordModelV4{
+				stringSharedModel: stringSharedModel{
+					Length: types.Int64Unknown(),
+				},
+				Preset: types.StringValue("azure_sql"),
+			},
+		},
+		"no-preset": {
+			model: passwordModelV4{
+				stringSharedModel: stringSharedModel{
+					Length: types.Int64Value(1),
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := validatePasswordPreset(testCase.model)
+
+			if diags.HasError() != testCase.expectError {
+				t.Errorf("expected error: %t, got: %v", testCase.expectError, diags)
+			}
+		})
+	}
+}
@@ -0,0 +1,115 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	// passwordPreviewChars is the number of characters revealed at each end
+	// of the result by result_preview.
+	passwordPreviewChars = 2
+
+	// passwordPreviewMinLength is the length below which the result is masked
+	// entirely, as revealing four characters would disclose too much of it.
+	passwordPreviewMinLength = 12
+
+	// passwordPreviewMask replaces the remaining characters of the result. It
+	// has a fixed length, so does not reveal the length of the result.
+	passwordPreviewMask = "****"
+)
+
+func enablePreviewAttribute() schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Description: "Generate `result_preview`. This is disabled by default as the preview discloses part of " +
+			"the result. Changing this value generates or removes `result_preview` without replacing the resource.",
+		Optional: true,
+	}
+}
+
+func resultPreviewAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: "A redacted preview of the result, which is not sensitive, consisting of its first and last " +
+			"two characters separated by a fixed mask, such as `Ab****9z`, so that the password can be correlated " +
+			"with where it is used in logs and user interfaces. Results shorter than 12 characters are masked " +
+			"entirely. This value is `null` unless `enable_preview` is `true`.",
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			resultPreviewPlanModifier(),
+		},
+	}
+}
+
+// passwordPreview returns the redacted preview of the result, or null if the
+// preview is not enabled.
+func passwordPreview(enable types.Bool, result string) types.String {
+	if !enable.ValueBool() {
+		return types.StringNull()
+	}
+
+	runes := []rune(result)
+
+	if len(runes) < passwordPreviewMinLength {
+		return types.StringValue(passwordPreviewMask)
+	}
+
+	return types.StringValue(string(runes[:passwordPreviewChars]) + passwordPreviewMask +
+		string(runes[len(runes)-passwordPreviewChars:]))
+}
+
+// resultPreviewPlanModifier returns a plan modifier for the result_preview
+// attribute which plans the preview of the result in state, so that changing
+// enable_preview updates the value in place.
+func resultPreviewPlanModifier() planmodifier.String {
+	return resultPreviewModifier{}
+}
+
+type resultPreviewModifier struct{}
+
+func (m resultPreviewModifier) Description(ctx context.Context) string {
+	return m.MarkdownDescription(ctx)
+}
+
+func (m resultPreviewModifier) MarkdownDescription(context.Context) string {
+	return "Null when enable_preview is not true, otherwise the preview of the result in state."
+}
+
+func (m resultPreviewModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	// Do nothing if the resource is being destroyed.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var enable types.Bool
+
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("enable_preview"), &enable)...)
+	if resp.Diagnostics.HasError() || enable.IsUnknown() {
+		return
+	}
+
+	if !enable.ValueBool() {
+		resp.PlanValue = types.StringNull()
+		return
+	}
+
+	// The result is generated when the resource is created.
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var result types.String
+
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("result"), &result)...)
+	if resp.Diagnostics.HasError() || result.IsNull() || result.IsUnknown() {
+		return
+	}
+
+	resp.PlanValue = passwordPreview(enable, result.ValueString())
+}
@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestPasswordPreview(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		enable   types.Bool
+		result   string
+		expected types.String
+	}{
+		"null": {
+			enable:   types.BoolNull(),
+			result:   "Abcdefghijk9z",
+			expected: types.StringNull(),
+		},
+		"disabled": {
+			enable:   types.BoolValue(false),
+			result:   "Abcdefghijk9z",
+			expected: types.StringNull(),
+		},
+		"minimum-length": {
+			enable:   types.BoolValue(true),
+			result:   "Abcdefghij9z",
+			expected: types.StringValue("Ab****9z"),
+		},
+		"long": {
+			enable:   types.BoolValue(true),
+			result:   "Abcdefghijklmnopqrstuvwxyz0123456789",
+			expected: types.StringValue("Ab****89"),
+		},
+		"short": {
+			enable:   types.BoolValue(true),
+			result:   "Abcdefghi9z",
+			expected: types.StringValue("****"),
+		},
+		"multibyte": {
+			enable:   types.BoolValue(true),
+			result:   "äöcdefghijüß",
+			expected: types.StringValue("äö****üß"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := passwordPreview(testCase.enable, testCase.result); !got.Equal(testCase.expected) {
+				t.Errorf("expected %s, got: %s", testCase.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,40 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+
+	"github.com/terraform-providers/terraform-provider-random/internal/publish"
+)
+
+// publishResults publishes every result with the publisher the provider was
+// constructed with, if any. The first result is published as the result.
+func publishResults(ctx context.Context, publisher publish.Publisher, resourceType string, results []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if publisher == nil {
+		return diags
+	}
+
+	secret := publish.Secret{
+		ResourceType: resourceType,
+		Result:       results[0],
+		Results:      results,
+	}
+
+	if err := publisher.Publish(ctx, secret); err != nil {
+		diags.AddError(
+			"Publish Random Password Error",
+			"While attempting to publish the generated password, an error occurred. The resource was not "+
+				"created, and the password was discarded.\n\n"+
+				fmt.Sprintf("Original Error: %s", err),
+		)
+	}
+
+	return diags
+}
@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"crypto/sha1"
+	"crypto/sha512"
+	"encoding/base64"
+	"hash"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/terraform-providers/terraform-provider-random/internal/diagnostics"
+	"github.com/terraform-providers/terraform-provider-random/internal/random"
+)
+
+const (
+	// sshaSaltLength is the length of the random salt of the SSHA hashes, in
+	// bytes, matching the salt generated by 389 Directory Server.
+	sshaSaltLength = 8
+
+	sshaScheme    = "{SSHA}"
+	ssha512Scheme = "{SSHA512}"
+)
+
+func sshaHashAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: "A salted SHA-1 hash of the generated random string, in the `{SSHA}<base64>` format of the " +
+			"LDAP `userPassword` attribute, for directory servers which cannot consume bcrypt. SHA-1 is weak " +
+			"against brute force attacks, so `ssha512` should be preferred where the directory server supports it.",
+		Computed:  true,
+		Sensitive: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+func ssha512Attribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: "A salted SHA-512 hash of the generated random string, in the `{SSHA512}<base64>` format " +
+			"of the LDAP `userPassword` attribute, for directory servers which cannot consume bcrypt.",
+		Computed:  true,
+		Sensitive: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+// setSSHAHashes sets ssha_hash and ssha512 for the given result, each with a
+// separately generated salt.
+func (r *passwordResource) setSSHAHashes(model *passwordModelV4, result string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	sshaHash, err := generateSSHAHash(r.entropy, sshaScheme, sha1.New, result)
+	if err != nil {
+		diags.Append(diagnostics.HashGenerationError(err.Error())...)
+
+		return diags
+	}
+
+	ssha512Hash, err := generateSSHAHash(r.entropy, ssha512Scheme, sha512.New, result)
+	if err != nil {
+		diags.Append(diagnostics.HashGenerationError(err.Error())...)
+
+		return diags
+	}
+
+	model.SSHAHash = types.StringValue(sshaHash)
+	model.SSHA512 = types.StringValue(ssha512Hash)
+
+	return diags
+}
+
+// generateSSHAHash returns the salted hash of the string in the format of the
+// LDAP userPassword attribute, which is the scheme followed by the base64
+// encoding of the digest of the string and salt, followed by the salt. The
+// salt is read from the given source of entropy.
+func generateSSHAHash(entropy *random.Source, scheme string, newHash func() hash.Hash, toHash string) (string, error) {
+	salt, err := entropy.CreateBytes(sshaSaltLength)
+	if err != nil {
+		return "", err
+	}
+
+	h := newHash()
+	h.Write([]byte(toHash))
+	h.Write(salt)
+
+	return scheme + base64.StdEncoding.EncodeToString(append(h.Sum(nil), salt...)), nil
+}
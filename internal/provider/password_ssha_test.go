@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"crypto/sha512"
+	"encoding/base64"
+	"hash"
+	"strings"
+	"testing"
+)
+
+func TestGenerateSSHAHash(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		scheme  string
+		newHash func() hash.Hash
+	}{
+		"ssha": {
+			scheme:  sshaScheme,
+			newHash: sha1.New,
+		},
+		"ssha512": {
+			scheme:  ssha512Scheme,
+			newHash: sha512.New,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := generateSSHAHash(nil, testCase.scheme, testCase.newHash, "password")
+			if err != nil {
+				t.Fatalf("unexpected generateSSHAHash error: %s", err)
+			}
+
+			if !strings.HasPrefix(got, testCase.scheme) {
+				t.Fatalf("expected hash prefixed with %s, got: %s", testCase.scheme, got)
+			}
+
+			decoded, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(got, testCase.scheme))
+			if err != nil {
+				t.Fatalf("unexpected hash decoding error: %s", err)
+			}
+
+			h := testCase.newHash()
+			digestLength := h.Size()
+
+			if len(decoded) != digestLength+sshaSaltLength {
+				t.Fatalf("expected %d decoded bytes, got: %d", digestLength+sshaSaltLength, len(decoded))
+			}
+
+			h.Write([]byte("password"))
+			h.Write(decoded[digestLength:])
+
+			if !bytes.Equal(decoded[:digestLength], h.Sum(nil)) {
+				t.Errorf("expected the digest of the password and salt, got: %x", decoded[:digestLength])
+			}
+		})
+	}
+}
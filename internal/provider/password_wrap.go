@@ -0,0 +1,140 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/terraform-providers/terraform-provider-random/internal/encrypt"
+	"github.com/terraform-providers/terraform-provider-random/internal/random"
+	"github.com/terraform-providers/terraform-provider-random/internal/validators"
+)
+
+func recipientPublicKeyAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: "The public key to which `wrapped_result` is encrypted, so that the result can be handed " +
+			"to a person or system which holds the private key without passing through sensitive outputs. Either " +
+			"an age X25519 recipient beginning with `age1`, or a PEM encoded RSA public key of at least 2048 bits. " +
+			"Changing this value encrypts the result to the new key without replacing the resource.",
+		Optional: true,
+		Validators: []validator.String{
+			validators.PublicKey(),
+		},
+	}
+}
+
+// wrappedResultAttribute returns the schema for the wrapped_result attribute.
+// As with encrypted_result, the value is not sensitive, as it can only be
+// decrypted with the private key of recipient_public_key.
+func wrappedResultAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: "The result encrypted to `recipient_public_key`. For an age recipient this is an ASCII " +
+			"armored age file, which can be decrypted with `age --decrypt`. For an RSA public key this is in the " +
+			"format `$aes-256-gcm$rsa-oaep-sha256$<wrapped key>$<nonce>$<ciphertext>`, as `encrypted_result` is. " +
+			"This value is `null` unless `recipient_public_key` is set.",
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			wrappedResultPlanModifier(),
+		},
+	}
+}
+
+// wrapResult returns the result encrypted to the recipient public key, or null
+// if no recipient is set.
+func wrapResult(recipient types.String, entropy *random.Source, result string) (types.String, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if recipient.IsNull() {
+		return types.StringNull(), nil
+	}
+
+	key, err := encrypt.ParsePublicKey(recipient.ValueString())
+	if err == nil {
+		var wrapped string
+
+		wrapped, err = key.Encrypt(entropy, []byte(result))
+		if err == nil {
+			return types.StringValue(wrapped), nil
+		}
+	}
+
+	diags.AddAttributeError(
+		path.Root("recipient_public_key"),
+		"Result Wrapping Error",
+		"While attempting to encrypt the result to the 'recipient_public_key' an error occurred.\n\n"+
+			"Original Error: "+err.Error(),
+	)
+
+	return types.StringNull(), diags
+}
+
+// wrappedResultPlanModifier returns a plan modifier for the wrapped_result
+// attribute which keeps the value in state while recipient_public_key is
+// unchanged, so that the result is only encrypted again when the key changes.
+func wrappedResultPlanModifier() planmodifier.String {
+	return wrappedValueModifier{key: "recipient_public_key"}
+}
+
+// wrappedValueModifier is a plan modifier for a value wrapped with the public
+// key of the key attribute, which is null when the key is not set, and
+// otherwise keeps the value in state unless the key or any of the parameters
+// which change the wrapped value have changed.
+type wrappedValueModifier struct {
+	key        string
+	parameters []string
+}
+
+func (m wrappedValueModifier) Description(ctx context.Context) string {
+	return m.MarkdownDescription(ctx)
+}
+
+func (m wrappedValueModifier) MarkdownDescription(context.Context) string {
+	return fmt.Sprintf("Null when %s is not set, otherwise the value in state unless %s has changed.", m.key,
+		strings.Join(append([]string{m.key}, m.parameters...), " or "))
+}
+
+func (m wrappedValueModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	// Do nothing if the resource is being destroyed.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var key types.String
+
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root(m.key), &key)...)
+	if resp.Diagnostics.HasError() || key.IsUnknown() {
+		return
+	}
+
+	if key.IsNull() {
+		resp.PlanValue = types.StringNull()
+		return
+	}
+
+	// The value is wrapped when the resource is created.
+	if req.State.Raw.IsNull() || req.StateValue.IsNull() {
+		return
+	}
+
+	for _, name := range append([]string{m.key}, m.parameters...) {
+		var planValue, stateValue types.String
+
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root(name), &planValue)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root(name), &stateValue)...)
+		if resp.Diagnostics.HasError() || !stateValue.Equal(planValue) {
+			return
+		}
+	}
+
+	resp.PlanValue = req.StateValue
+}
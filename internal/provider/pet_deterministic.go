@@ -0,0 +1,110 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"slices"
+	"strings"
+	"sync"
+
+	petname "github.com/dustinkirkland/golang-petname"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/terraform-providers/terraform-provider-random/internal/random"
+)
+
+// petDeriveInfo is the HKDF info string used when deriving a deterministic
+// random_pet name from its keepers.
+const petDeriveInfo = "terraform-provider-random random_pet"
+
+// petWordList is a list of the words of one kind which may be chosen for a pet
+// name.
+type petWordList []string
+
+// petWordLists returns the sorted adverbs, adjectives and animal names of the
+// petname library. The library does not export its word lists, so they are
+// collected by drawing words until every word has been seen, then sorted, so
+// that the index of each word is stable between runs of the provider.
+var petWordLists = sync.OnceValue(func() map[string]petWordList {
+	return map[string]petWordList{
+		"adverb":    collectPetWords(petname.Adverb, petAdverbs),
+		"adjective": collectPetWords(petname.Adjective, petAdjectives),
+		"animal":    collectPetWords(petname.Name, petNames),
+	}
+})
+
+// collectPetWords returns the sorted distinct words returned by draw, which
+// is called until count distinct words have been seen. The number of draws is
+// limited in case the library has fewer words than expected.
+func collectPetWords(draw func() string, count int) petWordList {
+	seen := make(map[string]struct{}, count)
+
+	for i := 0; len(seen) < count && i < 1000*count; i++ {
+		seen[strings.ToLower(draw())] = struct{}{}
+	}
+
+	words := make(petWordList, 0, len(seen))
+	for word := range seen {
+		words = append(words, word)
+	}
+
+	slices.Sort(words)
+
+	return words
+}
+
+// choose returns a word of the list chosen with entropy read from the source.
+func (l petWordList) choose(entropy *random.Source) (string, error) {
+	i, err := entropy.Intn(int64(len(l)))
+	if err != nil {
+		return "", err
+	}
+
+	return l[i], nil
+}
+
+// petDeterministicSource returns a source of bytes derived from the JSON
+// encoding of the keepers, excluding keys with null values as keepers_hash
+// does, so that the same keepers always produce the same pet name. A namespace
+// which is not null is mixed into the HKDF info string, after a NUL byte which
+// cannot occur in petDeriveInfo, so that the same keepers produce different
+// pet names in different namespaces, and names derived without a namespace are
+// unchanged.
+func petDeterministicSource(keepers types.Map, namespace types.String) (*random.Source, error) {
+	encoded, err := keepersJSON(keepers)
+	if err != nil {
+		return nil, err
+	}
+
+	info := petDeriveInfo
+
+	if !namespace.IsNull() {
+		info += "\x00" + namespace.ValueString()
+	}
+
+	return random.NewDerivedSource(string(encoded), info), nil
+}
+
+// petDeterministicWords returns the lowercased words of a pet name of the
+// given length, composed as petWords does, with each word chosen from the word
+// lists with entropy read from the source.
+func petDeterministicWords(entropy *random.Source, lists map[string]petWordList, length int) ([]string, error) {
+	kinds := []string{"animal"}
+	if length != 1 {
+		kinds = append(slices.Repeat([]string{"adverb"}, max(length-2, 0)), "adjective", "animal")
+	}
+
+	words := make([]string, len(kinds))
+
+	for i, kind := range kinds {
+		word, err := lists[kind].choose(entropy)
+		if err != nil {
+			return nil, err
+		}
+
+		words[i] = word
+	}
+
+	return words, nil
+}
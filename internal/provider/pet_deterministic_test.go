@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// TestPetWordLists verifies that every word of the petname library is
+// collected, so that the constants used for entropy_bits match the library.
+func TestPetWordLists(t *testing.T) {
+	t.Parallel()
+
+	expected := map[string]int{
+		"adverb":    petAdverbs,
+		"adjective": petAdjectives,
+		"animal":    petNames,
+	}
+
+	for kind, words := range petWordLists() {
+		if len(words) != expected[kind] {
+			t.Errorf("expected %d %s words, got: %d", expected[kind], kind, len(words))
+		}
+
+		if !slices.IsSorted(words) {
+			t.Errorf("expected sorted %s words", kind)
+		}
+	}
+}
+
+func TestPetDeterministicWords(t *testing.T) {
+	t.Parallel()
+
+	deterministicWords := func(t *testing.T, keepers map[string]attr.Value, length int) []string {
+		t.Helper()
+
+		entropy, err := petDeterministicSource(types.MapValueMust(types.StringType, keepers), types.StringNull())
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		words, err := petDeterministicWords(entropy, petWordLists(), length)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		return words
+	}
+
+	keepers := map[string]attr.Value{
+		"region": types.StringValue("us-east-1"),
+		"tenant": types.StringValue("example"),
+	}
+
+	words := deterministicWords(t, keepers, 4)
+
+	if len(words) != 4 {
+		t.Fatalf("expected 4 words, got: %v", words)
+	}
+
+	if diff := cmp.Diff(words, deterministicWords(t, keepers, 4)); diff != "" {
+		t.Errorf("expected the same words for the same keepers: %s", diff)
+	}
+
+	// Keys with null values do not change the name, as with keepers_hash.
+	keepers["unset"] = types.StringNull()
+
+	if diff := cmp.Diff(words, deterministicWords(t, keepers, 4)); diff != "" {
+		t.Errorf("expected the same words when a null value is added: %s", diff)
+	}
+
+	keepers["tenant"] = types.StringValue("other")
+
+	if slices.Equal(words, deterministicWords(t, keepers, 4)) {
+		t.Errorf("expected different words for different keepers, got: %v", words)
+	}
+}
+
+func TestPetDeterministicSource_Namespace(t *testing.T) {
+	t.Parallel()
+
+	keepers := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"tenant": types.StringValue("example"),
+	})
+
+	deterministicWords := func(t *testing.T, namespace types.String) []string {
+		t.Helper()
+
+		entropy, err := petDeterministicSource(keepers, namespace)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		words, err := petDeterministicWords(entropy, petWordLists(), 4)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		return words
+	}
+
+	words := deterministicWords(t, types.StringValue("platform"))
+
+	if diff := cmp.Diff(words, deterministicWords(t, types.StringValue("platform"))); diff != "" {
+		t.Errorf("expected the same words for the same namespace: %s", diff)
+	}
+
+	if slices.Equal(words, deterministicWords(t, types.StringValue("other"))) {
+		t.Errorf("expected different words for different namespaces, got: %v", words)
+	}
+
+	if slices.Equal(words, deterministicWords(t, types.StringNull())) {
+		t.Errorf("expected different words without a namespace, got: %v", words)
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// petKeeperPlaceholder matches a placeholder, such as {env}, in the prefix or
+// separator of a random_pet, which is replaced by the value of the keeper of
+// the same name when the pet name is created.
+var petKeeperPlaceholder = regexp.MustCompile(`\{([^{}]+)\}`)
+
+// expandPetKeepers returns the value with each placeholder which names a key of
+// the keepers replaced by the value of that keeper, or an empty string if the
+// value is null. Other placeholders are left unchanged, so that prefixes which
+// contained braces before placeholders were supported are unaffected.
+func expandPetKeepers(value string, keepers types.Map) string {
+	elements := keepers.Elements()
+
+	return petKeeperPlaceholder.ReplaceAllStringFunc(value, func(placeholder string) string {
+		keeper, ok := elements[placeholder[1:len(placeholder)-1]].(types.String)
+		if !ok {
+			return placeholder
+		}
+
+		return keeper.ValueString()
+	})
+}
+
+// unresolvedPetPlaceholders returns the names of the placeholders in the value
+// which do not name a key of the keepers, in the order in which they occur.
+func unresolvedPetPlaceholders(value string, keepers types.Map) []string {
+	var unresolved []string
+
+	for _, match := range petKeeperPlaceholder.FindAllStringSubmatch(value, -1) {
+		if _, ok := keepers.Elements()[match[1]]; !ok {
+			unresolved = append(unresolved, match[1])
+		}
+	}
+
+	return unresolved
+}
+
+// validatePetKeeperPlaceholders returns a warning for each of the prefix and
+// separator attributes of the configuration which contains placeholders that
+// do not name a keeper, as they are left unchanged in the pet name, which is
+// likely to be a mistake.
+func validatePetKeeperPlaceholders(ctx context.Context, config tfsdk.Config) diag.Diagnostics {
+	var (
+		diags   diag.Diagnostics
+		keepers types.Map
+	)
+
+	diags.Append(config.GetAttribute(ctx, path.Root("keepers"), &keepers)...)
+	if diags.HasError() || keepers.IsUnknown() {
+		return diags
+	}
+
+	for _, attribute := range []string{"prefix", "separator"} {
+		var value types.String
+
+		diags.Append(config.GetAttribute(ctx, path.Root(attribute), &value)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		unresolved := unresolvedPetPlaceholders(value.ValueString(), keepers)
+		if len(unresolved) == 0 {
+			continue
+		}
+
+		diags.AddAttributeWarning(
+			path.Root(attribute),
+			"Unresolved Keeper Placeholder",
+			fmt.Sprintf("Attribute %s contains placeholders which do not name a key of keepers, so they are not "+
+				"replaced in the pet name: {%s}. Add the keys to keepers, or remove the braces if the text is "+
+				"intended literally.", attribute, strings.Join(unresolved, "}, {")),
+		)
+	}
+
+	return diags
+}
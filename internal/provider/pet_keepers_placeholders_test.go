@@ -0,0 +1,83 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestExpandPetKeepers(t *testing.T) {
+	t.Parallel()
+
+	keepers := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"env":    types.StringValue("prod"),
+		"region": types.StringValue("eu"),
+		"unset":  types.StringNull(),
+	})
+
+	testCases := map[string]struct {
+		value              string
+		keepers            types.Map
+		expected           string
+		expectedUnresolved []string
+	}{
+		"none": {
+			value:    "app",
+			keepers:  keepers,
+			expected: "app",
+		},
+		"single": {
+			value:    "{env}-app",
+			keepers:  keepers,
+			expected: "prod-app",
+		},
+		"multiple": {
+			value:    "{region}{env}",
+			keepers:  keepers,
+			expected: "euprod",
+		},
+		"null-value": {
+			value:    "{unset}app",
+			keepers:  keepers,
+			expected: "app",
+		},
+		"unresolved": {
+			value:              "{env}-{team}",
+			keepers:            keepers,
+			expected:           "prod-{team}",
+			expectedUnresolved: []string{"team"},
+		},
+		"null-keepers": {
+			value:              "{env}",
+			keepers:            types.MapNull(types.StringType),
+			expected:           "{env}",
+			expectedUnresolved: []string{"env"},
+		},
+		"unmatched-braces": {
+			value:    "{env-}",
+			keepers:  keepers,
+			expected: "{env-}",
+			// The placeholder is unresolved, as there is no env- keeper.
+			expectedUnresolved: []string{"env-"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := expandPetKeepers(testCase.value, testCase.keepers); got != testCase.expected {
+				t.Errorf("expected %q, got %q", testCase.expected, got)
+			}
+
+			if got := unresolvedPetPlaceholders(testCase.value, testCase.keepers); !slices.Equal(got, testCase.expectedUnresolved) {
+				t.Errorf("expected unresolved placeholders %q, got %q", testCase.expectedUnresolved, got)
+			}
+		})
+	}
+}
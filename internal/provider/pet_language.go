@@ -0,0 +1,109 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"embed"
+	"math"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// petWordsFS contains the localized word lists, as one file for each kind of
+// word in a directory for each language. Each file contains one lowercase
+// ASCII word per line, in sorted order, so that the index of each word is
+// stable between versions of the provider unless the list is changed.
+//
+//go:embed pet_words
+var petWordsFS embed.FS
+
+// petLanguageEnglish is the language of the word lists of the petname library,
+// which are used when language is not set.
+const petLanguageEnglish = "en"
+
+// petLanguages are the languages of the localized word lists in petWordsFS.
+var petLanguages = []string{"de", "es", "fr"}
+
+func languageAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: "The language of the words of the pet name, one of `de` (German), `en` (English), `es` " +
+			"(Spanish) or `fr` (French). Letters with diacritics are transliterated, such as `ue` for `ü`, so " +
+			"that pet names only ever contain lowercase ASCII letters and remain safe to use in URLs and DNS " +
+			"names. The word lists of each language differ in size, which is reflected in `entropy_bits`. " +
+			"Conflicts with `template`. Default value is `en`.",
+		Optional: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+		Validators: []validator.String{
+			stringvalidator.OneOf(append([]string{petLanguageEnglish}, petLanguages...)...),
+			stringvalidator.ConflictsWith(path.MatchRoot("template")),
+		},
+	}
+}
+
+// petLocalizedWordLists returns the word lists of each of petLanguages, keyed
+// by language and then by kind, as petWordLists is.
+var petLocalizedWordLists = sync.OnceValue(func() map[string]map[string]petWordList {
+	lists := make(map[string]map[string]petWordList, len(petLanguages))
+
+	for _, language := range petLanguages {
+		lists[language] = make(map[string]petWordList, 3)
+
+		for _, kind := range []string{"adverb", "adjective", "animal"} {
+			data, err := petWordsFS.ReadFile("pet_words/" + language + "/" + kind + ".txt")
+			if err != nil {
+				// The files are embedded, so this is only possible if one is
+				// removed without updating petLanguages.
+				panic(err)
+			}
+
+			lists[language][kind] = petWordList(strings.Fields(string(data)))
+		}
+	}
+
+	return lists
+})
+
+// petLanguageWordLists returns the word lists of the language, which are those
+// of the petname library when the language is empty or en.
+func petLanguageWordLists(language string) map[string]petWordList {
+	if isPetLanguageEnglish(language) {
+		return petWordLists()
+	}
+
+	return petLocalizedWordLists()[language]
+}
+
+// isPetLanguageEnglish returns true if pet names of the language are generated
+// with the petname library.
+func isPetLanguageEnglish(language string) bool {
+	return language == "" || language == petLanguageEnglish
+}
+
+// petLanguageEntropyBits returns the entropy in bits of a pet name of the
+// given number of words of the language, followed by the given number of
+// random decimal digits, as petEntropyBits does for English.
+func petLanguageEntropyBits(language string, words int, digits int) float64 {
+	if isPetLanguageEnglish(language) {
+		return petEntropyBits(words, digits)
+	}
+
+	lists := petLanguageWordLists(language)
+
+	bits := math.Log2(float64(len(lists["animal"]))) + float64(digits)*math.Log2(10)
+
+	if words != 1 {
+		bits += math.Log2(float64(len(lists["adjective"]))) + float64(max(words-2, 0))*math.Log2(float64(len(lists["adverb"])))
+	}
+
+	return bits
+}
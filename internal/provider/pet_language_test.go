@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"slices"
+	"testing"
+)
+
+// TestPetLocalizedWordLists verifies that every embedded word list can be
+// used in a pet name which is safe to use in URLs and DNS names, and that the
+// index of each word is stable.
+func TestPetLocalizedWordLists(t *testing.T) {
+	t.Parallel()
+
+	wordRegexp := regexp.MustCompile(`^[a-z]+$`)
+
+	for _, language := range petLanguages {
+		lists := petLanguageWordLists(language)
+
+		for _, kind := range []string{"adverb", "adjective", "animal"} {
+			words := lists[kind]
+
+			if len(words) < 50 {
+				t.Errorf("expected at least 50 %s %s words, got: %d", language, kind, len(words))
+			}
+
+			if !slices.IsSorted(words) {
+				t.Errorf("expected sorted %s %s words", language, kind)
+			}
+
+			for i, word := range words {
+				if !wordRegexp.MatchString(word) {
+					t.Errorf("expected %s %s word %q to only contain lowercase ASCII letters", language, kind, word)
+				}
+
+				if i > 0 && words[i-1] == word {
+					t.Errorf("expected %s %s word %q to occur once", language, kind, word)
+				}
+			}
+		}
+	}
+}
+
+func TestPetLanguageEntropyBits(t *testing.T) {
+	t.Parallel()
+
+	for _, language := range []string{"", petLanguageEnglish} {
+		if got, expected := petLanguageEntropyBits(language, 3, 2), petEntropyBits(3, 2); got != expected {
+			t.Errorf("expected %f bits for %q, got: %f", expected, language, got)
+		}
+	}
+
+	// The German word lists are smaller than those of the petname library.
+	if bits := petLanguageEntropyBits("de", 3, 2); bits >= petEntropyBits(3, 2) {
+		t.Errorf("expected fewer bits for de than en, got: %f", bits)
+	}
+}
@@ -0,0 +1,160 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	petname "github.com/dustinkirkland/golang-petname"
+
+	"github.com/terraform-providers/terraform-provider-random/internal/random"
+)
+
+// petTemplateMaxDigits is the maximum number of digits of a number placeholder
+// in a random_pet template.
+const petTemplateMaxDigits = 18
+
+// petTemplateSegment is either literal text of a random_pet template, or a
+// placeholder for a random word or number.
+type petTemplateSegment struct {
+	literal string
+
+	// word is the kind of word of the placeholder, one of adverb, adjective or
+	// animal, or empty for literal text and numbers.
+	word string
+
+	// digits is the number of digits of a number placeholder.
+	digits int
+}
+
+// petTemplateWords returns a random word of each kind of word placeholder.
+var petTemplateWords = map[string]func() string{
+	"adverb":    petname.Adverb,
+	"adjective": petname.Adjective,
+	"animal":    petname.Name,
+}
+
+// parsePetTemplate parses a random_pet template, such as
+// "{adjective}-{animal}-{number:3}", into its segments. Placeholders are
+// enclosed in braces, and the template must contain at least one.
+func parsePetTemplate(template string) ([]petTemplateSegment, error) {
+	var segments []petTemplateSegment
+
+	placeholders := 0
+
+	for template != "" {
+		start := strings.IndexAny(template, "{}")
+		if start == -1 {
+			segments = append(segments, petTemplateSegment{literal: template})
+			break
+		}
+
+		if template[start] == '}' {
+			return nil, errors.New("unexpected \"}\" outside of a placeholder")
+		}
+
+		if start > 0 {
+			segments = append(segments, petTemplateSegment{literal: template[:start]})
+		}
+
+		end := strings.IndexByte(template[start:], '}')
+		if end == -1 {
+			return nil, errors.New("unterminated placeholder, expected \"}\"")
+		}
+
+		segment, err := parsePetPlaceholder(template[start+1 : start+end])
+		if err != nil {
+			return nil, err
+		}
+
+		segments = append(segments, segment)
+		placeholders++
+		template = template[start+end+1:]
+	}
+
+	if placeholders == 0 {
+		return nil, errors.New("must contain at least one placeholder, such as {animal}")
+	}
+
+	return segments, nil
+}
+
+// parsePetPlaceholder parses the contents of a placeholder, without the
+// enclosing braces.
+func parsePetPlaceholder(placeholder string) (petTemplateSegment, error) {
+	if _, ok := petTemplateWords[placeholder]; ok {
+		return petTemplateSegment{word: placeholder}, nil
+	}
+
+	if digits, ok := strings.CutPrefix(placeholder, "number:"); ok {
+		n, err := strconv.Atoi(digits)
+		if err != nil || n < 1 || n > petTemplateMaxDigits {
+			return petTemplateSegment{}, fmt.Errorf("the number of digits of placeholder {%s} must be between 1 and %d",
+				placeholder, petTemplateMaxDigits)
+		}
+
+		return petTemplateSegment{digits: n}, nil
+	}
+
+	return petTemplateSegment{}, fmt.Errorf("unknown placeholder {%s}, expected one of {adverb}, {adjective}, "+
+		"{animal} or {number:N}", placeholder)
+}
+
+// expandPetTemplate returns the pet name of the template segments, and the
+// words chosen for its word placeholders in order. The digits of number
+// placeholders are read from the source of entropy.
+func expandPetTemplate(entropy *random.Source, segments []petTemplateSegment) (string, []string, error) {
+	var (
+		b     strings.Builder
+		words []string
+	)
+
+	for _, segment := range segments {
+		switch {
+		case segment.word != "":
+			word := strings.ToLower(petTemplateWords[segment.word]())
+			words = append(words, word)
+			b.WriteString(word)
+		case segment.digits > 0:
+			digits, err := entropy.CreateString(random.StringParams{
+				Length:  int64(segment.digits),
+				Numeric: true,
+			})
+			if err != nil {
+				return "", nil, err
+			}
+
+			b.Write(digits)
+		default:
+			b.WriteString(segment.literal)
+		}
+	}
+
+	return b.String(), words, nil
+}
+
+// petTemplateEntropyBits returns the entropy in bits of pet names expanded
+// from the template segments.
+func petTemplateEntropyBits(segments []petTemplateSegment) float64 {
+	var bits float64
+
+	for _, segment := range segments {
+		switch segment.word {
+		case "adverb":
+			bits += math.Log2(petAdverbs)
+		case "adjective":
+			bits += math.Log2(petAdjectives)
+		case "animal":
+			bits += math.Log2(petNames)
+		}
+
+		bits += float64(segment.digits) * math.Log2(10)
+	}
+
+	return bits
+}
@@ -0,0 +1,122 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"math"
+	"math/rand"
+	"regexp"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/terraform-providers/terraform-provider-random/internal/random"
+)
+
+func TestParsePetTemplate(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		template         string
+		expectedSegments []petTemplateSegment
+		expectedError    string
+	}{
+		"words-and-number": {
+			template: "{adjective}-{animal}-{number:3}",
+			expectedSegments: []petTemplateSegment{
+				{word: "adjective"},
+				{literal: "-"},
+				{word: "animal"},
+				{literal: "-"},
+				{digits: 3},
+			},
+		},
+		"literals-and-duplicates": {
+			template: "app.{animal}.{animal}.prod",
+			expectedSegments: []petTemplateSegment{
+				{literal: "app."},
+				{word: "animal"},
+				{literal: "."},
+				{word: "animal"},
+				{literal: ".prod"},
+			},
+		},
+		"no-placeholders": {
+			template:      "static",
+			expectedError: "must contain at least one placeholder, such as {animal}",
+		},
+		"unknown-placeholder": {
+			template:      "{colour}-{animal}",
+			expectedError: "unknown placeholder {colour}, expected one of {adverb}, {adjective}, {animal} or {number:N}",
+		},
+		"unterminated-placeholder": {
+			template:      "{animal",
+			expectedError: `unterminated placeholder, expected "}"`,
+		},
+		"unexpected-brace": {
+			template:      "{animal}}",
+			expectedError: `unexpected "}" outside of a placeholder`,
+		},
+		"number-too-long": {
+			template:      "{number:19}",
+			expectedError: "the number of digits of placeholder {number:19} must be between 1 and 18",
+		},
+		"number-without-digits": {
+			template:      "{number:}",
+			expectedError: "the number of digits of placeholder {number:} must be between 1 and 18",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			segments, err := parsePetTemplate(testCase.template)
+
+			if testCase.expectedError != "" {
+				if err == nil || err.Error() != testCase.expectedError {
+					t.Fatalf("expected error %q, got: %v", testCase.expectedError, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(segments, testCase.expectedSegments, cmp.AllowUnexported(petTemplateSegment{})); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestExpandPetTemplate(t *testing.T) {
+	t.Parallel()
+
+	segments, err := parsePetTemplate("{adverb}_{adjective}_{animal}-{number:4}")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	pet, words, err := expandPetTemplate(random.NewSource(rand.New(rand.NewSource(1))), segments)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !regexp.MustCompile(`^[a-z]+_[a-z]+_[a-z]+-\d{4}$`).MatchString(pet) {
+		t.Errorf("unexpected pet name: %s", pet)
+	}
+
+	if len(words) != 3 {
+		t.Errorf("expected 3 words, got: %v", words)
+	}
+
+	expectedBits := math.Log2(petAdverbs) + math.Log2(petAdjectives) + math.Log2(petNames) + 4*math.Log2(10)
+
+	if bits := petTemplateEntropyBits(segments); math.Abs(bits-expectedBits) > 1e-9 {
+		t.Errorf("expected %f entropy bits, got: %f", expectedBits, bits)
+	}
+}
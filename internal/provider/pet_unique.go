@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"math"
+	"sync"
+)
+
+const (
+	// petUniqueAttempts is the number of pet names generated for a
+	// unique_within namespace before giving up, which is only reached when
+	// nearly every possible name in the namespace has been generated.
+	petUniqueAttempts = 100
+
+	// petMaxCollisionProbability is the highest acceptable probability that
+	// any two of expected_cardinality pet names are the same, from which the
+	// number of digits appended to the pet name is calculated.
+	petMaxCollisionProbability = 0.01
+)
+
+// petNamePool records the pet names generated in each unique_within
+// namespace by the provider instance, so that pet names generated in the same
+// namespace during the same Terraform operation are never the same.
+type petNamePool struct {
+	mu    sync.Mutex
+	names map[string]map[string]struct{}
+}
+
+func newPetNamePool() *petNamePool {
+	return &petNamePool{
+		names: make(map[string]map[string]struct{}),
+	}
+}
+
+// reserve records the name in the namespace, returning false if the name has
+// already been recorded. A nil pool, such as when the provider has not been
+// configured, records nothing.
+func (p *petNamePool) reserve(namespace, name string) bool {
+	if p == nil {
+		return true
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	names, ok := p.names[namespace]
+	if !ok {
+		names = make(map[string]struct{})
+		p.names[namespace] = names
+	}
+
+	if _, ok := names[name]; ok {
+		return false
+	}
+
+	names[name] = struct{}{}
+
+	return true
+}
+
+// petDigitsForCardinality returns the number of random decimal digits to
+// append to a pet name of the given number of words of the language, so that the probability
+// of any two of the given number of pet names being the same is at most
+// petMaxCollisionProbability.
+func petDigitsForCardinality(language string, words int, cardinality int64) int {
+	n := float64(cardinality)
+
+	// The probability of a collision is approximately n(n-1)/2N for N
+	// possible names, which is an upper bound.
+	pairs := n * (n - 1) / 2
+
+	digits := 0
+
+	for pairs > petMaxCollisionProbability*math.Exp2(petLanguageEntropyBits(language, words, digits)) {
+		digits++
+	}
+
+	return digits
+}
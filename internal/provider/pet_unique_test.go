@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+)
+
+func TestPetNamePoolReserve(t *testing.T) {
+	t.Parallel()
+
+	pool := newPetNamePool()
+
+	if !pool.reserve("a", "cute-cat") {
+		t.Fatal("expected the first name to be reserved")
+	}
+
+	if pool.reserve("a", "cute-cat") {
+		t.Error("expected the same name in the same namespace not to be reserved")
+	}
+
+	if !pool.reserve("b", "cute-cat") {
+		t.Error("expected the same name in another namespace to be reserved")
+	}
+
+	var nilPool *petNamePool
+
+	if !nilPool.reserve("a", "cute-cat") || !nilPool.reserve("a", "cute-cat") {
+		t.Error("expected a nil pool to reserve every name")
+	}
+}
+
+func TestPetDigitsForCardinality(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		words       int
+		cardinality int64
+		expected    int
+	}{
+		"one":      {words: 2, cardinality: 1, expected: 0},
+		"small":    {words: 2, cardinality: 10, expected: 0},
+		"thousand": {words: 2, cardinality: 1000, expected: 3},
+		"million":  {words: 2, cardinality: 1000000, expected: 9},
+		"3-words":  {words: 3, cardinality: 10000, expected: 2},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := petDigitsForCardinality("", testCase.words, testCase.cardinality); got != testCase.expected {
+				t.Errorf("expected %d digits, got: %d", testCase.expected, got)
+			}
+		})
+	}
+}
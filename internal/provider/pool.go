@@ -0,0 +1,31 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"runtime"
+)
+
+// entropyBufferSize is the number of bytes of entropy read at a time by the
+// source shared by all resources of a configured provider, so that creating
+// hundreds of resources in a single apply requires few reads from the
+// underlying random number generator.
+const entropyBufferSize = 4096
+
+// hashWorkers limits the number of hashes computed concurrently to the number
+// of available CPUs. Terraform creates many resources in parallel, and bcrypt
+// and PBKDF2 are deliberately expensive, so without a limit large applies
+// oversubscribe the CPUs, which slows every hash and the responses to other
+// requests alike.
+var hashWorkers = make(chan struct{}, runtime.GOMAXPROCS(0))
+
+// acquireHashWorker blocks until a hashing worker is available, and returns a
+// function which releases it.
+func acquireHashWorker() func() {
+	hashWorkers <- struct{}{}
+
+	return func() {
+		<-hashWorkers
+	}
+}
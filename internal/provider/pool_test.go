@@ -0,0 +1,89 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/terraform-providers/terraform-provider-random/internal/random"
+)
+
+func TestAcquireHashWorker(t *testing.T) {
+	t.Parallel()
+
+	var active, peak atomic.Int64
+	var wg sync.WaitGroup
+
+	for i := 0; i < cap(hashWorkers)*4; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+			defer acquireHashWorker()()
+
+			current := active.Add(1)
+			defer active.Add(-1)
+
+			for {
+				previous := peak.Load()
+				if current <= previous || peak.CompareAndSwap(previous, current) {
+					break
+				}
+			}
+
+			time.Sleep(time.Millisecond)
+		}()
+	}
+
+	wg.Wait()
+
+	if got, limit := peak.Load(), int64(cap(hashWorkers)); got > limit {
+		t.Errorf("expected at most %d concurrent hashes, got: %d", limit, got)
+	}
+}
+
+func TestNewBufferedSource(t *testing.T) {
+	t.Parallel()
+
+	entropy := random.NewBufferedSource(nil, entropyBufferSize)
+	results := make(chan string, 100)
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < cap(results); i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			result, err := entropy.CreateString(random.StringParams{
+				Length: 32,
+				Upper:  true,
+				Lower:  true,
+			})
+			if err != nil {
+				t.Errorf("unexpected CreateString error: %s", err)
+				return
+			}
+
+			results <- string(result)
+		}()
+	}
+
+	wg.Wait()
+	close(results)
+
+	seen := make(map[string]bool)
+
+	for result := range results {
+		if seen[result] {
+			t.Errorf("expected unique results from concurrent use, got duplicate: %s", result)
+		}
+
+		seen[result] = true
+	}
+}
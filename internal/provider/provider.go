@@ -5,43 +5,258 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"time"
 
+	"github.com/hashicorp/go-uuid"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/terraform-providers/terraform-provider-random/internal/encrypt"
+	"github.com/terraform-providers/terraform-provider-random/internal/metrics"
+	"github.com/terraform-providers/terraform-provider-random/internal/publish"
+	"github.com/terraform-providers/terraform-provider-random/internal/random"
 )
 
-func New() provider.Provider {
-	return &randomProvider{}
+func New(version string) func() provider.Provider {
+	return func() provider.Provider {
+		return &randomProvider{
+			version: version,
+		}
+	}
+}
+
+// NewWithPublisher returns the provider, with a publisher which receives the
+// secrets generated by resources which support publishing, such as
+// random_password, before they are written to state. This allows tooling which
+// embeds the provider to hand secrets to an external store.
+func NewWithPublisher(version string, publisher publish.Publisher) func() provider.Provider {
+	return func() provider.Provider {
+		return &randomProvider{
+			version:   version,
+			publisher: publisher,
+		}
+	}
 }
 
-var _ provider.Provider = (*randomProvider)(nil)
+var (
+	_ provider.Provider                       = (*randomProvider)(nil)
+	_ provider.ProviderWithEphemeralResources = (*randomProvider)(nil)
+	_ provider.ProviderWithFunctions          = (*randomProvider)(nil)
+)
+
+type randomProvider struct {
+	// version is set to the provider version on release, "dev" when the
+	// provider is built and ran locally, and "test" when running acceptance
+	// testing.
+	version string
+
+	// entropy is the source of entropy supplied to resources and data
+	// sources. It is nil, which reads from the cryptographic random number
+	// generator, unless replaced, such as with a deterministic reader in
+	// tests.
+	entropy *random.Source
+
+	// publisher receives the secrets generated by resources which support
+	// publishing, if set.
+	publisher publish.Publisher
+}
 
-type randomProvider struct{}
+type randomProviderModel struct {
+	FIPS                types.Bool   `tfsdk:"fips"`
+	EntropyTimeout      types.String `tfsdk:"entropy_timeout"`
+	ResultEncryptionKey types.String `tfsdk:"result_encryption_key"`
+	UUIDNamespace       types.String `tfsdk:"uuid_namespace"`
+	MaxGenerationTime   types.String `tfsdk:"max_generation_time"`
+}
 
 func (p *randomProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
 	resp.TypeName = "random"
+	resp.Version = p.version
 }
 
-func (p *randomProvider) Schema(context.Context, provider.SchemaRequest, *provider.SchemaResponse) {
+func (p *randomProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"fips": schema.BoolAttribute{
+				Description: "Restrict hashing of generated values to FIPS 140 approved algorithms. When enabled, " +
+					"`random_password` does not generate `bcrypt_hash`, and instead generates `pbkdf2_hash` using " +
+					"PBKDF2 with HMAC-SHA-256. Default value is `false`.",
+				Optional: true,
+			},
+			"entropy_timeout": schema.StringAttribute{
+				Description: "The maximum time to wait for the operating system's random number generator, as a " +
+					"duration such as `30s` or `2m`. Reads which fail with a transient error are retried with " +
+					"backoff, and reads which block, such as while the random number generator of a newly " +
+					"booted cloud image is initialized, are abandoned once the time has elapsed. Default " +
+					"value is `10s`.",
+				Optional: true,
+			},
+			"max_generation_time": schema.StringAttribute{
+				Description: "The maximum time for which `random_password` generates its results, as a duration " +
+					"such as `30s` or `2m`, so that an apply does not hang indefinitely. The time is checked " +
+					"before each read from the random number generator, and each read, including its retries, " +
+					"is bounded by `entropy_timeout`. When the time elapses, the error describes the bytes " +
+					"requested, the time waited and the interface of the random number generator. Default value " +
+					"is `1m`.",
+				Optional: true,
+			},
+			"result_encryption_key": schema.StringAttribute{
+				Description: "A passphrase of at least 12 characters, or a PEM encoded RSA public key of at least " +
+					"2048 bits, with which `random_password` and `random_string` additionally encrypt their " +
+					"result into the `encrypted_result` attribute, using AES-256-GCM, so that pipelines can " +
+					"forward the value without reading the sensitive result. With a passphrase, the key is " +
+					"derived using PBKDF2 with HMAC-SHA-256. With a public key, a random key is wrapped using " +
+					"RSA-OAEP with SHA-256.",
+				Optional:  true,
+				Sensitive: true,
+			},
+			"uuid_namespace": schema.StringAttribute{
+				Description: "A UUID, such as one generated once for an organization, which is the namespace of " +
+					"the version 5 UUIDs generated by `random_uuid` resources with `deterministic` enabled. " +
+					"Changing this value does not change existing UUIDs until they are replaced.",
+				Optional: true,
+			},
+		},
+	}
 }
 
-func (p *randomProvider) Configure(context.Context, provider.ConfigureRequest, *provider.ConfigureResponse) {
+func (p *randomProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	var config randomProviderModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	entropyTimeout := random.DefaultEntropyTimeout
+
+	if !config.EntropyTimeout.IsNull() && !config.EntropyTimeout.IsUnknown() {
+		timeout, err := time.ParseDuration(config.EntropyTimeout.ValueString())
+		if err != nil || timeout <= 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("entropy_timeout"),
+				"Invalid Attribute Value",
+				fmt.Sprintf("Attribute entropy_timeout must be a positive duration, such as \"30s\", got: %q",
+					config.EntropyTimeout.ValueString()),
+			)
+			return
+		}
+
+		entropyTimeout = timeout
+	}
+
+	maxGenerationTime := random.DefaultMaxGenerationTime
+
+	if !config.MaxGenerationTime.IsNull() && !config.MaxGenerationTime.IsUnknown() {
+		timeout, err := time.ParseDuration(config.MaxGenerationTime.ValueString())
+		if err != nil || timeout <= 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("max_generation_time"),
+				"Invalid Attribute Value",
+				fmt.Sprintf("Attribute max_generation_time must be a positive duration, such as \"2m\", got: %q",
+					config.MaxGenerationTime.ValueString()),
+			)
+			return
+		}
+
+		maxGenerationTime = timeout
+	}
+
+	var encryptionKey *encrypt.Key
+
+	if !config.ResultEncryptionKey.IsNull() && !config.ResultEncryptionKey.IsUnknown() {
+		key, err := encrypt.ParseKey(config.ResultEncryptionKey.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("result_encryption_key"),
+				"Invalid Attribute Value",
+				fmt.Sprintf("Attribute result_encryption_key must be a passphrase or a PEM encoded RSA public key: %s", err),
+			)
+			return
+		}
+
+		encryptionKey = key
+	}
+
+	var uuidNamespace []byte
+
+	if !config.UUIDNamespace.IsNull() && !config.UUIDNamespace.IsUnknown() {
+		namespace, err := uuid.ParseUUID(config.UUIDNamespace.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("uuid_namespace"),
+				"Invalid Attribute Value",
+				fmt.Sprintf("Attribute uuid_namespace must be a UUID: %s", err),
+			)
+			return
+		}
+
+		uuidNamespace = namespace
+	}
+
+	data := &providerData{
+		version:           p.version,
+		fips:              config.FIPS.ValueBool(),
+		entropy:           random.NewBufferedSource(metrics.NewEntropyReader(random.NewRetryReader(p.entropy, entropyTimeout)), entropyBufferSize),
+		encryptionKey:     encryptionKey,
+		petNames:          newPetNamePool(),
+		publisher:         p.publisher,
+		uuidNamespace:     uuidNamespace,
+		maxGenerationTime: maxGenerationTime,
+	}
+
+	resp.DataSourceData = data
+	resp.EphemeralResourceData = data
+	resp.ResourceData = data
 }
 
 func (p *randomProvider) Resources(context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewIdResource,
+		NewBase64SecretResource,
 		NewBytesResource,
+		NewChoiceResource,
+		NewDateResource,
+		NewDerivedKeyResource,
+		NewHexResource,
 		NewIntegerResource,
+		NewIPv6InterfaceIDResource,
 		NewPasswordResource,
 		NewPetResource,
+		NewRecoveryCodesResource,
+		NewRsaLikeTokenResource,
+		NewSampleMapResource,
 		NewShuffleResource,
 		NewStringResource,
 		NewUuidResource,
+		NewWeightedIntegerResource,
 	}
 }
 
 func (p *randomProvider) DataSources(context.Context) []func() datasource.DataSource {
-	return nil
+	return []func() datasource.DataSource{
+		NewIntegerDataSource,
+		NewShuffleDataSource,
+	}
+}
+
+func (p *randomProvider) EphemeralResources(context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewPetEphemeralResource,
+	}
+}
+
+func (p *randomProvider) Functions(context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewIntegerFunction,
+		NewPetnameFunction,
+		NewShuffleFunction,
+	}
 }
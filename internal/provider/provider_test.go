@@ -4,15 +4,23 @@
 package provider
 
 import (
+	"context"
+	"regexp"
+	"testing"
+
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 )
 
 //nolint:unparam
-func protoV5ProviderFactories() map[string]func() (tfprotov5.ProviderServer, error) {
-	return map[string]func() (tfprotov5.ProviderServer, error){
-		"random": providerserver.NewProtocol5WithError(New()),
+func protoV6ProviderFactories() map[string]func() (tfprotov6.ProviderServer, error) {
+	return map[string]func() (tfprotov6.ProviderServer, error){
+		"random": providerserver.NewProtocol6WithError(New("test")()),
 	}
 }
 
@@ -60,3 +68,158 @@ func providerVersion342() map[string]resource.ExternalProvider {
 		},
 	}
 }
+
+func providerVersion363() map[string]resource.ExternalProvider {
+	return map[string]resource.ExternalProvider{
+		"random": {
+			VersionConstraint: "3.6.3",
+			Source:            "hashicorp/random",
+		},
+	}
+}
+
+// TestProvider_GetProviderSchema verifies that every schema of the provider can
+// be served with protocol version 6, which is not otherwise exercised without
+// running the acceptance tests.
+func TestProvider_GetProviderSchema(t *testing.T) {
+	t.Parallel()
+
+	server, err := providerserver.NewProtocol6WithError(New("test")())()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	resp, err := server.GetProviderSchema(context.Background(), &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for _, diag := range resp.Diagnostics {
+		t.Errorf("unexpected diagnostic: %s: %s", diag.Summary, diag.Detail)
+	}
+}
+
+// TestProvider_GetProviderSchema_Protocol5 verifies that every schema of the
+// provider can be served with protocol version 5, which cannot represent
+// nested attributes.
+func TestProvider_GetProviderSchema_Protocol5(t *testing.T) {
+	t.Parallel()
+
+	server, err := providerserver.NewProtocol5WithError(New("test")())()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	resp, err := server.GetProviderSchema(context.Background(), &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for _, diag := range resp.Diagnostics {
+		t.Errorf("unexpected diagnostic: %s: %s", diag.Summary, diag.Detail)
+	}
+}
+
+func TestAccProvider_EntropyTimeout(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `provider "random" {
+							entropy_timeout = "30s"
+						}
+
+						resource "random_string" "test" {
+							length = 12
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_string.test", tfjsonpath.New("result"), knownvalue.StringRegexp(regexp.MustCompile(`^.{12}$`))),
+				},
+			},
+		},
+	})
+}
+
+func TestAccProvider_EntropyTimeout_Invalid(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `provider "random" {
+							entropy_timeout = "30"
+						}
+
+						resource "random_string" "test" {
+							length = 12
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute entropy_timeout must be a positive duration`),
+			},
+			{
+				Config: `provider "random" {
+							entropy_timeout = "-1s"
+						}
+
+						resource "random_string" "test" {
+							length = 12
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute entropy_timeout must be a positive duration`),
+			},
+		},
+	})
+}
+
+func TestAccProvider_MaxGenerationTime(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `provider "random" {
+							max_generation_time = "2m"
+						}
+
+						resource "random_password" "test" {
+							length = 12
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_password.test", tfjsonpath.New("result"), knownvalue.StringRegexp(regexp.MustCompile(`^.{12}$`))),
+				},
+			},
+		},
+	})
+}
+
+func TestAccProvider_MaxGenerationTime_Invalid(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `provider "random" {
+							max_generation_time = "0s"
+						}
+
+						resource "random_password" "test" {
+							length = 12
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute max_generation_time must be a positive duration`),
+			},
+		},
+	})
+}
+
+func TestAccProvider_ResultEncryptionKey_Invalid(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `provider "random" {
+							result_encryption_key = "short"
+						}
+
+						resource "random_string" "test" {
+							length = 12
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute result_encryption_key must be a passphrase or a PEM encoded RSA`),
+			},
+		},
+	})
+}
@@ -0,0 +1,221 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/terraform-providers/terraform-provider-random/internal/diagnostics"
+	"github.com/terraform-providers/terraform-provider-random/internal/random"
+)
+
+var (
+	_ resource.Resource               = (*base64SecretResource)(nil)
+	_ resource.ResourceWithConfigure  = (*base64SecretResource)(nil)
+	_ resource.ResourceWithModifyPlan = (*base64SecretResource)(nil)
+)
+
+func NewBase64SecretResource() resource.Resource {
+	return &base64SecretResource{}
+}
+
+type base64SecretResource struct {
+	providerVersion string
+	entropy         *random.Source
+}
+
+func (r *base64SecretResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_base64_secret"
+}
+
+func (r *base64SecretResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = base64SecretSchemaV0()
+}
+
+func (r *base64SecretResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	r.providerVersion = providerVersion(req.ProviderData)
+	r.entropy = providerEntropy(req.ProviderData)
+}
+
+// ModifyPlan prevents the replacement of the resource when lifecycle_guard is
+// enabled, unless allow_regeneration_token is changed.
+func (r *base64SecretResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	guardRegeneration(ctx, req, resp)
+}
+
+func (r *base64SecretResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan base64SecretModelV0
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	done := logGeneration(ctx, randomSourceCrypto, map[string]any{
+		"length": plan.Length.ValueInt64(),
+	})
+
+	bytes, err := r.entropy.CreateBytes(plan.Length.ValueInt64())
+	done()
+
+	if errors.Is(err, random.ErrEntropyUnavailable) {
+		resp.Diagnostics.Append(diagnostics.EntropyUnavailableError(err)...)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Create Random Base64 Secret Error",
+			"There was an error during random generation.\n\n"+
+				diagnostics.RetryMsg+
+				fmt.Sprintf("Original Error: %s", err),
+		)
+		return
+	}
+
+	u := &base64SecretModelV0{
+		Length:                 plan.Length,
+		URLSafe:                plan.URLSafe,
+		Padding:                plan.Padding,
+		Result:                 types.StringValue(base64SecretEncoding(plan.URLSafe.ValueBool(), plan.Padding.ValueBool()).EncodeToString(bytes)),
+		Keepers:                plan.Keepers,
+		KeepersHash:            plan.KeepersHash,
+		LifecycleGuard:         plan.LifecycleGuard,
+		AllowRegenerationToken: plan.AllowRegenerationToken,
+	}
+
+	u.CreatedAt, u.ProviderVersion = lifecycleValues(r.providerVersion)
+
+	diags = resp.State.Set(ctx, u)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read does not need to perform any operations as the state in ReadResourceResponse is already populated.
+func (r *base64SecretResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+}
+
+func (r *base64SecretResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var model base64SecretModelV0
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+// Delete does not need to explicitly call resp.State.RemoveResource() as this is automatically handled by the
+// [framework](https://github.com/hashicorp/terraform-plugin-framework/pull/301).
+func (r *base64SecretResource) Delete(context.Context, resource.DeleteRequest, *resource.DeleteResponse) {
+}
+
+// base64SecretEncoding returns the base64 encoding for the url_safe and
+// padding attribute values.
+func base64SecretEncoding(urlSafe, padding bool) *base64.Encoding {
+	switch {
+	case urlSafe && padding:
+		return base64.URLEncoding
+	case urlSafe:
+		return base64.RawURLEncoding
+	case padding:
+		return base64.StdEncoding
+	default:
+		return base64.RawStdEncoding
+	}
+}
+
+type base64SecretModelV0 struct {
+	Keepers                types.Map    `tfsdk:"keepers"`
+	KeepersHash            types.String `tfsdk:"keepers_hash"`
+	Length                 types.Int64  `tfsdk:"length"`
+	URLSafe                types.Bool   `tfsdk:"url_safe"`
+	Padding                types.Bool   `tfsdk:"padding"`
+	Result                 types.String `tfsdk:"result"`
+	CreatedAt              types.String `tfsdk:"created_at"`
+	ProviderVersion        types.String `tfsdk:"provider_version"`
+	LifecycleGuard         types.Bool   `tfsdk:"lifecycle_guard"`
+	AllowRegenerationToken types.String `tfsdk:"allow_regeneration_token"`
+}
+
+func base64SecretSchemaV0() schema.Schema {
+	return schema.Schema{
+		Description: "The resource `random_base64_secret` generates random bytes encoded as base64, which are " +
+			"intended to be used as a secret, such as an OAuth client secret or a JWT HMAC key. The result is " +
+			"considered sensitive, and is not displayed in the CLI.\n" +
+			"\n" +
+			"This resource *does* use a cryptographic random number generator.",
+		Attributes: map[string]schema.Attribute{
+			"keepers": schema.MapAttribute{
+				Description: "Arbitrary map of values that, when changed, will trigger recreation of " +
+					"resource. See [the main provider documentation](../index.html) for more information.",
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"length": schema.Int64Attribute{
+				Description: "The number of random bytes to encode. The minimum value for length is 1.",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"url_safe": schema.BoolAttribute{
+				Description: "Use the URL-safe base64 alphabet, which replaces `+` and `/` with `-` and `_` " +
+					"respectively. Default value is `false`.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"padding": schema.BoolAttribute{
+				Description: "Pad the result with `=` characters to a multiple of four characters. Default " +
+					"value is `true`.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"keepers_hash":             keepersHashAttribute(),
+			"created_at":               createdAtAttribute(),
+			"provider_version":         providerVersionAttribute(),
+			"lifecycle_guard":          lifecycleGuardAttribute(),
+			"allow_regeneration_token": allowRegenerationTokenAttribute(),
+			"result": schema.StringAttribute{
+				Description: "The generated bytes presented in base64 string format.",
+				Computed:    true,
+				Sensitive:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,176 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/compare"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccResourceBase64Secret(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_base64_secret" "test" {
+							length = 32
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_base64_secret.test", tfjsonpath.New("result"), knownvalue.StringRegexp(regexp.MustCompile(`^[A-Za-z\d+/]{43}=$`))),
+					statecheck.ExpectKnownValue("random_base64_secret.test", tfjsonpath.New("url_safe"), knownvalue.Bool(false)),
+					statecheck.ExpectKnownValue("random_base64_secret.test", tfjsonpath.New("padding"), knownvalue.Bool(true)),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceBase64Secret_URLSafe(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_base64_secret" "test" {
+							length   = 64
+							url_safe = true
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_base64_secret.test", tfjsonpath.New("result"), knownvalue.StringRegexp(regexp.MustCompile(`^[A-Za-z\d_-]{86}==$`))),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceBase64Secret_NoPadding(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_base64_secret" "test" {
+							length   = 32
+							url_safe = true
+							padding  = false
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_base64_secret.test", tfjsonpath.New("result"), knownvalue.StringRegexp(regexp.MustCompile(`^[A-Za-z\d_-]{43}$`))),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceBase64Secret_LengthErrors(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_base64_secret" "test" {
+							length = 0
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute length value must be at least 1, got: 0`),
+			},
+		},
+	})
+}
+
+func TestAccResourceBase64Secret_Padding_ForceReplacement(t *testing.T) {
+	// The result attribute values should differ between test steps
+	assertResultDiffer := statecheck.CompareValue(compare.ValuesDiffer())
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_base64_secret" "test" {
+							length = 32
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					assertResultDiffer.AddStateValue("random_base64_secret.test", tfjsonpath.New("result")),
+				},
+			},
+			{
+				Config: `resource "random_base64_secret" "test" {
+							length  = 32
+							padding = false
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					assertResultDiffer.AddStateValue("random_base64_secret.test", tfjsonpath.New("result")),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceBase64Secret_Keepers_Keep_Value(t *testing.T) {
+	// The result attribute values should be the same between test steps
+	assertResultSame := statecheck.CompareValue(compare.ValuesSame())
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_base64_secret" "test" {
+							length = 32
+							keepers = {
+								"key" = "123"
+							}
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					assertResultSame.AddStateValue("random_base64_secret.test", tfjsonpath.New("result")),
+				},
+			},
+			{
+				Config: `resource "random_base64_secret" "test" {
+							length = 32
+							keepers = {
+								"key" = "123"
+							}
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					assertResultSame.AddStateValue("random_base64_secret.test", tfjsonpath.New("result")),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceBase64Secret_Keepers_Replace_ValueToNewValue(t *testing.T) {
+	// The result attribute values should differ between test steps
+	assertResultDiffer := statecheck.CompareValue(compare.ValuesDiffer())
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_base64_secret" "test" {
+							length = 32
+							keepers = {
+								"key" = "123"
+							}
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					assertResultDiffer.AddStateValue("random_base64_secret.test", tfjsonpath.New("result")),
+				},
+			},
+			{
+				Config: `resource "random_base64_secret" "test" {
+							length = 32
+							keepers = {
+								"key" = "456"
+							}
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					assertResultDiffer.AddStateValue("random_base64_secret.test", tfjsonpath.New("result")),
+				},
+			},
+		},
+	})
+}
@@ -5,27 +5,38 @@ package provider
 
 import (
 	"context"
-	"crypto/rand"
 	"encoding/base64"
 	"encoding/hex"
+	"errors"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/terraform-providers/terraform-provider-random/internal/diagnostics"
+	"github.com/terraform-providers/terraform-provider-random/internal/random"
 )
 
+// bytesMaxLength is the maximum number of bytes, which bounds the memory used
+// to generate them and the size of the state, as they are stored both base64
+// and hex encoded.
+const bytesMaxLength = 65536
+
 var (
-	_ resource.Resource                = (*bytesResource)(nil)
-	_ resource.ResourceWithImportState = (*bytesResource)(nil)
+	_ resource.Resource                 = (*bytesResource)(nil)
+	_ resource.ResourceWithConfigure    = (*bytesResource)(nil)
+	_ resource.ResourceWithImportState  = (*bytesResource)(nil)
+	_ resource.ResourceWithModifyPlan   = (*bytesResource)(nil)
+	_ resource.ResourceWithUpgradeState = (*bytesResource)(nil)
 )
 
 func NewBytesResource() resource.Resource {
@@ -33,6 +44,8 @@ func NewBytesResource() resource.Resource {
 }
 
 type bytesResource struct {
+	providerVersion string
+	entropy         *random.Source
 }
 
 func (r *bytesResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -40,11 +53,28 @@ func (r *bytesResource) Metadata(_ context.Context, req resource.MetadataRequest
 }
 
 func (r *bytesResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resp.Schema = bytesSchemaV0()
+	resp.Schema = bytesSchemaV1()
+}
+
+func (r *bytesResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	r.providerVersion = providerVersion(req.ProviderData)
+	r.entropy = providerEntropy(req.ProviderData)
+}
+
+// ModifyPlan replaces the resource on every apply when regenerate is always,
+// and prevents the replacement of the resource when lifecycle_guard is
+// enabled, unless allow_regeneration_token is changed.
+func (r *bytesResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	regenerateOnApply(ctx, req, resp, path.Root("base64"), path.Root("hex"))
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	guardRegeneration(ctx, req, resp)
 }
 
 func (r *bytesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan bytesModelV0
+	var plan bytesModelV1
 
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -52,8 +82,27 @@ func (r *bytesResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	bytes := make([]byte, plan.Length.ValueInt64())
-	_, err := rand.Read(bytes)
+	// The length is validated in the schema, but may not be known until apply.
+	if length := plan.Length.ValueInt64(); length < 1 || length > bytesMaxLength {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("length"),
+			"Create Random bytes error",
+			fmt.Sprintf("The length must be between 1 and %d, got: %d.", bytesMaxLength, length),
+		)
+		return
+	}
+
+	done := logGeneration(ctx, randomSourceCrypto, map[string]any{
+		"length": plan.Length.ValueInt64(),
+	})
+
+	bytes, err := r.entropy.CreateBytes(plan.Length.ValueInt64())
+	done()
+
+	if errors.Is(err, random.ErrEntropyUnavailable) {
+		resp.Diagnostics.Append(diagnostics.EntropyUnavailableError(err)...)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Create Random bytes error",
@@ -64,13 +113,31 @@ func (r *bytesResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	u := &bytesModelV0{
-		Length:  plan.Length,
-		Base64:  types.StringValue(base64.StdEncoding.EncodeToString(bytes)),
-		Hex:     types.StringValue(hex.EncodeToString(bytes)),
-		Keepers: plan.Keepers,
+	u := &bytesModelV1{
+		Length:                 plan.Length,
+		Base64:                 types.StringValue(base64.StdEncoding.EncodeToString(bytes)),
+		Hex:                    types.StringValue(hex.EncodeToString(bytes)),
+		HexChunkSize:           plan.HexChunkSize,
+		Keepers:                plan.Keepers,
+		KeepersHash:            plan.KeepersHash,
+		Regenerate:             plan.Regenerate,
+		LifecycleGuard:         plan.LifecycleGuard,
+		AllowRegenerationToken: plan.AllowRegenerationToken,
+		WrappingPublicKey:      plan.WrappingPublicKey,
+		WrappingAlgorithm:      plan.WrappingAlgorithm,
 	}
 
+	u.WrappedKey, diags = wrapBytes(u.WrappingPublicKey, u.WrappingAlgorithm, r.entropy, bytes)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	u.HexChunks = hexChunks(u.Hex, u.HexChunkSize)
+	u.EntropyBits = bytesEntropyBits(u.Length)
+	u.Spec = bytesSpec(u.Length, u.EntropyBits)
+	u.CreatedAt, u.ProviderVersion = lifecycleValues(r.providerVersion)
+
 	diags = resp.State.Set(ctx, u)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -78,17 +145,77 @@ func (r *bytesResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 }
 
-// Read does not need to perform any operations as the state in ReadResourceResponse is already populated.
-func (r *bytesResource) Read(context.Context, resource.ReadRequest, *resource.ReadResponse) {
+// Read does not need to refresh the state as the state in ReadResourceResponse is already populated, other than to
+// populate entropy_bits and keepers_hash for resources created by earlier provider versions.
+func (r *bytesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var model bytesModelV1
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if model.EntropyBits.IsNull() || model.Spec.IsNull() {
+		logRefresh(ctx, "entropy_bits", "spec")
+
+		model.EntropyBits = bytesEntropyBits(model.Length)
+		model.Spec = bytesSpec(model.Length, model.EntropyBits)
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(refreshKeepersHash(ctx, &resp.State)...)
 }
 
 func (r *bytesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var model bytesModelV0
+	var model bytesModelV1
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	// The entropy_bits value is unknown in the plan if the state was not
+	// refreshed since upgrading from an earlier provider version.
+	if model.EntropyBits.IsUnknown() {
+		model.EntropyBits = bytesEntropyBits(model.Length)
+	}
+
+	if model.Spec.IsUnknown() {
+		model.Spec = bytesSpec(model.Length, model.EntropyBits)
+	}
+
+	// The hex_chunks value is unknown in the plan if hex_chunk_size was not
+	// known during plan.
+	if model.HexChunks.IsUnknown() {
+		model.HexChunks = hexChunks(model.Hex, model.HexChunkSize)
+	}
+
+	// The wrapped_key value is unknown in the plan if wrapping_public_key or
+	// wrapping_algorithm was changed or not known during plan.
+	if model.WrappedKey.IsUnknown() {
+		bytes, err := hex.DecodeString(model.Hex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Update Random bytes error",
+				"There was an error decoding the hex value in state.\n\n"+
+					fmt.Sprintf("Original Error: %s", err),
+			)
+			return
+		}
+
+		var diags diag.Diagnostics
+
+		model.WrappedKey, diags = wrapBytes(model.WrappingPublicKey, model.WrappingAlgorithm, r.entropy, bytes)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
 }
 
@@ -109,12 +236,29 @@ func (r *bytesResource) ImportState(ctx context.Context, req resource.ImportStat
 		return
 	}
 
-	var state bytesModelV0
+	logImport(ctx, map[string]any{
+		"length": len(bytes),
+	})
+
+	var state bytesModelV1
 
 	state.Length = types.Int64Value(int64(len(bytes)))
 	state.Base64 = types.StringValue(req.ID)
 	state.Hex = types.StringValue(hex.EncodeToString(bytes))
+	state.HexChunkSize = types.Int64Null()
+	state.HexChunks = types.ListNull(types.StringType)
+	state.EntropyBits = bytesEntropyBits(state.Length)
+	state.Spec = bytesSpec(state.Length, state.EntropyBits)
 	state.Keepers = types.MapNull(types.StringType)
+	state.KeepersHash = keepersHash(state.Keepers)
+	state.Regenerate = types.StringNull()
+	state.CreatedAt = types.StringNull()
+	state.ProviderVersion = types.StringNull()
+	state.LifecycleGuard = types.BoolNull()
+	state.AllowRegenerationToken = types.StringNull()
+	state.WrappingPublicKey = types.StringNull()
+	state.WrappingAlgorithm = types.StringNull()
+	state.WrappedKey = types.StringNull()
 
 	diags := resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -123,16 +267,81 @@ func (r *bytesResource) ImportState(ctx context.Context, req resource.ImportStat
 	}
 }
 
-type bytesModelV0 struct {
-	Length  types.Int64  `tfsdk:"length"`
-	Keepers types.Map    `tfsdk:"keepers"`
-	Base64  types.String `tfsdk:"base64"`
-	Hex     types.String `tfsdk:"hex"`
+func (r *bytesResource) UpgradeState(context.Context) map[int64]resource.StateUpgrader {
+	schemaV0 := bytesSchemaV0()
+
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema:   &schemaV0,
+			StateUpgrader: upgradeBytesStateV0toV1,
+		},
+	}
 }
 
-func bytesSchemaV0() schema.Schema {
+func upgradeBytesStateV0toV1(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	logStateUpgrade(ctx, 0, 1)
+
+	type modelV0 struct {
+		Length  types.Int64  `tfsdk:"length"`
+		Keepers types.Map    `tfsdk:"keepers"`
+		Base64  types.String `tfsdk:"base64"`
+		Hex     types.String `tfsdk:"hex"`
+	}
+
+	var bytesDataV0 modelV0
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &bytesDataV0)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The creation time and provider version of resources created prior to
+	// schema version 1 are not known, so they are left as null.
+	bytesDataV1 := bytesModelV1{
+		Length:                 bytesDataV0.Length,
+		Keepers:                bytesDataV0.Keepers,
+		Base64:                 bytesDataV0.Base64,
+		Hex:                    bytesDataV0.Hex,
+		HexChunkSize:           types.Int64Null(),
+		HexChunks:              types.ListNull(types.StringType),
+		KeepersHash:            types.StringNull(),
+		Regenerate:             types.StringNull(),
+		CreatedAt:              types.StringNull(),
+		ProviderVersion:        types.StringNull(),
+		LifecycleGuard:         types.BoolNull(),
+		AllowRegenerationToken: types.StringNull(),
+		WrappingPublicKey:      types.StringNull(),
+		WrappingAlgorithm:      types.StringNull(),
+		WrappedKey:             types.StringNull(),
+		Spec:                   types.ObjectNull(specAttrTypes),
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, bytesDataV1)...)
+}
+
+type bytesModelV1 struct {
+	Length                 types.Int64   `tfsdk:"length"`
+	Keepers                types.Map     `tfsdk:"keepers"`
+	KeepersHash            types.String  `tfsdk:"keepers_hash"`
+	Regenerate             types.String  `tfsdk:"regenerate"`
+	Base64                 types.String  `tfsdk:"base64"`
+	Hex                    types.String  `tfsdk:"hex"`
+	HexChunkSize           types.Int64   `tfsdk:"hex_chunk_size"`
+	HexChunks              types.List    `tfsdk:"hex_chunks"`
+	EntropyBits            types.Float64 `tfsdk:"entropy_bits"`
+	Spec                   types.Object  `tfsdk:"spec"`
+	CreatedAt              types.String  `tfsdk:"created_at"`
+	ProviderVersion        types.String  `tfsdk:"provider_version"`
+	LifecycleGuard         types.Bool    `tfsdk:"lifecycle_guard"`
+	AllowRegenerationToken types.String  `tfsdk:"allow_regeneration_token"`
+	WrappingPublicKey      types.String  `tfsdk:"wrapping_public_key"`
+	WrappingAlgorithm      types.String  `tfsdk:"wrapping_algorithm"`
+	WrappedKey             types.String  `tfsdk:"wrapped_key"`
+}
+
+func bytesSchemaV1() schema.Schema {
 	return schema.Schema{
-		Version: 0,
+		Version: 1,
 		Description: "The resource `random_bytes` generates random bytes that are intended to be " +
 			"used as a secret, or key. Use this in preference to `random_id` when the output is " +
 			"considered sensitive, and should not be displayed in the CLI.\n" +
@@ -145,19 +354,30 @@ func bytesSchemaV0() schema.Schema {
 				ElementType: types.StringType,
 				Optional:    true,
 				PlanModifiers: []planmodifier.Map{
-					mapplanmodifier.RequiresReplace(),
+					regenerateKeepersPlanModifier(),
 				},
 			},
+			"regenerate": regenerateAttribute(),
 			"length": schema.Int64Attribute{
-				Description: "The number of bytes requested. The minimum value for length is 1.",
-				Required:    true,
+				Description: fmt.Sprintf("The number of bytes requested. The minimum value for length is 1, and "+
+					"the maximum value is %d.", bytesMaxLength),
+				Required: true,
 				PlanModifiers: []planmodifier.Int64{
 					int64planmodifier.RequiresReplace(),
 				},
 				Validators: []validator.Int64{
 					int64validator.AtLeast(1),
+					int64validator.AtMost(bytesMaxLength),
 				},
 			},
+			"keepers_hash":             keepersHashAttribute(),
+			"created_at":               createdAtAttribute(),
+			"provider_version":         providerVersionAttribute(),
+			"lifecycle_guard":          lifecycleGuardAttribute(),
+			"allow_regeneration_token": allowRegenerationTokenAttribute(),
+			"wrapping_public_key":      wrappingPublicKeyAttribute(),
+			"wrapping_algorithm":       wrappingAlgorithmAttribute(),
+			"wrapped_key":              wrappedKeyAttribute(),
 			"base64": schema.StringAttribute{
 				Description: "The generated bytes presented in base64 string format.",
 				Computed:    true,
@@ -175,6 +395,52 @@ func bytesSchemaV0() schema.Schema {
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"hex_chunk_size": hexChunkSizeAttribute(),
+			"hex_chunks":     hexChunksAttribute(true, bytesHexFromState),
+			"spec":           specAttribute("random bytes", false),
+			"entropy_bits":   entropyBitsAttribute("The entropy of the generated bytes in bits, which is eight times `length`."),
 		},
 	}
 }
+
+func bytesSchemaV0() schema.Schema {
+	return schema.Schema{
+		Description: "The resource `random_bytes` generates random bytes that are intended to be " +
+			"used as a secret, or key. Use this in preference to `random_id` when the output is " +
+			"considered sensitive, and should not be displayed in the CLI.\n" +
+			"\n" +
+			"This resource *does* use a cryptographic random number generator.",
+		Attributes: map[string]schema.Attribute{
+			"keepers": schema.MapAttribute{
+				Description: "Arbitrary map of values that, when changed, will trigger recreation of " +
+					"resource. See [the main provider documentation](../index.html) for more information.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"length": schema.Int64Attribute{
+				Description: "The number of bytes requested. The minimum value for length is 1.",
+				Required:    true,
+			},
+			"base64": schema.StringAttribute{
+				Description: "The generated bytes presented in base64 string format.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"hex": schema.StringAttribute{
+				Description: "The generated bytes presented in lowercase hexadecimal string format. " +
+					"The length of the encoded string is exactly twice the `length` parameter.",
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+// bytesHexFromState returns the hex value in state.
+func bytesHexFromState(ctx context.Context, state tfsdk.State) (types.String, diag.Diagnostics) {
+	var hexStr types.String
+
+	diags := state.GetAttribute(ctx, path.Root("hex"), &hexStr)
+
+	return hexStr, diags
+}
@@ -4,22 +4,31 @@
 package provider
 
 import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
 	"fmt"
 	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/compare"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
 func TestAccResourceBytes(t *testing.T) {
 	resource.UnitTest(t, resource.TestCase{
-		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: `resource "random_bytes" "basic" {
@@ -49,6 +58,7 @@ func TestAccResourceBytes(t *testing.T) {
 				ResourceName:                         "random_bytes.basic",
 				ImportState:                          true,
 				ImportStateVerify:                    true,
+				ImportStateVerifyIgnore:              []string{"created_at", "provider_version"},
 				ImportStateVerifyIdentifierAttribute: "base64",
 			},
 		},
@@ -57,7 +67,7 @@ func TestAccResourceBytes(t *testing.T) {
 
 func TestAccResourceBytes_ImportWithoutKeepersThenUpdateShouldNotTriggerChange(t *testing.T) {
 	resource.UnitTest(t, resource.TestCase{
-		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				ImportState:        true,
@@ -83,9 +93,133 @@ func TestAccResourceBytes_ImportWithoutKeepersThenUpdateShouldNotTriggerChange(t
 	})
 }
 
+func TestAccResourceBytes_EntropyBits(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_bytes" "test" {
+							length = 32
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_bytes.test", tfjsonpath.New("entropy_bits"), knownvalue.Float64Exact(256)),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceBytes_Spec(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_bytes" "test" {
+							length = 32
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_bytes.test", tfjsonpath.New("spec"), knownvalue.ObjectExact(map[string]knownvalue.Check{
+						"length":            knownvalue.Int64Exact(32),
+						"upper":             knownvalue.Null(),
+						"lower":             knownvalue.Null(),
+						"numeric":           knownvalue.Null(),
+						"special":           knownvalue.Null(),
+						"min_upper":         knownvalue.Null(),
+						"min_lower":         knownvalue.Null(),
+						"min_numeric":       knownvalue.Null(),
+						"min_special":       knownvalue.Null(),
+						"entropy_bits":      knownvalue.Float64Exact(256),
+						"algorithm_version": knownvalue.Int64Exact(bytesAlgorithmVersion),
+					})),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceBytes_EntropyBits_UpgradeFromVersion3_6_3(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				ExternalProviders: providerVersion363(),
+				Config: `resource "random_bytes" "test" {
+							length = 32
+						}`,
+			},
+			{
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
+				Config: `resource "random_bytes" "test" {
+							length = 32
+						}`,
+				PlanOnly: true,
+			},
+			{
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
+				Config: `resource "random_bytes" "test" {
+							length = 32
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_bytes.test", tfjsonpath.New("entropy_bits"), knownvalue.Float64Exact(256)),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceBytes_HexChunks(t *testing.T) {
+	assertHexSame := statecheck.CompareValue(compare.ValuesSame())
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_bytes" "test" {
+							length         = 40
+							hex_chunk_size = 64
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					assertHexSame.AddStateValue("random_bytes.test", tfjsonpath.New("hex")),
+					statecheck.ExpectKnownValue("random_bytes.test", tfjsonpath.New("hex_chunks"), knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.StringRegexp(regexp.MustCompile(`^[a-f\d]{64}$`)),
+						knownvalue.StringRegexp(regexp.MustCompile(`^[a-f\d]{16}$`)),
+					})),
+				},
+			},
+			{
+				Config: `resource "random_bytes" "test" {
+							length         = 40
+							hex_chunk_size = 40
+						}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("random_bytes.test", plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue("random_bytes.test", tfjsonpath.New("hex_chunks"), knownvalue.ListSizeExact(2)),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					assertHexSame.AddStateValue("random_bytes.test", tfjsonpath.New("hex")),
+					statecheck.ExpectKnownValue("random_bytes.test", tfjsonpath.New("hex_chunks"), knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.StringRegexp(regexp.MustCompile(`^[a-f\d]{40}$`)),
+						knownvalue.StringRegexp(regexp.MustCompile(`^[a-f\d]{40}$`)),
+					})),
+				},
+			},
+			{
+				Config: `resource "random_bytes" "test" {
+							length = 40
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					assertHexSame.AddStateValue("random_bytes.test", tfjsonpath.New("hex")),
+					statecheck.ExpectKnownValue("random_bytes.test", tfjsonpath.New("hex_chunks"), knownvalue.Null()),
+				},
+			},
+		},
+	})
+}
+
 func TestAccResourceBytes_LengthErrors(t *testing.T) {
 	resource.UnitTest(t, resource.TestCase{
-		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: `resource "random_bytes" "invalid_length" {
@@ -93,6 +227,23 @@ func TestAccResourceBytes_LengthErrors(t *testing.T) {
 						}`,
 				ExpectError: regexp.MustCompile(`.*Attribute length value must be at least 1, got: 0`),
 			},
+			{
+				Config: `resource "random_bytes" "invalid_length" {
+							length = 65537
+						}`,
+				ExpectError: regexp.MustCompile(`.*Attribute length value must be at most 65536, got: 65537`),
+			},
+			{
+				Config: `resource "random_integer" "length" {
+							min = 65537
+							max = 65537
+						}
+
+						resource "random_bytes" "invalid_length" {
+							length = random_integer.length.result
+						}`,
+				ExpectError: regexp.MustCompile(`The length must be between 1 and 65536, got: 65537`),
+			},
 		},
 	})
 }
@@ -104,7 +255,7 @@ func TestAccResourceBytes_Length_ForceReplacement(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_bytes" "test" {
 					length = 1
 				}`,
@@ -115,7 +266,7 @@ func TestAccResourceBytes_Length_ForceReplacement(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_bytes" "test" {
 					length = 2
 				}`,
@@ -136,7 +287,7 @@ func TestAccResourceBytes_Keepers_Keep_EmptyMap(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_bytes" "test" {
 					length = 12
 					keepers = {}
@@ -147,7 +298,7 @@ func TestAccResourceBytes_Keepers_Keep_EmptyMap(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_bytes" "test" {
 					length = 12
 					keepers = {}
@@ -168,7 +319,7 @@ func TestAccResourceBytes_Keepers_Keep_NullMap(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_bytes" "test" {
 					length = 12
 				}`,
@@ -178,7 +329,7 @@ func TestAccResourceBytes_Keepers_Keep_NullMap(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_bytes" "test" {
 					length = 12
 				}`,
@@ -198,7 +349,7 @@ func TestAccResourceBytes_Keepers_Keep_NullValue(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_bytes" "test" {
 					length = 12
 					keepers = {
@@ -211,7 +362,7 @@ func TestAccResourceBytes_Keepers_Keep_NullValue(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_bytes" "test" {
 					length = 12
 					keepers = {
@@ -234,7 +385,7 @@ func TestAccResourceBytes_Keepers_Keep_NullValues(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_bytes" "test" {
 					length = 12
 					keepers = {
@@ -248,7 +399,7 @@ func TestAccResourceBytes_Keepers_Keep_NullValues(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_bytes" "test" {
 					length = 12
 					keepers = {
@@ -272,7 +423,7 @@ func TestAccResourceBytes_Keepers_Keep_Value(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_bytes" "test" {
 					length = 12
 					keepers = {
@@ -285,7 +436,7 @@ func TestAccResourceBytes_Keepers_Keep_Value(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_bytes" "test" {
 					length = 12
 					keepers = {
@@ -308,7 +459,7 @@ func TestAccResourceBytes_Keepers_Keep_Values(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_bytes" "test" {
 					length = 12
 					keepers = {
@@ -322,7 +473,7 @@ func TestAccResourceBytes_Keepers_Keep_Values(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_bytes" "test" {
 					length = 12
 					keepers = {
@@ -346,7 +497,7 @@ func TestAccResourceBytes_Keepers_Replace_EmptyMapToValue(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_bytes" "test" {
 					length = 12
 					keepers = {}
@@ -357,7 +508,7 @@ func TestAccResourceBytes_Keepers_Replace_EmptyMapToValue(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_bytes" "test" {
 					length = 12
 					keepers = {
@@ -380,7 +531,7 @@ func TestAccResourceBytes_Keepers_Replace_NullMapToValue(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_bytes" "test" {
 					length = 12
 				}`,
@@ -390,7 +541,7 @@ func TestAccResourceBytes_Keepers_Replace_NullMapToValue(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_bytes" "test" {
 					length = 12
 					keepers = {
@@ -413,7 +564,7 @@ func TestAccResourceBytes_Keepers_Replace_NullValueToValue(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_bytes" "test" {
 					length = 12
 					keepers = {
@@ -426,7 +577,7 @@ func TestAccResourceBytes_Keepers_Replace_NullValueToValue(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_bytes" "test" {
 					length = 12
 					keepers = {
@@ -449,7 +600,7 @@ func TestAccResourceBytes_Keepers_Replace_ValueToEmptyMap(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_bytes" "test" {
 					length = 12
 					keepers = {
@@ -462,7 +613,7 @@ func TestAccResourceBytes_Keepers_Replace_ValueToEmptyMap(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_bytes" "test" {
 					length = 12
 					keepers = {}
@@ -483,7 +634,7 @@ func TestAccResourceBytes_Keepers_Replace_ValueToNullMap(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_bytes" "test" {
 					length = 12
 					keepers = {
@@ -496,7 +647,7 @@ func TestAccResourceBytes_Keepers_Replace_ValueToNullMap(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_bytes" "test" {
 					length = 12
 				}`,
@@ -516,7 +667,7 @@ func TestAccResourceBytes_Keepers_Replace_ValueToNullValue(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_bytes" "test" {
 					length = 12
 					keepers = {
@@ -529,7 +680,7 @@ func TestAccResourceBytes_Keepers_Replace_ValueToNullValue(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_bytes" "test" {
 					length = 12
 					keepers = {
@@ -552,7 +703,7 @@ func TestAccResourceBytes_Keepers_Replace_ValueToNewValue(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_bytes" "test" {
 					length = 12
 					keepers = {
@@ -565,7 +716,7 @@ func TestAccResourceBytes_Keepers_Replace_ValueToNewValue(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_bytes" "test" {
 					length = 12
 					keepers = {
@@ -580,3 +731,309 @@ func TestAccResourceBytes_Keepers_Replace_ValueToNewValue(t *testing.T) {
 		},
 	})
 }
+
+func TestAccResourceBytes_Regenerate_Never(t *testing.T) {
+	assertHexSame := statecheck.CompareValue(compare.ValuesSame())
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_bytes" "test" {
+					length     = 12
+					regenerate = "never"
+					keepers = {
+						"key" = "123"
+					}
+				}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					assertHexSame.AddStateValue("random_bytes.test", tfjsonpath.New("hex")),
+				},
+			},
+			{
+				Config: `resource "random_bytes" "test" {
+					length     = 12
+					regenerate = "never"
+					keepers = {
+						"key" = "456"
+					}
+				}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("random_bytes.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					assertHexSame.AddStateValue("random_bytes.test", tfjsonpath.New("hex")),
+					statecheck.ExpectKnownValue("random_bytes.test", tfjsonpath.New("keepers").AtMapKey("key"), knownvalue.StringExact("456")),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceBytes_Regenerate_Always(t *testing.T) {
+	assertHexDiffer := statecheck.CompareValue(compare.ValuesDiffer())
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_bytes" "test" {
+					length     = 12
+					regenerate = "always"
+				}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					assertHexDiffer.AddStateValue("random_bytes.test", tfjsonpath.New("hex")),
+				},
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: `resource "random_bytes" "test" {
+					length     = 12
+					regenerate = "always"
+				}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("random_bytes.test", plancheck.ResourceActionReplace),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					assertHexDiffer.AddStateValue("random_bytes.test", tfjsonpath.New("hex")),
+				},
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: `resource "random_bytes" "test" {
+					length          = 12
+					regenerate      = "always"
+					lifecycle_guard = true
+				}`,
+				ExpectError: regexp.MustCompile(`Attribute regenerate cannot be "always" when lifecycle_guard is enabled`),
+			},
+		},
+	})
+}
+
+func TestAccResourceBytes_MoveFromID(t *testing.T) {
+	assertHexSame := statecheck.CompareValue(compare.ValuesSame())
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_id" "test" {
+							byte_length = 32
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					assertHexSame.AddStateValue("random_id.test", tfjsonpath.New("hex")),
+				},
+			},
+			{
+				Config: `moved {
+							from = random_id.test
+							to   = random_bytes.test
+						}
+
+						resource "random_bytes" "test" {
+							length = 32
+						}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("random_bytes.test", plancheck.ResourceActionNoop),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					assertHexSame.AddStateValue("random_bytes.test", tfjsonpath.New("hex")),
+					statecheck.ExpectKnownValue("random_bytes.test", tfjsonpath.New("entropy_bits"), knownvalue.Float64Exact(256)),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceBytes_WrappedKey(t *testing.T) {
+	assertHexSame := statecheck.CompareValue(compare.ValuesSame())
+
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	pkix, err := x509.MarshalPKIXPublicKey(&privateKey.PublicKey)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	rsaPublicKey := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: pkix})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`resource "random_bytes" "test" {
+							length              = 32
+							wrapping_public_key = %q
+							wrapping_algorithm  = "RSAES_OAEP_SHA_256"
+						}`, rsaPublicKey),
+				ConfigStateChecks: []statecheck.StateCheck{
+					assertHexSame.AddStateValue("random_bytes.test", tfjsonpath.New("hex")),
+				},
+				Check: func(s *terraform.State) error {
+					attributes := s.RootModule().Resources["random_bytes.test"].Primary.Attributes
+
+					wrapped, err := base64.StdEncoding.DecodeString(attributes["wrapped_key"])
+					if err != nil {
+						return err
+					}
+
+					unwrapped, err := rsa.DecryptOAEP(sha256.New(), nil, privateKey, wrapped, nil)
+					if err != nil {
+						return err
+					}
+
+					if hex.EncodeToString(unwrapped) != attributes["hex"] {
+						return fmt.Errorf("expected the unwrapped key to be the generated bytes, got: %x", unwrapped)
+					}
+
+					return nil
+				},
+			},
+			{
+				Config: fmt.Sprintf(`resource "random_bytes" "test" {
+							length              = 32
+							wrapping_public_key = %q
+							wrapping_algorithm  = "RSAES_OAEP_SHA_256"
+						}`, rsaPublicKey),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				Config: fmt.Sprintf(`resource "random_bytes" "test" {
+							length              = 32
+							wrapping_public_key = %q
+						}`, rsaPublicKey),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("random_bytes.test", plancheck.ResourceActionUpdate),
+						plancheck.ExpectUnknownValue("random_bytes.test", tfjsonpath.New("wrapped_key")),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					assertHexSame.AddStateValue("random_bytes.test", tfjsonpath.New("hex")),
+					// The 256 byte wrapped AES key is followed by the 40 byte
+					// wrapped bytes.
+					statecheck.ExpectKnownValue("random_bytes.test", tfjsonpath.New("wrapped_key"), knownvalue.StringRegexp(regexp.MustCompile(`^[A-Za-z0-9+/]{395}=$`))),
+				},
+			},
+			{
+				Config: `resource "random_bytes" "test" {
+							length = 32
+						}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("random_bytes.test", plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue("random_bytes.test", tfjsonpath.New("wrapped_key"), knownvalue.Null()),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					assertHexSame.AddStateValue("random_bytes.test", tfjsonpath.New("hex")),
+					statecheck.ExpectKnownValue("random_bytes.test", tfjsonpath.New("wrapped_key"), knownvalue.Null()),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceBytes_WrappedKey_Invalid(t *testing.T) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	rsaPublicKey := pem.EncodeToMemory(&pem.Block{
+		Type:  "RSA PUBLIC KEY",
+		Bytes: x509.MarshalPKCS1PublicKey(&privateKey.PublicKey),
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_bytes" "test" {
+							length              = 32
+							wrapping_public_key = "age1ql3z7hjy54pw3hyww5ayyfg7zqgvc7w3j2elw8zmrj2kg5sfn9aqmcac8p"
+						}`,
+				ExpectError: regexp.MustCompile(`Invalid Public Key`),
+			},
+			{
+				Config: `resource "random_bytes" "test" {
+							length             = 32
+							wrapping_algorithm = "RSA_AES_KEY_WRAP_SHA_1"
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute "wrapping_public_key" must be specified`),
+			},
+			{
+				Config: fmt.Sprintf(`resource "random_bytes" "test" {
+							length              = 191
+							wrapping_public_key = %q
+							wrapping_algorithm  = "RSAES_OAEP_SHA_256"
+						}`, rsaPublicKey),
+				ExpectError: regexp.MustCompile(`RSAES_OAEP_SHA_256 can wrap at most 190 bytes`),
+			},
+		},
+	})
+}
+
+func TestAccResourceBytes_LifecycleMetadata(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_bytes" "test" {
+							length = 32
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_bytes.test", tfjsonpath.New("created_at"), knownvalue.StringRegexp(regexp.MustCompile(`^\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}Z$`))),
+					statecheck.ExpectKnownValue("random_bytes.test", tfjsonpath.New("provider_version"), knownvalue.StringExact("test")),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceBytes_LifecycleMetadata_UpgradeFromVersion3_6_3(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				ExternalProviders: providerVersion363(),
+				Config: `resource "random_bytes" "test" {
+							length = 32
+						}`,
+			},
+			{
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
+				Config: `resource "random_bytes" "test" {
+							length = 32
+						}`,
+				PlanOnly: true,
+			},
+			{
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
+				Config: `resource "random_bytes" "test" {
+							length = 32
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_bytes.test", tfjsonpath.New("created_at"), knownvalue.Null()),
+					statecheck.ExpectKnownValue("random_bytes.test", tfjsonpath.New("provider_version"), knownvalue.Null()),
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,341 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	mapplanmodifiers "github.com/terraform-providers/terraform-provider-random/internal/planmodifiers/map"
+	"github.com/terraform-providers/terraform-provider-random/internal/random"
+)
+
+var (
+	_ resource.Resource                   = (*choiceResource)(nil)
+	_ resource.ResourceWithConfigure      = (*choiceResource)(nil)
+	_ resource.ResourceWithModifyPlan     = (*choiceResource)(nil)
+	_ resource.ResourceWithValidateConfig = (*choiceResource)(nil)
+)
+
+func NewChoiceResource() resource.Resource {
+	return &choiceResource{}
+}
+
+type choiceResource struct {
+	providerVersion string
+	entropy         *random.Source
+}
+
+func (r *choiceResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_choice"
+}
+
+func (r *choiceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = choiceSchemaV0()
+}
+
+func (r *choiceResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	r.providerVersion = providerVersion(req.ProviderData)
+	r.entropy = providerEntropy(req.ProviderData)
+}
+
+// ModifyPlan prevents the replacement of the resource when lifecycle_guard is
+// enabled, unless allow_regeneration_token is changed.
+func (r *choiceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	guardRegeneration(ctx, req, resp)
+}
+
+func (r *choiceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan choiceModelV0
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var input []string
+
+	resp.Diagnostics.Append(plan.Input.ElementsAs(ctx, &input, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var weights []float64
+
+	if !plan.Weights.IsNull() {
+		resp.Diagnostics.Append(plan.Weights.ElementsAs(ctx, &weights, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// The configuration may have contained unknown values during validation.
+	resp.Diagnostics.Append(validateChoiceWeights(len(input), weights)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	seed := plan.Seed.ValueString()
+
+	done := logGeneration(ctx, pseudoRandomSource(seed), map[string]any{
+		"input_count": len(input),
+	})
+
+	index, err := chooseIndex(r.entropy, len(input), weights, seed)
+	done()
+
+	if err != nil {
+		resp.Diagnostics.Append(entropyDiagnostics(err)...)
+		return
+	}
+
+	plan.Index = types.Int64Value(int64(index))
+	plan.Result = types.StringValue(input[index])
+	plan.CreatedAt, plan.ProviderVersion = lifecycleValues(r.providerVersion)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read does not need to perform any operations as the state in ReadResourceResponse is already populated.
+func (r *choiceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+}
+
+// Update ensures the plan value is copied to the state to complete the update.
+func (r *choiceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var model choiceModelV0
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+// Delete does not need to explicitly call resp.State.RemoveResource() as this is automatically handled by the
+// [framework](https://github.com/hashicorp/terraform-plugin-framework/pull/301).
+func (r *choiceResource) Delete(context.Context, resource.DeleteRequest, *resource.DeleteResponse) {
+}
+
+// ValidateConfig ensures that weights, if given, has one weight for each element of input and allows at least one
+// element to be chosen.
+func (r *choiceResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config choiceModelV0
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Input.IsNull() || config.Input.IsUnknown() || config.Weights.IsNull() || config.Weights.IsUnknown() {
+		return
+	}
+
+	var weights []types.Float64
+
+	resp.Diagnostics.Append(config.Weights.ElementsAs(ctx, &weights, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	values := make([]float64, 0, len(weights))
+
+	for _, weight := range weights {
+		if weight.IsUnknown() {
+			return
+		}
+
+		values = append(values, weight.ValueFloat64())
+	}
+
+	resp.Diagnostics.Append(validateChoiceWeights(len(config.Input.Elements()), values)...)
+}
+
+// validateChoiceWeights returns an error diagnostic if weights are given which do not have one weight for each of the
+// inputCount elements, or which are all zero.
+func validateChoiceWeights(inputCount int, weights []float64) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if weights == nil {
+		return diags
+	}
+
+	if len(weights) != inputCount {
+		diags.AddAttributeError(
+			path.Root("weights"),
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute weights must contain one weight for each element of input (%d), got: %d",
+				inputCount, len(weights)),
+		)
+
+		return diags
+	}
+
+	for _, weight := range weights {
+		if weight > 0 {
+			return diags
+		}
+	}
+
+	diags.AddAttributeError(
+		path.Root("weights"),
+		"Invalid Attribute Value",
+		"Attribute weights must contain at least one weight greater than 0.",
+	)
+
+	return diags
+}
+
+// chooseIndex returns the index of one of count elements, chosen with a probability proportional to its weight if
+// weights are given, or with equal probability otherwise. The same index is always chosen for the same non-empty
+// seed.
+func chooseIndex(entropy *random.Source, count int, weights []float64, seed string) (int, error) {
+	rng, err := entropy.NewRand(seed)
+	if err != nil {
+		return 0, err
+	}
+
+	return chooseWeightedIndex(rng, count, weights), nil
+}
+
+// chooseWeightedIndex returns the index of one of count elements, chosen as chooseIndex does with the random number
+// generator.
+func chooseWeightedIndex(rng *rand.Rand, count int, weights []float64) int {
+	if len(weights) == 0 {
+		return rng.Intn(count)
+	}
+
+	var total float64
+
+	for _, weight := range weights {
+		total += weight
+	}
+
+	target := rng.Float64() * total
+
+	for i, weight := range weights {
+		if target < weight {
+			return i
+		}
+
+		target -= weight
+	}
+
+	// Floating point rounding may leave a remainder, in which case the last
+	// element which can be chosen is used.
+	for i := len(weights) - 1; i >= 0; i-- {
+		if weights[i] > 0 {
+			return i
+		}
+	}
+
+	return 0
+}
+
+func choiceSchemaV0() schema.Schema {
+	return schema.Schema{
+		Description: "The resource `random_choice` chooses a single element from a list of strings given as an " +
+			"argument, such as an availability zone, region, or instance type, optionally weighting the " +
+			"probability of each element being chosen.\n" +
+			"\n" +
+			"This resource does not use a cryptographic random number generator.",
+		Attributes: map[string]schema.Attribute{
+			"keepers": schema.MapAttribute{
+				Description: "Arbitrary map of values that, when changed, will trigger recreation of " +
+					"resource. See [the main provider documentation](../index.html) for more information.",
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifiers.RequiresReplaceIfValuesNotNull(),
+				},
+			},
+			"seed": schema.StringAttribute{
+				Description: "Arbitrary string with which to seed the random number generator, in order to " +
+					"produce less-volatile choices.\n" +
+					"\n" +
+					"**Important:** Even with an identical seed, it is not guaranteed that the same element " +
+					"will be chosen across different versions of Terraform. This argument causes the " +
+					"result to be *less volatile*, but not fixed for all time.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"input": schema.ListAttribute{
+				Description: "The list of strings to choose from. Must contain at least one element.",
+				ElementType: types.StringType,
+				Required:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"weights": schema.ListAttribute{
+				Description: "The relative weight of each element of `input`, in the same order, such that an " +
+					"element with a weight of `2` is twice as likely to be chosen as an element with a weight " +
+					"of `1`. Elements with a weight of `0` are never chosen. Must contain one weight for each " +
+					"element of `input`, at least one of which is greater than `0`. If not set, every element " +
+					"is equally likely to be chosen.",
+				ElementType: types.Float64Type,
+				Optional:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.ValueFloat64sAre(float64validator.AtLeast(0)),
+				},
+			},
+			"keepers_hash":             keepersHashAttribute(),
+			"created_at":               createdAtAttribute(),
+			"provider_version":         providerVersionAttribute(),
+			"lifecycle_guard":          lifecycleGuardAttribute(),
+			"allow_regeneration_token": allowRegenerationTokenAttribute(),
+			"index": schema.Int64Attribute{
+				Description: "The index of the chosen element in `input`.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"result": schema.StringAttribute{
+				Description: "The chosen element of `input`.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+type choiceModelV0 struct {
+	Keepers                types.Map    `tfsdk:"keepers"`
+	KeepersHash            types.String `tfsdk:"keepers_hash"`
+	Seed                   types.String `tfsdk:"seed"`
+	Input                  types.List   `tfsdk:"input"`
+	Weights                types.List   `tfsdk:"weights"`
+	Index                  types.Int64  `tfsdk:"index"`
+	Result                 types.String `tfsdk:"result"`
+	CreatedAt              types.String `tfsdk:"created_at"`
+	ProviderVersion        types.String `tfsdk:"provider_version"`
+	LifecycleGuard         types.Bool   `tfsdk:"lifecycle_guard"`
+	AllowRegenerationToken types.String `tfsdk:"allow_regeneration_token"`
+}
@@ -0,0 +1,150 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"math/rand"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	"github.com/terraform-providers/terraform-provider-random/internal/random"
+	"github.com/terraform-providers/terraform-provider-random/internal/randomtest"
+)
+
+func TestChooseIndex(t *testing.T) {
+	t.Parallel()
+
+	t.Run("seeded", func(t *testing.T) {
+		t.Parallel()
+
+		first, err := chooseIndex(nil, 5, nil, "seed")
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		for i := 0; i < 10; i++ {
+			got, err := chooseIndex(nil, 5, nil, "seed")
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != first {
+				t.Fatalf("expected index %d for the same seed, got: %d", first, got)
+			}
+		}
+	})
+
+	t.Run("zero-weights", func(t *testing.T) {
+		t.Parallel()
+
+		entropy := random.NewSource(rand.New(rand.NewSource(1)))
+
+		for i := 0; i < 1000; i++ {
+			got, err := chooseIndex(entropy, 4, []float64{0, 1, 0, 2}, "")
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != 1 && got != 3 {
+				t.Fatalf("expected only elements with a positive weight to be chosen, got index: %d", got)
+			}
+		}
+	})
+
+	t.Run("weighted-distribution", func(t *testing.T) {
+		t.Parallel()
+
+		entropy := random.NewSource(rand.New(rand.NewSource(2)))
+		weights := []float64{1, 2, 3}
+		observed := make([]int, len(weights))
+
+		for i := 0; i < 6000; i++ {
+			index, err := chooseIndex(entropy, len(weights), weights, "")
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			observed[index]++
+		}
+
+		if err := randomtest.ChiSquaredWeighted(observed, weights); err != nil {
+			t.Error(err)
+		}
+	})
+}
+
+func TestAccResourceChoice(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_choice" "test" {
+							input = ["us-east-1a", "us-east-1b", "us-east-1c"]
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_choice.test", tfjsonpath.New("result"), knownvalue.StringRegexp(regexp.MustCompile(`^us-east-1[abc]$`))),
+					statecheck.ExpectKnownValue("random_choice.test", tfjsonpath.New("index"), knownvalue.NotNull()),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceChoice_Weights(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_choice" "test" {
+							input   = ["t3.micro", "t3.small", "t3.medium"]
+							weights = [0, 1, 0]
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_choice.test", tfjsonpath.New("result"), knownvalue.StringExact("t3.small")),
+					statecheck.ExpectKnownValue("random_choice.test", tfjsonpath.New("index"), knownvalue.Int64Exact(1)),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceChoice_Weights_Invalid(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_choice" "test" {
+							input   = ["a", "b", "c"]
+							weights = [1, 2]
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute weights must contain one weight for each element of input\s+\(3\), got: 2`),
+			},
+			{
+				Config: `resource "random_choice" "test" {
+							input   = ["a", "b"]
+							weights = [0, 0]
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute weights must contain at least one weight greater than 0`),
+			},
+			{
+				Config: `resource "random_choice" "test" {
+							input   = ["a", "b"]
+							weights = [1, -1]
+						}`,
+				ExpectError: regexp.MustCompile(`value must be at least 0`),
+			},
+			{
+				Config: `resource "random_choice" "test" {
+							input = []
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute input list must contain at least 1 elements`),
+			},
+		},
+	})
+}
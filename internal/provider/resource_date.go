@@ -0,0 +1,347 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	mapplanmodifiers "github.com/terraform-providers/terraform-provider-random/internal/planmodifiers/map"
+	"github.com/terraform-providers/terraform-provider-random/internal/random"
+)
+
+var (
+	_ resource.Resource                   = (*dateResource)(nil)
+	_ resource.ResourceWithConfigure      = (*dateResource)(nil)
+	_ resource.ResourceWithModifyPlan     = (*dateResource)(nil)
+	_ resource.ResourceWithValidateConfig = (*dateResource)(nil)
+)
+
+// The formats of the result of random_date.
+const (
+	dateFormatRFC3339 = "rfc3339"
+	dateFormatDate    = "date"
+	dateFormatUnix    = "unix"
+)
+
+func NewDateResource() resource.Resource {
+	return &dateResource{}
+}
+
+type dateResource struct {
+	providerVersion string
+	entropy         *random.Source
+}
+
+func (r *dateResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_date"
+}
+
+func (r *dateResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = dateSchemaV0()
+}
+
+func (r *dateResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	r.providerVersion = providerVersion(req.ProviderData)
+	r.entropy = providerEntropy(req.ProviderData)
+}
+
+// ModifyPlan prevents the replacement of the resource when lifecycle_guard is
+// enabled, unless allow_regeneration_token is changed.
+func (r *dateResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	guardRegeneration(ctx, req, resp)
+}
+
+func (r *dateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan dateModelV0
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The configuration may have contained unknown values during validation.
+	minTime, maxTime, diags := plan.bounds()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	seed := plan.Seed.ValueString()
+
+	done := logGeneration(ctx, pseudoRandomSource(seed), map[string]any{
+		"min": plan.Min.ValueString(),
+		"max": plan.Max.ValueString(),
+	})
+
+	result, err := sampleDate(r.entropy, minTime, maxTime, seed)
+	done()
+
+	if err != nil {
+		resp.Diagnostics.Append(entropyDiagnostics(err)...)
+		return
+	}
+
+	plan.Unix = types.Int64Value(result.Unix())
+	plan.Result = types.StringValue(formatDate(result, plan.Format.ValueString()))
+	plan.CreatedAt, plan.ProviderVersion = lifecycleValues(r.providerVersion)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read does not need to perform any operations as the state in ReadResourceResponse is already populated.
+func (r *dateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+}
+
+// Update ensures the plan value is copied to the state to complete the update. The result is planned from the date in
+// state when the format changes.
+func (r *dateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var model dateModelV0
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+// Delete does not need to explicitly call resp.State.RemoveResource() as this is automatically handled by the
+// [framework](https://github.com/hashicorp/terraform-plugin-framework/pull/301).
+func (r *dateResource) Delete(context.Context, resource.DeleteRequest, *resource.DeleteResponse) {
+}
+
+// ValidateConfig ensures that min and max are RFC3339 timestamps, and that min is not later than max.
+func (r *dateResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config dateModelV0
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, _, diags := config.bounds()
+	resp.Diagnostics.Append(diags...)
+}
+
+// bounds returns min and max truncated to whole seconds, with error diagnostics if either is not an RFC3339 timestamp
+// or min is later than max. Unknown values are ignored.
+func (m dateModelV0) bounds() (time.Time, time.Time, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	minTime, minOK := parseDateBound(path.Root("min"), m.Min, &diags)
+	maxTime, maxOK := parseDateBound(path.Root("max"), m.Max, &diags)
+
+	if minOK && maxOK && minTime.After(maxTime) {
+		diags.AddAttributeError(
+			path.Root("min"),
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute min must not be later than max (%s), got: %s",
+				m.Max.ValueString(), m.Min.ValueString()),
+		)
+	}
+
+	return minTime, maxTime, diags
+}
+
+// parseDateBound parses the RFC3339 timestamp of the attribute at the path,
+// returning whether it is known and valid.
+func parseDateBound(p path.Path, value types.String, diags *diag.Diagnostics) (time.Time, bool) {
+	if value.IsNull() || value.IsUnknown() {
+		return time.Time{}, false
+	}
+
+	t, err := time.Parse(time.RFC3339, value.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			p,
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute %s value must be an RFC3339 timestamp, such as \"2006-01-02T15:04:05Z\", got: %q",
+				p, value.ValueString()),
+		)
+
+		return time.Time{}, false
+	}
+
+	return t.Truncate(time.Second), true
+}
+
+// sampleDate returns a time between min and max inclusive, to the second, in
+// the time zone offset of min. The same time is always sampled for the same
+// non-empty seed.
+func sampleDate(entropy *random.Source, minTime, maxTime time.Time, seed string) (time.Time, error) {
+	rng, err := entropy.NewRand(seed)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	offset := rng.Int63n(maxTime.Unix() - minTime.Unix() + 1)
+
+	return time.Unix(minTime.Unix()+offset, 0).In(minTime.Location()), nil
+}
+
+// formatDate returns the time in the given format of the result of random_date.
+func formatDate(t time.Time, format string) string {
+	switch format {
+	case dateFormatDate:
+		return t.Format(time.DateOnly)
+	case dateFormatUnix:
+		return strconv.FormatInt(t.Unix(), 10)
+	default:
+		return t.Format(time.RFC3339)
+	}
+}
+
+func dateSchemaV0() schema.Schema {
+	return schema.Schema{
+		Description: "The resource `random_date` generates a random date and time between `min` and `max`, " +
+			"such as for synthetic test data, or to stagger the anchors of certificate or credential " +
+			"rotations.\n" +
+			"\n" +
+			"This resource does not use a cryptographic random number generator.",
+		Attributes: map[string]schema.Attribute{
+			"keepers": schema.MapAttribute{
+				Description: "Arbitrary map of values that, when changed, will trigger recreation of " +
+					"resource. See [the main provider documentation](../index.html) for more information.",
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifiers.RequiresReplaceIfValuesNotNull(),
+				},
+			},
+			"seed": schema.StringAttribute{
+				Description: "Arbitrary string with which to seed the random number generator, in order to " +
+					"produce less-volatile dates.\n" +
+					"\n" +
+					"**Important:** Even with an identical seed, it is not guaranteed that the same date " +
+					"will be generated across different versions of Terraform. This argument causes the " +
+					"result to be *less volatile*, but not fixed for all time.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"min": schema.StringAttribute{
+				Description: "The earliest inclusive time of the result, as an RFC3339 timestamp, such as " +
+					"`2024-01-01T00:00:00Z`. The result is in the same time zone offset as this value. Fractional " +
+					"seconds are ignored.",
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"max": schema.StringAttribute{
+				Description: "The latest inclusive time of the result, as an RFC3339 timestamp, which must not " +
+					"be earlier than `min`. Fractional seconds are ignored.",
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"format": schema.StringAttribute{
+				Description: "The format of `result`, which is one of `rfc3339`, such as `2024-03-15T09:26:53Z`, " +
+					"`date`, such as `2024-03-15`, or `unix`, the number of seconds since the Unix epoch, such as " +
+					"`1710494813`. Changing this value formats the existing date again without replacing the " +
+					"resource. Default value is `rfc3339`.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(dateFormatRFC3339),
+				Validators: []validator.String{
+					stringvalidator.OneOf(dateFormatRFC3339, dateFormatDate, dateFormatUnix),
+				},
+			},
+			"keepers_hash":             keepersHashAttribute(),
+			"created_at":               createdAtAttribute(),
+			"provider_version":         providerVersionAttribute(),
+			"lifecycle_guard":          lifecycleGuardAttribute(),
+			"allow_regeneration_token": allowRegenerationTokenAttribute(),
+			"result": schema.StringAttribute{
+				Description: "The random date in the format given by `format`.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					dateResultModifier{},
+				},
+			},
+			"unix": schema.Int64Attribute{
+				Description: "The random date as the number of seconds since the Unix epoch, regardless of " +
+					"`format`.",
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// dateResultModifier plans the result from the date in state, so that
+// changing the format updates the result in place.
+type dateResultModifier struct{}
+
+func (m dateResultModifier) Description(ctx context.Context) string {
+	return m.MarkdownDescription(ctx)
+}
+
+func (m dateResultModifier) MarkdownDescription(context.Context) string {
+	return "The date in state, in the planned format."
+}
+
+func (m dateResultModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	// Do nothing if the resource is being created or destroyed.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state dateModelV0
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Format.Equal(state.Format) {
+		resp.PlanValue = req.StateValue
+		return
+	}
+
+	minTime, minOK := parseDateBound(path.Root("min"), state.Min, &resp.Diagnostics)
+	if !minOK || state.Unix.IsNull() || plan.Format.IsUnknown() {
+		return
+	}
+
+	result := time.Unix(state.Unix.ValueInt64(), 0).In(minTime.Location())
+
+	resp.PlanValue = types.StringValue(formatDate(result, plan.Format.ValueString()))
+}
+
+type dateModelV0 struct {
+	Keepers                types.Map    `tfsdk:"keepers"`
+	KeepersHash            types.String `tfsdk:"keepers_hash"`
+	Seed                   types.String `tfsdk:"seed"`
+	Min                    types.String `tfsdk:"min"`
+	Max                    types.String `tfsdk:"max"`
+	Format                 types.String `tfsdk:"format"`
+	Result                 types.String `tfsdk:"result"`
+	Unix                   types.Int64  `tfsdk:"unix"`
+	CreatedAt              types.String `tfsdk:"created_at"`
+	ProviderVersion        types.String `tfsdk:"provider_version"`
+	LifecycleGuard         types.Bool   `tfsdk:"lifecycle_guard"`
+	AllowRegenerationToken types.String `tfsdk:"allow_regeneration_token"`
+}
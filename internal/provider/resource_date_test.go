@@ -0,0 +1,197 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"math/rand"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	"github.com/terraform-providers/terraform-provider-random/internal/random"
+)
+
+func TestSampleDate(t *testing.T) {
+	t.Parallel()
+
+	minTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.FixedZone("", 2*60*60))
+	maxTime := minTime.Add(10 * time.Second)
+
+	t.Run("range", func(t *testing.T) {
+		t.Parallel()
+
+		entropy := random.NewSource(rand.New(rand.NewSource(1)))
+		seen := make(map[int64]bool)
+
+		for i := 0; i < 1000; i++ {
+			result, err := sampleDate(entropy, minTime, maxTime, "")
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if result.Before(minTime) || result.After(maxTime) {
+				t.Fatalf("expected a time between %s and %s, got: %s", minTime, maxTime, result)
+			}
+
+			if _, offset := result.Zone(); offset != 2*60*60 {
+				t.Fatalf("expected the time zone offset of min, got: %s", result)
+			}
+
+			seen[result.Unix()] = true
+		}
+
+		if len(seen) != 11 {
+			t.Errorf("expected every second between min and max inclusive, got %d distinct times", len(seen))
+		}
+	})
+
+	t.Run("seeded", func(t *testing.T) {
+		t.Parallel()
+
+		first, err := sampleDate(nil, minTime, maxTime.Add(365*24*time.Hour), "seed")
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		for i := 0; i < 10; i++ {
+			got, err := sampleDate(nil, minTime, maxTime.Add(365*24*time.Hour), "seed")
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !got.Equal(first) {
+				t.Fatalf("expected %s for the same seed, got: %s", first, got)
+			}
+		}
+	})
+}
+
+func TestFormatDate(t *testing.T) {
+	t.Parallel()
+
+	date := time.Date(2024, 3, 15, 9, 26, 53, 0, time.UTC)
+
+	testCases := map[string]string{
+		dateFormatRFC3339: "2024-03-15T09:26:53Z",
+		dateFormatDate:    "2024-03-15",
+		dateFormatUnix:    "1710494813",
+	}
+
+	for format, expected := range testCases {
+		t.Run(format, func(t *testing.T) {
+			t.Parallel()
+
+			if got := formatDate(date, format); got != expected {
+				t.Errorf("expected %q, got: %q", expected, got)
+			}
+		})
+	}
+}
+
+func TestAccResourceDate(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_date" "test" {
+							min = "2024-03-15T09:26:53+01:00"
+							max = "2024-03-15T09:26:53.5+01:00"
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_date.test", tfjsonpath.New("format"), knownvalue.StringExact("rfc3339")),
+					statecheck.ExpectKnownValue("random_date.test", tfjsonpath.New("result"), knownvalue.StringExact("2024-03-15T09:26:53+01:00")),
+					statecheck.ExpectKnownValue("random_date.test", tfjsonpath.New("unix"), knownvalue.Int64Exact(1710491213)),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceDate_Format(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_date" "test" {
+							min    = "2024-03-15T09:26:53Z"
+							max    = "2024-03-15T09:26:53Z"
+							format = "date"
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_date.test", tfjsonpath.New("result"), knownvalue.StringExact("2024-03-15")),
+				},
+			},
+			{
+				Config: `resource "random_date" "test" {
+							min    = "2024-03-15T09:26:53Z"
+							max    = "2024-03-15T09:26:53Z"
+							format = "unix"
+						}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("random_date.test", plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue("random_date.test", tfjsonpath.New("result"), knownvalue.StringExact("1710494813")),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_date.test", tfjsonpath.New("result"), knownvalue.StringExact("1710494813")),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceDate_Seed(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_date" "test" {
+							min  = "2024-01-01T00:00:00Z"
+							max  = "2024-12-31T23:59:59Z"
+							seed = "seed"
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_date.test", tfjsonpath.New("result"), knownvalue.StringRegexp(regexp.MustCompile(`^2024-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}Z$`))),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceDate_Validation(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_date" "test" {
+							min = "2024-01-02T00:00:00Z"
+							max = "2024-01-01T00:00:00Z"
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute min must not be later than max`),
+			},
+			{
+				Config: `resource "random_date" "test" {
+							min = "2024-01-01"
+							max = "2024-01-02T00:00:00Z"
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute min value must be an RFC3339 timestamp`),
+			},
+			{
+				Config: `resource "random_date" "test" {
+							min    = "2024-01-01T00:00:00Z"
+							max    = "2024-01-02T00:00:00Z"
+							format = "iso"
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute format value must be one of`),
+			},
+		},
+	})
+}
@@ -0,0 +1,362 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/crypto/hkdf"
+	"golang.org/x/crypto/pbkdf2"
+
+	mapplanmodifiers "github.com/terraform-providers/terraform-provider-random/internal/planmodifiers/map"
+	"github.com/terraform-providers/terraform-provider-random/internal/random"
+)
+
+var (
+	_ resource.Resource                   = (*derivedKeyResource)(nil)
+	_ resource.ResourceWithConfigure      = (*derivedKeyResource)(nil)
+	_ resource.ResourceWithModifyPlan     = (*derivedKeyResource)(nil)
+	_ resource.ResourceWithValidateConfig = (*derivedKeyResource)(nil)
+)
+
+// The key derivation functions supported by random_derived_key, both of
+// which use SHA-256.
+const (
+	derivedKeyAlgorithmHKDF   = "hkdf"
+	derivedKeyAlgorithmPBKDF2 = "pbkdf2"
+)
+
+// derivedKeyDefaultLength is the default length of a derived key in bytes,
+// which is the output size of SHA-256.
+const derivedKeyDefaultLength = sha256.Size
+
+func NewDerivedKeyResource() resource.Resource {
+	return &derivedKeyResource{}
+}
+
+type derivedKeyResource struct {
+	providerVersion string
+}
+
+func (r *derivedKeyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_derived_key"
+}
+
+func (r *derivedKeyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = derivedKeySchemaV0()
+}
+
+func (r *derivedKeyResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	r.providerVersion = providerVersion(req.ProviderData)
+}
+
+// ModifyPlan prevents the replacement of the resource when lifecycle_guard is
+// enabled, unless allow_regeneration_token is changed.
+func (r *derivedKeyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	guardRegeneration(ctx, req, resp)
+}
+
+func (r *derivedKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan derivedKeyModelV0
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The configuration may have contained unknown values during validation.
+	resp.Diagnostics.Append(plan.validate()...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	done := logGeneration(ctx, randomSourceDerived, map[string]any{
+		"algorithm": plan.Algorithm.ValueString() + "-sha256",
+		"length":    plan.Length.ValueInt64(),
+	})
+
+	key, err := deriveKey(plan)
+	done()
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Create Random Derived Key Error",
+			"While attempting to derive a key from the secret, an error occurred.\n\n"+
+				fmt.Sprintf("Original Error: %s", err),
+		)
+		return
+	}
+
+	plan.Base64 = types.StringValue(base64.StdEncoding.EncodeToString(key))
+	plan.Hex = types.StringValue(hex.EncodeToString(key))
+	plan.CreatedAt, plan.ProviderVersion = lifecycleValues(r.providerVersion)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read does not need to perform any operations as the state in ReadResourceResponse is already populated.
+func (r *derivedKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+}
+
+// Update ensures the plan value is copied to the state to complete the update.
+func (r *derivedKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var model derivedKeyModelV0
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+// Delete does not need to explicitly call resp.State.RemoveResource() as this is automatically handled by the
+// [framework](https://github.com/hashicorp/terraform-plugin-framework/pull/301).
+func (r *derivedKeyResource) Delete(context.Context, resource.DeleteRequest, *resource.DeleteResponse) {
+}
+
+// ValidateConfig ensures that the attributes configured are those used by the algorithm, so that settings which
+// would be silently ignored are reported.
+func (r *derivedKeyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config derivedKeyModelV0
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The algorithm defaults to HKDF when not configured.
+	if config.Algorithm.IsNull() {
+		config.Algorithm = types.StringValue(derivedKeyAlgorithmHKDF)
+	}
+
+	resp.Diagnostics.Append(config.validate()...)
+}
+
+// validate returns an error diagnostic if an attribute is configured which is
+// not used by the algorithm, if the salt required by PBKDF2 is not
+// configured, or if the length exceeds the maximum output of HKDF. Unknown
+// values are not validated.
+func (m derivedKeyModelV0) validate() diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	switch m.Algorithm.ValueString() {
+	case derivedKeyAlgorithmHKDF:
+		if !m.Iterations.IsNull() {
+			diags.AddAttributeError(
+				path.Root("iterations"),
+				"Invalid Attribute Combination",
+				"Attribute iterations can only be configured when algorithm is \"pbkdf2\".",
+			)
+		}
+
+		if !m.Length.IsUnknown() && m.Length.ValueInt64() > random.MaxDerivedBytes {
+			diags.AddAttributeError(
+				path.Root("length"),
+				"Invalid Attribute Value",
+				fmt.Sprintf("Attribute length value must be at most %d when algorithm is \"hkdf\", got: %d",
+					random.MaxDerivedBytes, m.Length.ValueInt64()),
+			)
+		}
+	case derivedKeyAlgorithmPBKDF2:
+		if !m.Info.IsNull() {
+			diags.AddAttributeError(
+				path.Root("info"),
+				"Invalid Attribute Combination",
+				"Attribute info can only be configured when algorithm is \"hkdf\".",
+			)
+		}
+
+		if m.Salt.IsNull() {
+			diags.AddAttributeError(
+				path.Root("salt"),
+				"Missing Attribute Configuration",
+				"Attribute salt must be configured when algorithm is \"pbkdf2\".",
+			)
+		}
+	}
+
+	return diags
+}
+
+// deriveKey returns the key derived from the secret of the model using the
+// algorithm of the model. The same key is always derived for the same
+// attribute values.
+func deriveKey(m derivedKeyModelV0) ([]byte, error) {
+	secret := []byte(m.Secret.ValueString())
+	salt := []byte(m.Salt.ValueString())
+	length := m.Length.ValueInt64()
+
+	switch algorithm := m.Algorithm.ValueString(); algorithm {
+	case derivedKeyAlgorithmHKDF:
+		key := make([]byte, length)
+
+		if _, err := io.ReadFull(hkdf.New(sha256.New, secret, salt, []byte(m.Info.ValueString())), key); err != nil {
+			return nil, err
+		}
+
+		return key, nil
+	case derivedKeyAlgorithmPBKDF2:
+		iterations := int64(pbkdf2Iterations)
+
+		if !m.Iterations.IsNull() {
+			iterations = m.Iterations.ValueInt64()
+		}
+
+		release := acquireHashWorker()
+		defer release()
+
+		return pbkdf2.Key(secret, salt, int(iterations), int(length), sha256.New), nil
+	default:
+		return nil, fmt.Errorf("unsupported algorithm: %q", algorithm)
+	}
+}
+
+func derivedKeySchemaV0() schema.Schema {
+	return schema.Schema{
+		Description: "The resource `random_derived_key` derives key material from an input secret using a " +
+			"key derivation function, either HKDF or PBKDF2 with HMAC-SHA-256. The same key is always " +
+			"derived from the same secret and attributes, so the key is only as strong as the secret. Use " +
+			"this to derive separate keys for different purposes from a single secret, such as one " +
+			"generated by `random_bytes`.\n" +
+			"\n" +
+			"This resource does not generate random values.",
+		Attributes: map[string]schema.Attribute{
+			"keepers": schema.MapAttribute{
+				Description: "Arbitrary map of values that, when changed, will trigger recreation of " +
+					"resource. See [the main provider documentation](../index.html) for more information.",
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifiers.RequiresReplaceIfValuesNotNull(),
+				},
+			},
+			"secret": schema.StringAttribute{
+				Description: "The input secret from which the key is derived.",
+				Required:    true,
+				Sensitive:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"algorithm": schema.StringAttribute{
+				Description: "The key derivation function, which is one of `hkdf` (HKDF with SHA-256, as " +
+					"defined by RFC 5869), suitable for secrets with high entropy such as random bytes, or " +
+					"`pbkdf2` (PBKDF2 with HMAC-SHA-256, as defined by RFC 8018), suitable for secrets with " +
+					"low entropy such as passwords. Default value is `hkdf`.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(derivedKeyAlgorithmHKDF),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(derivedKeyAlgorithmHKDF, derivedKeyAlgorithmPBKDF2),
+				},
+			},
+			"salt": schema.StringAttribute{
+				Description: "The salt, which should be unique to the secret. Optional for `hkdf`, and " +
+					"required for `pbkdf2`, in which case it should be at least 16 characters long.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"info": schema.StringAttribute{
+				Description: "Context and application specific information, such as the purpose of the key, " +
+					"so that different keys are derived from the same secret for different purposes. Only " +
+					"used by `hkdf`.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"iterations": schema.Int64Attribute{
+				Description: "The number of iterations. Only used by `pbkdf2`. Defaults to `600000`, which " +
+					"follows the OWASP recommendation for PBKDF2-HMAC-SHA256. The minimum value is 1.",
+				Optional: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"length": schema.Int64Attribute{
+				Description: "The number of bytes of key material to derive. The maximum value for `hkdf` is " +
+					"8160. The minimum value is 1. Default value is `32`.",
+				Optional: true,
+				Computed: true,
+				Default:  int64default.StaticInt64(derivedKeyDefaultLength),
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"keepers_hash":             keepersHashAttribute(),
+			"created_at":               createdAtAttribute(),
+			"provider_version":         providerVersionAttribute(),
+			"lifecycle_guard":          lifecycleGuardAttribute(),
+			"allow_regeneration_token": allowRegenerationTokenAttribute(),
+			"base64": schema.StringAttribute{
+				Description: "The derived key presented in base64 string format.",
+				Computed:    true,
+				Sensitive:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"hex": schema.StringAttribute{
+				Description: "The derived key presented in lowercase hexadecimal string format. The length " +
+					"of the encoded string is exactly twice the `length` parameter.",
+				Computed:  true,
+				Sensitive: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+type derivedKeyModelV0 struct {
+	Keepers                types.Map    `tfsdk:"keepers"`
+	KeepersHash            types.String `tfsdk:"keepers_hash"`
+	Secret                 types.String `tfsdk:"secret"`
+	Algorithm              types.String `tfsdk:"algorithm"`
+	Salt                   types.String `tfsdk:"salt"`
+	Info                   types.String `tfsdk:"info"`
+	Iterations             types.Int64  `tfsdk:"iterations"`
+	Length                 types.Int64  `tfsdk:"length"`
+	Base64                 types.String `tfsdk:"base64"`
+	Hex                    types.String `tfsdk:"hex"`
+	CreatedAt              types.String `tfsdk:"created_at"`
+	ProviderVersion        types.String `tfsdk:"provider_version"`
+	LifecycleGuard         types.Bool   `tfsdk:"lifecycle_guard"`
+	AllowRegenerationToken types.String `tfsdk:"allow_regeneration_token"`
+}
@@ -0,0 +1,167 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/hex"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/compare"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+// TestDeriveKey verifies the derived keys against the test vectors of RFC 5869
+// for HKDF, and RFC 7914 for PBKDF2-HMAC-SHA256.
+func TestDeriveKey(t *testing.T) {
+	t.Parallel()
+
+	mustDecode := func(s string) string {
+		b, err := hex.DecodeString(s)
+		if err != nil {
+			t.Fatalf("unexpected error decoding test vector: %s", err)
+		}
+
+		return string(b)
+	}
+
+	testCases := map[string]struct {
+		model    derivedKeyModelV0
+		expected string
+	}{
+		"hkdf": {
+			model: derivedKeyModelV0{
+				Algorithm: types.StringValue(derivedKeyAlgorithmHKDF),
+				Secret:    types.StringValue(strings.Repeat("\x0b", 22)),
+				Salt:      types.StringValue(mustDecode("000102030405060708090a0b0c")),
+				Info:      types.StringValue(mustDecode("f0f1f2f3f4f5f6f7f8f9")),
+				Length:    types.Int64Value(42),
+			},
+			expected: "3cb25f25faacd57a90434f64d0362f2a2d2d0a90cf1a5a4c5db02d56ecc4c5bf34007208d5b887185865",
+		},
+		"hkdf-no-salt-no-info": {
+			model: derivedKeyModelV0{
+				Algorithm: types.StringValue(derivedKeyAlgorithmHKDF),
+				Secret:    types.StringValue(strings.Repeat("\x0b", 22)),
+				Salt:      types.StringNull(),
+				Info:      types.StringNull(),
+				Length:    types.Int64Value(42),
+			},
+			expected: "8da4e775a563c18f715f802a063c5a31b8a11f5c5ee1879ec3454e5f3c738d2d9d201395faa4b61a96c8",
+		},
+		"pbkdf2": {
+			model: derivedKeyModelV0{
+				Algorithm:  types.StringValue(derivedKeyAlgorithmPBKDF2),
+				Secret:     types.StringValue("passwd"),
+				Salt:       types.StringValue("salt"),
+				Iterations: types.Int64Value(1),
+				Length:     types.Int64Value(64),
+			},
+			expected: "55ac046e56e3089fec1691c22544b605f94185216dde0465e68b9d57c20dacbc49ca9cccf179b645991664b39d77ef317c71b845b1e30bd509112041d3a19783",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			key, err := deriveKey(testCase.model)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got := hex.EncodeToString(key); got != testCase.expected {
+				t.Errorf("expected key %s, got: %s", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestAccResourceDerivedKey(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_derived_key" "encryption" {
+							secret = "input secret"
+							info   = "encryption"
+						}
+
+						resource "random_derived_key" "signing" {
+							secret = "input secret"
+							info   = "signing"
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_derived_key.encryption", tfjsonpath.New("hex"), knownvalue.StringRegexp(regexp.MustCompile(`^[0-9a-f]{64}$`))),
+					statecheck.ExpectKnownValue("random_derived_key.encryption", tfjsonpath.New("algorithm"), knownvalue.StringExact("hkdf")),
+					statecheck.ExpectKnownValue("random_derived_key.encryption", tfjsonpath.New("length"), knownvalue.Int64Exact(32)),
+					statecheck.CompareValuePairs("random_derived_key.encryption", tfjsonpath.New("hex"), "random_derived_key.signing", tfjsonpath.New("hex"), compare.ValuesDiffer()),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceDerivedKey_PBKDF2(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_derived_key" "test" {
+							algorithm  = "pbkdf2"
+							secret     = "passwd"
+							salt       = "salt"
+							iterations = 1
+							length     = 64
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_derived_key.test", tfjsonpath.New("hex"), knownvalue.StringExact("55ac046e56e3089fec1691c22544b605f94185216dde0465e68b9d57c20dacbc49ca9cccf179b645991664b39d77ef317c71b845b1e30bd509112041d3a19783")),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceDerivedKey_Invalid(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_derived_key" "test" {
+							algorithm = "pbkdf2"
+							secret    = "passwd"
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute salt must be configured when algorithm is "pbkdf2"`),
+			},
+			{
+				Config: `resource "random_derived_key" "test" {
+							algorithm = "pbkdf2"
+							secret    = "passwd"
+							salt      = "salt"
+							info      = "encryption"
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute info can only be configured when algorithm is "hkdf"`),
+			},
+			{
+				Config: `resource "random_derived_key" "test" {
+							secret     = "input secret"
+							iterations = 1000
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute iterations can only be configured when algorithm is "pbkdf2"`),
+			},
+			{
+				Config: `resource "random_derived_key" "test" {
+							secret = "input secret"
+							length = 8161
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute length value must be at most 8160 when algorithm is "hkdf"`),
+			},
+		},
+	})
+}
@@ -0,0 +1,291 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/terraform-providers/terraform-provider-random/internal/diagnostics"
+	mapplanmodifiers "github.com/terraform-providers/terraform-provider-random/internal/planmodifiers/map"
+	"github.com/terraform-providers/terraform-provider-random/internal/random"
+)
+
+var (
+	_ resource.Resource                = (*hexResource)(nil)
+	_ resource.ResourceWithConfigure   = (*hexResource)(nil)
+	_ resource.ResourceWithIdentity    = (*hexResource)(nil)
+	_ resource.ResourceWithImportState = (*hexResource)(nil)
+	_ resource.ResourceWithModifyPlan  = (*hexResource)(nil)
+)
+
+func NewHexResource() resource.Resource {
+	return &hexResource{}
+}
+
+type hexResource struct {
+	providerVersion string
+	entropy         *random.Source
+}
+
+func (r *hexResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_hex"
+}
+
+func (r *hexResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = hexSchemaV0()
+}
+
+func (r *hexResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identitySchema("The generated hexadecimal string.")
+}
+
+func (r *hexResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	r.providerVersion = providerVersion(req.ProviderData)
+	r.entropy = providerEntropy(req.ProviderData)
+}
+
+// ModifyPlan prevents the replacement of the resource when lifecycle_guard is
+// enabled, unless allow_regeneration_token is changed.
+func (r *hexResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	guardRegeneration(ctx, req, resp)
+}
+
+func (r *hexResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan hexModelV0
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	length := plan.Length.ValueInt64()
+
+	// Each byte is encoded as two hexadecimal characters, so an extra byte is
+	// generated for odd lengths and the final character discarded.
+	done := logGeneration(ctx, randomSourceCrypto, map[string]any{
+		"length": length,
+	})
+
+	bytes, err := r.entropy.CreateBytes((length + 1) / 2)
+	done()
+
+	if errors.Is(err, random.ErrEntropyUnavailable) {
+		resp.Diagnostics.Append(diagnostics.EntropyUnavailableError(err)...)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Create Random Hex Error",
+			"There was an error during random generation.\n\n"+
+				diagnostics.RetryMsg+
+				fmt.Sprintf("Original Error: %s", err),
+		)
+		return
+	}
+
+	result := hex.EncodeToString(bytes)[:length]
+
+	if plan.Upper.ValueBool() {
+		result = strings.ToUpper(result)
+	}
+
+	h := &hexModelV0{
+		ID:                     types.StringValue(result),
+		Keepers:                plan.Keepers,
+		KeepersHash:            plan.KeepersHash,
+		Length:                 plan.Length,
+		Upper:                  plan.Upper,
+		Result:                 types.StringValue(result),
+		LifecycleGuard:         plan.LifecycleGuard,
+		AllowRegenerationToken: plan.AllowRegenerationToken,
+	}
+
+	h.CreatedAt, h.ProviderVersion = lifecycleValues(r.providerVersion)
+
+	diags = resp.State.Set(ctx, h)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, h.ID)...)
+}
+
+// Read does not need to perform any operations as the state in ReadResourceResponse is already populated.
+func (r *hexResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+}
+
+// Update ensures the plan value is copied to the state to complete the update.
+func (r *hexResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var model hexModelV0
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+// Delete does not need to explicitly call resp.State.RemoveResource() as this is automatically handled by the
+// [framework](https://github.com/hashicorp/terraform-plugin-framework/pull/301).
+func (r *hexResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
+
+func (r *hexResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, diags := importID(ctx, req)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if id == "" {
+		resp.Diagnostics.AddError(
+			"Import Random Hex Error",
+			"The value supplied is empty. Supply the hexadecimal string to import.",
+		)
+		return
+	}
+
+	lower := strings.ToLower(id)
+	upper := strings.ToUpper(id)
+
+	if id != lower && id != upper {
+		resp.Diagnostics.AddError(
+			"Import Random Hex Error",
+			"The value supplied contains both uppercase and lowercase hexadecimal characters. "+
+				"Supply a value which is entirely lowercase or entirely uppercase.",
+		)
+		return
+	}
+
+	// Decoding requires an even number of characters, so odd length values
+	// are padded for validation only.
+	if _, err := hex.DecodeString(lower + strings.Repeat("0", len(lower)%2)); err != nil {
+		resp.Diagnostics.AddError(
+			"Import Random Hex Error",
+			"The value supplied could not be parsed as a hexadecimal string.\n\n"+
+				fmt.Sprintf("Original Error: %s", err),
+		)
+		return
+	}
+
+	logImport(ctx, map[string]any{
+		"length": len(id),
+	})
+
+	var state hexModelV0
+
+	state.ID = types.StringValue(id)
+	state.Keepers = types.MapNull(types.StringType)
+	state.KeepersHash = keepersHash(state.Keepers)
+	state.Length = types.Int64Value(int64(len(id)))
+	// A value containing only digits is assumed to have been generated with
+	// the default of upper set to false.
+	state.Upper = types.BoolValue(id != lower)
+	state.Result = types.StringValue(id)
+	state.CreatedAt = types.StringNull()
+	state.ProviderVersion = types.StringNull()
+	state.LifecycleGuard = types.BoolNull()
+	state.AllowRegenerationToken = types.StringNull()
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, state.ID)...)
+}
+
+func hexSchemaV0() schema.Schema {
+	return schema.Schema{
+		Description: "The resource `random_hex` generates a random hexadecimal string of the requested " +
+			"number of characters, which is intended to be used as a token or salt. Use this in preference " +
+			"to `random_id` when the length of the string, rather than the number of bytes, is known. Use " +
+			"`random_bytes` when the output is considered sensitive, and should not be displayed in the CLI.\n" +
+			"\n" +
+			"This resource *does* use a cryptographic random number generator.",
+		Attributes: map[string]schema.Attribute{
+			"keepers": schema.MapAttribute{
+				Description: "Arbitrary map of values that, when changed, will trigger recreation of " +
+					"resource. See [the main provider documentation](../index.html) for more information.",
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifiers.RequiresReplaceIfValuesNotNull(),
+				},
+			},
+			"length": schema.Int64Attribute{
+				Description: "The number of hexadecimal characters to generate. The minimum value is 1, " +
+					"which produces four bits of randomness.",
+				Required: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"upper": schema.BoolAttribute{
+				Description: "Use uppercase hexadecimal characters (`A-F`) in the result. Default value is `false`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"result": schema.StringAttribute{
+				Description: "The generated hexadecimal string.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"keepers_hash":             keepersHashAttribute(),
+			"created_at":               createdAtAttribute(),
+			"provider_version":         providerVersionAttribute(),
+			"lifecycle_guard":          lifecycleGuardAttribute(),
+			"allow_regeneration_token": allowRegenerationTokenAttribute(),
+			"id": schema.StringAttribute{
+				Description: "The generated hexadecimal string.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+type hexModelV0 struct {
+	ID                     types.String `tfsdk:"id"`
+	Keepers                types.Map    `tfsdk:"keepers"`
+	KeepersHash            types.String `tfsdk:"keepers_hash"`
+	Length                 types.Int64  `tfsdk:"length"`
+	Upper                  types.Bool   `tfsdk:"upper"`
+	Result                 types.String `tfsdk:"result"`
+	CreatedAt              types.String `tfsdk:"created_at"`
+	ProviderVersion        types.String `tfsdk:"provider_version"`
+	LifecycleGuard         types.Bool   `tfsdk:"lifecycle_guard"`
+	AllowRegenerationToken types.String `tfsdk:"allow_regeneration_token"`
+}
@@ -0,0 +1,279 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/compare"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccResourceHex(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_hex" "test" {
+							length = 32
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_hex.test", tfjsonpath.New("result"), knownvalue.StringRegexp(regexp.MustCompile(`^[0-9a-f]{32}$`))),
+					statecheck.ExpectKnownValue("random_hex.test", tfjsonpath.New("upper"), knownvalue.Bool(false)),
+					statecheck.CompareValuePairs("random_hex.test", tfjsonpath.New("id"), "random_hex.test", tfjsonpath.New("result"), compare.ValuesSame()),
+				},
+			},
+			{
+				ResourceName:            "random_hex.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"created_at", "provider_version"},
+			},
+		},
+	})
+}
+
+func TestAccResourceHex_OddLength(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_hex" "test" {
+							length = 7
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_hex.test", tfjsonpath.New("result"), knownvalue.StringRegexp(regexp.MustCompile(`^[0-9a-f]{7}$`))),
+				},
+			},
+			{
+				ResourceName:            "random_hex.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"created_at", "provider_version"},
+			},
+		},
+	})
+}
+
+func TestAccResourceHex_Upper(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_hex" "test" {
+							length = 64
+							upper  = true
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_hex.test", tfjsonpath.New("result"), knownvalue.StringRegexp(regexp.MustCompile(`^[0-9A-F]{64}$`))),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceHex_Upper_Replace(t *testing.T) {
+	// The result attribute values should differ between test steps
+	assertResultDiffer := statecheck.CompareValue(compare.ValuesDiffer())
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_hex" "test" {
+							length = 16
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					assertResultDiffer.AddStateValue("random_hex.test", tfjsonpath.New("result")),
+				},
+			},
+			{
+				Config: `resource "random_hex" "test" {
+							length = 16
+							upper  = true
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					assertResultDiffer.AddStateValue("random_hex.test", tfjsonpath.New("result")),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceHex_Keepers_Replace_ValueToNewValue(t *testing.T) {
+	// The result attribute values should differ between test steps
+	assertResultDiffer := statecheck.CompareValue(compare.ValuesDiffer())
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_hex" "test" {
+							length = 16
+							keepers = {
+								"key" = "123"
+							}
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					assertResultDiffer.AddStateValue("random_hex.test", tfjsonpath.New("result")),
+				},
+			},
+			{
+				Config: `resource "random_hex" "test" {
+							length = 16
+							keepers = {
+								"key" = "456"
+							}
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					assertResultDiffer.AddStateValue("random_hex.test", tfjsonpath.New("result")),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceHex_Keepers_Keep_Value(t *testing.T) {
+	// The result attribute values should be the same between test steps
+	assertResultSame := statecheck.CompareValue(compare.ValuesSame())
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_hex" "test" {
+							length = 16
+							keepers = {
+								"key" = "123"
+							}
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					assertResultSame.AddStateValue("random_hex.test", tfjsonpath.New("result")),
+				},
+			},
+			{
+				Config: `resource "random_hex" "test" {
+							length = 16
+							keepers = {
+								"key" = "123"
+							}
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					assertResultSame.AddStateValue("random_hex.test", tfjsonpath.New("result")),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceHex_ImportUpperProducesNoPlannedChanges(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_hex" "test" {
+							length = 8
+							upper  = true
+						}`,
+				ResourceName:       "random_hex.test",
+				ImportStateId:      "6A1F3C9E",
+				ImportState:        true,
+				ImportStatePersist: true,
+			},
+			{
+				Config: `resource "random_hex" "test" {
+							length = 8
+							upper  = true
+						}`,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccResourceHex_Import_Invalid(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_hex" "test" {
+							length = 8
+						}`,
+				ResourceName:  "random_hex.test",
+				ImportStateId: "6a1f3c9g",
+				ImportState:   true,
+				ExpectError:   regexp.MustCompile(`could not be parsed as a hexadecimal string`),
+			},
+			{
+				Config: `resource "random_hex" "test" {
+							length = 8
+						}`,
+				ResourceName:  "random_hex.test",
+				ImportStateId: "6a1F3c9e",
+				ImportState:   true,
+				ExpectError:   regexp.MustCompile(`both uppercase and lowercase`),
+			},
+		},
+	})
+}
+
+func TestAccResourceHex_Length_Invalid(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_hex" "test" {
+							length = 0
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute length value must be at least 1, got: 0`),
+			},
+		},
+	})
+}
+
+func TestAccResourceHex_Identity(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_hex" "test" {
+							length = 16
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentityValueMatchesState("random_hex.test", tfjsonpath.New("id")),
+				},
+			},
+			{
+				ResourceName:    "random_hex.test",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+		},
+	})
+}
+
+func TestAccResourceHex_LifecycleMetadata(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_hex" "test" {
+							length = 16
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_hex.test", tfjsonpath.New("created_at"), knownvalue.StringRegexp(regexp.MustCompile(`^\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}Z$`))),
+					statecheck.ExpectKnownValue("random_hex.test", tfjsonpath.New("provider_version"), knownvalue.StringExact("test")),
+				},
+			},
+		},
+	})
+}
@@ -5,41 +5,575 @@ package provider
 
 import (
 	"context"
-	"crypto/rand"
 	"encoding/base64"
 	"encoding/hex"
+	"errors"
 	"fmt"
+	"io"
 	"math/big"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/terraform-providers/terraform-provider-random/internal/diagnostics"
-	mapplanmodifiers "github.com/terraform-providers/terraform-provider-random/internal/planmodifiers/map"
+	listplanmodifiers "github.com/terraform-providers/terraform-provider-random/internal/planmodifiers/list"
+	"github.com/terraform-providers/terraform-provider-random/internal/random"
 )
 
 var (
-	_ resource.Resource                = (*idResource)(nil)
-	_ resource.ResourceWithImportState = (*idResource)(nil)
+	_ resource.Resource                   = (*idResource)(nil)
+	_ resource.ResourceWithConfigure      = (*idResource)(nil)
+	_ resource.ResourceWithIdentity       = (*idResource)(nil)
+	_ resource.ResourceWithImportState    = (*idResource)(nil)
+	_ resource.ResourceWithModifyPlan     = (*idResource)(nil)
+	_ resource.ResourceWithUpgradeState   = (*idResource)(nil)
+	_ resource.ResourceWithValidateConfig = (*idResource)(nil)
+)
+
+// idDeriveInfo is the HKDF info string used when deriving the bytes of a
+// random_id from its seed, so that the same seed used for other purposes
+// does not produce the same bytes.
+const idDeriveInfo = "terraform-provider-random random_id"
+
+const (
+	// idMaxByteLength is the maximum byte_length, which bounds the size of the
+	// dec and dec_str outputs.
+	idMaxByteLength = 65536
+
+	// idMaxCountOutputs is the maximum number of ids in hexs and b64s.
+	idMaxCountOutputs = 10000
+
+	// idMaxBytes is the maximum number of bytes of all of the ids, which bounds
+	// the memory used to generate them and the size of the state.
+	idMaxBytes = 1 << 20
 )
 
 func NewIdResource() resource.Resource {
 	return &idResource{}
 }
 
-type idResource struct{}
+type idResource struct {
+	providerVersion string
+	entropy         *random.Source
+}
 
 func (r *idResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_id"
 }
 
 func (r *idResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resp.Schema = schema.Schema{
+	resp.Schema = idSchemaV1()
+}
+
+func (r *idResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema()
+}
+
+func (r *idResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	r.providerVersion = providerVersion(req.ProviderData)
+	r.entropy = providerEntropy(req.ProviderData)
+}
+
+// ModifyPlan prevents the replacement of the resource when lifecycle_guard is
+// enabled, unless allow_regeneration_token is changed.
+func (r *idResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	guardRegeneration(ctx, req, resp)
+}
+
+func (r *idResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan idModelV1
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	count := int64(1)
+
+	if !plan.CountOutputs.IsNull() {
+		count = plan.CountOutputs.ValueInt64()
+	}
+
+	// The lengths are validated in the schema and ValidateConfig, but may not be
+	// known until apply.
+	resp.Diagnostics.Append(validateIDLength(plan.ByteLength.ValueInt64(), count, !plan.Seed.IsNull())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bytes, err := r.createBytes(ctx, plan, count)
+
+	if errors.Is(err, random.ErrEntropyUnavailable) {
+		resp.Diagnostics.Append(diagnostics.EntropyUnavailableError(err)...)
+		return
+	}
+	if errors.Is(err, io.ErrUnexpectedEOF) {
+		resp.Diagnostics.Append(diagnostics.RandomnessGenerationError(err.Error())...)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.RandomReadError(err.Error())...)
+		return
+	}
+
+	i := idModelV1{
+		Keepers:                plan.Keepers,
+		KeepersHash:            plan.KeepersHash,
+		KeepersMode:            plan.KeepersMode,
+		KeepersAtGeneration:    plan.Keepers,
+		ByteLength:             types.Int64Value(plan.ByteLength.ValueInt64()),
+		Prefix:                 plan.Prefix,
+		Suffix:                 plan.Suffix,
+		Separator:              plan.Separator,
+		HexChunkSize:           plan.HexChunkSize,
+		Seed:                   plan.Seed,
+		CountOutputs:           plan.CountOutputs,
+		LifecycleGuard:         plan.LifecycleGuard,
+		AllowRegenerationToken: plan.AllowRegenerationToken,
+	}
+
+	// The first id is used for the other outputs, so that configurations which
+	// do not set count_outputs are unaffected.
+	byteLength := plan.ByteLength.ValueInt64()
+
+	i.setOutputs(bytes[:byteLength])
+	i.Hexs = types.ListNull(types.StringType)
+	i.B64s = types.ListNull(types.StringType)
+
+	if !plan.CountOutputs.IsNull() {
+		outputs := make([][]byte, count)
+		for n := range outputs {
+			outputs[n] = bytes[int64(n)*byteLength : int64(n+1)*byteLength]
+		}
+
+		i.setOutputLists(outputs)
+	}
+
+	i.EntropyBits = i.entropyBits()
+
+	i.CreatedAt, i.ProviderVersion = lifecycleValues(r.providerVersion)
+
+	diags = resp.State.Set(ctx, i)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{ID: i.ID, Prefix: i.Prefix})...)
+}
+
+// createBytes returns the bytes of the given number of ids, which are derived
+// from the seed if one is configured, or read from the provider's source of
+// entropy otherwise. The bytes of each id follow those of the previous id, so
+// the first id is the same regardless of the number of ids.
+func (r *idResource) createBytes(ctx context.Context, plan idModelV1, count int64) ([]byte, error) {
+	length := plan.ByteLength.ValueInt64() * count
+
+	if seed := plan.Seed.ValueString(); seed != "" {
+		done := logGeneration(ctx, randomSourceDerived, map[string]any{
+			"algorithm":     "hkdf-sha256",
+			"byte_length":   plan.ByteLength.ValueInt64(),
+			"count_outputs": count,
+		})
+		defer done()
+
+		return random.DeriveBytes(seed, idDeriveInfo, length)
+	}
+
+	done := logGeneration(ctx, randomSourceCrypto, map[string]any{
+		"byte_length":   plan.ByteLength.ValueInt64(),
+		"count_outputs": count,
+	})
+	defer done()
+
+	return r.entropy.CreateBytes(length)
+}
+
+// ValidateConfig ensures that no more bytes are requested than can be generated, or derived from a seed, including
+// those of each of count_outputs, and that separator is only set alongside prefix or suffix.
+func (r *idResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config idModelV1
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.Separator.IsNull() && config.Prefix.IsNull() && config.Suffix.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("separator"),
+			"Invalid Attribute Combination",
+			"Attribute separator can only be set when prefix or suffix is set.",
+		)
+	}
+
+	if config.ByteLength.IsNull() || config.ByteLength.IsUnknown() {
+		return
+	}
+
+	// The total is only checked once count_outputs is known, including in Create.
+	count := int64(1)
+
+	if !config.CountOutputs.IsNull() && !config.CountOutputs.IsUnknown() {
+		count = config.CountOutputs.ValueInt64()
+	}
+
+	// Values outside of their bounds are reported by the schema validators.
+	byteLength := config.ByteLength.ValueInt64()
+	if byteLength < 1 || byteLength > idMaxByteLength || count < 1 || count > idMaxCountOutputs {
+		return
+	}
+
+	resp.Diagnostics.Append(validateIDLength(byteLength, count, !config.Seed.IsNull())...)
+}
+
+// validateIDLength ensures that byte_length and count_outputs are within their bounds, and that no more bytes are
+// requested for all of the ids than can be generated, or derived from a seed when seeded is true.
+func validateIDLength(byteLength, count int64, seeded bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if byteLength < 1 || byteLength > idMaxByteLength {
+		diags.AddAttributeError(
+			path.Root("byte_length"),
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute byte_length value must be between 1 and %d, got: %d", idMaxByteLength, byteLength),
+		)
+
+		return diags
+	}
+
+	if count < 1 || count > idMaxCountOutputs {
+		diags.AddAttributeError(
+			path.Root("count_outputs"),
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute count_outputs value must be between 1 and %d, got: %d", idMaxCountOutputs, count),
+		)
+
+		return diags
+	}
+
+	if !seeded {
+		if total := byteLength * count; total > idMaxBytes {
+			diags.AddAttributeError(
+				path.Root("count_outputs"),
+				"Invalid Attribute Value",
+				fmt.Sprintf("Attribute count_outputs multiplied by byte_length must be at most %d, got: %d",
+					idMaxBytes, total),
+			)
+		}
+
+		return diags
+	}
+
+	if byteLength > random.MaxDerivedBytes {
+		diags.AddAttributeError(
+			path.Root("byte_length"),
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute byte_length value must be at most %d when seed is set, got: %d",
+				random.MaxDerivedBytes, byteLength),
+		)
+
+		return diags
+	}
+
+	if total := byteLength * count; total > random.MaxDerivedBytes {
+		diags.AddAttributeError(
+			path.Root("count_outputs"),
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute count_outputs multiplied by byte_length must be at most %d when seed is set, "+
+				"got: %d", random.MaxDerivedBytes, total),
+		)
+	}
+
+	return diags
+}
+
+// Read does not need to refresh the state as the state in ReadResourceResponse is already populated, other than to
+// populate dec_str, entropy_bits, the checksums and keepers_hash for resources created by earlier provider versions.
+// The identity is set from state, as those resources also do not have an identity.
+func (r *idResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var model idModelV1
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if model.DecStr.IsNull() || model.EntropyBits.IsNull() || model.SHA256.IsNull() {
+		logRefresh(ctx, "dec_str", "entropy_bits", "crc32", "sha1", "sha256")
+
+		model.DecStr = idDecStrFromID(model.ID)
+		model.EntropyBits = model.entropyBits()
+		model.CRC32, model.SHA1, model.SHA256 = idChecksumsFromID(model.ID)
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(refreshKeepersHash(ctx, &resp.State)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{ID: model.ID, Prefix: model.Prefix})...)
+}
+
+// Update ensures the plan value is copied to the state to complete the update. The dec_str, entropy_bits and checksum
+// values are unknown in the plan if the state was not refreshed since upgrading from an earlier provider version.
+func (r *idResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var model idModelV1
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if model.DecStr.IsUnknown() {
+		model.DecStr = idDecStrFromID(model.ID)
+	}
+
+	if model.EntropyBits.IsUnknown() {
+		model.EntropyBits = model.entropyBits()
+	}
+
+	if model.SHA256.IsUnknown() {
+		model.CRC32, model.SHA1, model.SHA256 = idChecksumsFromID(model.ID)
+	}
+
+	// The hex_chunks value is unknown in the plan if hex_chunk_size was not
+	// known during plan.
+	if model.HexChunks.IsUnknown() {
+		model.HexChunks = hexChunks(idHexFromID(model.ID), model.HexChunkSize)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+// Delete does not need to explicitly call resp.State.RemoveResource() as this is automatically handled by the
+// [framework](https://github.com/hashicorp/terraform-plugin-framework/pull/301).
+func (r *idResource) Delete(context.Context, resource.DeleteRequest, *resource.DeleteResponse) {
+}
+
+func (r *idResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var (
+		prefix string
+		bytes  []byte
+		err    error
+	)
+
+	if req.ID == "" {
+		var identity idIdentityModel
+
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		prefix = identity.Prefix.ValueString()
+		bytes, err = decodeIDImportValue(identity.ID.ValueString())
+	} else {
+		prefix, bytes, err = parseIDImport(req.ID)
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Import Random ID Error",
+			fmt.Sprintf("While attempting to import a random id, the import id could not be decoded: %s.\n\n", err)+
+				idImportUsage,
+		)
+		return
+	}
+
+	logImport(ctx, map[string]any{
+		"byte_length": len(bytes),
+	})
+
+	var state idModelV1
+
+	state.ByteLength = types.Int64Value(int64(len(bytes)))
+	state.Keepers = types.MapNull(types.StringType)
+	state.KeepersHash = keepersHash(state.Keepers)
+	state.KeepersMode = types.StringNull()
+	state.KeepersAtGeneration = state.Keepers
+	state.Suffix = types.StringNull()
+	state.Separator = types.StringNull()
+	state.HexChunkSize = types.Int64Null()
+	state.Seed = types.StringNull()
+	state.CountOutputs = types.Int64Null()
+	state.Hexs = types.ListNull(types.StringType)
+	state.B64s = types.ListNull(types.StringType)
+	state.EntropyBits = state.entropyBits()
+	state.CreatedAt = types.StringNull()
+	state.ProviderVersion = types.StringNull()
+	state.LifecycleGuard = types.BoolNull()
+	state.AllowRegenerationToken = types.StringNull()
+
+	if prefix == "" {
+		state.Prefix = types.StringNull()
+	} else {
+		state.Prefix = types.StringValue(prefix)
+	}
+
+	state.setOutputs(bytes)
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, idIdentityModel{ID: state.ID, Prefix: state.Prefix})...)
+}
+
+// idDecimal returns the exact, non-padded decimal representation of the bytes
+// interpreted as a big-endian unsigned integer, which is not limited in size.
+func idDecimal(bytes []byte) string {
+	return new(big.Int).SetBytes(bytes).String()
+}
+
+// idDecStrFromID returns the dec_str value for the id, which is the unprefixed
+// base64 URL encoding of the generated bytes. The value is null if the id
+// cannot be decoded.
+func idDecStrFromID(id types.String) types.String {
+	if id.IsNull() || id.IsUnknown() {
+		return types.StringNull()
+	}
+
+	bytes, err := base64.RawURLEncoding.DecodeString(id.ValueString())
+	if err != nil {
+		return types.StringNull()
+	}
+
+	return types.StringValue(idDecimal(bytes))
+}
+
+// idChecksumsFromID returns the checksums of the bytes of the id, which are
+// null if the id cannot be decoded.
+func idChecksumsFromID(id types.String) (types.String, types.String, types.String) {
+	if id.IsNull() || id.IsUnknown() {
+		return types.StringNull(), types.StringNull(), types.StringNull()
+	}
+
+	bytes, err := base64.RawURLEncoding.DecodeString(id.ValueString())
+	if err != nil {
+		return types.StringNull(), types.StringNull(), types.StringNull()
+	}
+
+	return checksums(bytes)
+}
+
+// idHexFromID returns the unprefixed hexadecimal encoding of the bytes of the
+// id, which is unknown if the id cannot be decoded.
+func idHexFromID(id types.String) types.String {
+	if id.IsNull() || id.IsUnknown() {
+		return types.StringUnknown()
+	}
+
+	bytes, err := base64.RawURLEncoding.DecodeString(id.ValueString())
+	if err != nil {
+		return types.StringUnknown()
+	}
+
+	return types.StringValue(hex.EncodeToString(bytes))
+}
+
+// idHexFromState returns the unprefixed hexadecimal encoding of the bytes of
+// the id in state.
+func idHexFromState(ctx context.Context, state tfsdk.State) (types.String, diag.Diagnostics) {
+	var id types.String
+
+	diags := state.GetAttribute(ctx, path.Root("id"), &id)
+
+	return idHexFromID(id), diags
+}
+
+func (r *idResource) UpgradeState(context.Context) map[int64]resource.StateUpgrader {
+	schemaV0 := idSchemaV0()
+
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema:   &schemaV0,
+			StateUpgrader: upgradeIdStateV0toV1,
+		},
+	}
+}
+
+func upgradeIdStateV0toV1(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	logStateUpgrade(ctx, 0, 1)
+
+	type modelV0 struct {
+		ID         types.String `tfsdk:"id"`
+		Keepers    types.Map    `tfsdk:"keepers"`
+		ByteLength types.Int64  `tfsdk:"byte_length"`
+		Prefix     types.String `tfsdk:"prefix"`
+		B64URL     types.String `tfsdk:"b64_url"`
+		B64Std     types.String `tfsdk:"b64_std"`
+		Hex        types.String `tfsdk:"hex"`
+		Dec        types.String `tfsdk:"dec"`
+	}
+
+	var idDataV0 modelV0
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &idDataV0)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The creation time and provider version of resources created prior to
+	// schema version 1 are not known, so they are left as null.
+	idDataV1 := idModelV1{
+		ID:                     idDataV0.ID,
+		Keepers:                idDataV0.Keepers,
+		KeepersMode:            types.StringNull(),
+		KeepersAtGeneration:    idDataV0.Keepers,
+		ByteLength:             idDataV0.ByteLength,
+		Prefix:                 idDataV0.Prefix,
+		Suffix:                 types.StringNull(),
+		Separator:              types.StringNull(),
+		HexChunkSize:           types.Int64Null(),
+		HexChunks:              types.ListNull(types.StringType),
+		B64URL:                 idDataV0.B64URL,
+		B64Std:                 idDataV0.B64Std,
+		Hex:                    idDataV0.Hex,
+		Dec:                    idDataV0.Dec,
+		DecStr:                 idDecStrFromID(idDataV0.ID),
+		CRC32:                  types.StringNull(),
+		SHA1:                   types.StringNull(),
+		SHA256:                 types.StringNull(),
+		Seed:                   types.StringNull(),
+		CountOutputs:           types.Int64Null(),
+		Hexs:                   types.ListNull(types.StringType),
+		B64s:                   types.ListNull(types.StringType),
+		KeepersHash:            types.StringNull(),
+		CreatedAt:              types.StringNull(),
+		ProviderVersion:        types.StringNull(),
+		LifecycleGuard:         types.BoolNull(),
+		AllowRegenerationToken: types.StringNull(),
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, idDataV1)...)
+}
+
+func idSchemaV1() schema.Schema {
+	return schema.Schema{
+		Version: 1,
 		Description: `
 The resource ` + "`random_id`" + ` generates random numbers that are intended to be
 used as unique identifiers for other resources. If the output is considered 
@@ -49,7 +583,8 @@ instead.
 This resource *does* use a cryptographic random number generator in order
 to minimize the chance of collisions, making the results of this resource
 when a 16-byte identifier is requested of equivalent uniqueness to a
-type-4 UUID.
+type-4 UUID. When ` + "`seed`" + ` is set, the id is instead derived from the seed,
+and is *not* random.
 
 This resource can be used in conjunction with resources that have
 the ` + "`create_before_destroy`" + ` lifecycle flag set to avoid conflicts with
@@ -63,16 +598,24 @@ exist concurrently.
 				ElementType: types.StringType,
 				Optional:    true,
 				PlanModifiers: []planmodifier.Map{
-					mapplanmodifiers.RequiresReplaceIfValuesNotNull(),
+					keepersModePlanModifier(),
 				},
 			},
+			"keepers_mode":          keepersModeAttribute(),
+			"keepers_at_generation": keepersAtGenerationAttribute(),
 			"byte_length": schema.Int64Attribute{
-				Description: "The number of random bytes to produce. The minimum value is 1, which produces " +
-					"eight bits of randomness.",
+				Description: fmt.Sprintf("The number of random bytes to produce. The minimum value is 1, which "+
+					"produces eight bits of randomness, and the maximum value is %d. Larger values, such as 512 or "+
+					"1024, can be used to produce key material, noting that every output attribute is stored in "+
+					"state.", idMaxByteLength),
 				Required: true,
 				PlanModifiers: []planmodifier.Int64{
 					int64planmodifier.RequiresReplace(),
 				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+					int64validator.AtMost(idMaxByteLength),
+				},
 			},
 			"prefix": schema.StringAttribute{
 				Description: "Arbitrary string to prefix the output value with. This string is supplied as-is, " +
@@ -82,6 +625,40 @@ exist concurrently.
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"suffix": schema.StringAttribute{
+				Description: "Arbitrary string to suffix the output value with. As with `prefix`, this string is " +
+					"supplied as-is, and is applied to `b64_url`, `b64_std`, `hex` and `dec`.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"separator": schema.StringAttribute{
+				Description: "Arbitrary string placed between `prefix` and the output value, and between the " +
+					"output value and `suffix`, such as `-` to produce `app-1a2b3c4d-prod`. Only applied " +
+					"alongside a configured `prefix` or `suffix`, so `prefix` or `suffix` must be set.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"seed": schema.StringAttribute{
+				Description: "Arbitrary string from which to derive the bytes of the id using HKDF-SHA256, " +
+					"instead of generating them randomly, in order to produce the same id every time the " +
+					"resource is created with the same seed and `byte_length`. Use this to produce stable " +
+					"identifiers derived from configuration for idempotent naming.\n" +
+					"\n" +
+					"**Important:** When set, the id is *not* random, and is only as difficult to guess as " +
+					"the seed. Do not use a seed for ids which must be unpredictable. The `entropy_bits` is " +
+					"`0`, and `byte_length` must be at most 8160.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
 			"b64_url": schema.StringAttribute{
 				Description: "The generated id presented in base64, using the URL-friendly character set: " +
 					"case-sensitive letters, digits and the characters `_` and `-`.",
@@ -91,8 +668,9 @@ exist concurrently.
 				},
 			},
 			"b64_std": schema.StringAttribute{
-				Description: "The generated id presented in base64 without additional transformations.",
-				Computed:    true,
+				Description: "The generated id presented in base64 without additional transformations. The " +
+					"value is not wrapped, so it is a single line regardless of the requested byte length.",
+				Computed: true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
@@ -105,6 +683,8 @@ exist concurrently.
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"hex_chunk_size": hexChunkSizeAttribute(),
+			"hex_chunks":     hexChunksAttribute(false, idHexFromState),
 			"dec": schema.StringAttribute{
 				Description: "The generated id presented in non-padded decimal digits.",
 				Computed:    true,
@@ -112,6 +692,57 @@ exist concurrently.
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"dec_str": schema.StringAttribute{
+				Description: "The generated id presented in non-padded decimal digits, without the prefix or suffix. The " +
+					"value is exact for any byte length, so it should be used in preference to converting " +
+					"`dec` to a number, which may lose precision when `byte_length` is greater than 8.",
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"crc32":  checksumAttribute("CRC-32 (IEEE)", "the generated bytes, excluding the prefix and suffix"),
+			"sha1":   checksumAttribute("SHA-1", "the generated bytes, excluding the prefix and suffix"),
+			"sha256": checksumAttribute("SHA-256", "the generated bytes, excluding the prefix and suffix"),
+			"count_outputs": schema.Int64Attribute{
+				Description: fmt.Sprintf("The number of independent ids to generate in `hexs` and `b64s`, between "+
+					"1 and %d, each following the same arguments, such as to name many resources from a single "+
+					"resource rather than using `count`. When set, the other outputs are those of the first id. "+
+					"`count_outputs` multiplied by `byte_length` must be at most %d.", idMaxCountOutputs, idMaxBytes),
+				Optional: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+					int64validator.AtMost(idMaxCountOutputs),
+				},
+			},
+			"hexs": schema.ListAttribute{
+				Description: "The generated ids presented in the same format as `hex`, with the number of " +
+					"elements given by `count_outputs`. The order of the elements does not change once generated. " +
+					"This value is `null` when `count_outputs` is not set.",
+				ElementType: types.StringType,
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifiers.UseStateForUnknownIncludingNull(),
+				},
+			},
+			"b64s": schema.ListAttribute{
+				Description: "The generated ids presented in the same format as `b64_url`, in the same order as " +
+					"`hexs`. This value is `null` when `count_outputs` is not set.",
+				ElementType: types.StringType,
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifiers.UseStateForUnknownIncludingNull(),
+				},
+			},
+			"entropy_bits":             entropyBitsAttribute("The entropy of the id in bits, which is eight times `byte_length`, or `0` when `seed` is set."),
+			"keepers_hash":             keepersHashAttribute(),
+			"created_at":               createdAtAttribute(),
+			"provider_version":         providerVersionAttribute(),
+			"lifecycle_guard":          lifecycleGuardAttribute(),
+			"allow_regeneration_token": allowRegenerationTokenAttribute(),
 			"id": schema.StringAttribute{
 				Description: "The generated id presented in base64 without additional transformations or prefix.",
 				Computed:    true,
@@ -123,135 +754,177 @@ exist concurrently.
 	}
 }
 
-func (r *idResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan idModelV0
-
-	diags := req.Plan.Get(ctx, &plan)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	byteLength := plan.ByteLength.ValueInt64()
-	bytes := make([]byte, byteLength)
-
-	n, err := rand.Reader.Read(bytes)
-	if int64(n) != byteLength {
-		resp.Diagnostics.Append(diagnostics.RandomnessGenerationError(err.Error())...)
-		return
-	}
-	if err != nil {
-		resp.Diagnostics.Append(diagnostics.RandomReadError(err.Error())...)
-		return
-	}
-
-	id := base64.RawURLEncoding.EncodeToString(bytes)
-	prefix := plan.Prefix.ValueString()
-	b64Std := base64.StdEncoding.EncodeToString(bytes)
-	hexStr := hex.EncodeToString(bytes)
-
-	bigInt := big.Int{}
-	bigInt.SetBytes(bytes)
-	dec := bigInt.String()
+func idSchemaV0() schema.Schema {
+	return schema.Schema{
+		Description: `
+The resource ` + "`random_id`" + ` generates random numbers that are intended to be
+used as unique identifiers for other resources. If the output is considered 
+sensitive, and should not be displayed in the CLI, use ` + "`random_bytes`" + `
+instead.
 
-	i := idModelV0{
-		ID:         types.StringValue(id),
-		Keepers:    plan.Keepers,
-		ByteLength: types.Int64Value(plan.ByteLength.ValueInt64()),
-		Prefix:     plan.Prefix,
-		B64URL:     types.StringValue(prefix + id),
-		B64Std:     types.StringValue(prefix + b64Std),
-		Hex:        types.StringValue(prefix + hexStr),
-		Dec:        types.StringValue(prefix + dec),
-	}
+This resource *does* use a cryptographic random number generator in order
+to minimize the chance of collisions, making the results of this resource
+when a 16-byte identifier is requested of equivalent uniqueness to a
+type-4 UUID.
 
-	diags = resp.State.Set(ctx, i)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
+This resource can be used in conjunction with resources that have
+the ` + "`create_before_destroy`" + ` lifecycle flag set to avoid conflicts with
+unique names during the brief period where both the old and new resources
+exist concurrently.
+`,
+		Attributes: map[string]schema.Attribute{
+			"keepers": schema.MapAttribute{
+				Description: "Arbitrary map of values that, when changed, will trigger recreation of " +
+					"resource. See [the main provider documentation](../index.html) for more information.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"byte_length": schema.Int64Attribute{
+				Description: "The number of random bytes to produce. The minimum value is 1, which produces " +
+					"eight bits of randomness.",
+				Required: true,
+			},
+			"prefix": schema.StringAttribute{
+				Description: "Arbitrary string to prefix the output value with. This string is supplied as-is, " +
+					"meaning it is not guaranteed to be URL-safe or base64 encoded.",
+				Optional: true,
+			},
+			"b64_url": schema.StringAttribute{
+				Description: "The generated id presented in base64, using the URL-friendly character set: " +
+					"case-sensitive letters, digits and the characters `_` and `-`.",
+				Computed: true,
+			},
+			"b64_std": schema.StringAttribute{
+				Description: "The generated id presented in base64 without additional transformations.",
+				Computed:    true,
+			},
+			"hex": schema.StringAttribute{
+				Description: "The generated id presented in padded hexadecimal digits. This result will " +
+					"always be twice as long as the requested byte length.",
+				Computed: true,
+			},
+			"dec": schema.StringAttribute{
+				Description: "The generated id presented in non-padded decimal digits.",
+				Computed:    true,
+			},
+			"id": schema.StringAttribute{
+				Description: "The generated id presented in base64 without additional transformations or prefix.",
+				Computed:    true,
+			},
+		},
 	}
 }
 
-// Read does not need to perform any operations as the state in ReadResourceResponse is already populated.
-func (r *idResource) Read(context.Context, resource.ReadRequest, *resource.ReadResponse) {
+type idModelV1 struct {
+	ID                     types.String  `tfsdk:"id"`
+	Keepers                types.Map     `tfsdk:"keepers"`
+	KeepersHash            types.String  `tfsdk:"keepers_hash"`
+	KeepersMode            types.String  `tfsdk:"keepers_mode"`
+	KeepersAtGeneration    types.Map     `tfsdk:"keepers_at_generation"`
+	ByteLength             types.Int64   `tfsdk:"byte_length"`
+	Prefix                 types.String  `tfsdk:"prefix"`
+	Suffix                 types.String  `tfsdk:"suffix"`
+	Separator              types.String  `tfsdk:"separator"`
+	B64URL                 types.String  `tfsdk:"b64_url"`
+	B64Std                 types.String  `tfsdk:"b64_std"`
+	Hex                    types.String  `tfsdk:"hex"`
+	HexChunkSize           types.Int64   `tfsdk:"hex_chunk_size"`
+	HexChunks              types.List    `tfsdk:"hex_chunks"`
+	Dec                    types.String  `tfsdk:"dec"`
+	DecStr                 types.String  `tfsdk:"dec_str"`
+	CRC32                  types.String  `tfsdk:"crc32"`
+	SHA1                   types.String  `tfsdk:"sha1"`
+	SHA256                 types.String  `tfsdk:"sha256"`
+	Seed                   types.String  `tfsdk:"seed"`
+	CountOutputs           types.Int64   `tfsdk:"count_outputs"`
+	Hexs                   types.List    `tfsdk:"hexs"`
+	B64s                   types.List    `tfsdk:"b64s"`
+	EntropyBits            types.Float64 `tfsdk:"entropy_bits"`
+	CreatedAt              types.String  `tfsdk:"created_at"`
+	ProviderVersion        types.String  `tfsdk:"provider_version"`
+	LifecycleGuard         types.Bool    `tfsdk:"lifecycle_guard"`
+	AllowRegenerationToken types.String  `tfsdk:"allow_regeneration_token"`
 }
 
-// Update ensures the plan value is copied to the state to complete the update.
-func (r *idResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var model idModelV0
+// setOutputs sets the id and the encoded outputs of the bytes, which other
+// than id, dec_str and hex_chunks are affixed with the prefix, suffix and
+// separator.
+func (m *idModelV1) setOutputs(bytes []byte) {
+	id := base64.RawURLEncoding.EncodeToString(bytes)
+	hexStr := types.StringValue(hex.EncodeToString(bytes))
+	dec := idDecimal(bytes)
+
+	m.ID = types.StringValue(id)
+	m.B64URL = m.affix(id)
+	m.B64Std = m.affix(base64.StdEncoding.EncodeToString(bytes))
+	m.Hex = m.affix(hexStr.ValueString())
+	m.HexChunks = hexChunks(hexStr, m.HexChunkSize)
+	m.Dec = m.affix(dec)
+	m.DecStr = types.StringValue(dec)
+	m.CRC32, m.SHA1, m.SHA256 = checksums(bytes)
+}
 
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+// setOutputLists sets hexs and b64s to the encoded outputs of the bytes of
+// each id, which are affixed in the same way as hex and b64_url.
+func (m *idModelV1) setOutputLists(outputs [][]byte) {
+	hexs := make([]attr.Value, len(outputs))
+	b64s := make([]attr.Value, len(outputs))
 
-	if resp.Diagnostics.HasError() {
-		return
+	for i, bytes := range outputs {
+		hexs[i] = m.affix(hex.EncodeToString(bytes))
+		b64s[i] = m.affix(base64.RawURLEncoding.EncodeToString(bytes))
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	m.Hexs = types.ListValueMust(types.StringType, hexs)
+	m.B64s = types.ListValueMust(types.StringType, b64s)
 }
 
-// Delete does not need to explicitly call resp.State.RemoveResource() as this is automatically handled by the
-// [framework](https://github.com/hashicorp/terraform-plugin-framework/pull/301).
-func (r *idResource) Delete(context.Context, resource.DeleteRequest, *resource.DeleteResponse) {
-}
-
-func (r *idResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	id := req.ID
-	var prefix string
-
-	sep := strings.LastIndex(id, ",")
-	if sep != -1 {
-		prefix = id[:sep]
-		id = id[sep+1:]
-	}
+// affix returns the encoded value with the prefix and suffix, each joined to
+// the value by the separator when set.
+func (m idModelV1) affix(encoded string) types.String {
+	var b strings.Builder
 
-	bytes, err := base64.RawURLEncoding.DecodeString(id)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Import Random ID Error",
-			"While attempting to import a random id there was a decoding error.\n\n+"+
-				diagnostics.RetryMsg+
-				fmt.Sprintf("Original Error: %s", err),
-		)
-		return
+	if prefix := m.Prefix.ValueString(); prefix != "" {
+		b.WriteString(prefix)
+		b.WriteString(m.Separator.ValueString())
 	}
 
-	b64Std := base64.StdEncoding.EncodeToString(bytes)
-	hexStr := hex.EncodeToString(bytes)
-
-	bigInt := big.Int{}
-	bigInt.SetBytes(bytes)
-	dec := bigInt.String()
+	b.WriteString(encoded)
 
-	var state idModelV0
+	if suffix := m.Suffix.ValueString(); suffix != "" {
+		b.WriteString(m.Separator.ValueString())
+		b.WriteString(suffix)
+	}
 
-	state.ID = types.StringValue(id)
-	state.ByteLength = types.Int64Value(int64(len(bytes)))
-	state.Keepers = types.MapNull(types.StringType)
-	state.B64Std = types.StringValue(prefix + b64Std)
-	state.B64URL = types.StringValue(prefix + id)
-	state.Hex = types.StringValue(prefix + hexStr)
-	state.Dec = types.StringValue(prefix + dec)
+	return types.StringValue(b.String())
+}
 
-	if prefix == "" {
-		state.Prefix = types.StringNull()
-	} else {
-		state.Prefix = types.StringValue(prefix)
+// entropyBits returns the entropy of the id, which is zero if the bytes were
+// derived from a seed.
+func (m idModelV1) entropyBits() types.Float64 {
+	if !m.Seed.IsNull() {
+		return types.Float64Value(0)
 	}
 
-	diags := resp.State.Set(ctx, &state)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
+	return bytesEntropyBits(m.ByteLength)
+}
+
+func idIdentitySchema() identityschema.Schema {
+	return identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       "The generated id presented in base64 without additional transformations or prefix.",
+				RequiredForImport: true,
+			},
+			"prefix": identityschema.StringAttribute{
+				Description:       "Arbitrary string to prefix the output value with.",
+				OptionalForImport: true,
+			},
+		},
 	}
 }
 
-type idModelV0 struct {
-	ID         types.String `tfsdk:"id"`
-	Keepers    types.Map    `tfsdk:"keepers"`
-	ByteLength types.Int64  `tfsdk:"byte_length"`
-	Prefix     types.String `tfsdk:"prefix"`
-	B64URL     types.String `tfsdk:"b64_url"`
-	B64Std     types.String `tfsdk:"b64_std"`
-	Hex        types.String `tfsdk:"hex"`
-	Dec        types.String `tfsdk:"dec"`
+type idIdentityModel struct {
+	ID     types.String `tfsdk:"id"`
+	Prefix types.String `tfsdk:"prefix"`
 }
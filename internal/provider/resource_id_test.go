@@ -4,19 +4,24 @@
 package provider
 
 import (
+	"fmt"
+	"regexp"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/compare"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/terraform-providers/terraform-provider-random/internal/randomtest"
 )
 
 func TestAccResourceID(t *testing.T) {
 	resource.UnitTest(t, resource.TestCase{
-		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: `resource "random_id" "foo" {
@@ -30,9 +35,93 @@ func TestAccResourceID(t *testing.T) {
 				},
 			},
 			{
-				ResourceName:      "random_id.foo",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "random_id.foo",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"created_at", "provider_version"},
+			},
+		},
+	})
+}
+
+func TestAccResourceID_ByteLength_Large(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_id" "foo" {
+  							byte_length = 1024
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_id.foo", tfjsonpath.New("b64_url"), randomtest.StringLengthExact(1366)),
+					statecheck.ExpectKnownValue("random_id.foo", tfjsonpath.New("b64_std"), randomtest.StringLengthExact(1368)),
+					statecheck.ExpectKnownValue("random_id.foo", tfjsonpath.New("b64_std"), knownvalue.StringRegexp(regexp.MustCompile(`^[A-Za-z0-9+/]+=*$`))),
+					statecheck.ExpectKnownValue("random_id.foo", tfjsonpath.New("hex"), randomtest.StringLengthExact(2048)),
+					statecheck.ExpectKnownValue("random_id.foo", tfjsonpath.New("dec"), randomtest.StringLengthMin(1)),
+				},
+			},
+			{
+				ResourceName:            "random_id.foo",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"created_at", "provider_version"},
+			},
+		},
+	})
+}
+
+func TestAccResourceID_ByteLength_Seed(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_id" "foo" {
+  							byte_length = 512
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_id.foo", tfjsonpath.New("b64_url"), randomtest.StringLengthExact(683)),
+					statecheck.ExpectKnownValue("random_id.foo", tfjsonpath.New("b64_std"), randomtest.StringLengthExact(684)),
+					statecheck.ExpectKnownValue("random_id.foo", tfjsonpath.New("hex"), randomtest.StringLengthExact(1024)),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceID_ByteLength_Invalid(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_id" "foo" {
+  							byte_length = 0
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute byte_length value must be at least 1, got: 0`),
+			},
+			{
+				Config: `resource "random_id" "foo" {
+  							byte_length = 65537
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute byte_length value must be at most 65536, got: 65537`),
+			},
+		},
+	})
+}
+
+func TestAccResourceID_ByteLength_UnknownInvalid(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_integer" "length" {
+							min = 65537
+							max = 65537
+						}
+
+						resource "random_id" "foo" {
+  							byte_length = random_integer.length.result
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute byte_length value must be between 1 and 65536, got: 65537`),
 			},
 		},
 	})
@@ -40,7 +129,7 @@ func TestAccResourceID(t *testing.T) {
 
 func TestAccResourceID_ImportWithPrefix(t *testing.T) {
 	resource.UnitTest(t, resource.TestCase{
-		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: `resource "random_id" "bar" {
@@ -55,18 +144,339 @@ func TestAccResourceID_ImportWithPrefix(t *testing.T) {
 				},
 			},
 			{
-				ResourceName:        "random_id.bar",
-				ImportState:         true,
-				ImportStateIdPrefix: "cloud-,",
-				ImportStateVerify:   true,
+				ResourceName:            "random_id.bar",
+				ImportState:             true,
+				ImportStateIdPrefix:     "cloud-,",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"created_at", "provider_version"},
 			},
 		},
 	})
 }
 
+func TestAccResourceID_Suffix(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_id" "foo" {
+  							byte_length = 4
+  							suffix      = "-prod"
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_id.foo", tfjsonpath.New("b64_url"), knownvalue.StringRegexp(regexp.MustCompile(`^[A-Za-z0-9_-]{6}-prod$`))),
+					statecheck.ExpectKnownValue("random_id.foo", tfjsonpath.New("b64_std"), knownvalue.StringRegexp(regexp.MustCompile(`^[A-Za-z0-9+/]{6}==-prod$`))),
+					statecheck.ExpectKnownValue("random_id.foo", tfjsonpath.New("hex"), knownvalue.StringRegexp(regexp.MustCompile(`^[0-9a-f]{8}-prod$`))),
+					statecheck.ExpectKnownValue("random_id.foo", tfjsonpath.New("dec"), knownvalue.StringRegexp(regexp.MustCompile(`^[0-9]+-prod$`))),
+					statecheck.ExpectKnownValue("random_id.foo", tfjsonpath.New("dec_str"), knownvalue.StringRegexp(regexp.MustCompile(`^[0-9]+$`))),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceID_Separator(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_id" "foo" {
+  							byte_length = 4
+  							prefix      = "app"
+  							suffix      = "prod"
+  							separator   = "-"
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_id.foo", tfjsonpath.New("b64_url"), knownvalue.StringRegexp(regexp.MustCompile(`^app-[A-Za-z0-9_-]{6}-prod$`))),
+					statecheck.ExpectKnownValue("random_id.foo", tfjsonpath.New("b64_std"), knownvalue.StringRegexp(regexp.MustCompile(`^app-[A-Za-z0-9+/]{6}==-prod$`))),
+					statecheck.ExpectKnownValue("random_id.foo", tfjsonpath.New("hex"), knownvalue.StringRegexp(regexp.MustCompile(`^app-[0-9a-f]{8}-prod$`))),
+					statecheck.ExpectKnownValue("random_id.foo", tfjsonpath.New("dec"), knownvalue.StringRegexp(regexp.MustCompile(`^app-[0-9]+-prod$`))),
+				},
+			},
+			{
+				Config: `resource "random_id" "foo" {
+  							byte_length = 4
+  							prefix      = "app"
+  							separator   = "_"
+						}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("random_id.foo", plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_id.foo", tfjsonpath.New("hex"), knownvalue.StringRegexp(regexp.MustCompile(`^app_[0-9a-f]{8}$`))),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceID_Separator_Invalid(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_id" "foo" {
+  							byte_length = 4
+  							separator   = "-"
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute separator can only be set when prefix or suffix is set`),
+			},
+		},
+	})
+}
+
+func TestAccResourceID_HexChunks(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_id" "foo" {
+  							byte_length    = 16
+  							prefix         = "cloud-"
+  							hex_chunk_size = 8
+						}`,
+				ResourceName:       "random_id.foo",
+				ImportState:        true,
+				ImportStateId:      "cloud-,_____________________w",
+				ImportStatePersist: true,
+			},
+			{
+				Config: `resource "random_id" "foo" {
+  							byte_length    = 16
+  							prefix         = "cloud-"
+  							hex_chunk_size = 8
+						}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("random_id.foo", plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_id.foo", tfjsonpath.New("hex"), knownvalue.StringExact("cloud-ffffffffffffffffffffffffffffffff")),
+					statecheck.ExpectKnownValue("random_id.foo", tfjsonpath.New("hex_chunks"), knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.StringExact("ffffffff"),
+						knownvalue.StringExact("ffffffff"),
+						knownvalue.StringExact("ffffffff"),
+						knownvalue.StringExact("ffffffff"),
+					})),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceID_DecStr(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_id" "foo" {
+  							byte_length = 32
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_id.foo", tfjsonpath.New("dec_str"), knownvalue.StringRegexp(regexp.MustCompile(`^[0-9]+$`))),
+					statecheck.CompareValuePairs("random_id.foo", tfjsonpath.New("dec"), "random_id.foo", tfjsonpath.New("dec_str"), compare.ValuesSame()),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceID_DecStr_Prefix(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_id" "foo" {
+  							byte_length = 16
+  							prefix      = "cloud-"
+						}`,
+				ResourceName:       "random_id.foo",
+				ImportState:        true,
+				ImportStateId:      "cloud-,_____________________w",
+				ImportStatePersist: true,
+			},
+			{
+				Config: `resource "random_id" "foo" {
+  							byte_length = 16
+  							prefix      = "cloud-"
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_id.foo", tfjsonpath.New("dec"), knownvalue.StringExact("cloud-340282366920938463463374607431768211455")),
+					statecheck.ExpectKnownValue("random_id.foo", tfjsonpath.New("dec_str"), knownvalue.StringExact("340282366920938463463374607431768211455")),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceID_DecStr_UpgradeFromVersion3_6_3(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				ExternalProviders: providerVersion363(),
+				Config: `resource "random_id" "test" {
+							byte_length = 16
+						}`,
+			},
+			{
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
+				Config: `resource "random_id" "test" {
+							byte_length = 16
+						}`,
+				PlanOnly: true,
+			},
+			{
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
+				Config: `resource "random_id" "test" {
+							byte_length = 16
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.CompareValuePairs("random_id.test", tfjsonpath.New("dec"), "random_id.test", tfjsonpath.New("dec_str"), compare.ValuesSame()),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceID_EntropyBits(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_id" "foo" {
+  							byte_length = 4
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_id.foo", tfjsonpath.New("entropy_bits"), knownvalue.Float64Exact(32)),
+				},
+			},
+			{
+				ResourceName:            "random_id.foo",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"created_at", "provider_version"},
+			},
+		},
+	})
+}
+
+func TestIDDecStrFromID(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		id       types.String
+		expected types.String
+	}{
+		"null": {
+			id:       types.StringNull(),
+			expected: types.StringNull(),
+		},
+		"invalid": {
+			id:       types.StringValue("!"),
+			expected: types.StringNull(),
+		},
+		"zero": {
+			id:       types.StringValue("AAAAAA"),
+			expected: types.StringValue("0"),
+		},
+		"128-bit": {
+			id:       types.StringValue("_____________________w"),
+			expected: types.StringValue("340282366920938463463374607431768211455"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := idDecStrFromID(testCase.id)
+
+			if !got.Equal(testCase.expected) {
+				t.Errorf("expected %s, got %s", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestValidateIDLength(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		byteLength int64
+		count      int64
+		seeded     bool
+		expected   string
+	}{
+		"valid": {
+			byteLength: 1024,
+			count:      1024,
+		},
+		"valid-seeded": {
+			byteLength: 8,
+			count:      1020,
+			seeded:     true,
+		},
+		"byte-length-zero": {
+			byteLength: 0,
+			count:      1,
+			expected:   "Attribute byte_length value must be between 1 and 65536, got: 0",
+		},
+		"byte-length-too-large": {
+			byteLength: 65537,
+			count:      1,
+			expected:   "Attribute byte_length value must be between 1 and 65536, got: 65537",
+		},
+		"count-too-large": {
+			byteLength: 1,
+			count:      10001,
+			expected:   "Attribute count_outputs value must be between 1 and 10000, got: 10001",
+		},
+		"total-too-large": {
+			byteLength: 1024,
+			count:      1025,
+			expected:   "Attribute count_outputs multiplied by byte_length must be at most 1048576, got: 1049600",
+		},
+		"byte-length-too-large-seeded": {
+			byteLength: 8161,
+			count:      1,
+			seeded:     true,
+			expected:   "Attribute byte_length value must be at most 8160 when seed is set, got: 8161",
+		},
+		"total-too-large-seeded": {
+			byteLength: 4096,
+			count:      2,
+			seeded:     true,
+			expected:   "Attribute count_outputs multiplied by byte_length must be at most 8160 when seed is set, got: 8192",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := validateIDLength(testCase.byteLength, testCase.count, testCase.seeded)
+
+			if testCase.expected == "" {
+				if diags.HasError() {
+					t.Errorf("unexpected error: %v", diags)
+				}
+
+				return
+			}
+
+			if diags.ErrorsCount() != 1 || diags.Errors()[0].Detail() != testCase.expected {
+				t.Errorf("expected %q, got: %v", testCase.expected, diags)
+			}
+		})
+	}
+}
+
 func TestAccResourceID_ImportWithoutKeepersProducesNoPlannedChanges(t *testing.T) {
 	resource.UnitTest(t, resource.TestCase{
-		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: `resource "random_id" "foo" {
@@ -87,6 +497,62 @@ func TestAccResourceID_ImportWithoutKeepersProducesNoPlannedChanges(t *testing.T
 	})
 }
 
+func TestAccResourceID_ImportHex(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_id" "foo" {
+  							byte_length = 4
+  							prefix      = "cloud-"
+						}`,
+				ResourceName:       "random_id.foo",
+				ImportStateId:      "cloud-,hex:a7ef6152",
+				ImportState:        true,
+				ImportStatePersist: true,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_id.foo", tfjsonpath.New("id"), knownvalue.StringExact("p-9hUg")),
+					statecheck.ExpectKnownValue("random_id.foo", tfjsonpath.New("b64_url"), knownvalue.StringExact("cloud-p-9hUg")),
+					statecheck.ExpectKnownValue("random_id.foo", tfjsonpath.New("hex"), knownvalue.StringExact("cloud-a7ef6152")),
+				},
+			},
+			{
+				Config: `resource "random_id" "foo" {
+  							byte_length = 4
+  							prefix      = "cloud-"
+						}`,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccResourceID_Import_Invalid(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_id" "foo" {
+  							byte_length = 4
+						}`,
+				ResourceName:  "random_id.foo",
+				ImportStateId: "p+9hUg==",
+				ImportState:   true,
+				ExpectError:   regexp.MustCompile(`contains characters of standard base64 or padding`),
+			},
+			{
+				Config: `resource "random_id" "foo" {
+  							byte_length = 4
+						}`,
+				ResourceName:  "random_id.foo",
+				ImportStateId: "cloud-,hex:a7ef615",
+				ImportState:   true,
+				ExpectError:   regexp.MustCompile(`odd number of digits`),
+			},
+		},
+	})
+}
+
 func TestAccResourceID_UpgradeFromVersion3_3_2(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		Steps: []resource.TestStep{
@@ -104,7 +570,7 @@ func TestAccResourceID_UpgradeFromVersion3_3_2(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_id" "bar" {
   							byte_length = 4
   							prefix      = "cloud-"
@@ -112,7 +578,7 @@ func TestAccResourceID_UpgradeFromVersion3_3_2(t *testing.T) {
 				PlanOnly: true,
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_id" "bar" {
   							byte_length = 4
   							prefix      = "cloud-"
@@ -135,7 +601,7 @@ func TestAccResourceID_Keepers_Keep_EmptyMap(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_id" "test" {
 					byte_length = 4
 					keepers = {}
@@ -146,7 +612,7 @@ func TestAccResourceID_Keepers_Keep_EmptyMap(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_id" "test" {
 					byte_length = 4
 					keepers = {}
@@ -167,7 +633,7 @@ func TestAccResourceID_Keepers_Keep_EmptyMapToNullValue(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_id" "test" {
 					byte_length = 4
 					keepers = {}
@@ -178,7 +644,7 @@ func TestAccResourceID_Keepers_Keep_EmptyMapToNullValue(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_id" "test" {
 					byte_length = 4
 					keepers = {
@@ -201,7 +667,7 @@ func TestAccResourceID_Keepers_Keep_NullMap(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_id" "test" {
 					byte_length = 4
 				}`,
@@ -211,7 +677,7 @@ func TestAccResourceID_Keepers_Keep_NullMap(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_id" "test" {
 					byte_length = 4
 				}`,
@@ -231,7 +697,7 @@ func TestAccResourceID_Keepers_Keep_NullMapToNullValue(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_id" "test" {
 					byte_length = 4
 				}`,
@@ -241,7 +707,7 @@ func TestAccResourceID_Keepers_Keep_NullMapToNullValue(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_id" "test" {
 					byte_length = 4
 					keepers = {
@@ -264,7 +730,7 @@ func TestAccResourceID_Keepers_Keep_NullValue(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_id" "test" {
 					byte_length = 4
 					keepers = {
@@ -277,7 +743,7 @@ func TestAccResourceID_Keepers_Keep_NullValue(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_id" "test" {
 					byte_length = 4
 					keepers = {
@@ -300,7 +766,7 @@ func TestAccResourceID_Keepers_Keep_NullValues(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_id" "test" {
 					byte_length = 4
 					keepers = {
@@ -314,7 +780,7 @@ func TestAccResourceID_Keepers_Keep_NullValues(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_id" "test" {
 					byte_length = 4
 					keepers = {
@@ -338,7 +804,7 @@ func TestAccResourceID_Keepers_Keep_Value(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_id" "test" {
 					byte_length = 4
 					keepers = {
@@ -351,7 +817,7 @@ func TestAccResourceID_Keepers_Keep_Value(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_id" "test" {
 					byte_length = 4
 					keepers = {
@@ -374,7 +840,7 @@ func TestAccResourceID_Keepers_Keep_Values(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_id" "test" {
 					byte_length = 4
 					keepers = {
@@ -388,7 +854,7 @@ func TestAccResourceID_Keepers_Keep_Values(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_id" "test" {
 					byte_length = 4
 					keepers = {
@@ -412,7 +878,7 @@ func TestAccResourceID_Keepers_Replace_EmptyMapToValue(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_id" "test" {
 					byte_length = 4
 					keepers = {}
@@ -423,7 +889,7 @@ func TestAccResourceID_Keepers_Replace_EmptyMapToValue(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_id" "test" {
 					byte_length = 4
 					keepers = {
@@ -446,7 +912,7 @@ func TestAccResourceID_Keepers_Replace_NullMapToValue(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_id" "test" {
 					byte_length = 4
 				}`,
@@ -456,7 +922,7 @@ func TestAccResourceID_Keepers_Replace_NullMapToValue(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_id" "test" {
 					byte_length = 4
 					keepers = {
@@ -479,7 +945,7 @@ func TestAccResourceID_Keepers_Replace_NullValueToValue(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_id" "test" {
 					byte_length = 4
 					keepers = {
@@ -492,7 +958,7 @@ func TestAccResourceID_Keepers_Replace_NullValueToValue(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_id" "test" {
 					byte_length = 4
 					keepers = {
@@ -515,7 +981,7 @@ func TestAccResourceID_Keepers_Replace_ValueToEmptyMap(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_id" "test" {
 					byte_length = 4
 					keepers = {
@@ -528,7 +994,7 @@ func TestAccResourceID_Keepers_Replace_ValueToEmptyMap(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_id" "test" {
 					byte_length = 4
 					keepers = {}
@@ -549,7 +1015,7 @@ func TestAccResourceID_Keepers_Replace_ValueToNullMap(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_id" "test" {
 					byte_length = 4
 					keepers = {
@@ -562,7 +1028,7 @@ func TestAccResourceID_Keepers_Replace_ValueToNullMap(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_id" "test" {
 					byte_length = 4
 				}`,
@@ -582,7 +1048,7 @@ func TestAccResourceID_Keepers_Replace_ValueToNullValue(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_id" "test" {
 					byte_length = 4
 					keepers = {
@@ -595,7 +1061,7 @@ func TestAccResourceID_Keepers_Replace_ValueToNullValue(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_id" "test" {
 					byte_length = 4
 					keepers = {
@@ -618,7 +1084,7 @@ func TestAccResourceID_Keepers_Replace_ValueToNewValue(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_id" "test" {
 					byte_length = 4
 					keepers = {
@@ -631,7 +1097,7 @@ func TestAccResourceID_Keepers_Replace_ValueToNewValue(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_id" "test" {
 					byte_length = 4
 					keepers = {
@@ -647,6 +1113,189 @@ func TestAccResourceID_Keepers_Replace_ValueToNewValue(t *testing.T) {
 	})
 }
 
+func TestAccResourceID_KeepersMode_All(t *testing.T) {
+	assertIdSame := statecheck.CompareValue(compare.ValuesSame())
+	assertIdDiffer := statecheck.CompareValue(compare.ValuesDiffer())
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_id" "test" {
+					byte_length  = 4
+					keepers_mode = "all"
+					keepers = {
+						"primary"   = "a"
+						"secondary" = "b"
+					}
+				}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					assertIdSame.AddStateValue("random_id.test", tfjsonpath.New("id")),
+					assertIdDiffer.AddStateValue("random_id.test", tfjsonpath.New("id")),
+				},
+			},
+			{
+				Config: `resource "random_id" "test" {
+					byte_length  = 4
+					keepers_mode = "all"
+					keepers = {
+						"primary"   = "c"
+						"secondary" = "b"
+					}
+				}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("random_id.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					assertIdSame.AddStateValue("random_id.test", tfjsonpath.New("id")),
+					statecheck.ExpectKnownValue("random_id.test", tfjsonpath.New("keepers").AtMapKey("primary"), knownvalue.StringExact("c")),
+				},
+			},
+			{
+				Config: `resource "random_id" "test" {
+					byte_length  = 4
+					keepers_mode = "all"
+					keepers = {
+						"primary"   = "d"
+						"secondary" = "e"
+					}
+				}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("random_id.test", plancheck.ResourceActionReplace),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					assertIdDiffer.AddStateValue("random_id.test", tfjsonpath.New("id")),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceID_KeepersMode_All_Staggered(t *testing.T) {
+	assertIdSame := statecheck.CompareValue(compare.ValuesSame())
+	assertIdDiffer := statecheck.CompareValue(compare.ValuesDiffer())
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_id" "test" {
+					byte_length  = 4
+					keepers_mode = "all"
+					keepers = {
+						"primary"   = "a"
+						"secondary" = "b"
+					}
+				}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					assertIdSame.AddStateValue("random_id.test", tfjsonpath.New("id")),
+					assertIdDiffer.AddStateValue("random_id.test", tfjsonpath.New("id")),
+					statecheck.ExpectKnownValue("random_id.test", tfjsonpath.New("keepers_at_generation").AtMapKey("primary"), knownvalue.StringExact("a")),
+				},
+			},
+			{
+				Config: `resource "random_id" "test" {
+					byte_length  = 4
+					keepers_mode = "all"
+					keepers = {
+						"primary"   = "c"
+						"secondary" = "b"
+					}
+				}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("random_id.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					assertIdSame.AddStateValue("random_id.test", tfjsonpath.New("id")),
+					statecheck.ExpectKnownValue("random_id.test", tfjsonpath.New("keepers_at_generation").AtMapKey("primary"), knownvalue.StringExact("a")),
+				},
+			},
+			{
+				// The primary keeper changed in the previous step, so changing
+				// the secondary keeper regenerates the id.
+				Config: `resource "random_id" "test" {
+					byte_length  = 4
+					keepers_mode = "all"
+					keepers = {
+						"primary"   = "c"
+						"secondary" = "d"
+					}
+				}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("random_id.test", plancheck.ResourceActionReplace),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					assertIdDiffer.AddStateValue("random_id.test", tfjsonpath.New("id")),
+					statecheck.ExpectKnownValue("random_id.test", tfjsonpath.New("keepers_at_generation").AtMapKey("primary"), knownvalue.StringExact("c")),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceID_KeepersMode_Any(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_id" "test" {
+					byte_length  = 4
+					keepers_mode = "all"
+					keepers = {
+						"primary"   = "a"
+						"secondary" = "b"
+					}
+				}`,
+			},
+			{
+				Config: `resource "random_id" "test" {
+					byte_length  = 4
+					keepers_mode = "any"
+					keepers = {
+						"primary"   = "a"
+						"secondary" = "b"
+					}
+				}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("random_id.test", plancheck.ResourceActionUpdate),
+					},
+				},
+			},
+			{
+				Config: `resource "random_id" "test" {
+					byte_length  = 4
+					keepers_mode = "any"
+					keepers = {
+						"primary"   = "c"
+						"secondary" = "b"
+					}
+				}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("random_id.test", plancheck.ResourceActionReplace),
+					},
+				},
+			},
+			{
+				Config: `resource "random_id" "test" {
+					byte_length  = 4
+					keepers_mode = "every"
+				}`,
+				ExpectError: regexp.MustCompile(`Attribute keepers_mode value must be one of`),
+			},
+		},
+	})
+}
+
 func TestAccResourceID_Keepers_FrameworkMigration_NullMapToNullValue(t *testing.T) {
 	// The id attribute values should be the same between test steps
 	assertIdSame := statecheck.CompareValue(compare.ValuesSame())
@@ -667,7 +1316,7 @@ func TestAccResourceID_Keepers_FrameworkMigration_NullMapToNullValue(t *testing.
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_id" "test" {
 					byte_length = 4
 					keepers = {
@@ -703,7 +1352,7 @@ func TestAccResourceID_Keepers_FrameworkMigration_NullMapToValue(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_id" "test" {
 					byte_length = 4
 					keepers = {
@@ -740,7 +1389,7 @@ func TestAccResourceID_Keepers_FrameworkMigration_NullMapToMultipleNullValue(t *
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_id" "test" {
 					byte_length = 4
 					keepers = {
@@ -778,7 +1427,7 @@ func TestAccResourceID_Keepers_FrameworkMigration_NullMapToMultipleValue(t *test
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_id" "test" {
 					byte_length = 4
 					keepers = {
@@ -816,7 +1465,7 @@ func TestAccResourceID_Keepers_FrameworkMigration_NullMapValue(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_id" "test" {
 					byte_length = 4
 					keepers = {
@@ -854,7 +1503,7 @@ func TestAccResourceID_Keepers_FrameworkMigration_NullMapValueToValue(t *testing
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_id" "test" {
 					byte_length = 4
 					keepers = {
@@ -870,3 +1519,246 @@ func TestAccResourceID_Keepers_FrameworkMigration_NullMapValueToValue(t *testing
 		},
 	})
 }
+
+func TestAccResourceID_Seed(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_id" "test" {
+							byte_length = 8
+							prefix      = "web-"
+							seed        = "web-server"
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_id.test", tfjsonpath.New("hex"), knownvalue.StringExact("web-c2c2b627f0f8f18e")),
+					statecheck.ExpectKnownValue("random_id.test", tfjsonpath.New("entropy_bits"), knownvalue.Float64Exact(0)),
+				},
+			},
+			{
+				// Replacing the resource derives the same id from the seed.
+				Taint: []string{"random_id.test"},
+				Config: `resource "random_id" "test" {
+							byte_length = 8
+							prefix      = "web-"
+							seed        = "web-server"
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_id.test", tfjsonpath.New("hex"), knownvalue.StringExact("web-c2c2b627f0f8f18e")),
+				},
+			},
+			{
+				Config: `resource "random_id" "test" {
+							byte_length = 8
+							prefix      = "web-"
+							seed        = "db-server"
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_id.test", tfjsonpath.New("hex"), knownvalue.StringRegexp(regexp.MustCompile(`^web-[0-9a-f]{16}$`))),
+					statecheck.ExpectKnownValue("random_id.test", tfjsonpath.New("hex"), knownvalue.StringFunc(func(v string) error {
+						if v == "web-c2c2b627f0f8f18e" {
+							return fmt.Errorf("expected a different id for a different seed, got: %s", v)
+						}
+
+						return nil
+					})),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceID_Checksums(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_id" "test" {
+							byte_length = 8
+							prefix      = "web-"
+							seed        = "web-server"
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_id.test", tfjsonpath.New("hex"), knownvalue.StringExact("web-c2c2b627f0f8f18e")),
+					statecheck.ExpectKnownValue("random_id.test", tfjsonpath.New("crc32"), knownvalue.StringExact("834758e9")),
+					statecheck.ExpectKnownValue("random_id.test", tfjsonpath.New("sha1"), knownvalue.StringExact("d3e83149ccd40f25a05148a73e6659f60aab1311")),
+					statecheck.ExpectKnownValue("random_id.test", tfjsonpath.New("sha256"), knownvalue.StringExact("efd4b841d4de62972228ee4379057734c0f9399e22de72c891fd7d5c42d4bbaa")),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceID_Seed_Invalid(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_id" "test" {
+							byte_length = 8161
+							seed        = "web-server"
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute byte_length value must be at most 8160 when seed is set, got: 8161`),
+			},
+			{
+				Config: `resource "random_id" "test" {
+							byte_length = 8
+							seed        = ""
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute seed string length must be at least 1`),
+			},
+		},
+	})
+}
+
+func TestAccResourceID_CountOutputs(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_id" "test" {
+							byte_length   = 8
+							prefix        = "web-"
+							seed          = "web-server"
+							count_outputs = 3
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_id.test", tfjsonpath.New("hex"), knownvalue.StringExact("web-c2c2b627f0f8f18e")),
+					statecheck.ExpectKnownValue("random_id.test", tfjsonpath.New("hexs"), knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.StringExact("web-c2c2b627f0f8f18e"),
+						knownvalue.StringRegexp(regexp.MustCompile(`^web-[0-9a-f]{16}$`)),
+						knownvalue.StringRegexp(regexp.MustCompile(`^web-[0-9a-f]{16}$`)),
+					})),
+					statecheck.ExpectKnownValue("random_id.test", tfjsonpath.New("b64s"), knownvalue.ListSizeExact(3)),
+					statecheck.ExpectKnownValue("random_id.test", tfjsonpath.New("b64s").AtSliceIndex(0), knownvalue.StringExact("web-wsK2J_D48Y4")),
+					statecheck.CompareValuePairs("random_id.test", tfjsonpath.New("hexs").AtSliceIndex(1), "random_id.test", tfjsonpath.New("hexs").AtSliceIndex(2), compare.ValuesDiffer()),
+				},
+			},
+			{
+				Config: `resource "random_id" "test" {
+							byte_length = 8
+							prefix      = "web-"
+							seed        = "web-server"
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_id.test", tfjsonpath.New("hex"), knownvalue.StringExact("web-c2c2b627f0f8f18e")),
+					statecheck.ExpectKnownValue("random_id.test", tfjsonpath.New("hexs"), knownvalue.Null()),
+					statecheck.ExpectKnownValue("random_id.test", tfjsonpath.New("b64s"), knownvalue.Null()),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceID_CountOutputs_Invalid(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_id" "test" {
+							byte_length   = 4096
+							seed          = "web-server"
+							count_outputs = 2
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute count_outputs multiplied by byte_length must be at most 8160 when\s+seed is set, got: 8192`),
+			},
+			{
+				Config: `resource "random_id" "test" {
+							byte_length   = 8
+							count_outputs = 0
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute count_outputs value must be at least 1`),
+			},
+			{
+				Config: `resource "random_id" "test" {
+							byte_length   = 8
+							count_outputs = 10001
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute count_outputs value must be at most 10000, got: 10001`),
+			},
+			{
+				Config: `resource "random_id" "test" {
+							byte_length   = 1024
+							count_outputs = 1025
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute count_outputs multiplied by byte_length must be at most 1048576,\s+got: 1049600`),
+			},
+		},
+	})
+}
+
+func TestAccResourceID_LifecycleMetadata(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_id" "test" {
+							byte_length = 4
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_id.test", tfjsonpath.New("created_at"), knownvalue.StringRegexp(regexp.MustCompile(`^\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}Z$`))),
+					statecheck.ExpectKnownValue("random_id.test", tfjsonpath.New("provider_version"), knownvalue.StringExact("test")),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceID_LifecycleMetadata_UpgradeFromVersion3_6_3(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				ExternalProviders: providerVersion363(),
+				Config: `resource "random_id" "test" {
+							byte_length = 4
+						}`,
+			},
+			{
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
+				Config: `resource "random_id" "test" {
+							byte_length = 4
+						}`,
+				PlanOnly: true,
+			},
+			{
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
+				Config: `resource "random_id" "test" {
+							byte_length = 4
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_id.test", tfjsonpath.New("created_at"), knownvalue.Null()),
+					statecheck.ExpectKnownValue("random_id.test", tfjsonpath.New("provider_version"), knownvalue.Null()),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceID_Identity(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_id" "test" {
+							byte_length = 4
+							prefix      = "cloud-"
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentity("random_id.test", map[string]knownvalue.Check{
+						"id":     knownvalue.NotNull(),
+						"prefix": knownvalue.StringExact("cloud-"),
+					}),
+					statecheck.ExpectIdentityValueMatchesState("random_id.test", tfjsonpath.New("id")),
+				},
+			},
+			{
+				ResourceName:    "random_id.test",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+		},
+	})
+}
@@ -5,11 +5,16 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -21,79 +26,52 @@ import (
 )
 
 var (
-	_ resource.Resource                = (*integerResource)(nil)
-	_ resource.ResourceWithImportState = (*integerResource)(nil)
+	_ resource.Resource                   = (*integerResource)(nil)
+	_ resource.ResourceWithConfigure      = (*integerResource)(nil)
+	_ resource.ResourceWithIdentity       = (*integerResource)(nil)
+	_ resource.ResourceWithImportState    = (*integerResource)(nil)
+	_ resource.ResourceWithModifyPlan     = (*integerResource)(nil)
+	_ resource.ResourceWithUpgradeState   = (*integerResource)(nil)
+	_ resource.ResourceWithValidateConfig = (*integerResource)(nil)
 )
 
 func NewIntegerResource() resource.Resource {
 	return &integerResource{}
 }
 
-type integerResource struct{}
+type integerResource struct {
+	providerVersion string
+	entropy         *random.Source
+}
 
 func (r *integerResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_integer"
+	// The identity includes the min and max values, which can be updated in-place
+	// when the result is within the new range.
+	resp.ResourceBehavior.MutableIdentity = true
 }
 
 func (r *integerResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		Description: "The resource `random_integer` generates random values from a given range, described " +
-			"by the `min` and `max` attributes of a given resource.\n" +
-			"\n" +
-			"This resource can be used in conjunction with resources that have the `create_before_destroy` " +
-			"lifecycle flag set, to avoid conflicts with unique names during the brief period where both the " +
-			"old and new resources exist concurrently.",
-		Attributes: map[string]schema.Attribute{
-			"keepers": schema.MapAttribute{
-				Description: "Arbitrary map of values that, when changed, will trigger recreation of " +
-					"resource. See [the main provider documentation](../index.html) for more information.",
-				ElementType: types.StringType,
-				Optional:    true,
-				PlanModifiers: []planmodifier.Map{
-					mapplanmodifiers.RequiresReplaceIfValuesNotNull(),
-				},
-			},
-			"min": schema.Int64Attribute{
-				Description: "The minimum inclusive value of the range.",
-				Required:    true,
-				PlanModifiers: []planmodifier.Int64{
-					int64planmodifier.RequiresReplace(),
-				},
-			},
-			"max": schema.Int64Attribute{
-				Description: "The maximum inclusive value of the range.",
-				Required:    true,
-				PlanModifiers: []planmodifier.Int64{
-					int64planmodifier.RequiresReplace(),
-				},
-			},
-			"seed": schema.StringAttribute{
-				Description: "A custom seed to always produce the same value.",
-				Optional:    true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
-			},
-			"result": schema.Int64Attribute{
-				Description: "The random integer result.",
-				Computed:    true,
-				PlanModifiers: []planmodifier.Int64{
-					int64planmodifier.UseStateForUnknown(),
-				},
-			},
-			"id": schema.StringAttribute{
-				Description: "The string representation of the integer result.",
-				Computed:    true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.UseStateForUnknown(),
-				},
-			},
-		},
-	}
+	resp.Schema = integerSchemaV1()
+}
+
+func (r *integerResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = integerIdentitySchema()
+}
+
+func (r *integerResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	r.providerVersion = providerVersion(req.ProviderData)
+	r.entropy = providerEntropy(req.ProviderData)
+}
+
+// ModifyPlan prevents the replacement of the resource when lifecycle_guard is
+// enabled, unless allow_regeneration_token is changed.
+func (r *integerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	guardRegeneration(ctx, req, resp)
 }
 
 func (r *integerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan integerModelV0
+	var plan integerModelV1
 
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -113,17 +91,84 @@ func (r *integerResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
-	rand := random.NewRand(seed)
-	number := rand.Intn((maxVal+1)-minVal) + minVal
+	if plan.AlgorithmVersion.IsUnknown() {
+		plan.AlgorithmVersion = types.Int64Value(integerAlgorithmVersion)
+	}
+
+	version := plan.AlgorithmVersion.ValueInt64()
+
+	done := logGeneration(ctx, sampledRandomSource(seed), map[string]any{
+		"min":               minVal,
+		"max":               maxVal,
+		"algorithm_version": version,
+	})
 
-	u := &integerModelV0{
-		ID:      types.StringValue(strconv.Itoa(number)),
-		Keepers: plan.Keepers,
-		Min:     types.Int64Value(int64(minVal)),
-		Max:     types.Int64Value(int64(maxVal)),
-		Result:  types.Int64Value(int64(number)),
+	number := 0
+	results := types.ListNull(types.Int64Type)
+
+	if plan.Quantity.IsNull() && plan.Exclude.IsNull() {
+		var err error
+
+		number, err = randomInteger(r.entropy, minVal, maxVal, seed, version)
+		if err != nil {
+			resp.Diagnostics.Append(entropyDiagnostics(err)...)
+			return
+		}
+	} else {
+		exclude, diags := plan.excludeIntervals(ctx)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		quantity := max(plan.Quantity.ValueInt64(), 1)
+		intervals := integerAllowedIntervals(int64(minVal), int64(maxVal), exclude)
+
+		generated, err := randomIntegers(r.entropy, intervals, quantity, plan.Unique.ValueBool(), seed, version)
+		if errors.Is(err, random.ErrEntropyUnavailable) {
+			resp.Diagnostics.Append(entropyDiagnostics(err)...)
+			return
+		}
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Create Random Integer Error",
+				"While attempting to generate the random integers, an error occurred.\n\n"+
+					fmt.Sprintf("Original Error: %s", err),
+			)
+			return
+		}
+
+		number = int(generated[0])
+
+		if !plan.Quantity.IsNull() {
+			results = integerResultsValue(generated)
+		}
+	}
+
+	done()
+
+	u := &integerModelV1{
+		ID:                     types.StringValue(strconv.Itoa(number)),
+		Keepers:                plan.Keepers,
+		KeepersHash:            plan.KeepersHash,
+		Min:                    types.Int64Value(int64(minVal)),
+		Max:                    types.Int64Value(int64(maxVal)),
+		Result:                 types.Int64Value(int64(number)),
+		Quantity:               plan.Quantity,
+		Unique:                 plan.Unique,
+		Exclude:                plan.Exclude,
+		Results:                results,
+		PadWidth:               plan.PadWidth,
+		ResultPadded:           integerPadded(int64(number), plan.PadWidth),
+		ResultHex:              integerHex(int64(number)),
+		ResultBase62:           integerBase62(int64(number)),
+		AlgorithmVersion:       plan.AlgorithmVersion,
+		LifecycleGuard:         plan.LifecycleGuard,
+		AllowRegenerationToken: plan.AllowRegenerationToken,
 	}
 
+	u.CreatedAt, u.ProviderVersion = lifecycleValues(r.providerVersion)
+
 	if seed != "" {
 		u.Seed = types.StringValue(seed)
 	} else {
@@ -135,15 +180,124 @@ func (r *integerResource) Create(ctx context.Context, req resource.CreateRequest
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, u.identity())...)
+}
+
+// ValidateConfig ensures that exclude does not exclude every integer in the range, and that there are enough integers
+// which are not excluded for the quantity when unique is true. Nothing is validated if any of the values are unknown,
+// as the configuration is validated again with known values during apply.
+func (r *integerResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config integerModelV1
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(algorithmVersionWarning(config.AlgorithmVersion, integerAlgorithmVersion)...)
+
+	if config.Min.IsNull() || config.Min.IsUnknown() || config.Max.IsNull() || config.Max.IsUnknown() ||
+		config.Quantity.IsUnknown() || config.Unique.IsUnknown() || config.Exclude.IsUnknown() {
+		return
+	}
+
+	for _, element := range config.Exclude.Elements() {
+		if element.IsUnknown() {
+			return
+		}
+	}
+
+	exclude, diags := config.excludeIntervals(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || config.Min.ValueInt64() > config.Max.ValueInt64() {
+		return
+	}
+
+	count, ok := integerCount(integerAllowedIntervals(config.Min.ValueInt64(), config.Max.ValueInt64(), exclude))
+	if !ok {
+		return
+	}
+
+	if count == 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("exclude"),
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute exclude must not exclude every integer between min (%d) and max (%d).",
+				config.Min.ValueInt64(), config.Max.ValueInt64()),
+		)
+		return
+	}
+
+	if config.Unique.ValueBool() && config.Quantity.ValueInt64() > count {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("quantity"),
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute quantity value must be at most the number of integers between min and max which "+
+				"are not excluded (%d) when unique is true, got: %d", count, config.Quantity.ValueInt64()),
+		)
+	}
 }
 
-// Read does not need to perform any operations as the state in ReadResourceResponse is already populated.
+// Read does not need to refresh the state as the state in ReadResourceResponse is already populated, other than to
+// populate result_padded, algorithm_version, result_hex, result_base62 and keepers_hash for resources created by earlier provider versions. The identity is set
+// from state, as those resources also do not have an identity.
 func (r *integerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var model integerModelV1
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if model.ResultPadded.IsNull() {
+		logRefresh(ctx, "result_padded")
+
+		model.ResultPadded = integerPadded(model.Result.ValueInt64(), model.PadWidth)
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if model.AlgorithmVersion.IsNull() {
+		logRefresh(ctx, "algorithm_version")
+
+		model.AlgorithmVersion = types.Int64Value(integerLegacyAlgorithmVersion)
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if model.ResultHex.IsNull() || model.ResultBase62.IsNull() {
+		logRefresh(ctx, "result_hex", "result_base62")
+
+		model.ResultHex = integerHex(model.Result.ValueInt64())
+		model.ResultBase62 = integerBase62(model.Result.ValueInt64())
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(refreshKeepersHash(ctx, &resp.State)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, model.identity())...)
 }
 
-// Update ensures the plan value is copied to the state to complete the update.
+// Update ensures the plan value is copied to the state to complete the update, padding the result if pad_width was
+// not known when planned, and recording the algorithm version and encodings of the result of resources whose state
+// was not refreshed since upgrading from an earlier provider version. The identity is also updated, as the min and max values can change in-place when the
+// result is within the new range.
 func (r *integerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var model integerModelV0
+	var model integerModelV1
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
 
@@ -151,7 +305,29 @@ func (r *integerResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	if model.ResultPadded.IsUnknown() {
+		model.ResultPadded = integerPadded(model.Result.ValueInt64(), model.PadWidth)
+	}
+
+	if model.AlgorithmVersion.IsUnknown() {
+		model.AlgorithmVersion = types.Int64Value(integerLegacyAlgorithmVersion)
+	}
+
+	if model.ResultHex.IsUnknown() {
+		model.ResultHex = integerHex(model.Result.ValueInt64())
+	}
+
+	if model.ResultBase62.IsUnknown() {
+		model.ResultBase62 = integerBase62(model.Result.ValueInt64())
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, model.identity())...)
 }
 
 // Delete does not need to explicitly call resp.State.RemoveResource() as this is automatically handled by the
@@ -161,6 +337,28 @@ func (r *integerResource) Delete(ctx context.Context, req resource.DeleteRequest
 
 func (r *integerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	parts := strings.Split(req.ID, ",")
+
+	// When importing by identity, the identity values are used in place of
+	// the comma-separated import identifier.
+	if req.ID == "" {
+		var identity integerIdentityModel
+
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		parts = []string{
+			strconv.FormatInt(identity.Result.ValueInt64(), 10),
+			strconv.FormatInt(identity.Min.ValueInt64(), 10),
+			strconv.FormatInt(identity.Max.ValueInt64(), 10),
+		}
+
+		if !identity.Seed.IsNull() {
+			parts = append(parts, identity.Seed.ValueString())
+		}
+	}
+
 	if len(parts) != 3 && len(parts) != 4 {
 		resp.Diagnostics.AddError(
 			"Import Random Integer Error",
@@ -199,13 +397,32 @@ func (r *integerResource) ImportState(ctx context.Context, req resource.ImportSt
 		return
 	}
 
-	var state integerModelV0
+	logImport(ctx, map[string]any{
+		"min": minVal,
+		"max": maxVal,
+	})
+
+	var state integerModelV1
 
 	state.ID = types.StringValue(parts[0])
 	state.Keepers = types.MapNull(types.StringType)
+	state.KeepersHash = keepersHash(state.Keepers)
 	state.Result = types.Int64Value(result)
 	state.Min = types.Int64Value(minVal)
 	state.Max = types.Int64Value(maxVal)
+	state.Quantity = types.Int64Null()
+	state.Unique = types.BoolNull()
+	state.Exclude = types.ListNull(types.StringType)
+	state.Results = types.ListNull(types.Int64Type)
+	state.PadWidth = types.Int64Null()
+	state.ResultPadded = integerPadded(result, state.PadWidth)
+	state.ResultHex = integerHex(result)
+	state.ResultBase62 = integerBase62(result)
+	state.AlgorithmVersion = types.Int64Null()
+	state.CreatedAt = types.StringNull()
+	state.ProviderVersion = types.StringNull()
+	state.LifecycleGuard = types.BoolNull()
+	state.AllowRegenerationToken = types.StringNull()
 
 	if len(parts) == 4 {
 		state.Seed = types.StringValue(parts[3])
@@ -216,13 +433,332 @@ func (r *integerResource) ImportState(ctx context.Context, req resource.ImportSt
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, state.identity())...)
+}
+
+func (r *integerResource) UpgradeState(context.Context) map[int64]resource.StateUpgrader {
+	schemaV0 := integerSchemaV0()
+
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema:   &schemaV0,
+			StateUpgrader: upgradeIntegerStateV0toV1,
+		},
+	}
+}
+
+func upgradeIntegerStateV0toV1(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	logStateUpgrade(ctx, 0, 1)
+
+	type modelV0 struct {
+		ID      types.String `tfsdk:"id"`
+		Keepers types.Map    `tfsdk:"keepers"`
+		Min     types.Int64  `tfsdk:"min"`
+		Max     types.Int64  `tfsdk:"max"`
+		Seed    types.String `tfsdk:"seed"`
+		Result  types.Int64  `tfsdk:"result"`
+	}
+
+	var integerDataV0 modelV0
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &integerDataV0)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The creation time and provider version of resources created prior to
+	// schema version 1 are not known, so they are left as null.
+	integerDataV1 := integerModelV1{
+		ID:                     integerDataV0.ID,
+		Keepers:                integerDataV0.Keepers,
+		Min:                    integerDataV0.Min,
+		Max:                    integerDataV0.Max,
+		Seed:                   integerDataV0.Seed,
+		Result:                 integerDataV0.Result,
+		Quantity:               types.Int64Null(),
+		Unique:                 types.BoolNull(),
+		Exclude:                types.ListNull(types.StringType),
+		Results:                types.ListNull(types.Int64Type),
+		PadWidth:               types.Int64Null(),
+		ResultPadded:           types.StringNull(),
+		ResultHex:              types.StringNull(),
+		ResultBase62:           types.StringNull(),
+		AlgorithmVersion:       types.Int64Null(),
+		KeepersHash:            types.StringNull(),
+		CreatedAt:              types.StringNull(),
+		ProviderVersion:        types.StringNull(),
+		LifecycleGuard:         types.BoolNull(),
+		AllowRegenerationToken: types.StringNull(),
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, integerDataV1)...)
+}
+
+// requiresReplaceIfResultOutsideRange returns a plan modifier for the min and max attributes, which only requires
+// replacement when the prior result, or any of the prior results, does not fall within the planned range. Resources with a seed are always
+// replaced, as the result is expected to be the one produced by the seed for the range.
+func requiresReplaceIfResultOutsideRange() planmodifier.Int64 {
+	return int64planmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.Int64Request, resp *int64planmodifier.RequiresReplaceIfFuncResponse) {
+			var state integerModelV1
+
+			resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+			var minVal, maxVal types.Int64
+
+			resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("min"), &minVal)...)
+			resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("max"), &maxVal)...)
+
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			if !state.Seed.IsNull() || minVal.IsUnknown() || maxVal.IsUnknown() || state.Result.IsNull() {
+				resp.RequiresReplace = true
+				return
+			}
+
+			for _, result := range append([]attr.Value{state.Result}, state.Results.Elements()...) {
+				value := result.(types.Int64).ValueInt64()
+
+				if value < minVal.ValueInt64() || value > maxVal.ValueInt64() {
+					resp.RequiresReplace = true
+					return
+				}
+			}
+		},
+		"If the value of this attribute changes, Terraform will destroy and recreate the resource if the "+
+			"existing result is outside of the new range.",
+		"If the value of this attribute changes, Terraform will destroy and recreate the resource if the "+
+			"existing result is outside of the new range.",
+	)
+}
+
+// randomInteger returns a random integer in the inclusive range between the
+// minimum and maximum values, which always has the same value for the same
+// non-empty seed and algorithm version. Without a seed, the integer is chosen
+// by rejection sampling from the given source of entropy.
+func randomInteger(entropy *random.Source, minVal, maxVal int, seed string, version int64) (int, error) {
+	rand, err := newIntegerRand(entropy, seed, version)
+	if err != nil {
+		return 0, err
+	}
+
+	// The generator of math/rand chooses integers which fit in 31 bits
+	// differently with Intn than with Int63n, which version 1 used.
+	if rand, ok := rand.(interface{ Intn(n int) int }); ok {
+		return rand.Intn((maxVal+1)-minVal) + minVal, nil
+	}
+
+	result := int(rand.Int63n(int64((maxVal+1)-minVal))) + minVal
+
+	if err := integerRandErr(rand); err != nil {
+		return 0, err
+	}
+
+	return result, nil
+}
+
+func integerSchemaV1() schema.Schema {
+	return schema.Schema{
+		Version: 1,
+		Description: "The resource `random_integer` generates random values from a given range, described " +
+			"by the `min` and `max` attributes of a given resource.\n" +
+			"\n" +
+			"This resource can be used in conjunction with resources that have the `create_before_destroy` " +
+			"lifecycle flag set, to avoid conflicts with unique names during the brief period where both the " +
+			"old and new resources exist concurrently.",
+		Attributes: map[string]schema.Attribute{
+			"keepers": schema.MapAttribute{
+				Description: "Arbitrary map of values that, when changed, will trigger recreation of " +
+					"resource. See [the main provider documentation](../index.html) for more information.",
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifiers.RequiresReplaceIfValuesNotNull(),
+				},
+			},
+			"min": schema.Int64Attribute{
+				Description: "The minimum inclusive value of the range. Changing this value only forces a new " +
+					"result to be generated if `seed` is set or the existing result is outside of the new range.",
+				Required: true,
+				PlanModifiers: []planmodifier.Int64{
+					requiresReplaceIfResultOutsideRange(),
+				},
+			},
+			"max": schema.Int64Attribute{
+				Description: "The maximum inclusive value of the range. Changing this value only forces a new " +
+					"result to be generated if `seed` is set or the existing result is outside of the new range.",
+				Required: true,
+				PlanModifiers: []planmodifier.Int64{
+					requiresReplaceIfResultOutsideRange(),
+				},
+			},
+			"seed": schema.StringAttribute{
+				Description: "A custom seed to always produce the same value. From `algorithm_version` `2`, " +
+					"seeded results are generated with SplitMix64, whose state is initialised with the first 8 " +
+					"bytes, read as a big-endian integer, of the SHA-256 digest of the seed, and integers are " +
+					"chosen from its output by rejection sampling, so that they do not depend on the Go " +
+					"standard library.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"result": schema.Int64Attribute{
+				Description: "The random integer result.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"quantity":                 quantityAttribute(),
+			"unique":                   uniqueAttribute(),
+			"exclude":                  excludeAttribute(),
+			"results":                  resultsAttribute(),
+			"pad_width":                padWidthAttribute(),
+			"result_padded":            resultPaddedAttribute(),
+			"result_hex":               resultHexAttribute(),
+			"result_base62":            resultBase62Attribute(),
+			"algorithm_version":        algorithmVersionAttribute(integerAlgorithmVersion),
+			"keepers_hash":             keepersHashAttribute(),
+			"created_at":               createdAtAttribute(),
+			"provider_version":         providerVersionAttribute(),
+			"lifecycle_guard":          lifecycleGuardAttribute(),
+			"allow_regeneration_token": allowRegenerationTokenAttribute(),
+			"id": schema.StringAttribute{
+				Description: "The string representation of the integer result.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func integerSchemaV0() schema.Schema {
+	return schema.Schema{
+		Description: "The resource `random_integer` generates random values from a given range, described " +
+			"by the `min` and `max` attributes of a given resource.\n" +
+			"\n" +
+			"This resource can be used in conjunction with resources that have the `create_before_destroy` " +
+			"lifecycle flag set, to avoid conflicts with unique names during the brief period where both the " +
+			"old and new resources exist concurrently.",
+		Attributes: map[string]schema.Attribute{
+			"keepers": schema.MapAttribute{
+				Description: "Arbitrary map of values that, when changed, will trigger recreation of " +
+					"resource. See [the main provider documentation](../index.html) for more information.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"min": schema.Int64Attribute{
+				Description: "The minimum inclusive value of the range.",
+				Required:    true,
+			},
+			"max": schema.Int64Attribute{
+				Description: "The maximum inclusive value of the range.",
+				Required:    true,
+			},
+			"seed": schema.StringAttribute{
+				Description: "A custom seed to always produce the same value.",
+				Optional:    true,
+			},
+			"result": schema.Int64Attribute{
+				Description: "The random integer result.",
+				Computed:    true,
+			},
+			"id": schema.StringAttribute{
+				Description: "The string representation of the integer result.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+type integerModelV1 struct {
+	ID                     types.String `tfsdk:"id"`
+	Keepers                types.Map    `tfsdk:"keepers"`
+	KeepersHash            types.String `tfsdk:"keepers_hash"`
+	Min                    types.Int64  `tfsdk:"min"`
+	Max                    types.Int64  `tfsdk:"max"`
+	Seed                   types.String `tfsdk:"seed"`
+	Result                 types.Int64  `tfsdk:"result"`
+	Quantity               types.Int64  `tfsdk:"quantity"`
+	Unique                 types.Bool   `tfsdk:"unique"`
+	Exclude                types.List   `tfsdk:"exclude"`
+	Results                types.List   `tfsdk:"results"`
+	PadWidth               types.Int64  `tfsdk:"pad_width"`
+	ResultPadded           types.String `tfsdk:"result_padded"`
+	ResultHex              types.String `tfsdk:"result_hex"`
+	ResultBase62           types.String `tfsdk:"result_base62"`
+	AlgorithmVersion       types.Int64  `tfsdk:"algorithm_version"`
+	CreatedAt              types.String `tfsdk:"created_at"`
+	ProviderVersion        types.String `tfsdk:"provider_version"`
+	LifecycleGuard         types.Bool   `tfsdk:"lifecycle_guard"`
+	AllowRegenerationToken types.String `tfsdk:"allow_regeneration_token"`
+}
+
+// excludeIntervals returns the intervals of the integers of exclude, with an
+// error diagnostic if any element is not valid.
+func (m integerModelV1) excludeIntervals(ctx context.Context) ([]integerInterval, diag.Diagnostics) {
+	var exclude []string
+
+	diags := m.Exclude.ElementsAs(ctx, &exclude, true)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	intervals, err := parseIntegerExclude(exclude)
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("exclude"),
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute exclude is not valid: %s.", err),
+		)
+	}
+
+	return intervals, diags
+}
+
+// identity returns the resource identity, which contains the values required
+// to import the resource.
+func (m integerModelV1) identity() integerIdentityModel {
+	return integerIdentityModel{
+		Result: m.Result,
+		Min:    m.Min,
+		Max:    m.Max,
+		Seed:   m.Seed,
+	}
+}
+
+func integerIdentitySchema() identityschema.Schema {
+	return identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"result": identityschema.Int64Attribute{
+				Description:       "The random integer result.",
+				RequiredForImport: true,
+			},
+			"min": identityschema.Int64Attribute{
+				Description:       "The minimum inclusive value of the range.",
+				RequiredForImport: true,
+			},
+			"max": identityschema.Int64Attribute{
+				Description:       "The maximum inclusive value of the range.",
+				RequiredForImport: true,
+			},
+			"seed": identityschema.StringAttribute{
+				Description:       "A custom seed to always produce the same value.",
+				OptionalForImport: true,
+			},
+		},
+	}
 }
 
-type integerModelV0 struct {
-	ID      types.String `tfsdk:"id"`
-	Keepers types.Map    `tfsdk:"keepers"`
-	Min     types.Int64  `tfsdk:"min"`
-	Max     types.Int64  `tfsdk:"max"`
-	Seed    types.String `tfsdk:"seed"`
-	Result  types.Int64  `tfsdk:"result"`
+type integerIdentityModel struct {
+	Result types.Int64  `tfsdk:"result"`
+	Min    types.Int64  `tfsdk:"min"`
+	Max    types.Int64  `tfsdk:"max"`
+	Seed   types.String `tfsdk:"seed"`
 }
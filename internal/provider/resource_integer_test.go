@@ -5,20 +5,123 @@ package provider
 
 import (
 	"fmt"
+	"math/rand"
+	"regexp"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-testing/compare"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+
+	"github.com/terraform-providers/terraform-provider-random/internal/random"
+	"github.com/terraform-providers/terraform-provider-random/internal/randomtest"
 )
 
+// TestRandomInteger_Distribution guards against bias in the generated
+// integers, both for distinct seeds and for the generator seeded from the
+// source of entropy.
+func TestRandomInteger_Distribution(t *testing.T) {
+	t.Parallel()
+
+	for version := int64(1); version <= integerAlgorithmVersion; version++ {
+		t.Run(fmt.Sprintf("seeded-v%d", version), func(t *testing.T) {
+			t.Parallel()
+
+			observed := make([]int, 6)
+
+			for i := 0; i < 6000; i++ {
+				result, err := randomInteger(nil, 1, 6, fmt.Sprintf("seed-%d", i), version)
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				observed[result-1]++
+			}
+
+			if err := randomtest.ChiSquaredUniform(observed); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+
+	t.Run("unseeded", func(t *testing.T) {
+		t.Parallel()
+
+		entropy := random.NewSource(rand.New(rand.NewSource(1)))
+		observed := make([]int, 7)
+
+		for i := 0; i < 7000; i++ {
+			result, err := randomInteger(entropy, -3, 3, "", integerAlgorithmVersion)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			observed[result+3]++
+		}
+
+		if err := randomtest.ChiSquaredUniform(observed); err != nil {
+			t.Error(err)
+		}
+	})
+}
+
+// TestRandomInteger_AlgorithmVersion guards against changes to the seeded
+// results of each algorithm version, which must remain reproducible.
+func TestRandomInteger_AlgorithmVersion(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		version         int64
+		expectedInteger int
+		expectedResults []int64
+	}{
+		"version-1": {
+			version:         1,
+			expectedInteger: 36,
+			expectedResults: []int64{59, 18, 1, 68, 83},
+		},
+		"version-2": {
+			version:         2,
+			expectedInteger: 58,
+			expectedResults: []int64{41, 86, 22, 66, 94},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := randomInteger(nil, 1, 100, "reproducible", testCase.version)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.expectedInteger {
+				t.Errorf("expected integer %d, got %d", testCase.expectedInteger, got)
+			}
+
+			results, err := randomIntegers(nil, []integerInterval{{min: 1, max: 100}}, 5, true, "reproducible", testCase.version)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(testCase.expectedResults, results); diff != "" {
+				t.Errorf("unexpected results (-expected +got):\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestAccResourceInteger(t *testing.T) {
 	t.Parallel()
 	resource.UnitTest(t, resource.TestCase{
-		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: `resource "random_integer" "integer_1" {
@@ -31,10 +134,11 @@ func TestAccResourceInteger(t *testing.T) {
 				},
 			},
 			{
-				ResourceName:      "random_integer.integer_1",
-				ImportState:       true,
-				ImportStateId:     "3,1,3,12345",
-				ImportStateVerify: true,
+				ResourceName:            "random_integer.integer_1",
+				ImportState:             true,
+				ImportStateId:           "3,1,3,12345",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"created_at", "provider_version", "algorithm_version"},
 			},
 		},
 	})
@@ -42,7 +146,7 @@ func TestAccResourceInteger(t *testing.T) {
 
 func TestAccResourceInteger_ImportWithoutKeepersProducesNoPlannedChanges(t *testing.T) {
 	resource.UnitTest(t, resource.TestCase{
-		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: `resource "random_integer" "integer_1" {
@@ -70,7 +174,7 @@ func TestAccResourceInteger_ImportWithoutKeepersProducesNoPlannedChanges(t *test
 func TestAccResourceInteger_ChangeSeed(t *testing.T) {
 	t.Parallel()
 	resource.UnitTest(t, resource.TestCase{
-		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: `resource "random_integer" "integer_1" {
@@ -99,7 +203,7 @@ func TestAccResourceInteger_ChangeSeed(t *testing.T) {
 func TestAccResourceInteger_SeedlessToSeeded(t *testing.T) {
 	t.Parallel()
 	resource.UnitTest(t, resource.TestCase{
-		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: `resource "random_integer" "integer_1" {
@@ -127,7 +231,7 @@ func TestAccResourceInteger_SeedlessToSeeded(t *testing.T) {
 func TestAccResourceInteger_SeededToSeedless(t *testing.T) {
 	t.Parallel()
 	resource.UnitTest(t, resource.TestCase{
-		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: `resource "random_integer" "integer_1" {
@@ -155,7 +259,7 @@ func TestAccResourceInteger_SeededToSeedless(t *testing.T) {
 func TestAccResourceInteger_Big(t *testing.T) {
 	t.Parallel()
 	resource.UnitTest(t, resource.TestCase{
-		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: `resource "random_integer" "integer_1" {
@@ -165,10 +269,11 @@ func TestAccResourceInteger_Big(t *testing.T) {
 						}`,
 			},
 			{
-				ResourceName:      "random_integer.integer_1",
-				ImportState:       true,
-				ImportStateId:     "7227701560655103598,7227701560655103597,7227701560655103598,12345",
-				ImportStateVerify: true,
+				ResourceName:            "random_integer.integer_1",
+				ImportState:             true,
+				ImportStateId:           "7227701560655103598,7227701560655103597,7227701560655103598,12345",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"created_at", "provider_version", "algorithm_version"},
 			},
 		},
 	})
@@ -189,7 +294,7 @@ func TestAccResourceInteger_UpgradeFromVersion3_3_2(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_integer" "integer_1" {
    							min  = 1
 							max  = 3
@@ -198,7 +303,7 @@ func TestAccResourceInteger_UpgradeFromVersion3_3_2(t *testing.T) {
 				PlanOnly: true,
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_integer" "integer_1" {
    							min  = 1
 							max  = 3
@@ -219,7 +324,7 @@ func TestAccResourceInteger_Keepers_Keep_EmptyMap(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_integer" "test" {
 					min = 1
 					max = 100000000
@@ -231,7 +336,7 @@ func TestAccResourceInteger_Keepers_Keep_EmptyMap(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_integer" "test" {
 					min = 1
 					max = 100000000
@@ -253,7 +358,7 @@ func TestAccResourceInteger_Keepers_Keep_EmptyMapToNullValue(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_integer" "test" {
 					min = 1
 					max = 100000000
@@ -265,7 +370,7 @@ func TestAccResourceInteger_Keepers_Keep_EmptyMapToNullValue(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_integer" "test" {
 					min = 1
 					max = 100000000
@@ -289,7 +394,7 @@ func TestAccResourceInteger_Keepers_Keep_NullMap(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_integer" "test" {
 					min = 1
 					max = 100000000
@@ -300,7 +405,7 @@ func TestAccResourceInteger_Keepers_Keep_NullMap(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_integer" "test" {
 					min = 1
 					max = 100000000
@@ -321,7 +426,7 @@ func TestAccResourceInteger_Keepers_Keep_NullMapToNullValue(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_integer" "test" {
 					min = 1
 					max = 100000000
@@ -332,7 +437,7 @@ func TestAccResourceInteger_Keepers_Keep_NullMapToNullValue(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_integer" "test" {
 					min = 1
 					max = 100000000
@@ -356,7 +461,7 @@ func TestAccResourceInteger_Keepers_Keep_NullValue(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_integer" "test" {
 					min = 1
 					max = 100000000
@@ -370,7 +475,7 @@ func TestAccResourceInteger_Keepers_Keep_NullValue(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_integer" "test" {
 					min = 1
 					max = 100000000
@@ -394,7 +499,7 @@ func TestAccResourceInteger_Keepers_Keep_NullValues(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_integer" "test" {
 					min = 1
 					max = 100000000
@@ -409,7 +514,7 @@ func TestAccResourceInteger_Keepers_Keep_NullValues(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_integer" "test" {
 					min = 1
 					max = 100000000
@@ -434,7 +539,7 @@ func TestAccResourceInteger_Keepers_Keep_Value(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_integer" "test" {
 					min = 1
 					max = 100000000
@@ -448,7 +553,7 @@ func TestAccResourceInteger_Keepers_Keep_Value(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_integer" "test" {
 					min = 1
 					max = 100000000
@@ -472,7 +577,7 @@ func TestAccResourceInteger_Keepers_Keep_Values(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_integer" "test" {
 					min = 1
 					max = 100000000
@@ -487,7 +592,7 @@ func TestAccResourceInteger_Keepers_Keep_Values(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_integer" "test" {
 					min = 1
 					max = 100000000
@@ -512,7 +617,7 @@ func TestAccResourceInteger_Keepers_Replace_EmptyMapToValue(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_integer" "test" {
 					min = 1
 					max = 100000000
@@ -524,7 +629,7 @@ func TestAccResourceInteger_Keepers_Replace_EmptyMapToValue(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_integer" "test" {
 					min = 1
 					max = 100000000
@@ -548,7 +653,7 @@ func TestAccResourceInteger_Keepers_Replace_NullMapToValue(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_integer" "test" {
 					min = 1
 					max = 100000000
@@ -559,7 +664,7 @@ func TestAccResourceInteger_Keepers_Replace_NullMapToValue(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_integer" "test" {
 					min = 1
 					max = 100000000
@@ -583,7 +688,7 @@ func TestAccResourceInteger_Keepers_Replace_NullValueToValue(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_integer" "test" {
 					min = 1
 					max = 100000000
@@ -597,7 +702,7 @@ func TestAccResourceInteger_Keepers_Replace_NullValueToValue(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_integer" "test" {
 					min = 1
 					max = 100000000
@@ -621,7 +726,7 @@ func TestAccResourceInteger_Keepers_Replace_ValueToEmptyMap(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_integer" "test" {
 					min = 1
 					max = 100000000
@@ -635,7 +740,7 @@ func TestAccResourceInteger_Keepers_Replace_ValueToEmptyMap(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_integer" "test" {
 					min = 1
 					max = 100000000
@@ -657,7 +762,7 @@ func TestAccResourceInteger_Keepers_Replace_ValueToNullMap(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_integer" "test" {
 					min = 1
 					max = 100000000
@@ -671,7 +776,7 @@ func TestAccResourceInteger_Keepers_Replace_ValueToNullMap(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_integer" "test" {
 					min = 1
 					max = 100000000
@@ -692,7 +797,7 @@ func TestAccResourceInteger_Keepers_Replace_ValueToNullValue(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_integer" "test" {
 					min = 1
 					max = 100000000
@@ -706,7 +811,7 @@ func TestAccResourceInteger_Keepers_Replace_ValueToNullValue(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_integer" "test" {
 					min = 1
 					max = 100000000
@@ -730,7 +835,7 @@ func TestAccResourceInteger_Keepers_Replace_ValueToNewValue(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_integer" "test" {
 					min = 1
 					max = 100000000
@@ -744,7 +849,7 @@ func TestAccResourceInteger_Keepers_Replace_ValueToNewValue(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_integer" "test" {
 					min = 1
 					max = 100000000
@@ -782,7 +887,7 @@ func TestAccResourceInteger_Keepers_FrameworkMigration_NullMapToNullValue(t *tes
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_integer" "test" {
 					min = 1
 					max = 100000000
@@ -820,7 +925,7 @@ func TestAccResourceInteger_Keepers_FrameworkMigration_NullMapToValue(t *testing
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_integer" "test" {
 					min = 1
 					max = 100000000
@@ -859,7 +964,7 @@ func TestAccResourceInteger_Keepers_FrameworkMigration_NullMapToMultipleNullValu
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_integer" "test" {
 					min = 1
 					max = 100000000
@@ -899,7 +1004,7 @@ func TestAccResourceInteger_Keepers_FrameworkMigration_NullMapToMultipleValue(t
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_integer" "test" {
 					min = 1
 					max = 100000000
@@ -939,7 +1044,7 @@ func TestAccResourceInteger_Keepers_FrameworkMigration_NullMapValue(t *testing.T
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_integer" "test" {
 					min = 1
 					max = 100000000
@@ -979,7 +1084,7 @@ func TestAccResourceInteger_Keepers_FrameworkMigration_NullMapValueToValue(t *te
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_integer" "test" {
 					min = 1
 					max = 100000000
@@ -1035,3 +1140,497 @@ func testStringValue(sPtr *string) string {
 
 	return *sPtr
 }
+
+func TestAccResourceInteger_Range_Keep_ResultInRange(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_integer" "test" {
+							min = 5
+							max = 5
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_integer.test", tfjsonpath.New("result"), knownvalue.Int64Exact(5)),
+				},
+			},
+			{
+				Config: `resource "random_integer" "test" {
+							min = 1
+							max = 10
+						}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("random_integer.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_integer.test", tfjsonpath.New("result"), knownvalue.Int64Exact(5)),
+					statecheck.ExpectKnownValue("random_integer.test", tfjsonpath.New("id"), knownvalue.StringExact("5")),
+					statecheck.ExpectKnownValue("random_integer.test", tfjsonpath.New("min"), knownvalue.Int64Exact(1)),
+					statecheck.ExpectKnownValue("random_integer.test", tfjsonpath.New("max"), knownvalue.Int64Exact(10)),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceInteger_Range_Replace_ResultOutsideRange(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_integer" "test" {
+							min = 5
+							max = 5
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_integer.test", tfjsonpath.New("result"), knownvalue.Int64Exact(5)),
+				},
+			},
+			{
+				Config: `resource "random_integer" "test" {
+							min = 6
+							max = 6
+						}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("random_integer.test", plancheck.ResourceActionReplace),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_integer.test", tfjsonpath.New("result"), knownvalue.Int64Exact(6)),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceInteger_Range_Replace_Seeded(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_integer" "test" {
+							min  = 1
+							max  = 3
+							seed = "12345"
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_integer.test", tfjsonpath.New("result"), knownvalue.Int64Exact(3)),
+				},
+			},
+			{
+				Config: `resource "random_integer" "test" {
+							min  = 1
+							max  = 4
+							seed = "12345"
+						}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("random_integer.test", plancheck.ResourceActionReplace),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceInteger_Range_Identity(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_integer" "test" {
+							min = 5
+							max = 5
+						}`,
+			},
+			{
+				Config: `resource "random_integer" "test" {
+							min = 1
+							max = 10
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentity("random_integer.test", map[string]knownvalue.Check{
+						"result": knownvalue.Int64Exact(5),
+						"min":    knownvalue.Int64Exact(1),
+						"max":    knownvalue.Int64Exact(10),
+						"seed":   knownvalue.Null(),
+					}),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceInteger_LifecycleMetadata(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_integer" "test" {
+							min = 1
+							max = 3
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_integer.test", tfjsonpath.New("created_at"), knownvalue.StringRegexp(regexp.MustCompile(`^\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}Z$`))),
+					statecheck.ExpectKnownValue("random_integer.test", tfjsonpath.New("provider_version"), knownvalue.StringExact("test")),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceInteger_LifecycleMetadata_UpgradeFromVersion3_6_3(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				ExternalProviders: providerVersion363(),
+				Config: `resource "random_integer" "test" {
+							min = 1
+							max = 3
+						}`,
+			},
+			{
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
+				Config: `resource "random_integer" "test" {
+							min = 1
+							max = 3
+						}`,
+				PlanOnly: true,
+			},
+			{
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
+				Config: `resource "random_integer" "test" {
+							min = 1
+							max = 3
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_integer.test", tfjsonpath.New("created_at"), knownvalue.Null()),
+					statecheck.ExpectKnownValue("random_integer.test", tfjsonpath.New("provider_version"), knownvalue.Null()),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceInteger_Identity(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_integer" "test" {
+							min  = 1
+							max  = 3
+							seed = "12345"
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentity("random_integer.test", map[string]knownvalue.Check{
+						"result": knownvalue.Int64Exact(3),
+						"min":    knownvalue.Int64Exact(1),
+						"max":    knownvalue.Int64Exact(3),
+						"seed":   knownvalue.StringExact("12345"),
+					}),
+				},
+			},
+			{
+				ResourceName:    "random_integer.test",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+		},
+	})
+}
+
+func TestAccResourceInteger_AlgorithmVersion(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_integer" "test" {
+							min  = 1
+							max  = 100
+							seed = "reproducible"
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_integer.test", tfjsonpath.New("algorithm_version"), knownvalue.Int64Exact(integerAlgorithmVersion)),
+					statecheck.ExpectKnownValue("random_integer.test", tfjsonpath.New("result"), knownvalue.Int64Exact(58)),
+				},
+			},
+			{
+				// Setting the version recorded in state does not replace the resource.
+				Config: fmt.Sprintf(`resource "random_integer" "test" {
+							min               = 1
+							max               = 100
+							seed              = "reproducible"
+							algorithm_version = %d
+						}`, integerAlgorithmVersion),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("random_integer.test", plancheck.ResourceActionNoop),
+					},
+				},
+			},
+			{
+				Config: fmt.Sprintf(`resource "random_integer" "test" {
+							min               = 1
+							max               = 100
+							seed              = "reproducible"
+							algorithm_version = %d
+						}`, integerLegacyAlgorithmVersion),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("random_integer.test", plancheck.ResourceActionReplace),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_integer.test", tfjsonpath.New("algorithm_version"), knownvalue.Int64Exact(integerLegacyAlgorithmVersion)),
+					statecheck.ExpectKnownValue("random_integer.test", tfjsonpath.New("result"), knownvalue.Int64Exact(36)),
+				},
+			},
+			{
+				Config: fmt.Sprintf(`resource "random_integer" "test" {
+							min               = 1
+							max               = 100
+							algorithm_version = %d
+						}`, integerAlgorithmVersion+1),
+				ExpectError: regexp.MustCompile(`Attribute algorithm_version value must be between 1 and`),
+			},
+		},
+	})
+}
+
+func TestAccResourceInteger_ResultPadded(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_integer" "test" {
+							min = 7
+							max = 7
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_integer.test", tfjsonpath.New("result_padded"), knownvalue.StringExact("7")),
+				},
+			},
+			{
+				Config: `resource "random_integer" "test" {
+							min       = 7
+							max       = 7
+							pad_width = 3
+						}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("random_integer.test", plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue("random_integer.test", tfjsonpath.New("result_padded"), knownvalue.StringExact("007")),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_integer.test", tfjsonpath.New("result"), knownvalue.Int64Exact(7)),
+					statecheck.ExpectKnownValue("random_integer.test", tfjsonpath.New("result_padded"), knownvalue.StringExact("007")),
+				},
+			},
+			{
+				Config: `resource "random_integer" "test" {
+							min       = -7
+							max       = -7
+							pad_width = 3
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_integer.test", tfjsonpath.New("result_padded"), knownvalue.StringExact("-07")),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceInteger_ResultPadded_UpgradeFromVersion3_6_3(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				ExternalProviders: providerVersion363(),
+				Config: `resource "random_integer" "test" {
+							min = 5
+							max = 5
+						}`,
+			},
+			{
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
+				Config: `resource "random_integer" "test" {
+							min       = 5
+							max       = 5
+							pad_width = 2
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_integer.test", tfjsonpath.New("result_padded"), knownvalue.StringExact("05")),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceInteger_ResultEncodings(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_integer" "test" {
+							min = 500
+							max = 500
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_integer.test", tfjsonpath.New("result_hex"), knownvalue.StringExact("1f4")),
+					statecheck.ExpectKnownValue("random_integer.test", tfjsonpath.New("result_base62"), knownvalue.StringExact("84")),
+				},
+			},
+			{
+				Config: `resource "random_integer" "test" {
+							min = 0
+							max = 1000
+						}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("random_integer.test", plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue("random_integer.test", tfjsonpath.New("result_hex"), knownvalue.StringExact("1f4")),
+						plancheck.ExpectKnownValue("random_integer.test", tfjsonpath.New("result_base62"), knownvalue.StringExact("84")),
+					},
+				},
+			},
+			{
+				Config: `resource "random_integer" "test" {
+							min = -9223372036854775808
+							max = -9223372036854775808
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_integer.test", tfjsonpath.New("result_hex"), knownvalue.StringExact("-8000000000000000")),
+					statecheck.ExpectKnownValue("random_integer.test", tfjsonpath.New("result_base62"), knownvalue.StringExact("-aZl8N0y58M8")),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceInteger_ResultEncodings_UpgradeFromVersion3_6_3(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				ExternalProviders: providerVersion363(),
+				Config: `resource "random_integer" "test" {
+							min = 500
+							max = 500
+						}`,
+			},
+			{
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
+				Config: `resource "random_integer" "test" {
+							min = 500
+							max = 500
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_integer.test", tfjsonpath.New("result_hex"), knownvalue.StringExact("1f4")),
+					statecheck.ExpectKnownValue("random_integer.test", tfjsonpath.New("result_base62"), knownvalue.StringExact("84")),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceInteger_Quantity(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_integer" "test" {
+							min      = 1
+							max      = 4094
+							quantity = 10
+							unique   = true
+							exclude  = ["1000-2000", "1"]
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_integer.test", tfjsonpath.New("results"), knownvalue.ListSizeExact(10)),
+					statecheck.ExpectKnownValue("random_integer.test", tfjsonpath.New("results").AtSliceIndex(0), knownvalue.NotNull()),
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("random_integer.test", "result", "random_integer.test", "results.0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceInteger_Exclude(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_integer" "test" {
+							min     = 1
+							max     = 3
+							exclude = ["1", "3"]
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_integer.test", tfjsonpath.New("result"), knownvalue.Int64Exact(2)),
+					statecheck.ExpectKnownValue("random_integer.test", tfjsonpath.New("results"), knownvalue.Null()),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceInteger_Quantity_Invalid(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_integer" "test" {
+							min      = 1
+							max      = 10
+							quantity = 6
+							unique   = true
+							exclude  = ["1-5"]
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute quantity value must be at most the number of integers between min\s+and max which are not excluded \(5\) when unique is true, got: 6`),
+			},
+			{
+				Config: `resource "random_integer" "test" {
+							min     = 1
+							max     = 10
+							exclude = ["0-10"]
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute exclude must not exclude every integer between min \(1\) and max\s+\(10\)`),
+			},
+			{
+				Config: `resource "random_integer" "test" {
+							min     = 1
+							max     = 10
+							exclude = ["5-2"]
+						}`,
+				ExpectError: regexp.MustCompile(`must not begin with an integer greater than the one it ends with`),
+			},
+			{
+				Config: `resource "random_integer" "test" {
+							min    = 1
+							max    = 10
+							unique = true
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute "quantity" must be specified when "unique" is specified`),
+			},
+			{
+				Config: `resource "random_integer" "test" {
+							min      = 1
+							max      = 10
+							quantity = 10001
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute quantity value must be between 1 and 10000, got: 10001`),
+			},
+		},
+	})
+}
@@ -0,0 +1,389 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	mapplanmodifiers "github.com/terraform-providers/terraform-provider-random/internal/planmodifiers/map"
+)
+
+var (
+	_ resource.Resource                   = (*ipv6InterfaceIDResource)(nil)
+	_ resource.ResourceWithConfigure      = (*ipv6InterfaceIDResource)(nil)
+	_ resource.ResourceWithModifyPlan     = (*ipv6InterfaceIDResource)(nil)
+	_ resource.ResourceWithValidateConfig = (*ipv6InterfaceIDResource)(nil)
+)
+
+// ipv6MinSecretKeyLength is the minimum length of the secret key in bytes,
+// which RFC 7217 recommends is at least 128 bits.
+const ipv6MinSecretKeyLength = 16
+
+// ipv6MaxDADAttempts is the number of times the DAD counter is incremented
+// when the generated interface identifier is reserved, before giving up.
+const ipv6MaxDADAttempts = 16
+
+func NewIPv6InterfaceIDResource() resource.Resource {
+	return &ipv6InterfaceIDResource{}
+}
+
+type ipv6InterfaceIDResource struct {
+	providerVersion string
+}
+
+func (r *ipv6InterfaceIDResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ipv6_interface_id"
+}
+
+func (r *ipv6InterfaceIDResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = ipv6InterfaceIDSchemaV0()
+}
+
+func (r *ipv6InterfaceIDResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	r.providerVersion = providerVersion(req.ProviderData)
+}
+
+// ModifyPlan prevents the replacement of the resource when lifecycle_guard is
+// enabled, unless allow_regeneration_token is changed.
+func (r *ipv6InterfaceIDResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	guardRegeneration(ctx, req, resp)
+}
+
+func (r *ipv6InterfaceIDResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ipv6InterfaceIDModelV0
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The configuration may have contained unknown values during validation.
+	prefix, diags := parseIPv6Prefix(plan.Prefix)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	done := logGeneration(ctx, randomSourceDerived, map[string]any{
+		"algorithm":     "rfc7217-hmac-sha256",
+		"prefix_length": prefix.Bits(),
+	})
+
+	address, err := stableIPv6Address(prefix, plan)
+	done()
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Create Random IPv6 Interface ID Error",
+			"While attempting to generate an interface identifier for the prefix, an error occurred.\n\n"+
+				fmt.Sprintf("Original Error: %s", err),
+		)
+		return
+	}
+
+	plan.Address = types.StringValue(address.String())
+	plan.AddressCIDR = types.StringValue(netip.PrefixFrom(address, prefix.Bits()).String())
+	plan.InterfaceID = types.StringValue(ipv6InterfaceID(address, prefix.Bits()).String())
+	plan.CreatedAt, plan.ProviderVersion = lifecycleValues(r.providerVersion)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read does not need to refresh the state as the state in ReadResourceResponse is already populated.
+func (r *ipv6InterfaceIDResource) Read(context.Context, resource.ReadRequest, *resource.ReadResponse) {
+}
+
+// Update ensures the plan value is copied to the state to complete the update.
+func (r *ipv6InterfaceIDResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var model ipv6InterfaceIDModelV0
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+// Delete does not need to explicitly call resp.State.RemoveResource() as this is automatically handled by the
+// [framework](https://github.com/hashicorp/terraform-plugin-framework/pull/301).
+func (r *ipv6InterfaceIDResource) Delete(context.Context, resource.DeleteRequest, *resource.DeleteResponse) {
+}
+
+// ValidateConfig ensures that the prefix is an IPv6 prefix which leaves bits for the interface identifier.
+func (r *ipv6InterfaceIDResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config ipv6InterfaceIDModelV0
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, diags := parseIPv6Prefix(config.Prefix)
+	resp.Diagnostics.Append(diags...)
+}
+
+// parseIPv6Prefix returns the prefix, masked to its length, or an error
+// diagnostic if the value is not an IPv6 prefix shorter than 128 bits. Unknown
+// and null values are not validated.
+func parseIPv6Prefix(value types.String) (netip.Prefix, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if value.IsNull() || value.IsUnknown() {
+		return netip.Prefix{}, diags
+	}
+
+	prefix, err := netip.ParsePrefix(value.ValueString())
+
+	switch {
+	case err != nil:
+		diags.AddAttributeError(
+			path.Root("prefix"),
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute prefix must be an IPv6 prefix in CIDR notation, such as 2001:db8::/64: %s", err),
+		)
+	case !prefix.Addr().Is6() || prefix.Addr().Is4In6():
+		diags.AddAttributeError(
+			path.Root("prefix"),
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute prefix must be an IPv6 prefix, got: %s", prefix),
+		)
+	case prefix.Bits() == 128:
+		diags.AddAttributeError(
+			path.Root("prefix"),
+			"Invalid Attribute Value",
+			"Attribute prefix must be shorter than 128 bits, so that bits remain for the interface identifier.",
+		)
+	}
+
+	return prefix.Masked(), diags
+}
+
+// stableIPv6Address returns the address formed from the prefix and an
+// interface identifier generated as described by RFC 7217, using HMAC-SHA-256
+// keyed with the secret key as the pseudorandom function F():
+//
+//	RID = F(Prefix, Net_Iface, Network_ID, DAD_Counter, secret_key)
+//
+// The identifier is the bits of the first 128 bits of the RID which are not
+// part of the prefix. As RFC 7217 describes, DAD_Counter is incremented when the
+// identifier is reserved, so the same address is always generated for the
+// same attribute values.
+func stableIPv6Address(prefix netip.Prefix, m ipv6InterfaceIDModelV0) (netip.Addr, error) {
+	prefixBytes := prefix.Addr().As16()
+
+	for attempt := int64(0); attempt < ipv6MaxDADAttempts; attempt++ {
+		mac := hmac.New(sha256.New, []byte(m.SecretKey.ValueString()))
+
+		// The variable length fields are prefixed with their length, so that
+		// the concatenation of different values is never the same.
+		mac.Write(prefixBytes[:])
+		mac.Write([]byte{byte(prefix.Bits())})
+
+		for _, field := range []string{m.Interface.ValueString(), m.NetworkID.ValueString()} {
+			mac.Write(binary.BigEndian.AppendUint32(nil, uint32(len(field))))
+			mac.Write([]byte(field))
+		}
+
+		mac.Write(binary.BigEndian.AppendUint64(nil, uint64(m.DADCounter.ValueInt64()+attempt)))
+
+		rid := mac.Sum(nil)
+
+		var address [16]byte
+
+		for i := range address {
+			hostBits := min(max(8*(i+1)-prefix.Bits(), 0), 8)
+			hostMask := byte(1<<hostBits - 1)
+
+			address[i] = prefixBytes[i]&^hostMask | rid[i]&hostMask
+		}
+
+		addr := netip.AddrFrom16(address)
+
+		if !ipv6ReservedInterfaceID(ipv6InterfaceID(addr, prefix.Bits()), prefix.Bits()) {
+			return addr, nil
+		}
+	}
+
+	return netip.Addr{}, fmt.Errorf("a reserved interface identifier was generated %d times", ipv6MaxDADAttempts)
+}
+
+// ipv6InterfaceID returns the interface identifier of the address, which is
+// the address with the bits of the prefix cleared.
+func ipv6InterfaceID(addr netip.Addr, prefixBits int) netip.Addr {
+	prefix := netip.PrefixFrom(addr, prefixBits).Masked().Addr().As16()
+	address := addr.As16()
+
+	for i := range address {
+		address[i] ^= prefix[i]
+	}
+
+	return netip.AddrFrom16(address)
+}
+
+// ipv6ReservedInterfaceID reports whether the interface identifier is
+// reserved: the all-zeros Subnet-Router anycast identifier, or for 64-bit
+// identifiers, the identifiers reserved by RFC 5453.
+func ipv6ReservedInterfaceID(id netip.Addr, prefixBits int) bool {
+	if id.IsUnspecified() {
+		return true
+	}
+
+	if prefixBits != 64 {
+		return false
+	}
+
+	address := id.As16()
+	iid := binary.BigEndian.Uint64(address[8:])
+
+	switch {
+	// Reserved IPv6 Interface Identifiers corresponding to the IANA Ethernet
+	// Block, including Proxy Mobile IPv6.
+	case iid >= 0x02005efffe000000 && iid <= 0x02005efffeffffff:
+		return true
+	// Reserved Subnet Anycast Addresses.
+	case iid >= 0xfdffffffffffff80:
+		return true
+	}
+
+	return false
+}
+
+func ipv6InterfaceIDSchemaV0() schema.Schema {
+	return schema.Schema{
+		Description: "The resource `random_ipv6_interface_id` generates a stable, semantically opaque IPv6 " +
+			"interface identifier for a prefix, as described by RFC 7217, and the resulting address. The " +
+			"identifier is derived from the prefix, interface, network and secret key using HMAC-SHA-256, so " +
+			"the same address is always generated for the same values, but addresses cannot be predicted " +
+			"without the secret key. Use `random_bytes` to generate the secret key.\n" +
+			"\n" +
+			"This resource does not generate random values.",
+		Attributes: map[string]schema.Attribute{
+			"keepers": schema.MapAttribute{
+				Description: "Arbitrary map of values that, when changed, will trigger recreation of " +
+					"resource. See [the main provider documentation](../index.html) for more information.",
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifiers.RequiresReplaceIfValuesNotNull(),
+				},
+			},
+			"prefix": schema.StringAttribute{
+				Description: "The IPv6 prefix of the address in CIDR notation, such as `2001:db8::/64`. The " +
+					"interface identifier fills the bits of the address which are not part of the prefix.",
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"secret_key": schema.StringAttribute{
+				Description: "The secret key from which the interface identifier is derived, which must be at " +
+					"least 16 bytes long. Addresses can be predicted by anyone who knows the secret key.",
+				Required:  true,
+				Sensitive: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(ipv6MinSecretKeyLength),
+				},
+			},
+			"interface": schema.StringAttribute{
+				Description: "An identifier of the network interface, such as its name or index, so that " +
+					"different addresses are generated for different interfaces with the same prefix.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"network_id": schema.StringAttribute{
+				Description: "An identifier of the network, such as the name of the subnet, so that different " +
+					"addresses are generated for the same interface on different networks.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"dad_counter": schema.Int64Attribute{
+				Description: "The duplicate address detection counter. Increment this value to generate a " +
+					"different address, such as when the generated address is already in use. Default value " +
+					"is `0`.",
+				Optional: true,
+				Computed: true,
+				Default:  int64default.StaticInt64(0),
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"keepers_hash":             keepersHashAttribute(),
+			"created_at":               createdAtAttribute(),
+			"provider_version":         providerVersionAttribute(),
+			"lifecycle_guard":          lifecycleGuardAttribute(),
+			"allow_regeneration_token": allowRegenerationTokenAttribute(),
+			"interface_id": schema.StringAttribute{
+				Description: "The generated interface identifier, in IPv6 address notation, such as " +
+					"`::1c2d:3e4f:5a6b:7c8d`.",
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"address": schema.StringAttribute{
+				Description: "The address formed from the prefix and the interface identifier, such as " +
+					"`2001:db8::1c2d:3e4f:5a6b:7c8d`.",
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"address_cidr": schema.StringAttribute{
+				Description: "The address with the length of the prefix in CIDR notation, such as " +
+					"`2001:db8::1c2d:3e4f:5a6b:7c8d/64`.",
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+type ipv6InterfaceIDModelV0 struct {
+	Keepers                types.Map    `tfsdk:"keepers"`
+	KeepersHash            types.String `tfsdk:"keepers_hash"`
+	Prefix                 types.String `tfsdk:"prefix"`
+	SecretKey              types.String `tfsdk:"secret_key"`
+	Interface              types.String `tfsdk:"interface"`
+	NetworkID              types.String `tfsdk:"network_id"`
+	DADCounter             types.Int64  `tfsdk:"dad_counter"`
+	InterfaceID            types.String `tfsdk:"interface_id"`
+	Address                types.String `tfsdk:"address"`
+	AddressCIDR            types.String `tfsdk:"address_cidr"`
+	CreatedAt              types.String `tfsdk:"created_at"`
+	ProviderVersion        types.String `tfsdk:"provider_version"`
+	LifecycleGuard         types.Bool   `tfsdk:"lifecycle_guard"`
+	AllowRegenerationToken types.String `tfsdk:"allow_regeneration_token"`
+}
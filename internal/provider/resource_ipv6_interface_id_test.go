@@ -0,0 +1,161 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"net/netip"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/compare"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestStableIPv6Address(t *testing.T) {
+	t.Parallel()
+
+	model := func(iface string, dadCounter int64) ipv6InterfaceIDModelV0 {
+		return ipv6InterfaceIDModelV0{
+			SecretKey:  types.StringValue("0123456789abcdef"),
+			Interface:  types.StringValue(iface),
+			NetworkID:  types.StringNull(),
+			DADCounter: types.Int64Value(dadCounter),
+		}
+	}
+
+	generate := func(prefix string, m ipv6InterfaceIDModelV0) netip.Addr {
+		t.Helper()
+
+		addr, err := stableIPv6Address(netip.MustParsePrefix(prefix), m)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		return addr
+	}
+
+	for _, prefix := range []string{"2001:db8::/64", "2001:db8:1:2::/56", "2001:db8::/100"} {
+		p := netip.MustParsePrefix(prefix)
+		addr := generate(prefix, model("eth0", 0))
+
+		if !p.Contains(addr) {
+			t.Errorf("expected %s to be within %s", addr, p)
+		}
+
+		if again := generate(prefix, model("eth0", 0)); again != addr {
+			t.Errorf("expected the same address for the same values, got: %s and %s", addr, again)
+		}
+
+		for _, other := range []ipv6InterfaceIDModelV0{model("eth1", 0), model("eth0", 1)} {
+			if generate(prefix, other) == addr {
+				t.Errorf("expected a different address for %s, got: %s", prefix, addr)
+			}
+		}
+	}
+}
+
+func TestIPv6ReservedInterfaceID(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		id         string
+		prefixBits int
+		expected   bool
+	}{
+		"subnet-router-anycast": {
+			id:         "::",
+			prefixBits: 64,
+			expected:   true,
+		},
+		"subnet-router-anycast-short-iid": {
+			id:         "::",
+			prefixBits: 112,
+			expected:   true,
+		},
+		"ethernet-block": {
+			id:         "::200:5eff:fe00:5213",
+			prefixBits: 64,
+			expected:   true,
+		},
+		"subnet-anycast": {
+			id:         "::fdff:ffff:ffff:ff80",
+			prefixBits: 64,
+			expected:   true,
+		},
+		"subnet-anycast-short-iid": {
+			id:         "::fdff:ffff:ffff:ff80",
+			prefixBits: 48,
+		},
+		"unreserved": {
+			id:         "::1c2d:3e4f:5a6b:7c8d",
+			prefixBits: 64,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := ipv6ReservedInterfaceID(netip.MustParseAddr(testCase.id), testCase.prefixBits)
+
+			if got != testCase.expected {
+				t.Errorf("expected %t, got: %t", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestAccResourceIPv6InterfaceID(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_ipv6_interface_id" "eth0" {
+							prefix     = "2001:db8:0:1::/64"
+							secret_key = "0123456789abcdef"
+							interface  = "eth0"
+						}
+
+						resource "random_ipv6_interface_id" "eth1" {
+							prefix     = "2001:db8:0:1::/64"
+							secret_key = "0123456789abcdef"
+							interface  = "eth1"
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_ipv6_interface_id.eth0", tfjsonpath.New("address"), knownvalue.StringRegexp(regexp.MustCompile(`^2001:db8:0:1:`))),
+					statecheck.ExpectKnownValue("random_ipv6_interface_id.eth0", tfjsonpath.New("address_cidr"), knownvalue.StringRegexp(regexp.MustCompile(`^2001:db8:0:1:.*/64$`))),
+					statecheck.ExpectKnownValue("random_ipv6_interface_id.eth0", tfjsonpath.New("interface_id"), knownvalue.StringRegexp(regexp.MustCompile(`^::`))),
+					statecheck.ExpectKnownValue("random_ipv6_interface_id.eth0", tfjsonpath.New("dad_counter"), knownvalue.Int64Exact(0)),
+					statecheck.CompareValuePairs("random_ipv6_interface_id.eth0", tfjsonpath.New("address"), "random_ipv6_interface_id.eth1", tfjsonpath.New("address"), compare.ValuesDiffer()),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceIPv6InterfaceID_Invalid(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_ipv6_interface_id" "test" {
+							prefix     = "192.0.2.0/24"
+							secret_key = "0123456789abcdef"
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute prefix must be an IPv6 prefix`),
+			},
+			{
+				Config: `resource "random_ipv6_interface_id" "test" {
+							prefix     = "2001:db8::/64"
+							secret_key = "short"
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute secret_key string length must be at least 16`),
+			},
+		},
+	})
+}
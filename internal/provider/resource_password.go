@@ -5,52 +5,101 @@ package provider
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/base64"
 	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"golang.org/x/crypto/bcrypt"
+	"golang.org/x/crypto/pbkdf2"
 
+	"github.com/terraform-providers/terraform-provider-random/internal/crypt"
 	"github.com/terraform-providers/terraform-provider-random/internal/diagnostics"
-	boolplanmodifiers "github.com/terraform-providers/terraform-provider-random/internal/planmodifiers/bool"
-	mapplanmodifiers "github.com/terraform-providers/terraform-provider-random/internal/planmodifiers/map"
+	"github.com/terraform-providers/terraform-provider-random/internal/encrypt"
+	"github.com/terraform-providers/terraform-provider-random/internal/metrics"
+	listplanmodifiers "github.com/terraform-providers/terraform-provider-random/internal/planmodifiers/list"
 	stringplanmodifiers "github.com/terraform-providers/terraform-provider-random/internal/planmodifiers/string"
+	"github.com/terraform-providers/terraform-provider-random/internal/publish"
 	"github.com/terraform-providers/terraform-provider-random/internal/random"
-	"github.com/terraform-providers/terraform-provider-random/internal/validators"
 )
 
+// passwordMaxCountResults is the maximum number of passwords in results, as each
+// is hashed with bcrypt, which is deliberately slow.
+const passwordMaxCountResults = 100
+
 var (
-	_ resource.Resource                 = (*passwordResource)(nil)
-	_ resource.ResourceWithImportState  = (*passwordResource)(nil)
-	_ resource.ResourceWithUpgradeState = (*passwordResource)(nil)
+	_ resource.Resource                   = (*passwordResource)(nil)
+	_ resource.ResourceWithConfigure      = (*passwordResource)(nil)
+	_ resource.ResourceWithImportState    = (*passwordResource)(nil)
+	_ resource.ResourceWithModifyPlan     = (*passwordResource)(nil)
+	_ resource.ResourceWithUpgradeState   = (*passwordResource)(nil)
+	_ resource.ResourceWithValidateConfig = (*passwordResource)(nil)
 )
 
 func NewPasswordResource() resource.Resource {
 	return &passwordResource{}
 }
 
-type passwordResource struct{}
+type passwordResource struct {
+	providerVersion string
+	entropy         *random.Source
+	fips            bool
+	encryptionKey   *encrypt.Key
+	publisher       publish.Publisher
+
+	// maxGenerationTime bounds the time taken to generate the results of a
+	// resource, including reads of entropy which are retried or blocked.
+	maxGenerationTime time.Duration
+}
 
 func (r *passwordResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_password"
 }
 
 func (r *passwordResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resp.Schema = passwordSchemaV3()
+	resp.Schema = passwordSchemaV4()
+}
+
+func (r *passwordResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	r.providerVersion = providerVersion(req.ProviderData)
+	r.entropy = providerEntropy(req.ProviderData)
+	r.fips = providerFIPS(req.ProviderData)
+	r.encryptionKey = providerEncryptionKey(req.ProviderData)
+	r.publisher = providerPublisher(req.ProviderData)
+	r.maxGenerationTime = providerMaxGenerationTime(req.ProviderData)
+}
+
+// ModifyPlan prevents the replacement of the resource when lifecycle_guard is
+// enabled, unless allow_regeneration_token is changed.
+func (r *passwordResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	guardRegeneration(ctx, req, resp)
 }
 
 func (r *passwordResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan passwordModelV3
+	var plan passwordModelV4
 
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -58,44 +107,264 @@ func (r *passwordResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
-	params := random.StringParams{
-		Length:          plan.Length.ValueInt64(),
-		Upper:           plan.Upper.ValueBool(),
-		MinUpper:        plan.MinUpper.ValueInt64(),
-		Lower:           plan.Lower.ValueBool(),
-		MinLower:        plan.MinLower.ValueInt64(),
-		Numeric:         plan.Numeric.ValueBool(),
-		MinNumeric:      plan.MinNumeric.ValueInt64(),
-		Special:         plan.Special.ValueBool(),
-		MinSpecial:      plan.MinSpecial.ValueInt64(),
-		OverrideSpecial: plan.OverrideSpecial.ValueString(),
+	// The configuration may have contained unknown values during validation.
+	resp.Diagnostics.Append(validatePasswordLengthRange(plan.MinLength, plan.MaxLength, plan.PinnedPrefix, plan.mins()...)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	result, err := random.CreateString(params)
-	if err != nil {
-		resp.Diagnostics.Append(diagnostics.RandomReadError(err.Error())...)
+	if plan.Length.IsUnknown() {
+		length, err := chooseLength(r.entropy, plan.MinLength.ValueInt64(), plan.MaxLength.ValueInt64())
+		if errors.Is(err, random.ErrEntropyUnavailable) {
+			resp.Diagnostics.Append(diagnostics.EntropyUnavailableError(err)...)
+			return
+		}
+		if err != nil {
+			resp.Diagnostics.Append(diagnostics.RandomReadError(err.Error())...)
+			return
+		}
+
+		plan.Length = types.Int64Value(length)
+	}
+
+	resp.Diagnostics.Append(validateLengthAtLeastMins(plan.Length, plan.mins()...)...)
+	resp.Diagnostics.Append(validateMinCharacterClasses(plan.MinCharacterClasses, plan.Length, "length", plan.classes(), plan.mins())...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	hash, err := generateHash(string(result))
-	if err != nil {
-		resp.Diagnostics.Append(diagnostics.HashGenerationError(err.Error())...)
+	resp.Diagnostics.Append(validatePinnedPrefix(plan.PinnedPrefix, plan.Length, plan.mins()...)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validatePasswordGroups(ctx, plan.Groups, plan.Length, plan.mins()...)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validatePasswordPreset(plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	count := int64(1)
+
+	if !plan.CountResults.IsNull() {
+		count = plan.CountResults.ValueInt64()
+	}
+
+	// The count is validated in the schema, but may not be known until apply.
+	if count < 1 || count > passwordMaxCountResults {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("count_results"),
+			"Create Random Password Error",
+			fmt.Sprintf("The count_results must be between 1 and %d, got: %d.", passwordMaxCountResults, count),
+		)
+		return
+	}
+
+	done := logGeneration(ctx, randomSourceCrypto, map[string]any{
+		"length":        plan.Length.ValueInt64(),
+		"count_results": count,
+	})
+
+	// The first generated password is used for the result attribute, so that
+	// configurations which do not set count_results are unaffected.
+	results := make([]string, count)
+	entropy := r.entropy.WithTimeout(r.maxGenerationTime)
+
+	for i := range results {
+		result, err := r.generate(ctx, entropy, plan)
+		if errors.Is(err, random.ErrEntropyUnavailable) {
+			done()
+			resp.Diagnostics.Append(diagnostics.EntropyUnavailableError(err)...)
+			return
+		}
+		if err != nil {
+			done()
+			resp.Diagnostics.Append(diagnostics.RandomReadError(err.Error())...)
+			return
+		}
+
+		results[i] = result
+	}
+
+	done()
+
+	result := results[0]
+
+	resp.Diagnostics.Append(r.setHashes(&plan, result)...)
+	resp.Diagnostics.Append(r.setPHCHash(ctx, &plan, result)...)
+
+	plan.EncryptedResult, diags = encryptResult(r.encryptionKey, r.entropy, result)
+	resp.Diagnostics.Append(diags...)
+
+	plan.WrappedResult, diags = wrapResult(plan.RecipientPublicKey, r.entropy, result)
+	resp.Diagnostics.Append(diags...)
+
+	plan.Results = types.ListNull(types.StringType)
+	plan.BcryptHashes = types.ListNull(types.StringType)
+
+	if !plan.CountResults.IsNull() {
+		resultValues := make([]attr.Value, len(results))
+		for i, result := range results {
+			resultValues[i] = types.StringValue(result)
+		}
+
+		plan.Results = types.ListValueMust(types.StringType, resultValues)
+
+		resp.Diagnostics.Append(r.setBcryptHashes(&plan, results)...)
 	}
 
-	plan.BcryptHash = types.StringValue(hash)
 	plan.ID = types.StringValue("none")
-	plan.Result = types.StringValue(string(result))
+	plan.Result = types.StringValue(result)
+	plan.ResultPreview = passwordPreview(plan.EnablePreview, result)
+	plan.NTLMHash = ntlmHash(plan.EnableLegacyHashes, result)
+	plan.Fingerprint = passwordFingerprint(result)
+	plan.EntropyBits = plan.entropyBits(ctx)
+	plan.Spec = plan.spec(ctx)
+	plan.CreatedAt, plan.ProviderVersion = lifecycleValues(r.providerVersion)
+
+	// The results must not leave the provider if the resource will not be
+	// created.
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The results are published before they are written to state, so that the
+	// resource is not created if they could not be published.
+	resp.Diagnostics.Append(publishResults(ctx, r.publisher, "random_password", results)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
 
-// Read does not need to perform any operations as the state in ReadResourceResponse is already populated.
+// generate returns a password generated from the parameters of the plan using
+// the source of entropy, beginning with the pinned prefix and formatted in
+// groups if configured.
+func (r *passwordResource) generate(ctx context.Context, entropy *random.Source, plan passwordModelV4) (string, error) {
+	result, err := entropy.CreateString(plan.params(ctx))
+	if err != nil {
+		return "", err
+	}
+
+	result = append([]byte(plan.PinnedPrefix.ValueString()), result...)
+
+	if groups, ok := plan.groups(ctx); ok {
+		result = groups.format(result)
+	}
+
+	return string(result), nil
+}
+
+// Read does not need to refresh the state as the state in ReadResourceResponse is already populated, other than to
+// populate entropy_bits, generate_bcrypt_hash, the crypt and SSHA hashes and keepers_hash for resources created by
+// earlier provider versions, pbkdf2_hash for resources created before the provider was configured with fips = true,
+// and encrypted_result for resources created before the provider was configured with result_encryption_key.
 func (r *passwordResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var model passwordModelV4
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	refresh := false
+
+	if model.EntropyBits.IsNull() {
+		logRefresh(ctx, "entropy_bits")
+
+		model.EntropyBits = model.entropyBits(ctx)
+		refresh = true
+	}
+
+	if model.Spec.IsNull() {
+		logRefresh(ctx, "spec")
+
+		model.Spec = model.spec(ctx)
+		refresh = true
+	}
+
+	if model.Fingerprint.IsNull() && !model.Result.IsNull() {
+		logRefresh(ctx, "fingerprint")
+
+		model.Fingerprint = passwordFingerprint(model.Result.ValueString())
+		refresh = true
+	}
+
+	if model.GenerateBcryptHash.IsNull() {
+		logRefresh(ctx, "generate_bcrypt_hash")
+
+		model.GenerateBcryptHash = types.BoolValue(true)
+		refresh = true
+	}
+
+	if r.fips && model.PBKDF2Hash.IsNull() && !model.Result.IsNull() {
+		logRefresh(ctx, "pbkdf2_hash")
+
+		hash, err := generatePBKDF2Hash(r.entropy, model.Result.ValueString())
+		if err != nil {
+			resp.Diagnostics.Append(diagnostics.HashGenerationError(err.Error())...)
+			return
+		}
+
+		model.PBKDF2Hash = types.StringValue(hash)
+		refresh = true
+	}
+
+	if r.encryptionKey != nil && model.EncryptedResult.IsNull() && !model.Result.IsNull() {
+		logRefresh(ctx, "encrypted_result")
+
+		var diags diag.Diagnostics
+
+		model.EncryptedResult, diags = encryptResult(r.encryptionKey, r.entropy, model.Result.ValueString())
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		refresh = true
+	}
+
+	if model.SHA512Crypt.IsNull() && !model.Result.IsNull() {
+		logRefresh(ctx, "sha512_crypt")
+
+		resp.Diagnostics.Append(r.setCryptHashes(&model, model.Result.ValueString())...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		refresh = true
+	}
+
+	if model.SSHA512.IsNull() && !model.Result.IsNull() {
+		logRefresh(ctx, "ssha512")
+
+		resp.Diagnostics.Append(r.setSSHAHashes(&model, model.Result.ValueString())...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		refresh = true
+	}
+
+	if refresh {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(refreshKeepersHash(ctx, &resp.State)...)
 }
 
-// Update ensures the plan value is copied to the state to complete the update.
+// Update ensures the plan value is copied to the state to complete the update. The entropy_bits, crypt hash and SSHA hash
+// values are unknown in the plan if the state was not refreshed since upgrading from an earlier provider version.
 func (r *passwordResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var model passwordModelV3
+	var model passwordModelV4
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
 
@@ -103,9 +372,109 @@ func (r *passwordResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
+	if model.EntropyBits.IsUnknown() {
+		model.EntropyBits = model.entropyBits(ctx)
+	}
+
+	if model.Spec.IsUnknown() {
+		model.Spec = model.spec(ctx)
+	}
+
+	// The result_preview value is unknown in the plan if enable_preview was
+	// not known during plan.
+	if model.ResultPreview.IsUnknown() {
+		model.ResultPreview = passwordPreview(model.EnablePreview, model.Result.ValueString())
+	}
+
+	// The ntlm_hash value is unknown in the plan if enable_legacy_hashes was
+	// not known during plan.
+	if model.NTLMHash.IsUnknown() {
+		model.NTLMHash = ntlmHash(model.EnableLegacyHashes, model.Result.ValueString())
+	}
+
+	if model.Fingerprint.IsUnknown() {
+		model.Fingerprint = passwordFingerprint(model.Result.ValueString())
+	}
+
+	if model.BcryptHash.IsUnknown() {
+		if r.fips {
+			model.BcryptHash = types.StringNull()
+		} else {
+			resp.Diagnostics.Append(setBcryptHash(&model, model.Result.ValueString())...)
+		}
+	}
+
+	if model.SHA512Crypt.IsUnknown() {
+		resp.Diagnostics.Append(r.setCryptHashes(&model, model.Result.ValueString())...)
+	}
+
+	if model.SSHA512.IsUnknown() {
+		resp.Diagnostics.Append(r.setSSHAHashes(&model, model.Result.ValueString())...)
+	}
+
+	if model.EncryptedResult.IsUnknown() {
+		var diags diag.Diagnostics
+
+		model.EncryptedResult, diags = encryptResult(r.encryptionKey, r.entropy, model.Result.ValueString())
+		resp.Diagnostics.Append(diags...)
+	}
+
+	// The wrapped_result value is unknown in the plan if recipient_public_key
+	// was changed or not known during plan.
+	if model.WrappedResult.IsUnknown() {
+		var diags diag.Diagnostics
+
+		model.WrappedResult, diags = wrapResult(model.RecipientPublicKey, r.entropy, model.Result.ValueString())
+		resp.Diagnostics.Append(diags...)
+	}
+
+	if phcHashUnknown(ctx, model.PHCHash) {
+		resp.Diagnostics.Append(r.setPHCHash(ctx, &model, model.Result.ValueString())...)
+	}
+
+	if model.BcryptHashes.IsUnknown() {
+		var results []string
+
+		if !model.Results.IsNull() {
+			resp.Diagnostics.Append(model.Results.ElementsAs(ctx, &results, false)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+
+		resp.Diagnostics.Append(r.setBcryptHashes(&model, results)...)
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
 }
 
+// ValidateConfig ensures that the length, or min_length, is at least the sum of the minimum number of characters of
+// each class, that min_character_classes can be satisfied by the enabled classes and the length, that max_length is at least min_length, that a pinned_prefix leaves enough characters of the length to
+// be randomly generated, including the minimum number of characters of each class, that the length matches the
+// groups, that the configuration satisfies the preset, and that the phc_hash parameters apply to its algorithm. A warning is returned while legacy hashes are enabled.
+func (r *passwordResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config passwordModelV4
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateLengthAtLeastMins(config.Length, config.mins()...)...)
+	resp.Diagnostics.Append(validatePasswordLengthRange(config.MinLength, config.MaxLength, config.PinnedPrefix, config.mins()...)...)
+	resp.Diagnostics.Append(validateMinCharacterClasses(config.MinCharacterClasses, config.Length, "length", config.classes(), config.mins())...)
+	resp.Diagnostics.Append(validateMinCharacterClasses(config.MinCharacterClasses, config.MinLength, "min_length", config.classes(), config.mins())...)
+	resp.Diagnostics.Append(validatePinnedPrefix(config.PinnedPrefix, config.Length, config.mins()...)...)
+	resp.Diagnostics.Append(validatePasswordGroups(ctx, config.Groups, config.Length, config.mins()...)...)
+	resp.Diagnostics.Append(validatePasswordPreset(config)...)
+	resp.Diagnostics.Append(validatePHCHash(ctx, config.PHCHash)...)
+
+	if config.EnableLegacyHashes.ValueBool() {
+		resp.Diagnostics.Append(diagnostics.LegacyHashesWarning()...)
+	}
+}
+
 // Delete does not need to explicitly call resp.State.RemoveResource() as this is automatically handled by the
 // [framework](https://github.com/hashicorp/terraform-plugin-framework/pull/301).
 func (r *passwordResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -114,59 +483,235 @@ func (r *passwordResource) Delete(ctx context.Context, req resource.DeleteReques
 func (r *passwordResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	id := req.ID
 
-	state := passwordModelV3{
-		ID:              types.StringValue("none"),
-		Result:          types.StringValue(id),
-		Length:          types.Int64Value(int64(len(id))),
-		Special:         types.BoolValue(true),
-		Upper:           types.BoolValue(true),
-		Lower:           types.BoolValue(true),
-		Number:          types.BoolValue(true),
-		Numeric:         types.BoolValue(true),
-		MinSpecial:      types.Int64Value(0),
-		MinUpper:        types.Int64Value(0),
-		MinLower:        types.Int64Value(0),
-		MinNumeric:      types.Int64Value(0),
-		Keepers:         types.MapNull(types.StringType),
-		OverrideSpecial: types.StringNull(),
-	}
-
-	hash, err := generateHash(id)
-	if err != nil {
-		resp.Diagnostics.Append(diagnostics.HashGenerationError(err.Error())...)
+	state := passwordModelV4{
+		stringSharedModel: stringSharedModel{
+			Result:                 types.StringValue(id),
+			Length:                 types.Int64Value(importLength(id)),
+			Special:                types.BoolValue(true),
+			Upper:                  types.BoolValue(true),
+			Lower:                  types.BoolValue(true),
+			Number:                 types.BoolValue(true),
+			Numeric:                types.BoolValue(true),
+			MinSpecial:             types.Int64Value(0),
+			MinUpper:               types.Int64Value(0),
+			MinLower:               types.Int64Value(0),
+			MinNumeric:             types.Int64Value(0),
+			Keepers:                types.MapNull(types.StringType),
+			OverrideSpecial:        types.StringNull(),
+			OverrideSpecialList:    types.ListNull(types.StringType),
+			CharsetPreset:          types.StringNull(),
+			MinCharacterClasses:    types.Int64Null(),
+			KeepersHash:            keepersHash(types.MapNull(types.StringType)),
+			CreatedAt:              types.StringNull(),
+			ProviderVersion:        types.StringNull(),
+			LifecycleGuard:         types.BoolNull(),
+			AllowRegenerationToken: types.StringNull(),
+		},
+		ID:                 types.StringValue("none"),
+		MinLength:          types.Int64Null(),
+		MaxLength:          types.Int64Null(),
+		PinnedPrefix:       types.StringNull(),
+		NoLeadingNumeric:   types.BoolNull(),
+		NoLeadingSpecial:   types.BoolNull(),
+		NoTrailingNumeric:  types.BoolNull(),
+		NoTrailingSpecial:  types.BoolNull(),
+		MaxRepeat:          types.Int64Null(),
+		Distinct:           types.BoolNull(),
+		Groups:             types.ObjectNull(passwordGroupsAttrTypes),
+		Preset:             types.StringNull(),
+		EnablePreview:      types.BoolNull(),
+		ResultPreview:      types.StringNull(),
+		EnableLegacyHashes: types.BoolNull(),
+		NTLMHash:           types.StringNull(),
+		GenerateBcryptHash: types.BoolValue(true),
+		PBKDF2Hash:         types.StringNull(),
+		CountResults:       types.Int64Null(),
+		Results:            types.ListNull(types.StringType),
+		BcryptHashes:       types.ListNull(types.StringType),
+		PHCHash:            types.ObjectNull(phcHashAttrTypes),
+		RecipientPublicKey: types.StringNull(),
+		WrappedResult:      types.StringNull(),
+		Fingerprint:        types.StringNull(),
+		EncryptedResult:    types.StringNull(),
+	}
+
+	if isImportJSON(id) {
+		var data passwordImportJSON
+
+		if err := data.decode(id); err != nil {
+			resp.Diagnostics.AddError(
+				"Import Random Password Error",
+				"While attempting to import a random password, the import identifier could not be decoded as JSON. "+
+					"Either supply the password as the import identifier, or a JSON object containing at least "+
+					"the \"result\" key, for example: {\"result\": \"password\", \"length\": 8, \"special\": false}\n\n"+
+					fmt.Sprintf("Original Error: %s", err),
+			)
+			return
+		}
+
+		data.apply(&state)
+		id = state.Result.ValueString()
 	}
 
-	state.BcryptHash = types.StringValue(hash)
+	logImport(ctx, map[string]any{
+		"length": state.Length.ValueInt64(),
+	})
 
-	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(r.setHashes(&state, id)...)
+
+	state.Fingerprint = passwordFingerprint(id)
+	state.EntropyBits = state.entropyBits(ctx)
+	state.Spec = state.spec(ctx)
+
+	var diags diag.Diagnostics
+
+	state.EncryptedResult, diags = encryptResult(r.encryptionKey, r.entropy, id)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// passwordImportJSON is the JSON object which can be supplied as the import
+// identifier, in place of the bare password, so that the imported state can
+// match the arguments used in configuration. Keys which are omitted retain the
+// defaults used when importing a bare password.
+type passwordImportJSON struct {
+	stringSharedImportJSON
+
+	MinLength         *int64  `json:"min_length"`
+	MaxLength         *int64  `json:"max_length"`
+	PinnedPrefix      *string `json:"pinned_prefix"`
+	NoLeadingNumeric  *bool   `json:"no_leading_numeric"`
+	NoLeadingSpecial  *bool   `json:"no_leading_special"`
+	NoTrailingNumeric *bool   `json:"no_trailing_numeric"`
+	NoTrailingSpecial *bool   `json:"no_trailing_special"`
+	MaxRepeat         *int64  `json:"max_repeat"`
+	Distinct          *bool   `json:"distinct"`
+	Preset            *string `json:"preset"`
+	Groups            *struct {
+		Count     int64   `json:"count"`
+		Size      int64   `json:"size"`
+		Separator *string `json:"separator"`
+	} `json:"groups"`
+}
+
+func (d *passwordImportJSON) decode(id string) error {
+	if err := decodeImportJSON(id, d); err != nil {
+		return err
+	}
+
+	if err := d.validate("password"); err != nil {
+		return err
+	}
+
+	if (d.MinLength == nil) != (d.MaxLength == nil) {
+		return errors.New(`the "min_length" and "max_length" keys must be set together`)
+	}
+
+	if d.PinnedPrefix != nil && !strings.HasPrefix(*d.Result, *d.PinnedPrefix) {
+		return errors.New(`the "result" key must begin with the value of the "pinned_prefix" key`)
+	}
+
+	if d.Preset != nil {
+		if _, ok := passwordPresets[*d.Preset]; !ok {
+			return fmt.Errorf(`the "preset" key must be one of %q, got: %q`, passwordPresetNames(), *d.Preset)
+		}
+	}
+
+	return nil
+}
+
+func (d *passwordImportJSON) apply(state *passwordModelV4) {
+	d.stringSharedImportJSON.apply(&state.stringSharedModel)
+
+	if d.MinLength != nil {
+		state.MinLength = types.Int64Value(*d.MinLength)
+	}
+
+	if d.MaxLength != nil {
+		state.MaxLength = types.Int64Value(*d.MaxLength)
+	}
+
+	if d.PinnedPrefix != nil {
+		state.PinnedPrefix = types.StringValue(*d.PinnedPrefix)
+	}
+
+	if d.NoLeadingNumeric != nil {
+		state.NoLeadingNumeric = types.BoolValue(*d.NoLeadingNumeric)
+	}
+
+	if d.NoLeadingSpecial != nil {
+		state.NoLeadingSpecial = types.BoolValue(*d.NoLeadingSpecial)
+	}
+
+	if d.NoTrailingNumeric != nil {
+		state.NoTrailingNumeric = types.BoolValue(*d.NoTrailingNumeric)
+	}
+
+	if d.NoTrailingSpecial != nil {
+		state.NoTrailingSpecial = types.BoolValue(*d.NoTrailingSpecial)
+	}
+
+	if d.MaxRepeat != nil {
+		state.MaxRepeat = types.Int64Value(*d.MaxRepeat)
+	}
+
+	if d.Distinct != nil {
+		state.Distinct = types.BoolValue(*d.Distinct)
+	}
+
+	if d.Preset != nil {
+		state.Preset = types.StringValue(*d.Preset)
+	}
+
+	if d.Groups != nil {
+		separator := "-"
+
+		if d.Groups.Separator != nil {
+			separator = *d.Groups.Separator
+		}
+
+		state.Groups = types.ObjectValueMust(passwordGroupsAttrTypes, map[string]attr.Value{
+			"count":     types.Int64Value(d.Groups.Count),
+			"size":      types.Int64Value(d.Groups.Size),
+			"separator": types.StringValue(separator),
+		})
+	}
 }
 
 func (r *passwordResource) UpgradeState(context.Context) map[int64]resource.StateUpgrader {
 	schemaV0 := passwordSchemaV0()
 	schemaV1 := passwordSchemaV1()
 	schemaV2 := passwordSchemaV2()
+	schemaV3 := passwordSchemaV3()
 
 	return map[int64]resource.StateUpgrader{
 		0: {
 			PriorSchema:   &schemaV0,
-			StateUpgrader: upgradePasswordStateV0toV3,
+			StateUpgrader: upgradePasswordStateV0toV4,
 		},
 		1: {
 			PriorSchema:   &schemaV1,
-			StateUpgrader: upgradePasswordStateV1toV3,
+			StateUpgrader: upgradePasswordStateV1toV4,
 		},
 		2: {
 			PriorSchema:   &schemaV2,
-			StateUpgrader: upgradePasswordStateV2toV3,
+			StateUpgrader: upgradePasswordStateV2toV4,
+		},
+		3: {
+			PriorSchema:   &schemaV3,
+			StateUpgrader: upgradePasswordStateV3toV4,
 		},
 	}
 }
 
-func upgradePasswordStateV0toV3(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+func upgradePasswordStateV0toV4(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	logStateUpgrade(ctx, 0, 4)
+
 	type modelV0 struct {
 		ID              types.String `tfsdk:"id"`
 		Keepers         types.Map    `tfsdk:"keepers"`
@@ -248,36 +793,51 @@ func upgradePasswordStateV0toV3(ctx context.Context, req resource.UpgradeStateRe
 		number = types.BoolValue(true)
 	}
 
-	passwordDataV3 := passwordModelV3{
-		Keepers:         passwordDataV0.Keepers,
-		Length:          length,
-		Special:         special,
-		Upper:           upper,
-		Lower:           lower,
-		Number:          number,
-		Numeric:         number,
-		MinNumeric:      minNumeric,
-		MinUpper:        minUpper,
-		MinLower:        minLower,
-		MinSpecial:      minSpecial,
-		OverrideSpecial: passwordDataV0.OverrideSpecial,
-		Result:          passwordDataV0.Result,
-		ID:              passwordDataV0.ID,
-	}
-
-	hash, err := generateHash(passwordDataV3.Result.ValueString())
+	passwordDataV4 := passwordModelV4{
+		stringSharedModel: stringSharedModel{
+			Keepers:             passwordDataV0.Keepers,
+			Length:              length,
+			Special:             special,
+			Upper:               upper,
+			Lower:               lower,
+			Number:              number,
+			Numeric:             number,
+			MinNumeric:          minNumeric,
+			MinUpper:            minUpper,
+			MinLower:            minLower,
+			MinSpecial:          minSpecial,
+			OverrideSpecial:     passwordDataV0.OverrideSpecial,
+			OverrideSpecialList: types.ListNull(types.StringType),
+			CharsetPreset:       types.StringNull(),
+			Result:              passwordDataV0.Result,
+		},
+		ID:                 passwordDataV0.ID,
+		Groups:             types.ObjectNull(passwordGroupsAttrTypes),
+		Results:            types.ListNull(types.StringType),
+		BcryptHashes:       types.ListNull(types.StringType),
+		PHCHash:            types.ObjectNull(phcHashAttrTypes),
+		RecipientPublicKey: types.StringNull(),
+		WrappedResult:      types.StringNull(),
+		Fingerprint:        types.StringNull(),
+		GenerateBcryptHash: types.BoolNull(),
+		Spec:               types.ObjectNull(specAttrTypes),
+	}
+
+	hash, err := generateHash(passwordDataV4.Result.ValueString())
 	if err != nil {
 		resp.Diagnostics.Append(diagnostics.HashGenerationError(err.Error())...)
 		return
 	}
 
-	passwordDataV3.BcryptHash = types.StringValue(hash)
+	passwordDataV4.BcryptHash = types.StringValue(hash)
 
-	diags := resp.State.Set(ctx, passwordDataV3)
+	diags := resp.State.Set(ctx, passwordDataV4)
 	resp.Diagnostics.Append(diags...)
 }
 
-func upgradePasswordStateV1toV3(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+func upgradePasswordStateV1toV4(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	logStateUpgrade(ctx, 1, 4)
+
 	type modelV1 struct {
 		ID              types.String `tfsdk:"id"`
 		Keepers         types.Map    `tfsdk:"keepers"`
@@ -360,29 +920,44 @@ func upgradePasswordStateV1toV3(ctx context.Context, req resource.UpgradeStateRe
 		number = types.BoolValue(true)
 	}
 
-	passwordDataV3 := passwordModelV3{
-		Keepers:         passwordDataV1.Keepers,
-		Length:          length,
-		Special:         special,
-		Upper:           upper,
-		Lower:           lower,
-		Number:          number,
-		Numeric:         number,
-		MinNumeric:      minNumeric,
-		MinUpper:        minUpper,
-		MinLower:        minLower,
-		MinSpecial:      minSpecial,
-		OverrideSpecial: passwordDataV1.OverrideSpecial,
-		BcryptHash:      passwordDataV1.BcryptHash,
-		Result:          passwordDataV1.Result,
-		ID:              passwordDataV1.ID,
-	}
-
-	diags := resp.State.Set(ctx, passwordDataV3)
+	passwordDataV4 := passwordModelV4{
+		stringSharedModel: stringSharedModel{
+			Keepers:             passwordDataV1.Keepers,
+			Length:              length,
+			Special:             special,
+			Upper:               upper,
+			Lower:               lower,
+			Number:              number,
+			Numeric:             number,
+			MinNumeric:          minNumeric,
+			MinUpper:            minUpper,
+			MinLower:            minLower,
+			MinSpecial:          minSpecial,
+			OverrideSpecial:     passwordDataV1.OverrideSpecial,
+			OverrideSpecialList: types.ListNull(types.StringType),
+			CharsetPreset:       types.StringNull(),
+			Result:              passwordDataV1.Result,
+		},
+		BcryptHash:         passwordDataV1.BcryptHash,
+		ID:                 passwordDataV1.ID,
+		Groups:             types.ObjectNull(passwordGroupsAttrTypes),
+		Results:            types.ListNull(types.StringType),
+		BcryptHashes:       types.ListNull(types.StringType),
+		PHCHash:            types.ObjectNull(phcHashAttrTypes),
+		RecipientPublicKey: types.StringNull(),
+		WrappedResult:      types.StringNull(),
+		Fingerprint:        types.StringNull(),
+		GenerateBcryptHash: types.BoolNull(),
+		Spec:               types.ObjectNull(specAttrTypes),
+	}
+
+	diags := resp.State.Set(ctx, passwordDataV4)
 	resp.Diagnostics.Append(diags...)
 }
 
-func upgradePasswordStateV2toV3(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+func upgradePasswordStateV2toV4(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	logStateUpgrade(ctx, 2, 4)
+
 	type passwordModelV2 struct {
 		ID              types.String `tfsdk:"id"`
 		Keepers         types.Map    `tfsdk:"keepers"`
@@ -419,130 +994,582 @@ func upgradePasswordStateV2toV3(ctx context.Context, req resource.UpgradeStateRe
 		length = types.Int64Value(int64(len(passwordDataV2.Result.ValueString())))
 	}
 
-	minNumeric := passwordDataV2.MinNumeric
+	minNumeric := passwordDataV2.MinNumeric
+
+	if minNumeric.IsNull() {
+		minNumeric = types.Int64Value(0)
+	}
+
+	minUpper := passwordDataV2.MinUpper
+
+	if minUpper.IsNull() {
+		minUpper = types.Int64Value(0)
+	}
+
+	minLower := passwordDataV2.MinLower
+
+	if minLower.IsNull() {
+		minLower = types.Int64Value(0)
+	}
+
+	minSpecial := passwordDataV2.MinSpecial
+
+	if minSpecial.IsNull() {
+		minSpecial = types.Int64Value(0)
+	}
+
+	special := passwordDataV2.Special
+
+	if special.IsNull() {
+		special = types.BoolValue(true)
+	}
+
+	upper := passwordDataV2.Upper
+
+	if upper.IsNull() {
+		upper = types.BoolValue(true)
+	}
+
+	lower := passwordDataV2.Lower
+
+	if lower.IsNull() {
+		lower = types.BoolValue(true)
+	}
+
+	number := passwordDataV2.Number
+
+	if number.IsNull() {
+		number = types.BoolValue(true)
+	}
+
+	numeric := passwordDataV2.Number
+
+	if numeric.IsNull() {
+		numeric = types.BoolValue(true)
+	}
+
+	// Schema version 2 to schema version 3 is a duplicate of the data,
+	// however the BcryptHash value may have been incorrectly generated.
+	//nolint:gosimple // V3 model will expand over time so all fields are written out to help future code changes.
+	passwordDataV4 := passwordModelV4{
+		stringSharedModel: stringSharedModel{
+			Keepers:             passwordDataV2.Keepers,
+			Length:              length,
+			Lower:               lower,
+			MinLower:            minLower,
+			MinNumeric:          minNumeric,
+			MinSpecial:          minSpecial,
+			MinUpper:            minUpper,
+			Number:              number,
+			Numeric:             numeric,
+			OverrideSpecial:     passwordDataV2.OverrideSpecial,
+			OverrideSpecialList: types.ListNull(types.StringType),
+			CharsetPreset:       types.StringNull(),
+			Result:              passwordDataV2.Result,
+			Special:             special,
+			Upper:               upper,
+		},
+		BcryptHash:         passwordDataV2.BcryptHash,
+		ID:                 passwordDataV2.ID,
+		Groups:             types.ObjectNull(passwordGroupsAttrTypes),
+		Results:            types.ListNull(types.StringType),
+		BcryptHashes:       types.ListNull(types.StringType),
+		PHCHash:            types.ObjectNull(phcHashAttrTypes),
+		RecipientPublicKey: types.StringNull(),
+		WrappedResult:      types.StringNull(),
+		Fingerprint:        types.StringNull(),
+		GenerateBcryptHash: types.BoolNull(),
+		Spec:               types.ObjectNull(specAttrTypes),
+	}
+
+	// Set the duplicated data now so we can easily return early below.
+	// The BcryptHash value will be adjusted later if it is incorrect.
+	resp.Diagnostics.Append(resp.State.Set(ctx, passwordDataV4)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// If the BcryptHash value does not correctly verify against the Result
+	// value we should regenerate it.
+	err := bcrypt.CompareHashAndPassword([]byte(passwordDataV2.BcryptHash.ValueString()), []byte(passwordDataV2.Result.ValueString()))
+
+	// If the hash matched the password, there is nothing to do.
+	if err == nil {
+		return
+	}
+
+	if !errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+		resp.Diagnostics.AddError(
+			"Version 3 State Upgrade Error",
+			"An unexpected error occurred when comparing the state version 2 password and bcrypt hash. "+
+				"This is always an issue in the provider and should be reported to the provider developers.\n\n"+
+				"Original Error: "+err.Error(),
+		)
+		return
+	}
+
+	// Regenerate the BcryptHash value.
+	start := time.Now()
+
+	newBcryptHash, err := bcrypt.GenerateFromPassword([]byte(passwordDataV2.Result.ValueString()), bcrypt.DefaultCost)
+
+	metrics.RecordBcrypt(time.Since(start))
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Version 3 State Upgrade Error",
+			"An unexpected error occurred when generating a new password bcrypt hash. "+
+				"Check the error below and ensure the system executing Terraform can properly generate randomness.\n\n"+
+				"Original Error: "+err.Error(),
+		)
+		return
+	}
+
+	passwordDataV4.BcryptHash = types.StringValue(string(newBcryptHash))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, passwordDataV4)...)
+}
+
+func upgradePasswordStateV3toV4(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	logStateUpgrade(ctx, 3, 4)
+
+	type passwordModelV3 struct {
+		ID              types.String `tfsdk:"id"`
+		Keepers         types.Map    `tfsdk:"keepers"`
+		Length          types.Int64  `tfsdk:"length"`
+		Special         types.Bool   `tfsdk:"special"`
+		Upper           types.Bool   `tfsdk:"upper"`
+		Lower           types.Bool   `tfsdk:"lower"`
+		Number          types.Bool   `tfsdk:"number"`
+		Numeric         types.Bool   `tfsdk:"numeric"`
+		MinNumeric      types.Int64  `tfsdk:"min_numeric"`
+		MinUpper        types.Int64  `tfsdk:"min_upper"`
+		MinLower        types.Int64  `tfsdk:"min_lower"`
+		MinSpecial      types.Int64  `tfsdk:"min_special"`
+		OverrideSpecial types.String `tfsdk:"override_special"`
+		Result          types.String `tfsdk:"result"`
+		BcryptHash      types.String `tfsdk:"bcrypt_hash"`
+	}
+
+	var passwordDataV3 passwordModelV3
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &passwordDataV3)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Schema version 3 to schema version 4 is a duplicate of the data. The
+	// creation time and provider version of resources created prior to
+	// schema version 4 are not known, so they are left as null.
+	passwordDataV4 := passwordModelV4{
+		stringSharedModel: stringSharedModel{
+			Keepers:                passwordDataV3.Keepers,
+			Length:                 passwordDataV3.Length,
+			Lower:                  passwordDataV3.Lower,
+			MinLower:               passwordDataV3.MinLower,
+			MinNumeric:             passwordDataV3.MinNumeric,
+			MinSpecial:             passwordDataV3.MinSpecial,
+			MinUpper:               passwordDataV3.MinUpper,
+			Number:                 passwordDataV3.Number,
+			Numeric:                passwordDataV3.Numeric,
+			OverrideSpecial:        passwordDataV3.OverrideSpecial,
+			OverrideSpecialList:    types.ListNull(types.StringType),
+			CharsetPreset:          types.StringNull(),
+			MinCharacterClasses:    types.Int64Null(),
+			Result:                 passwordDataV3.Result,
+			Special:                passwordDataV3.Special,
+			Upper:                  passwordDataV3.Upper,
+			KeepersHash:            types.StringNull(),
+			CreatedAt:              types.StringNull(),
+			ProviderVersion:        types.StringNull(),
+			LifecycleGuard:         types.BoolNull(),
+			AllowRegenerationToken: types.StringNull(),
+		},
+		BcryptHash:         passwordDataV3.BcryptHash,
+		ID:                 passwordDataV3.ID,
+		MinLength:          types.Int64Null(),
+		MaxLength:          types.Int64Null(),
+		PinnedPrefix:       types.StringNull(),
+		NoLeadingNumeric:   types.BoolNull(),
+		NoLeadingSpecial:   types.BoolNull(),
+		NoTrailingNumeric:  types.BoolNull(),
+		NoTrailingSpecial:  types.BoolNull(),
+		MaxRepeat:          types.Int64Null(),
+		Distinct:           types.BoolNull(),
+		Groups:             types.ObjectNull(passwordGroupsAttrTypes),
+		Preset:             types.StringNull(),
+		EnablePreview:      types.BoolNull(),
+		ResultPreview:      types.StringNull(),
+		EnableLegacyHashes: types.BoolNull(),
+		NTLMHash:           types.StringNull(),
+		GenerateBcryptHash: types.BoolNull(),
+		CryptSalt:          types.StringNull(),
+		SHA256Crypt:        types.StringNull(),
+		SHA512Crypt:        types.StringNull(),
+		SSHAHash:           types.StringNull(),
+		SSHA512:            types.StringNull(),
+		CountResults:       types.Int64Null(),
+		Results:            types.ListNull(types.StringType),
+		BcryptHashes:       types.ListNull(types.StringType),
+		PHCHash:            types.ObjectNull(phcHashAttrTypes),
+		RecipientPublicKey: types.StringNull(),
+		WrappedResult:      types.StringNull(),
+		Fingerprint:        types.StringNull(),
+		EncryptedResult:    types.StringNull(),
+		Spec:               types.ObjectNull(specAttrTypes),
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, passwordDataV4)...)
+}
+
+// validatePinnedPrefix returns an error diagnostic if the pinned prefix does not leave at least one character of the
+// length, and the minimum number of characters of each class, to be randomly generated. Unknown values are not
+// validated.
+func validatePinnedPrefix(pinnedPrefix types.String, length types.Int64, mins ...types.Int64) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if pinnedPrefix.IsNull() || pinnedPrefix.IsUnknown() || length.IsNull() || length.IsUnknown() {
+		return diags
+	}
+
+	var sumOfMins int64
+
+	for _, m := range mins {
+		if m.IsUnknown() {
+			return diags
+		}
+
+		sumOfMins += m.ValueInt64()
+	}
+
+	prefixLength := int64(len(pinnedPrefix.ValueString()))
+
+	if length.ValueInt64() < prefixLength+max(1, sumOfMins) {
+		diags.AddAttributeError(
+			path.Root("length"),
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute length value must be greater than the length of pinned_prefix (%d), and at "+
+				"least the length of pinned_prefix plus the sum of min_upper, min_lower, min_numeric and "+
+				"min_special (%d), got: %d", prefixLength, prefixLength+sumOfMins, length.ValueInt64()),
+		)
+	}
+
+	return diags
+}
+
+// validatePasswordGroups ensures that the length is the length of the result
+// split into the groups, including the separators between the groups, and
+// that the groups contain enough characters for the minimum number of
+// characters of each class.
+func validatePasswordGroups(ctx context.Context, groupsValue types.Object, length types.Int64, mins ...types.Int64) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	groups, ok := passwordModelV4{Groups: groupsValue}.groups(ctx)
+	if !ok || groups.Count.IsNull() || groups.Size.IsNull() || length.IsNull() || length.IsUnknown() {
+		return diags
+	}
+
+	if groups.length() != length.ValueInt64() {
+		diags.AddAttributeError(
+			path.Root("length"),
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute length value must equal the length of the grouped result, including separators, "+
+				"which is groups.count * groups.size + (groups.count - 1) * the length of groups.separator (%d), "+
+				"got: %d", groups.length(), length.ValueInt64()),
+		)
+
+		return diags
+	}
+
+	var sumOfMins int64
+
+	for _, m := range mins {
+		if m.IsUnknown() {
+			return diags
+		}
+
+		sumOfMins += m.ValueInt64()
+	}
+
+	if characters := groups.Count.ValueInt64() * groups.Size.ValueInt64(); characters < sumOfMins {
+		diags.AddAttributeError(
+			path.Root("groups"),
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute groups must contain at least the sum of min_upper, min_lower, min_numeric and "+
+				"min_special (%d) characters, excluding separators, got: %d", sumOfMins, characters),
+		)
+	}
+
+	return diags
+}
+
+// setHashes sets the hash attributes of the model for the given result. When
+// the provider is configured with fips = true, bcrypt_hash is not generated as
+// bcrypt is not a FIPS 140 approved algorithm, and pbkdf2_hash is generated
+// instead. sha256_crypt, sha512_crypt, ssha_hash and ssha512 are always
+// generated.
+func (r *passwordResource) setHashes(model *passwordModelV4, result string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.Append(r.setCryptHashes(model, result)...)
+	diags.Append(r.setSSHAHashes(model, result)...)
+
+	if r.fips {
+		hash, err := generatePBKDF2Hash(r.entropy, result)
+		if err != nil {
+			diags.Append(diagnostics.HashGenerationError(err.Error())...)
+		}
+
+		model.BcryptHash = types.StringNull()
+		model.PBKDF2Hash = types.StringValue(hash)
+
+		if model.GenerateBcryptHash.ValueBool() {
+			diags.Append(diagnostics.FIPSBcryptHashWarning()...)
+		}
+
+		return diags
+	}
+
+	model.PBKDF2Hash = types.StringNull()
+
+	return setBcryptHash(model, result)
+}
+
+// setPHCHash sets the hash of phc_hash for the given result, if phc_hash is
+// set. Only pbkdf2-sha256 is a FIPS 140 approved algorithm, so argon2id cannot
+// be used when the provider is configured with fips = true.
+func (r *passwordResource) setPHCHash(ctx context.Context, model *passwordModelV4, result string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if model.PHCHash.IsNull() {
+		return diags
+	}
+
+	var phcHash phcHashModel
+
+	diags.Append(model.PHCHash.As(ctx, &phcHash, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if r.fips && phcHash.Algorithm.ValueString() != phcPBKDF2SHA256 {
+		diags.AddAttributeError(
+			path.Root("phc_hash").AtName("algorithm"),
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute phc_hash.algorithm must be %q when the provider is configured with fips = true, "+
+				"as %s is not a FIPS 140 approved algorithm.", phcPBKDF2SHA256, phcHash.Algorithm.ValueString()),
+		)
+
+		return diags
+	}
+
+	hash, err := phcHash.generate(r.entropy, result)
+	if err != nil {
+		diags.Append(diagnostics.HashGenerationError(err.Error())...)
+
+		return diags
+	}
+
+	phcHash.Hash = types.StringValue(hash)
+
+	value, objectDiags := types.ObjectValueFrom(ctx, phcHashAttrTypes, phcHash)
+	diags.Append(objectDiags...)
+
+	model.PHCHash = value
+
+	return diags
+}
+
+// setCryptHashes sets sha256_crypt and sha512_crypt for the given result,
+// with a newly generated crypt_salt.
+func (r *passwordResource) setCryptHashes(model *passwordModelV4, result string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	salt, err := r.entropy.CreateString(random.StringParams{
+		Length:          crypt.MaxSaltLength,
+		Upper:           true,
+		Lower:           true,
+		Numeric:         true,
+		Special:         true,
+		OverrideSpecial: "./",
+	})
+	if err != nil {
+		diags.Append(diagnostics.HashGenerationError(err.Error())...)
+
+		return diags
+	}
+
+	release := acquireHashWorker()
+	model.CryptSalt = types.StringValue(string(salt))
+	model.SHA256Crypt = types.StringValue(crypt.SHA256(result, string(salt)))
+	model.SHA512Crypt = types.StringValue(crypt.SHA512(result, string(salt)))
+	release()
+
+	return diags
+}
+
+// setBcryptHashes sets bcrypt_hashes for the given results, or null if there
+// are no results, if generate_bcrypt_hash is false, or if the provider is
+// configured with fips = true.
+func (r *passwordResource) setBcryptHashes(model *passwordModelV4, results []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if results == nil || r.fips || !model.GenerateBcryptHash.ValueBool() {
+		model.BcryptHashes = types.ListNull(types.StringType)
+
+		return diags
+	}
+
+	hashes, err := generateHashes(results)
+	if err != nil {
+		diags.Append(diagnostics.HashGenerationError(err.Error())...)
+	}
+
+	hashValues := make([]attr.Value, len(hashes))
+	for i, hash := range hashes {
+		hashValues[i] = types.StringValue(hash)
+	}
+
+	model.BcryptHashes = types.ListValueMust(types.StringType, hashValues)
+
+	return diags
+}
 
-	if minNumeric.IsNull() {
-		minNumeric = types.Int64Value(0)
-	}
+// setBcryptHash sets bcrypt_hash for the given result, or null if
+// generate_bcrypt_hash is false.
+func setBcryptHash(model *passwordModelV4, result string) diag.Diagnostics {
+	var diags diag.Diagnostics
 
-	minUpper := passwordDataV2.MinUpper
+	if !model.GenerateBcryptHash.ValueBool() {
+		model.BcryptHash = types.StringNull()
 
-	if minUpper.IsNull() {
-		minUpper = types.Int64Value(0)
+		return diags
 	}
 
-	minLower := passwordDataV2.MinLower
-
-	if minLower.IsNull() {
-		minLower = types.Int64Value(0)
+	hash, err := generateHash(result)
+	if err != nil {
+		diags.Append(diagnostics.HashGenerationError(err.Error())...)
 	}
 
-	minSpecial := passwordDataV2.MinSpecial
+	model.BcryptHash = types.StringValue(hash)
 
-	if minSpecial.IsNull() {
-		minSpecial = types.Int64Value(0)
-	}
+	return diags
+}
 
-	special := passwordDataV2.Special
+// bcryptHashPlanModifier returns a plan modifier for the bcrypt_hash attribute
+// which plans null when generate_bcrypt_hash is false, and otherwise uses the
+// prior state value unless it is null, so that toggling generate_bcrypt_hash
+// generates or removes the hash during update without replacing the result.
+func bcryptHashPlanModifier() planmodifier.String {
+	return bcryptHashModifier{}
+}
 
-	if special.IsNull() {
-		special = types.BoolValue(true)
-	}
+// bcryptHashesPlanModifier returns the equivalent plan modifier for the
+// bcrypt_hashes attribute.
+func bcryptHashesPlanModifier() planmodifier.List {
+	return bcryptHashModifier{}
+}
 
-	upper := passwordDataV2.Upper
+type bcryptHashModifier struct{}
 
-	if upper.IsNull() {
-		upper = types.BoolValue(true)
-	}
+func (m bcryptHashModifier) Description(ctx context.Context) string {
+	return m.MarkdownDescription(ctx)
+}
 
-	lower := passwordDataV2.Lower
+func (m bcryptHashModifier) MarkdownDescription(context.Context) string {
+	return "Null when generate_bcrypt_hash is false, otherwise the value in state will not change once set."
+}
 
-	if lower.IsNull() {
-		lower = types.BoolValue(true)
+func (m bcryptHashModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	null, useState := planBcryptHash(ctx, req.Plan, req.State, req.StateValue.IsNull(), &resp.Diagnostics)
+
+	switch {
+	case null:
+		resp.PlanValue = types.StringNull()
+	case useState:
+		resp.PlanValue = req.StateValue
 	}
+}
 
-	number := passwordDataV2.Number
+func (m bcryptHashModifier) PlanModifyList(ctx context.Context, req planmodifier.ListRequest, resp *planmodifier.ListResponse) {
+	null, useState := planBcryptHash(ctx, req.Plan, req.State, req.StateValue.IsNull(), &resp.Diagnostics)
 
-	if number.IsNull() {
-		number = types.BoolValue(true)
-	}
+	// There are no results to hash unless count_results is set.
+	if !req.Plan.Raw.IsNull() {
+		var count types.Int64
 
-	numeric := passwordDataV2.Number
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("count_results"), &count)...)
 
-	if numeric.IsNull() {
-		numeric = types.BoolValue(true)
+		null = null || count.IsNull()
 	}
 
-	// Schema version 2 to schema version 3 is a duplicate of the data,
-	// however the BcryptHash value may have been incorrectly generated.
-	//nolint:gosimple // V3 model will expand over time so all fields are written out to help future code changes.
-	passwordDataV3 := passwordModelV3{
-		BcryptHash:      passwordDataV2.BcryptHash,
-		ID:              passwordDataV2.ID,
-		Keepers:         passwordDataV2.Keepers,
-		Length:          length,
-		Lower:           lower,
-		MinLower:        minLower,
-		MinNumeric:      minNumeric,
-		MinSpecial:      minSpecial,
-		MinUpper:        minUpper,
-		Number:          number,
-		Numeric:         numeric,
-		OverrideSpecial: passwordDataV2.OverrideSpecial,
-		Result:          passwordDataV2.Result,
-		Special:         special,
-		Upper:           upper,
+	switch {
+	case null:
+		resp.PlanValue = types.ListNull(types.StringType)
+	case useState:
+		resp.PlanValue = req.StateValue
 	}
+}
 
-	// Set the duplicated data now so we can easily return early below.
-	// The BcryptHash value will be adjusted later if it is incorrect.
-	resp.Diagnostics.Append(resp.State.Set(ctx, passwordDataV3)...)
-
-	if resp.Diagnostics.HasError() {
-		return
+// planBcryptHash returns whether a bcrypt hash attribute is planned as null,
+// because generate_bcrypt_hash is false, or otherwise whether the prior state
+// value is used. Neither is true when the resource is being destroyed, or the
+// value is left unknown to be generated.
+func planBcryptHash(ctx context.Context, plan tfsdk.Plan, state tfsdk.State, stateValueNull bool, diags *diag.Diagnostics) (bool, bool) {
+	// Do nothing if the resource is being destroyed.
+	if plan.Raw.IsNull() {
+		return false, false
 	}
 
-	// If the BcryptHash value does not correctly verify against the Result
-	// value we should regenerate it.
-	err := bcrypt.CompareHashAndPassword([]byte(passwordDataV2.BcryptHash.ValueString()), []byte(passwordDataV2.Result.ValueString()))
+	var generate types.Bool
 
-	// If the hash matched the password, there is nothing to do.
-	if err == nil {
-		return
+	diags.Append(plan.GetAttribute(ctx, path.Root("generate_bcrypt_hash"), &generate)...)
+	if diags.HasError() {
+		return false, false
 	}
 
-	if !errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
-		resp.Diagnostics.AddError(
-			"Version 3 State Upgrade Error",
-			"An unexpected error occurred when comparing the state version 2 password and bcrypt hash. "+
-				"This is always an issue in the provider and should be reported to the provider developers.\n\n"+
-				"Original Error: "+err.Error(),
-		)
-		return
+	if !generate.IsUnknown() && !generate.ValueBool() {
+		return true, false
 	}
 
-	// Regenerate the BcryptHash value.
-	newBcryptHash, err := bcrypt.GenerateFromPassword([]byte(passwordDataV2.Result.ValueString()), bcrypt.DefaultCost)
+	// Leave the value unknown when the resource is being created, or when
+	// the hash is to be generated during update.
+	return false, !state.Raw.IsNull() && !stateValueNull
+}
 
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Version 3 State Upgrade Error",
-			"An unexpected error occurred when generating a new password bcrypt hash. "+
-				"Check the error below and ensure the system executing Terraform can properly generate randomness.\n\n"+
-				"Original Error: "+err.Error(),
-		)
-		return
+// generateHashes returns the bcrypt hashes of the strings, generated
+// concurrently by the limited hashing workers.
+func generateHashes(toHash []string) ([]string, error) {
+	hashes := make([]string, len(toHash))
+	errs := make([]error, len(toHash))
+
+	var wg sync.WaitGroup
+
+	for i := range toHash {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			hashes[i], errs[i] = generateHash(toHash[i])
+		}()
 	}
 
-	passwordDataV3.BcryptHash = types.StringValue(string(newBcryptHash))
+	wg.Wait()
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, passwordDataV3)...)
+	return hashes, errors.Join(errs...)
 }
 
 // generateHash truncates strings that are longer than 72 bytes in
 // order to avoid the error returned from bcrypt.GenerateFromPassword
 // in versions v0.5.0 and above: https://pkg.go.dev/golang.org/x/crypto@v0.8.0/bcrypt#GenerateFromPassword
+//
+// Hashing waits for one of the limited hashing workers to become available.
 func generateHash(toHash string) (string, error) {
+	defer acquireHashWorker()()
+
 	bytesHash := []byte(toHash)
 	bytesToHash := bytesHash
 
@@ -550,11 +1577,379 @@ func generateHash(toHash string) (string, error) {
 		bytesToHash = bytesHash[:72]
 	}
 
+	start := time.Now()
+
 	hash, err := bcrypt.GenerateFromPassword(bytesToHash, bcrypt.DefaultCost)
 
+	metrics.RecordBcrypt(time.Since(start))
+
 	return string(hash), err
 }
 
+const (
+	pbkdf2Iterations = 600000
+	pbkdf2SaltLength = 16
+	pbkdf2KeyLength  = 32
+	pbkdf2HashPrefix = "$pbkdf2-sha256$"
+)
+
+// generatePBKDF2Hash returns a PBKDF2 with HMAC-SHA-256 hash of the string in
+// the modular crypt format used by passlib, for example:
+// $pbkdf2-sha256$i=600000$<salt>$<hash>. The iteration count follows the
+// OWASP recommendation for PBKDF2-HMAC-SHA256. The salt is read from the
+// given source of entropy.
+func generatePBKDF2Hash(entropy *random.Source, toHash string) (string, error) {
+	salt, err := entropy.CreateBytes(pbkdf2SaltLength)
+	if err != nil {
+		return "", err
+	}
+
+	release := acquireHashWorker()
+	key := pbkdf2.Key([]byte(toHash), salt, pbkdf2Iterations, pbkdf2KeyLength, sha256.New)
+	release()
+
+	return fmt.Sprintf("%si=%d$%s$%s", pbkdf2HashPrefix, pbkdf2Iterations,
+		base64.RawStdEncoding.EncodeToString(salt), base64.RawStdEncoding.EncodeToString(key)), nil
+}
+
+func passwordSchemaV4() schema.Schema {
+	return schema.Schema{
+		Version: 4,
+		Description: "Identical to [random_string](string.html) with the exception that the result is " +
+			"treated as sensitive and, thus, _not_ displayed in console output. Read more about sensitive " +
+			"data handling in the " +
+			"[Terraform documentation](https://www.terraform.io/docs/language/state/sensitive-data.html).\n\n" +
+			"This resource *does* use a cryptographic random number generator.",
+		Attributes: stringSchemaAttributes(stringSchemaOptions{
+			Sensitive:          true,
+			LengthPlanModifier: int64planmodifier.RequiresReplace(),
+			LengthAlternative:  &passwordMinLength,
+			LengthAlternativeDescription: "Required unless `min_length` and `max_length` are set, in which case " +
+				"the length chosen between them is recorded here.",
+			ConflictsWith: []path.Expression{path.MatchRoot("preset")},
+		}, passwordAttributes()),
+		Blocks: map[string]schema.Block{
+			"groups": schema.SingleNestedBlock{
+				Description: "Split the result into groups of characters joined by a separator, such as " +
+					"`4821-9937-1204-5561`, for license key or PIN style secrets. The separators count toward " +
+					"`length`, so `length` must equal `count` * `size` + (`count` - 1) * the length of " +
+					"`separator`. Conflicts with `pinned_prefix`.",
+				Attributes: map[string]schema.Attribute{
+					"count": schema.Int64Attribute{
+						Description: "The number of groups, which must be set when `groups` is configured. The " +
+							"minimum value is 1.",
+						Optional: true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+					"size": schema.Int64Attribute{
+						Description: "The number of randomly generated characters in each group, which must be set " +
+							"when `groups` is configured. The minimum value is 1.",
+						Optional: true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+					"separator": schema.StringAttribute{
+						Description: "The string placed between groups. Default value is `-`.",
+						Optional:    true,
+						Computed:    true,
+						Default:     stringdefault.StaticString(passwordGroupsDefaultSeparator),
+					},
+				},
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+				// The attributes of a block are validated even when the block is
+				// not configured, so count and size are required by the block
+				// rather than marked as required.
+				Validators: []validator.Object{
+					objectvalidator.ConflictsWith(path.MatchRoot("pinned_prefix")),
+					objectvalidator.AlsoRequires(
+						path.MatchRelative().AtName("count"),
+						path.MatchRelative().AtName("size"),
+					),
+				},
+			},
+
+			"phc_hash": phcHashBlock(),
+		},
+	}
+}
+
+// passwordAttributes returns the attributes of random_password which are not shared with
+// random_string.
+func passwordAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"min_length": schema.Int64Attribute{
+			Description: "The minimum length of the result, as an alternative to `length`, for policies which " +
+				"require passwords of varying lengths. The length is chosen randomly between `min_length` and " +
+				"`max_length`, inclusive, when the result is generated, and recorded in `length`. Must be at " +
+				"least the length of `pinned_prefix` plus (`min_upper` + `min_lower` + `min_numeric` + " +
+				"`min_special`), so that every length in the range can be generated. Requires `max_length`, " +
+				"and conflicts with `groups` and `preset`, which constrain the length.",
+			Optional: true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.RequiresReplace(),
+			},
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+				int64validator.AlsoRequires(path.MatchRoot("max_length")),
+				int64validator.ConflictsWith(path.MatchRoot("groups"), path.MatchRoot("preset")),
+			},
+		},
+
+		"max_length": schema.Int64Attribute{
+			Description: "The maximum length of the result, as an alternative to `length`. Must be at least " +
+				"`min_length`. Requires `min_length`.",
+			Optional: true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.RequiresReplace(),
+			},
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+				int64validator.AlsoRequires(passwordMinLength),
+			},
+		},
+
+		"no_leading_numeric": schema.BoolAttribute{
+			Description: "Ensure the result does not begin with a numeric character, for systems which " +
+				"reject such passwords. The first character is chosen from the other characters of the " +
+				"result rather than by regenerating it, so the `min_*` arguments are still satisfied. " +
+				"Conflicts with `pinned_prefix`.",
+			Optional: true,
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.RequiresReplace(),
+			},
+			Validators: []validator.Bool{
+				boolvalidator.ConflictsWith(path.MatchRoot("pinned_prefix")),
+			},
+		},
+
+		"no_leading_special": schema.BoolAttribute{
+			Description: "Ensure the result does not begin with a special character, meaning any " +
+				"character other than an ASCII letter or digit, for systems which reject such passwords. " +
+				"Conflicts with `pinned_prefix`.",
+			Optional: true,
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.RequiresReplace(),
+			},
+			Validators: []validator.Bool{
+				boolvalidator.ConflictsWith(path.MatchRoot("pinned_prefix")),
+			},
+		},
+
+		"no_trailing_numeric": schema.BoolAttribute{
+			Description: "Ensure the result does not end with a numeric character.",
+			Optional:    true,
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.RequiresReplace(),
+			},
+		},
+
+		"no_trailing_special": schema.BoolAttribute{
+			Description: "Ensure the result does not end with a special character, meaning any character " +
+				"other than an ASCII letter or digit.",
+			Optional: true,
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.RequiresReplace(),
+			},
+		},
+
+		"max_repeat": schema.Int64Attribute{
+			Description: "The maximum number of times a character may be repeated consecutively, such as " +
+				"`2` to allow `aa` but not `aaa`. The minimum value is 1. Characters are arranged to satisfy " +
+				"the limit rather than regenerated, and those exceeding it are replaced only when they occur " +
+				"too often to be arranged. Only applies to the randomly generated characters.",
+			Optional: true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.RequiresReplace(),
+			},
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+		},
+
+		"distinct": schema.BoolAttribute{
+			Description: "Ensure no character occurs more than once in the result, so `length` must not " +
+				"exceed the number of distinct characters which may be chosen. Only applies to the randomly " +
+				"generated characters.",
+			Optional: true,
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.RequiresReplace(),
+			},
+		},
+
+		"pinned_prefix": schema.StringAttribute{
+			Description: "A fixed prefix for the result, such as one identifying the environment, which is " +
+				"retained whenever the result is regenerated. The prefix counts toward `length`, and only the " +
+				"remaining characters are randomly generated, so `length` must be greater than the length of " +
+				"the prefix plus (`min_upper` + `min_lower` + `min_numeric` + `min_special`). The prefix is " +
+				"not random, and does not contribute to the strength of the result.",
+			Optional: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
+			},
+		},
+
+		"preset": schema.StringAttribute{
+			Description: "Generate a password satisfying the documented password policy of a cloud service, " +
+				"by using only the special characters the service accepts and requiring the minimum number " +
+				"of characters of each class it requires. Valid values are `aws_rds` (Amazon RDS master " +
+				"passwords, 8 to 41 characters), `azure_sql` (Azure SQL Database, 8 to 128 characters) and " +
+				"`gcp_sql` (Cloud SQL with the default complexity policy, at least 8 characters). The `min_*` " +
+				"arguments may require more characters of a class than the preset. Conflicts with " +
+				"`override_special`, `override_special_list` and `charset_preset`.",
+			Optional: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+			Validators: []validator.String{
+				stringvalidator.OneOf(passwordPresetNames()...),
+				stringvalidator.ConflictsWith(
+					path.MatchRoot("override_special"),
+					path.MatchRoot("override_special_list"),
+					path.MatchRoot("charset_preset"),
+				),
+			},
+		},
+
+		"enable_preview": enablePreviewAttribute(),
+
+		"result_preview": resultPreviewAttribute(),
+
+		"enable_legacy_hashes": enableLegacyHashesAttribute(),
+
+		"ntlm_hash": ntlmHashAttribute(),
+
+		"fingerprint": fingerprintAttribute(),
+
+		"count_results": schema.Int64Attribute{
+			Description: fmt.Sprintf("The number of independent passwords to generate in `results`, between "+
+				"1 and %d, each following the same arguments, such as to provision a batch of users from a "+
+				"single resource. When set, `result` is the first element of `results`.", passwordMaxCountResults),
+			Optional: true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.RequiresReplace(),
+			},
+			Validators: []validator.Int64{
+				int64validator.Between(1, passwordMaxCountResults),
+			},
+		},
+
+		"results": schema.ListAttribute{
+			Description: "The generated random strings, with the number of elements given by " +
+				"`count_results`. This value is `null` when `count_results` is not set.",
+			ElementType: types.StringType,
+			Computed:    true,
+			Sensitive:   true,
+			PlanModifiers: []planmodifier.List{
+				listplanmodifiers.UseStateForUnknownIncludingNull(),
+			},
+		},
+
+		"bcrypt_hashes": schema.ListAttribute{
+			Description: "The bcrypt hashes of the elements of `results`, in the same order, truncated in the " +
+				"same way as `bcrypt_hash`. This value is `null` when `count_results` is not set, when " +
+				"`generate_bcrypt_hash` is `false`, or when the provider is configured with `fips = true`.",
+			ElementType: types.StringType,
+			Computed:    true,
+			Sensitive:   true,
+			PlanModifiers: []planmodifier.List{
+				bcryptHashesPlanModifier(),
+			},
+		},
+
+		"bcrypt_hash": schema.StringAttribute{
+			Description: "A bcrypt hash of the generated random string. " +
+				"**NOTE**: If the generated random string is greater than 72 bytes in length, " +
+				"`bcrypt_hash` will contain a hash of the first 72 bytes. This value is `null` when " +
+				"`generate_bcrypt_hash` is `false`.",
+			Computed:  true,
+			Sensitive: true,
+			PlanModifiers: []planmodifier.String{
+				bcryptHashPlanModifier(),
+			},
+		},
+
+		"generate_bcrypt_hash": schema.BoolAttribute{
+			Description: "Generate `bcrypt_hash`. Set to `false` to avoid the cost of bcrypt hashing when " +
+				"`bcrypt_hash` is not used, in which case `bcrypt_hash` is `null`. Changing this value " +
+				"generates or removes `bcrypt_hash` without replacing the resource. Default value is `true`.",
+			Optional: true,
+			Computed: true,
+			Default:  booldefault.StaticBool(true),
+		},
+
+		"pbkdf2_hash": schema.StringAttribute{
+			Description: "A PBKDF2 with HMAC-SHA-256 hash of the generated random string, in the format " +
+				"`$pbkdf2-sha256$i=<iterations>$<salt>$<hash>` with the salt and hash base64 encoded without " +
+				"padding. Only generated when the provider is configured with `fips = true`, in which case " +
+				"`bcrypt_hash` is not generated.",
+			Computed:  true,
+			Sensitive: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifiers.UseStateForUnknownIncludingNull(),
+			},
+		},
+
+		"encrypted_result":     encryptedResultAttribute(),
+		"recipient_public_key": recipientPublicKeyAttribute(),
+		"wrapped_result":       wrappedResultAttribute(),
+
+		"crypt_salt": schema.StringAttribute{
+			Description: "The randomly generated salt of `sha256_crypt` and `sha512_crypt`, 16 characters " +
+				"chosen from `./0-9A-Za-z`.",
+			Computed: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+
+		"sha256_crypt": schema.StringAttribute{
+			Description: "A SHA-256 crypt hash of the generated random string with `crypt_salt`, in the " +
+				"`$5$<salt>$<hash>` format used in `/etc/shadow`.",
+			Computed:  true,
+			Sensitive: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+
+		"sha512_crypt": schema.StringAttribute{
+			Description: "A SHA-512 crypt hash of the generated random string with `crypt_salt`, in the " +
+				"`$6$<salt>$<hash>` format used in `/etc/shadow`, for example as the `passwd` of a " +
+				"cloud-init user.",
+			Computed:  true,
+			Sensitive: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+
+		"ssha_hash": sshaHashAttribute(),
+
+		"ssha512": ssha512Attribute(),
+
+		"spec": specAttribute("result", true),
+		"entropy_bits": entropyBitsAttribute("The entropy of the result in bits, calculated as the number of " +
+			"randomly generated characters multiplied by the base 2 logarithm of the number of distinct " +
+			"characters which may be chosen. Characters of `pinned_prefix` are not random, so are excluded."),
+
+		"id": schema.StringAttribute{
+			Description: "A static value used internally by Terraform, this should not be referenced in configurations.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+	}
+}
+
 func passwordSchemaV3() schema.Schema {
 	return schema.Schema{
 		Version: 3,
@@ -569,56 +1964,30 @@ func passwordSchemaV3() schema.Schema {
 					"resource. See [the main provider documentation](../index.html) for more information.",
 				ElementType: types.StringType,
 				Optional:    true,
-				PlanModifiers: []planmodifier.Map{
-					mapplanmodifiers.RequiresReplaceIfValuesNotNull(),
-				},
 			},
 
 			"length": schema.Int64Attribute{
 				Description: "The length of the string desired. The minimum value for length is 1 and, length " +
 					"must also be >= (`min_upper` + `min_lower` + `min_numeric` + `min_special`).",
 				Required: true,
-				PlanModifiers: []planmodifier.Int64{
-					int64planmodifier.RequiresReplace(),
-				},
-				Validators: []validator.Int64{
-					int64validator.AtLeast(1),
-					int64validator.AtLeastSumOf(
-						path.MatchRoot("min_upper"),
-						path.MatchRoot("min_lower"),
-						path.MatchRoot("min_numeric"),
-						path.MatchRoot("min_special"),
-					),
-				},
 			},
 
 			"special": schema.BoolAttribute{
 				Description: "Include special characters in the result. These are `!@#$%&*()-_=+[]{}<>:?`. Default value is `true`.",
 				Optional:    true,
 				Computed:    true,
-				Default:     booldefault.StaticBool(true),
-				PlanModifiers: []planmodifier.Bool{
-					boolplanmodifier.RequiresReplace(),
-				},
 			},
 
 			"upper": schema.BoolAttribute{
 				Description: "Include uppercase alphabet characters in the result. Default value is `true`.",
 				Optional:    true,
 				Computed:    true,
-				Default:     booldefault.StaticBool(true),
-				PlanModifiers: []planmodifier.Bool{
-					boolplanmodifier.RequiresReplace(),
-				}},
+			},
 
 			"lower": schema.BoolAttribute{
 				Description: "Include lowercase alphabet characters in the result. Default value is `true`.",
 				Optional:    true,
 				Computed:    true,
-				Default:     booldefault.StaticBool(true),
-				PlanModifiers: []planmodifier.Bool{
-					boolplanmodifier.RequiresReplace(),
-				},
 			},
 
 			"number": schema.BoolAttribute{
@@ -626,20 +1995,9 @@ func passwordSchemaV3() schema.Schema {
 					"If `number`, `upper`, `lower`, and `special` are all configured, at least one " +
 					"of them must be set to `true`. " +
 					"**NOTE**: This is deprecated, use `numeric` instead.",
-				Optional: true,
-				Computed: true,
-				PlanModifiers: []planmodifier.Bool{
-					boolplanmodifiers.NumberNumericAttributePlanModifier(),
-					boolplanmodifier.RequiresReplace(),
-				},
+				Optional:           true,
+				Computed:           true,
 				DeprecationMessage: "**NOTE**: This is deprecated, use `numeric` instead.",
-				Validators: []validator.Bool{
-					validators.AtLeastOneOfTrue(
-						path.MatchRoot("special"),
-						path.MatchRoot("upper"),
-						path.MatchRoot("lower"),
-					),
-				},
 			},
 
 			"numeric": schema.BoolAttribute{
@@ -648,57 +2006,30 @@ func passwordSchemaV3() schema.Schema {
 					"of them must be set to `true`.",
 				Optional: true,
 				Computed: true,
-				PlanModifiers: []planmodifier.Bool{
-					boolplanmodifiers.NumberNumericAttributePlanModifier(),
-					boolplanmodifier.RequiresReplace(),
-				},
-				Validators: []validator.Bool{
-					validators.AtLeastOneOfTrue(
-						path.MatchRoot("special"),
-						path.MatchRoot("upper"),
-						path.MatchRoot("lower"),
-					),
-				},
 			},
 
 			"min_numeric": schema.Int64Attribute{
 				Description: "Minimum number of numeric characters in the result. Default value is `0`.",
 				Optional:    true,
 				Computed:    true,
-				Default:     int64default.StaticInt64(0),
-				PlanModifiers: []planmodifier.Int64{
-					int64planmodifier.RequiresReplace(),
-				},
 			},
 
 			"min_upper": schema.Int64Attribute{
 				Description: "Minimum number of uppercase alphabet characters in the result. Default value is `0`.",
 				Optional:    true,
 				Computed:    true,
-				Default:     int64default.StaticInt64(0),
-				PlanModifiers: []planmodifier.Int64{
-					int64planmodifier.RequiresReplace(),
-				},
 			},
 
 			"min_lower": schema.Int64Attribute{
 				Description: "Minimum number of lowercase alphabet characters in the result. Default value is `0`.",
 				Optional:    true,
 				Computed:    true,
-				Default:     int64default.StaticInt64(0),
-				PlanModifiers: []planmodifier.Int64{
-					int64planmodifier.RequiresReplace(),
-				},
 			},
 
 			"min_special": schema.Int64Attribute{
 				Description: "Minimum number of special characters in the result. Default value is `0`.",
 				Optional:    true,
 				Computed:    true,
-				Default:     int64default.StaticInt64(0),
-				PlanModifiers: []planmodifier.Int64{
-					int64planmodifier.RequiresReplace(),
-				},
 			},
 
 			"override_special": schema.StringAttribute{
@@ -706,22 +2037,12 @@ func passwordSchemaV3() schema.Schema {
 					"overrides the default character list in the special argument.  The `special` argument must " +
 					"still be set to true for any overwritten characters to be used in generation.",
 				Optional: true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplaceIf(
-						stringplanmodifiers.RequiresReplaceUnlessEmptyStringToNull(),
-						"Replace on modification unless updating from empty string (\"\") to null.",
-						"Replace on modification unless updating from empty string (`\"\"`) to `null`.",
-					),
-				},
 			},
 
 			"result": schema.StringAttribute{
 				Description: "The generated random string.",
 				Computed:    true,
 				Sensitive:   true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.UseStateForUnknown(),
-				},
 			},
 
 			"bcrypt_hash": schema.StringAttribute{
@@ -730,17 +2051,11 @@ func passwordSchemaV3() schema.Schema {
 					"`bcrypt_hash` will contain a hash of the first 72 bytes.",
 				Computed:  true,
 				Sensitive: true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.UseStateForUnknown(),
-				},
 			},
 
 			"id": schema.StringAttribute{
 				Description: "A static value used internally by Terraform, this should not be referenced in configurations.",
 				Computed:    true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.UseStateForUnknown(),
-				},
 			},
 		},
 	}
@@ -1045,20 +2360,171 @@ func passwordSchemaV0() schema.Schema {
 	}
 }
 
-type passwordModelV3 struct {
-	ID              types.String `tfsdk:"id"`
-	Keepers         types.Map    `tfsdk:"keepers"`
-	Length          types.Int64  `tfsdk:"length"`
-	Special         types.Bool   `tfsdk:"special"`
-	Upper           types.Bool   `tfsdk:"upper"`
-	Lower           types.Bool   `tfsdk:"lower"`
-	Number          types.Bool   `tfsdk:"number"`
-	Numeric         types.Bool   `tfsdk:"numeric"`
-	MinNumeric      types.Int64  `tfsdk:"min_numeric"`
-	MinUpper        types.Int64  `tfsdk:"min_upper"`
-	MinLower        types.Int64  `tfsdk:"min_lower"`
-	MinSpecial      types.Int64  `tfsdk:"min_special"`
-	OverrideSpecial types.String `tfsdk:"override_special"`
-	Result          types.String `tfsdk:"result"`
-	BcryptHash      types.String `tfsdk:"bcrypt_hash"`
+type passwordModelV4 struct {
+	stringSharedModel
+
+	ID                 types.String  `tfsdk:"id"`
+	MinLength          types.Int64   `tfsdk:"min_length"`
+	MaxLength          types.Int64   `tfsdk:"max_length"`
+	PinnedPrefix       types.String  `tfsdk:"pinned_prefix"`
+	NoLeadingNumeric   types.Bool    `tfsdk:"no_leading_numeric"`
+	NoLeadingSpecial   types.Bool    `tfsdk:"no_leading_special"`
+	NoTrailingNumeric  types.Bool    `tfsdk:"no_trailing_numeric"`
+	NoTrailingSpecial  types.Bool    `tfsdk:"no_trailing_special"`
+	MaxRepeat          types.Int64   `tfsdk:"max_repeat"`
+	Distinct           types.Bool    `tfsdk:"distinct"`
+	Groups             types.Object  `tfsdk:"groups"`
+	Preset             types.String  `tfsdk:"preset"`
+	EnablePreview      types.Bool    `tfsdk:"enable_preview"`
+	ResultPreview      types.String  `tfsdk:"result_preview"`
+	EnableLegacyHashes types.Bool    `tfsdk:"enable_legacy_hashes"`
+	NTLMHash           types.String  `tfsdk:"ntlm_hash"`
+	CountResults       types.Int64   `tfsdk:"count_results"`
+	Results            types.List    `tfsdk:"results"`
+	BcryptHash         types.String  `tfsdk:"bcrypt_hash"`
+	BcryptHashes       types.List    `tfsdk:"bcrypt_hashes"`
+	GenerateBcryptHash types.Bool    `tfsdk:"generate_bcrypt_hash"`
+	PBKDF2Hash         types.String  `tfsdk:"pbkdf2_hash"`
+	EncryptedResult    types.String  `tfsdk:"encrypted_result"`
+	PHCHash            types.Object  `tfsdk:"phc_hash"`
+	RecipientPublicKey types.String  `tfsdk:"recipient_public_key"`
+	WrappedResult      types.String  `tfsdk:"wrapped_result"`
+	Fingerprint        types.String  `tfsdk:"fingerprint"`
+	CryptSalt          types.String  `tfsdk:"crypt_salt"`
+	SHA256Crypt        types.String  `tfsdk:"sha256_crypt"`
+	SHA512Crypt        types.String  `tfsdk:"sha512_crypt"`
+	SSHAHash           types.String  `tfsdk:"ssha_hash"`
+	SSHA512            types.String  `tfsdk:"ssha512"`
+	EntropyBits        types.Float64 `tfsdk:"entropy_bits"`
+	Spec               types.Object  `tfsdk:"spec"`
+}
+
+// params returns the parameters for generating the random characters of the
+// result. The pinned prefix and group separators count toward the length, so
+// only the remaining characters are generated.
+func (m passwordModelV4) params(ctx context.Context) random.StringParams {
+	length := m.Length.ValueInt64() - int64(len(m.PinnedPrefix.ValueString()))
+
+	if groups, ok := m.groups(ctx); ok {
+		length = groups.Count.ValueInt64() * groups.Size.ValueInt64()
+	}
+
+	mins := m.mins()
+	overrideSpecial, _ := overrideSpecialValue(m.OverrideSpecial, m.OverrideSpecialList)
+
+	if preset, ok := m.preset(); ok {
+		overrideSpecial = types.StringValue(preset.special)
+	}
+
+	return random.StringParams{
+		Length:              length,
+		Upper:               m.Upper.ValueBool(),
+		MinUpper:            mins[0].ValueInt64(),
+		Lower:               m.Lower.ValueBool(),
+		MinLower:            mins[1].ValueInt64(),
+		Numeric:             m.Numeric.ValueBool(),
+		MinNumeric:          mins[2].ValueInt64(),
+		Special:             m.Special.ValueBool(),
+		MinSpecial:          mins[3].ValueInt64(),
+		MinCharacterClasses: m.MinCharacterClasses.ValueInt64(),
+		OverrideSpecial:     overrideSpecial.ValueString(),
+		NoLeadingNumeric:    m.NoLeadingNumeric.ValueBool(),
+		NoLeadingSpecial:    m.NoLeadingSpecial.ValueBool(),
+		NoTrailingNumeric:   m.NoTrailingNumeric.ValueBool(),
+		NoTrailingSpecial:   m.NoTrailingSpecial.ValueBool(),
+		MaxRepeat:           m.MaxRepeat.ValueInt64(),
+		Distinct:            m.Distinct.ValueBool(),
+		Charset:             charsetPresetChars(m.CharsetPreset),
+	}
+}
+
+// mins returns the minimum number of uppercase, lowercase, numeric and special
+// characters, in that order, raised to those required by the preset.
+func (m passwordModelV4) mins() []types.Int64 {
+	mins := []types.Int64{m.MinUpper, m.MinLower, m.MinNumeric, m.MinSpecial}
+
+	preset, ok := m.preset()
+	if !ok {
+		return mins
+	}
+
+	for i, presetMin := range []int64{preset.minUpper, preset.minLower, preset.minNumeric, preset.minSpecial} {
+		if !mins[i].IsUnknown() && mins[i].ValueInt64() < presetMin {
+			mins[i] = types.Int64Value(presetMin)
+		}
+	}
+
+	return mins
+}
+
+func (m passwordModelV4) entropyBits(ctx context.Context) types.Float64 {
+	return types.Float64Value(random.StringEntropyBits(m.params(ctx)))
+}
+
+func (m passwordModelV4) spec(ctx context.Context) types.Object {
+	return stringSpec(m.Length, m.params(ctx), m.entropyBits(ctx))
+}
+
+// groups returns the groups of the model, and whether the groups are set and
+// known.
+func (m passwordModelV4) groups(ctx context.Context) (passwordGroupsModel, bool) {
+	var groups passwordGroupsModel
+
+	if m.Groups.IsNull() || m.Groups.IsUnknown() {
+		return groups, false
+	}
+
+	if diags := m.Groups.As(ctx, &groups, basetypes.ObjectAsOptions{}); diags.HasError() {
+		return groups, false
+	}
+
+	if groups.Count.IsUnknown() || groups.Size.IsUnknown() || groups.Separator.IsUnknown() {
+		return groups, false
+	}
+
+	return groups, true
+}
+
+// passwordGroupsDefaultSeparator is the default separator of groups.
+const passwordGroupsDefaultSeparator = "-"
+
+var passwordGroupsAttrTypes = map[string]attr.Type{
+	"count":     types.Int64Type,
+	"size":      types.Int64Type,
+	"separator": types.StringType,
+}
+
+type passwordGroupsModel struct {
+	Count     types.Int64  `tfsdk:"count"`
+	Size      types.Int64  `tfsdk:"size"`
+	Separator types.String `tfsdk:"separator"`
+}
+
+// separator returns the separator of the groups, which is the default in
+// configuration which does not set it, as defaults are only applied to the
+// plan.
+func (g passwordGroupsModel) separator() string {
+	if g.Separator.IsNull() {
+		return passwordGroupsDefaultSeparator
+	}
+
+	return g.Separator.ValueString()
+}
+
+// length returns the length of a result split into the groups, which includes
+// the separators between the groups.
+func (g passwordGroupsModel) length() int64 {
+	return g.Count.ValueInt64()*g.Size.ValueInt64() + (g.Count.ValueInt64()-1)*int64(len(g.separator()))
+}
+
+// format splits the result into the groups, joined by the separator.
+func (g passwordGroupsModel) format(result []byte) []byte {
+	size := int(g.Size.ValueInt64())
+	parts := make([]string, 0, g.Count.ValueInt64())
+
+	for i := 0; i < len(result); i += size {
+		parts = append(parts, string(result[i:min(i+size, len(result))]))
+	}
+
+	return []byte(strings.Join(parts, g.separator()))
 }
@@ -4,17 +4,30 @@
 package provider
 
 import (
+	"bytes"
 	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
 	"errors"
 	"fmt"
+	"math"
 	"regexp"
 	"runtime"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	res "github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/compare"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -23,8 +36,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"golang.org/x/crypto/bcrypt"
+	"golang.org/x/crypto/pbkdf2"
 
+	"github.com/terraform-providers/terraform-provider-random/internal/publish"
 	"github.com/terraform-providers/terraform-provider-random/internal/random"
 	"github.com/terraform-providers/terraform-provider-random/internal/randomtest"
 )
@@ -52,10 +68,10 @@ func TestGenerateHash(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			randomBytes, err := random.CreateString(testCase.input)
+			randomBytes, err := random.NewSource(nil).CreateString(testCase.input)
 
 			if err != nil {
-				t.Fatalf("unexpected random.CreateString error: %s", err)
+				t.Fatalf("unexpected CreateString error: %s", err)
 			}
 
 			hash, err := generateHash(string(randomBytes))
@@ -73,6 +89,69 @@ func TestGenerateHash(t *testing.T) {
 	}
 }
 
+func TestGeneratePBKDF2Hash(t *testing.T) {
+	t.Parallel()
+
+	hash, err := generatePBKDF2Hash(nil, "password")
+
+	if err != nil {
+		t.Fatalf("unexpected generatePBKDF2Hash error: %s", err)
+	}
+
+	parts := strings.Split(hash, "$")
+
+	if len(parts) != 5 || parts[1] != "pbkdf2-sha256" || parts[2] != fmt.Sprintf("i=%d", pbkdf2Iterations) {
+		t.Fatalf("unexpected hash format: %s", hash)
+	}
+
+	salt, err := base64.RawStdEncoding.DecodeString(parts[3])
+
+	if err != nil {
+		t.Fatalf("unexpected salt decoding error: %s", err)
+	}
+
+	key := pbkdf2.Key([]byte("password"), salt, pbkdf2Iterations, pbkdf2KeyLength, sha256.New)
+
+	if got, want := parts[4], base64.RawStdEncoding.EncodeToString(key); got != want {
+		t.Errorf("expected hash %s, got: %s", want, got)
+	}
+}
+
+func TestPasswordGroupsFormat(t *testing.T) {
+	t.Parallel()
+
+	groups := passwordGroupsModel{
+		Count:     types.Int64Value(4),
+		Size:      types.Int64Value(4),
+		Separator: types.StringValue("-"),
+	}
+
+	got := string(groups.format([]byte("4821993712045561")))
+
+	if want := "4821-9937-1204-5561"; got != want {
+		t.Errorf("expected %s, got: %s", want, got)
+	}
+
+	if got, want := groups.length(), int64(len(got)); got != want {
+		t.Errorf("expected length %d, got: %d", want, got)
+	}
+}
+
+func TestPasswordGroupsLength_DefaultSeparator(t *testing.T) {
+	t.Parallel()
+
+	// The separator is null in configuration which does not set it.
+	groups := passwordGroupsModel{
+		Count:     types.Int64Value(4),
+		Size:      types.Int64Value(4),
+		Separator: types.StringNull(),
+	}
+
+	if got, want := groups.length(), int64(len("4821-9937-1204-5561")); got != want {
+		t.Errorf("expected length %d, got: %d", want, got)
+	}
+}
+
 func TestCreateString(t *testing.T) {
 	t.Parallel()
 
@@ -105,7 +184,7 @@ func TestCreateString(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			_, err := random.CreateString(testCase.input)
+			_, err := random.NewSource(nil).CreateString(testCase.input)
 
 			if diff := cmp.Diff(testCase.expectedError, err, equateErrorMessage); diff != "" {
 				t.Errorf("unexpected difference: %s", diff)
@@ -114,9 +193,45 @@ func TestCreateString(t *testing.T) {
 	}
 }
 
+func TestCreateString_Source(t *testing.T) {
+	t.Parallel()
+
+	input := random.StringParams{
+		Length:  32,
+		Lower:   true,
+		Numeric: true,
+		Special: true,
+		Upper:   true,
+	}
+
+	entropy := bytes.Repeat([]byte{0x2a, 0x07, 0xf1, 0x90}, 256)
+
+	first, err := random.NewSource(bytes.NewReader(entropy)).CreateString(input)
+
+	if err != nil {
+		t.Fatalf("unexpected CreateString error: %s", err)
+	}
+
+	second, err := random.NewSource(bytes.NewReader(entropy)).CreateString(input)
+
+	if err != nil {
+		t.Fatalf("unexpected CreateString error: %s", err)
+	}
+
+	if string(first) != string(second) {
+		t.Errorf("expected identical strings from identical sources, got: %s and %s", first, second)
+	}
+
+	_, err = random.NewSource(bytes.NewReader(nil)).CreateString(input)
+
+	if err == nil {
+		t.Error("expected error from exhausted source, got none")
+	}
+}
+
 func TestAccResourcePassword_Import(t *testing.T) {
 	resource.UnitTest(t, resource.TestCase{
-		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: `resource "random_password" "basic" {
@@ -145,7 +260,7 @@ func TestAccResourcePassword_Import(t *testing.T) {
 				},
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"bcrypt_hash"},
+				ImportStateVerifyIgnore: []string{"bcrypt_hash", "created_at", "crypt_salt", "provider_version", "sha256_crypt", "sha512_crypt"},
 			},
 		},
 	})
@@ -155,7 +270,7 @@ func TestAccResourcePassword_BcryptHash(t *testing.T) {
 	t.Parallel()
 
 	resource.UnitTest(t, resource.TestCase{
-		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: `resource "random_password" "test" {
@@ -196,7 +311,7 @@ func TestAccResourcePassword_BcryptHash_FromVersion3_3_2(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_password" "test" {
 					length = 12
 				}`,
@@ -236,7 +351,7 @@ func TestAccResourcePassword_BcryptHash_FromVersion3_4_2(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_password" "test" {
 					length = 12
 				}`,
@@ -255,7 +370,7 @@ func TestAccResourcePassword_BcryptHash_FromVersion3_4_2(t *testing.T) {
 
 func TestAccResourcePassword_Override(t *testing.T) {
 	resource.UnitTest(t, resource.TestCase{
-		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: `resource "random_password" "override" {
@@ -265,101 +380,1666 @@ func TestAccResourcePassword_Override(t *testing.T) {
 							upper = false
 							numeric = false
 						}`,
-				ConfigStateChecks: []statecheck.StateCheck{
-					statecheck.ExpectKnownValue("random_password.override", tfjsonpath.New("result"), randomtest.StringLengthExact(4)),
-					statecheck.ExpectKnownValue("random_password.override", tfjsonpath.New("result"), knownvalue.StringExact("!!!!")),
-				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_password.override", tfjsonpath.New("result"), randomtest.StringLengthExact(4)),
+					statecheck.ExpectKnownValue("random_password.override", tfjsonpath.New("result"), knownvalue.StringExact("!!!!")),
+				},
+			},
+		},
+	})
+}
+
+// TestAccResourcePassword_OverrideSpecial_FromVersion3_3_2 verifies behaviour
+// when upgrading the provider version from 3.3.2, which set the
+// override_special value to null and should not result in a plan difference.
+// Reference: https://github.com/hashicorp/terraform-provider-random/issues/306
+func TestAccResourcePassword_OverrideSpecial_FromVersion3_3_2(t *testing.T) {
+	// The result attribute values should be the same between test steps
+	assertResultSame := statecheck.CompareValue(compare.ValuesSame())
+
+	resource.ParallelTest(t, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				ExternalProviders: providerVersion332(),
+				Config: `resource "random_password" "test" {
+							length = 12
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_password.test", tfjsonpath.New("override_special"), knownvalue.Null()),
+					assertResultSame.AddStateValue("random_password.test", tfjsonpath.New("result")),
+				},
+			},
+			{
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
+				Config: `resource "random_password" "test" {
+					length = 12
+				}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_password.test", tfjsonpath.New("override_special"), knownvalue.Null()),
+					assertResultSame.AddStateValue("random_password.test", tfjsonpath.New("result")),
+				},
+			},
+		},
+	})
+}
+
+// TestAccResourcePassword_OverrideSpecial_FromVersion3_4_2 verifies behaviour
+// when upgrading the provider version from 3.4.2, which set the
+// override_special value to "", while other versions do not.
+// Reference: https://github.com/hashicorp/terraform-provider-random/issues/306
+func TestAccResourcePassword_OverrideSpecial_FromVersion3_4_2(t *testing.T) {
+	// The result attribute values should be the same between test steps
+	assertResultSame := statecheck.CompareValue(compare.ValuesSame())
+
+	resource.ParallelTest(t, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				ExternalProviders: providerVersion342(),
+				Config: `resource "random_password" "test" {
+							length = 12
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_password.test", tfjsonpath.New("override_special"), knownvalue.StringExact("")),
+					assertResultSame.AddStateValue("random_password.test", tfjsonpath.New("result")),
+				},
+			},
+			{
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
+				Config: `resource "random_password" "test" {
+					length = 12
+				}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_password.test", tfjsonpath.New("override_special"), knownvalue.Null()),
+					assertResultSame.AddStateValue("random_password.test", tfjsonpath.New("result")),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourcePassword_ImportWithoutKeepersProducesNoPlannedChanges(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_password" "test" {
+							length = 12
+						}`,
+				ResourceName:       "random_password.test",
+				ImportStateId:      "Z=:cbrJE?Ltg",
+				ImportState:        true,
+				ImportStatePersist: true,
+			},
+			{
+				Config: `resource "random_password" "test" {
+							length = 12
+						}`,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestPasswordImportJSON(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		id                string
+		expectedLength    int64
+		expectedMinLength types.Int64
+		expectedPreset    types.String
+		expectedError     string
+	}{
+		// The length is measured in characters, as it is for random_string.
+		"multibyte-result": {
+			id:                `{"result": "äöü"}`,
+			expectedLength:    3,
+			expectedMinLength: types.Int64Null(),
+			expectedPreset:    types.StringNull(),
+		},
+		"min-max-length": {
+			id:                `{"result": "abcdef", "min_length": 4, "max_length": 8, "charset_preset": "alphanumeric"}`,
+			expectedLength:    6,
+			expectedMinLength: types.Int64Value(4),
+			expectedPreset:    types.StringValue("alphanumeric"),
+		},
+		"min-length-only": {
+			id:            `{"result": "abcdef", "min_length": 4}`,
+			expectedError: `the "min_length" and "max_length" keys must be set together`,
+		},
+		"missing-result": {
+			id:            `{"length": 3}`,
+			expectedError: `the "result" key must be set to the password`,
+		},
+		"unknown-key": {
+			id:            `{"result": "abc", "count_results": 2}`,
+			expectedError: `json: unknown field "count_results"`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var data passwordImportJSON
+
+			err := data.decode(testCase.id)
+
+			if testCase.expectedError != "" {
+				if err == nil || err.Error() != testCase.expectedError {
+					t.Errorf("expected error %q, got: %v", testCase.expectedError, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			state := passwordModelV4{
+				stringSharedModel: stringSharedModel{
+					CharsetPreset: types.StringNull(),
+				},
+				MinLength: types.Int64Null(),
+			}
+
+			data.apply(&state)
+
+			if state.Length.ValueInt64() != testCase.expectedLength {
+				t.Errorf("expected length %d, got: %d", testCase.expectedLength, state.Length.ValueInt64())
+			}
+
+			if !state.MinLength.Equal(testCase.expectedMinLength) {
+				t.Errorf("expected min_length %s, got: %s", testCase.expectedMinLength, state.MinLength)
+			}
+
+			if !state.CharsetPreset.Equal(testCase.expectedPreset) {
+				t.Errorf("expected charset_preset %s, got: %s", testCase.expectedPreset, state.CharsetPreset)
+			}
+		})
+	}
+}
+
+func TestAccResourcePassword_ImportJSON(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_password" "test" {
+							length  = 16
+							lower   = false
+							special = false
+						}`,
+				ResourceName:       "random_password.test",
+				ImportStateId:      `{"result": "ZKCBRJELTGPDAQWN", "length": 16, "lower": false, "special": false}`,
+				ImportState:        true,
+				ImportStatePersist: true,
+			},
+			{
+				Config: `resource "random_password" "test" {
+							length  = 16
+							lower   = false
+							special = false
+						}`,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccResourcePassword_ImportJSON_MissingResult(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_password" "test" {
+							length = 12
+						}`,
+				ResourceName:  "random_password.test",
+				ImportStateId: `{"length": 12}`,
+				ImportState:   true,
+				ExpectError:   regexp.MustCompile(`the "result" key must be set to the password`),
+			},
+		},
+	})
+}
+
+func TestAccResourcePassword_ImportJSON_UnknownKey(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_password" "test" {
+							length = 12
+						}`,
+				ResourceName:  "random_password.test",
+				ImportStateId: `{"result": "Z=:cbrJE?Ltg", "lenght": 12}`,
+				ImportState:   true,
+				ExpectError:   regexp.MustCompile(`unknown field "lenght"`),
+			},
+		},
+	})
+}
+
+func TestAccResourcePassword_ImportJSON_PinnedPrefix(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_password" "test" {
+							length        = 12
+							pinned_prefix = "prd-"
+						}`,
+				ResourceName:       "random_password.test",
+				ImportStateId:      `{"result": "prd-Z=:cbrJE", "pinned_prefix": "prd-"}`,
+				ImportState:        true,
+				ImportStatePersist: true,
+			},
+			{
+				Config: `resource "random_password" "test" {
+							length        = 12
+							pinned_prefix = "prd-"
+						}`,
+				PlanOnly: true,
+			},
+			{
+				Config: `resource "random_password" "test" {
+							length        = 12
+							pinned_prefix = "prd-"
+						}`,
+				ResourceName:  "random_password.test",
+				ImportStateId: `{"result": "Z=:cbrJE?Ltg", "pinned_prefix": "prd-"}`,
+				ImportState:   true,
+				ExpectError:   regexp.MustCompile(`must begin with the value of the "pinned_prefix" key`),
+			},
+		},
+	})
+}
+
+func TestAccResourcePassword_PinnedPrefix(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_password" "test" {
+							length        = 20
+							special       = false
+							pinned_prefix = "prd-"
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_password.test", tfjsonpath.New("result"), knownvalue.StringRegexp(regexp.MustCompile(`^prd-[a-zA-Z0-9]{16}$`))),
+					statecheck.ExpectKnownValue("random_password.test", tfjsonpath.New("pinned_prefix"), knownvalue.StringExact("prd-")),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourcePassword_PinnedPrefix_Keepers_Replace(t *testing.T) {
+	// The result attribute values should differ between test steps
+	assertResultDiffer := statecheck.CompareValue(compare.ValuesDiffer())
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_password" "test" {
+							length        = 20
+							pinned_prefix = "prd-"
+							keepers = {
+								"key" = "123"
+							}
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					assertResultDiffer.AddStateValue("random_password.test", tfjsonpath.New("result")),
+					statecheck.ExpectKnownValue("random_password.test", tfjsonpath.New("result"), knownvalue.StringRegexp(regexp.MustCompile(`^prd-.{16}$`))),
+				},
+			},
+			{
+				Config: `resource "random_password" "test" {
+							length        = 20
+							pinned_prefix = "prd-"
+							keepers = {
+								"key" = "456"
+							}
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					assertResultDiffer.AddStateValue("random_password.test", tfjsonpath.New("result")),
+					statecheck.ExpectKnownValue("random_password.test", tfjsonpath.New("result"), knownvalue.StringRegexp(regexp.MustCompile(`^prd-.{16}$`))),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourcePassword_EntropyBits(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_password" "test" {
+							length        = 20
+							upper         = false
+							lower         = false
+							special       = false
+							pinned_prefix = "prd-"
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_password.test", tfjsonpath.New("entropy_bits"), knownvalue.Float64Exact(16*math.Log2(10))),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourcePassword_SSHA(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_password" "test" {
+							length = 12
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_password.test", tfjsonpath.New("ssha_hash"), knownvalue.StringRegexp(regexp.MustCompile(`^\{SSHA\}[A-Za-z0-9+/]{38}==$`))),
+					statecheck.ExpectKnownValue("random_password.test", tfjsonpath.New("ssha512"), knownvalue.StringRegexp(regexp.MustCompile(`^\{SSHA512\}[A-Za-z0-9+/]{96}$`))),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourcePassword_FIPS(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `provider "random" {
+							fips = true
+						}
+
+						resource "random_password" "test" {
+							length = 12
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_password.test", tfjsonpath.New("bcrypt_hash"), knownvalue.Null()),
+					statecheck.ExpectKnownValue("random_password.test", tfjsonpath.New("pbkdf2_hash"), knownvalue.StringRegexp(regexp.MustCompile(`^\$pbkdf2-sha256\$i=600000\$[A-Za-z0-9+/]{22}\$[A-Za-z0-9+/]{43}$`))),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourcePassword_EncryptedResult(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_password" "test" {
+							length = 12
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_password.test", tfjsonpath.New("encrypted_result"), knownvalue.Null()),
+				},
+			},
+			{
+				Config: `provider "random" {
+							result_encryption_key = "correct horse battery staple"
+						}
+
+						resource "random_password" "test" {
+							length = 12
+						}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_password.test", tfjsonpath.New("encrypted_result"), knownvalue.StringRegexp(regexp.MustCompile(`^\$aes-256-gcm\$pbkdf2-sha256\$i=600000\$[A-Za-z0-9+/]{22}\$[A-Za-z0-9+/]{16}\$[A-Za-z0-9+/]{38}$`))),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourcePassword_FIPS_Disabled(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_password" "test" {
+							length = 12
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_password.test", tfjsonpath.New("bcrypt_hash"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue("random_password.test", tfjsonpath.New("pbkdf2_hash"), knownvalue.Null()),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourcePassword_FIPS_Enabled(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_password" "test" {
+							length = 12
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_password.test", tfjsonpath.New("pbkdf2_hash"), knownvalue.Null()),
+				},
+			},
+			{
+				Config: `provider "random" {
+							fips = true
+						}
+
+						resource "random_password" "test" {
+							length = 12
+						}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_password.test", tfjsonpath.New("pbkdf2_hash"), knownvalue.NotNull()),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourcePassword_FIPS_Import(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `provider "random" {
+							fips = true
+						}
+
+						resource "random_password" "test" {
+							length = 12
+						}`,
+				ResourceName:       "random_password.test",
+				ImportStateId:      "Z=:cbrJE?Ltg",
+				ImportState:        true,
+				ImportStatePersist: true,
+				ImportStateCheck: composeImportStateCheck(
+					testCheckNoResourceAttrInstanceState("bcrypt_hash"),
+					testCheckResourceAttrInstanceState("pbkdf2_hash"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourcePassword_CryptHashes(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_password" "test" {
+							length = 12
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_password.test", tfjsonpath.New("crypt_salt"), knownvalue.StringRegexp(regexp.MustCompile(`^[./0-9A-Za-z]{16}$`))),
+					statecheck.ExpectKnownValue("random_password.test", tfjsonpath.New("sha256_crypt"), knownvalue.StringRegexp(regexp.MustCompile(`^\$5\$[./0-9A-Za-z]{16}\$[./0-9A-Za-z]{43}$`))),
+					statecheck.ExpectKnownValue("random_password.test", tfjsonpath.New("sha512_crypt"), knownvalue.StringRegexp(regexp.MustCompile(`^\$6\$[./0-9A-Za-z]{16}\$[./0-9A-Za-z]{86}$`))),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourcePassword_NoLeadingTrailing(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_password" "test" {
+							length              = 12
+							min_numeric         = 5
+							min_special         = 5
+							no_leading_numeric  = true
+							no_leading_special  = true
+							no_trailing_special = true
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_password.test", tfjsonpath.New("result"), knownvalue.StringRegexp(regexp.MustCompile(`^[A-Za-z].{10}[A-Za-z0-9]$`))),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourcePassword_NoLeading_PinnedPrefix(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_password" "test" {
+							length             = 12
+							pinned_prefix      = "prd-"
+							no_leading_numeric = true
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute "pinned_prefix" cannot be specified when "no_leading_numeric" is\s+specified`),
+			},
+		},
+	})
+}
+
+func TestAccResourcePassword_MaxRepeat(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_password" "test" {
+							length           = 32
+							special          = true
+							upper            = false
+							lower            = false
+							numeric          = false
+							override_special = "!@"
+							max_repeat       = 1
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_password.test", tfjsonpath.New("result"), knownvalue.StringRegexp(regexp.MustCompile(`^(!@|@!)+$`))),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourcePassword_Distinct(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_password" "test" {
+							length   = 10
+							special  = false
+							upper    = false
+							lower    = false
+							distinct = true
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_password.test", tfjsonpath.New("result"), knownvalue.StringFunc(func(value string) error {
+						for _, c := range "0123456789" {
+							if strings.Count(value, string(c)) != 1 {
+								return fmt.Errorf("expected each digit exactly once, got: %s", value)
+							}
+						}
+
+						return nil
+					})),
+				},
+			},
+			{
+				Config: `resource "random_password" "test" {
+							length   = 11
+							special  = false
+							upper    = false
+							lower    = false
+							distinct = true
+						}`,
+				ExpectError: regexp.MustCompile(`11 distinct characters were required, but only 10 are available`),
+			},
+		},
+	})
+}
+
+func TestAccResourcePassword_CountResults(t *testing.T) {
+	assertResultsSame := statecheck.CompareValue(compare.ValuesSame())
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_password" "test" {
+							length        = 12
+							count_results = 3
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					assertResultsSame.AddStateValue("random_password.test", tfjsonpath.New("results")),
+					statecheck.ExpectKnownValue("random_password.test", tfjsonpath.New("results"), knownvalue.ListSizeExact(3)),
+					statecheck.ExpectKnownValue("random_password.test", tfjsonpath.New("bcrypt_hashes"), knownvalue.ListSizeExact(3)),
+					statecheck.CompareValuePairs(
+						"random_password.test", tfjsonpath.New("result"),
+						"random_password.test", tfjsonpath.New("results").AtSliceIndex(0),
+						compare.ValuesSame(),
+					),
+					statecheck.CompareValuePairs(
+						"random_password.test", tfjsonpath.New("results").AtSliceIndex(0),
+						"random_password.test", tfjsonpath.New("results").AtSliceIndex(1),
+						compare.ValuesDiffer(),
+					),
+				},
+			},
+			{
+				Config: `resource "random_password" "test" {
+							length               = 12
+							count_results        = 3
+							generate_bcrypt_hash = false
+						}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("random_password.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					assertResultsSame.AddStateValue("random_password.test", tfjsonpath.New("results")),
+					statecheck.ExpectKnownValue("random_password.test", tfjsonpath.New("bcrypt_hashes"), knownvalue.Null()),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourcePassword_CountResults_Unset(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_password" "test" {
+							length = 12
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_password.test", tfjsonpath.New("results"), knownvalue.Null()),
+					statecheck.ExpectKnownValue("random_password.test", tfjsonpath.New("bcrypt_hashes"), knownvalue.Null()),
+				},
+			},
+			{
+				Config: `resource "random_password" "test" {
+							length = 12
+						}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourcePassword_CountResults_Invalid(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_password" "test" {
+							length        = 12
+							count_results = 101
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute count_results value must be between 1 and 100, got: 101`),
+			},
+		},
+	})
+}
+
+func TestAccResourcePassword_GenerateBcryptHash(t *testing.T) {
+	assertResultSame := statecheck.CompareValue(compare.ValuesSame())
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_password" "test" {
+							length               = 12
+							generate_bcrypt_hash = false
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					assertResultSame.AddStateValue("random_password.test", tfjsonpath.New("result")),
+					statecheck.ExpectKnownValue("random_password.test", tfjsonpath.New("generate_bcrypt_hash"), knownvalue.Bool(false)),
+					statecheck.ExpectKnownValue("random_password.test", tfjsonpath.New("bcrypt_hash"), knownvalue.Null()),
+				},
+			},
+			{
+				Config: `resource "random_password" "test" {
+							length = 12
+						}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("random_password.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					assertResultSame.AddStateValue("random_password.test", tfjsonpath.New("result")),
+					statecheck.ExpectKnownValue("random_password.test", tfjsonpath.New("generate_bcrypt_hash"), knownvalue.Bool(true)),
+					statecheck.CompareValuePairs("random_password.test", tfjsonpath.New("bcrypt_hash"), "random_password.test", tfjsonpath.New("result"), randomtest.BcryptHashMatch()),
+				},
+			},
+			{
+				Config: `resource "random_password" "test" {
+							length               = 12
+							generate_bcrypt_hash = false
+						}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("random_password.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					assertResultSame.AddStateValue("random_password.test", tfjsonpath.New("result")),
+					statecheck.ExpectKnownValue("random_password.test", tfjsonpath.New("bcrypt_hash"), knownvalue.Null()),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourcePassword_ResultPreview(t *testing.T) {
+	assertResultSame := statecheck.CompareValue(compare.ValuesSame())
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_password" "test" {
+							length = 16
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					assertResultSame.AddStateValue("random_password.test", tfjsonpath.New("result")),
+					statecheck.ExpectKnownValue("random_password.test", tfjsonpath.New("result_preview"), knownvalue.Null()),
+				},
+			},
+			{
+				Config: `resource "random_password" "test" {
+							length         = 16
+							enable_preview = true
+						}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("random_password.test", plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue("random_password.test", tfjsonpath.New("result_preview"), knownvalue.StringRegexp(regexp.MustCompile(`^.{2}\*{4}.{2}$`))),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					assertResultSame.AddStateValue("random_password.test", tfjsonpath.New("result")),
+					statecheck.ExpectKnownValue("random_password.test", tfjsonpath.New("result_preview"), knownvalue.StringRegexp(regexp.MustCompile(`^.{2}\*{4}.{2}$`))),
+				},
+			},
+			{
+				Config: `resource "random_password" "test" {
+							length = 16
+						}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("random_password.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					assertResultSame.AddStateValue("random_password.test", tfjsonpath.New("result")),
+					statecheck.ExpectKnownValue("random_password.test", tfjsonpath.New("result_preview"), knownvalue.Null()),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourcePassword_WrappedResult(t *testing.T) {
+	assertResultSame := statecheck.CompareValue(compare.ValuesSame())
+
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	pkix, err := x509.MarshalPKIXPublicKey(&privateKey.PublicKey)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	rsaPublicKey := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: pkix})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_password" "test" {
+							length               = 16
+							recipient_public_key = "age1ql3z7hjy54pw3hyww5ayyfg7zqgvc7w3j2elw8zmrj2kg5sfn9aqmcac8p"
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					assertResultSame.AddStateValue("random_password.test", tfjsonpath.New("result")),
+					statecheck.ExpectKnownValue("random_password.test", tfjsonpath.New("wrapped_result"), knownvalue.StringRegexp(regexp.MustCompile(`^-----BEGIN AGE ENCRYPTED FILE-----\n[A-Za-z0-9+/=\n]+-----END AGE ENCRYPTED FILE-----\n$`))),
+				},
+			},
+			{
+				Config: `resource "random_password" "test" {
+							length               = 16
+							recipient_public_key = "age1ql3z7hjy54pw3hyww5ayyfg7zqgvc7w3j2elw8zmrj2kg5sfn9aqmcac8p"
+						}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				Config: fmt.Sprintf(`resource "random_password" "test" {
+							length               = 16
+							recipient_public_key = %q
+						}`, rsaPublicKey),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("random_password.test", plancheck.ResourceActionUpdate),
+						plancheck.ExpectUnknownValue("random_password.test", tfjsonpath.New("wrapped_result")),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					assertResultSame.AddStateValue("random_password.test", tfjsonpath.New("result")),
+					statecheck.ExpectKnownValue("random_password.test", tfjsonpath.New("wrapped_result"), knownvalue.StringRegexp(regexp.MustCompile(`^\$aes-256-gcm\$rsa-oaep-sha256\$[A-Za-z0-9+/]{342}\$[A-Za-z0-9+/]{16}\$[A-Za-z0-9+/]{43}$`))),
+				},
+			},
+			{
+				Config: `resource "random_password" "test" {
+							length = 16
+						}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("random_password.test", plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue("random_password.test", tfjsonpath.New("wrapped_result"), knownvalue.Null()),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					assertResultSame.AddStateValue("random_password.test", tfjsonpath.New("result")),
+					statecheck.ExpectKnownValue("random_password.test", tfjsonpath.New("wrapped_result"), knownvalue.Null()),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourcePassword_WrappedResult_Invalid(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_password" "test" {
+							length               = 16
+							recipient_public_key = "correct horse battery staple"
+						}`,
+				ExpectError: regexp.MustCompile(`Invalid Public Key`),
+			},
+			{
+				Config: `resource "random_password" "test" {
+							length               = 16
+							recipient_public_key = "age1ql3z7hjy54pw3hyww5ayyfg7zqgvc7w3j2elw8zmrj2kg5sfn9aqmcac8q"
+						}`,
+				ExpectError: regexp.MustCompile(`checksum is invalid`),
+			},
+		},
+	})
+}
+
+func TestAccResourcePassword_Spec(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_password" "test" {
+							length    = 20
+							preset    = "aws_rds"
+							min_upper = 3
+						}
+
+						resource "terraform_data" "policy" {
+							lifecycle {
+								precondition {
+									condition     = random_password.test.spec.length >= 16 && random_password.test.spec.entropy_bits >= 100
+									error_message = "The password does not satisfy the policy."
+								}
+							}
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_password.test", tfjsonpath.New("spec").AtMapKey("length"), knownvalue.Int64Exact(20)),
+					statecheck.ExpectKnownValue("random_password.test", tfjsonpath.New("spec").AtMapKey("special"), knownvalue.Bool(true)),
+					statecheck.ExpectKnownValue("random_password.test", tfjsonpath.New("spec").AtMapKey("min_upper"), knownvalue.Int64Exact(3)),
+					statecheck.ExpectKnownValue("random_password.test", tfjsonpath.New("spec").AtMapKey("algorithm_version"), knownvalue.Int64Exact(stringAlgorithmVersion)),
+					statecheck.CompareValuePairs("random_password.test", tfjsonpath.New("spec").AtMapKey("entropy_bits"), "random_password.test", tfjsonpath.New("entropy_bits"), compare.ValuesSame()),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourcePassword_Fingerprint(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_password" "test" {
+							length = 16
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_password.test", tfjsonpath.New("fingerprint"), knownvalue.StringRegexp(regexp.MustCompile(`^[0-9a-f]{8}$`))),
+				},
+				Check: func(s *terraform.State) error {
+					attributes := s.RootModule().Resources["random_password.test"].Primary.Attributes
+
+					hash := sha256.Sum256([]byte(attributes["result"]))
+
+					if expected := hex.EncodeToString(hash[:4]); attributes["fingerprint"] != expected {
+						return fmt.Errorf("expected fingerprint %q, got: %q", expected, attributes["fingerprint"])
+					}
+
+					return nil
+				},
+			},
+			{
+				Config: `resource "random_password" "test" {
+							length = 16
+						}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourcePassword_NTLMHash(t *testing.T) {
+	assertResultSame := statecheck.CompareValue(compare.ValuesSame())
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_password" "test" {
+							length = 16
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					assertResultSame.AddStateValue("random_password.test", tfjsonpath.New("result")),
+					statecheck.ExpectKnownValue("random_password.test", tfjsonpath.New("ntlm_hash"), knownvalue.Null()),
+				},
+			},
+			{
+				Config: `resource "random_password" "test" {
+							length               = 16
+							enable_legacy_hashes = true
+						}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("random_password.test", plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue("random_password.test", tfjsonpath.New("ntlm_hash"), knownvalue.StringRegexp(regexp.MustCompile(`^[0-9a-f]{32}$`))),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					assertResultSame.AddStateValue("random_password.test", tfjsonpath.New("result")),
+					statecheck.ExpectKnownValue("random_password.test", tfjsonpath.New("ntlm_hash"), knownvalue.StringRegexp(regexp.MustCompile(`^[0-9a-f]{32}$`))),
+				},
+			},
+			{
+				Config: `resource "random_password" "test" {
+							length = 16
+						}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("random_password.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					assertResultSame.AddStateValue("random_password.test", tfjsonpath.New("result")),
+					statecheck.ExpectKnownValue("random_password.test", tfjsonpath.New("ntlm_hash"), knownvalue.Null()),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourcePassword_NTLMHash_Create(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_password" "test" {
+							length               = 16
+							enable_legacy_hashes = true
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_password.test", tfjsonpath.New("ntlm_hash"), knownvalue.StringRegexp(regexp.MustCompile(`^[0-9a-f]{32}$`))),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourcePassword_ResultPreview_Short(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_password" "test" {
+							length         = 11
+							enable_preview = true
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_password.test", tfjsonpath.New("result_preview"), knownvalue.StringExact("****")),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourcePassword_PHCHash(t *testing.T) {
+	assertResultSame := statecheck.CompareValue(compare.ValuesSame())
+	assertHashSame := statecheck.CompareValue(compare.ValuesSame())
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_password" "test" {
+							length = 12
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					assertResultSame.AddStateValue("random_password.test", tfjsonpath.New("result")),
+					statecheck.ExpectKnownValue("random_password.test", tfjsonpath.New("phc_hash"), knownvalue.Null()),
+				},
+			},
+			{
+				Config: `resource "random_password" "test" {
+							length = 12
+							phc_hash {
+								algorithm   = "argon2id"
+								memory      = 64
+								iterations  = 1
+								parallelism = 2
+							}
+						}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("random_password.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					assertResultSame.AddStateValue("random_password.test", tfjsonpath.New("result")),
+					assertHashSame.AddStateValue("random_password.test", tfjsonpath.New("phc_hash").AtMapKey("hash")),
+					statecheck.ExpectKnownValue("random_password.test", tfjsonpath.New("phc_hash").AtMapKey("hash"),
+						knownvalue.StringRegexp(regexp.MustCompile(`^\$argon2id\$v=19\$m=64,t=1,p=2\$[A-Za-z0-9+/]{22}\$[A-Za-z0-9+/]{43}$`))),
+				},
+			},
+			{
+				Config: `resource "random_password" "test" {
+							length = 12
+							phc_hash {
+								algorithm   = "argon2id"
+								memory      = 64
+								iterations  = 1
+								parallelism = 2
+							}
+						}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					assertHashSame.AddStateValue("random_password.test", tfjsonpath.New("phc_hash").AtMapKey("hash")),
+				},
+			},
+			{
+				Config: `resource "random_password" "test" {
+							length = 12
+							phc_hash {
+								algorithm  = "pbkdf2-sha256"
+								iterations = 1000
+							}
+						}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("random_password.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					assertResultSame.AddStateValue("random_password.test", tfjsonpath.New("result")),
+					statecheck.ExpectKnownValue("random_password.test", tfjsonpath.New("phc_hash").AtMapKey("hash"),
+						knownvalue.StringRegexp(regexp.MustCompile(`^\$pbkdf2-sha256\$i=1000\$[A-Za-z0-9+/]{22}\$[A-Za-z0-9+/]{43}$`))),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourcePassword_PHCHash_FIPS(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `provider "random" {
+							fips = true
+						}
+
+						resource "random_password" "test" {
+							length = 12
+							phc_hash {
+								algorithm = "argon2id"
+							}
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute phc_hash.algorithm must be "pbkdf2-sha256" when the provider is\s+configured with fips = true`),
+			},
+		},
+	})
+}
+
+func TestAccResourcePassword_PHCHash_Validation(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_password" "test" {
+							length = 12
+							phc_hash {
+								algorithm   = "pbkdf2-sha256"
+								parallelism = 2
+							}
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute phc_hash.parallelism can only be set when phc_hash.algorithm is\s+"argon2id"`),
+			},
+			{
+				Config: `resource "random_password" "test" {
+							length = 12
+							phc_hash {
+								algorithm   = "argon2id"
+								memory      = 8
+								parallelism = 2
+							}
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute phc_hash.memory must be at least 8 times phc_hash.parallelism`),
+			},
+			{
+				Config: `resource "random_password" "test" {
+							length = 12
+							phc_hash {
+								iterations = 3
+							}
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute "phc_hash.algorithm" must be specified when "phc_hash" is\s+specified`),
+			},
+		},
+	})
+}
+
+func TestAccResourcePassword_GenerateBcryptHash_UpgradeFromVersion3_6_3(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				ExternalProviders: providerVersion363(),
+				Config: `resource "random_password" "test" {
+							length = 12
+						}`,
+			},
+			{
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
+				Config: `resource "random_password" "test" {
+							length = 12
+						}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_password.test", tfjsonpath.New("generate_bcrypt_hash"), knownvalue.Bool(true)),
+					statecheck.CompareValuePairs("random_password.test", tfjsonpath.New("bcrypt_hash"), "random_password.test", tfjsonpath.New("result"), randomtest.BcryptHashMatch()),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourcePassword_Groups(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_password" "test" {
+							length  = 19
+							upper   = false
+							lower   = false
+							special = false
+							groups {
+								count = 4
+								size  = 4
+							}
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_password.test", tfjsonpath.New("result"), knownvalue.StringRegexp(regexp.MustCompile(`^\d{4}-\d{4}-\d{4}-\d{4}$`))),
+					statecheck.ExpectKnownValue("random_password.test", tfjsonpath.New("groups").AtMapKey("separator"), knownvalue.StringExact("-")),
+					statecheck.ExpectKnownValue("random_password.test", tfjsonpath.New("entropy_bits"), knownvalue.Float64Exact(16*math.Log2(10))),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourcePassword_Groups_Separator(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_password" "test" {
+							length  = 17
+							special = false
+							groups {
+								count     = 3
+								size      = 5
+								separator = "."
+							}
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_password.test", tfjsonpath.New("result"), knownvalue.StringRegexp(regexp.MustCompile(`^[A-Za-z0-9]{5}\.[A-Za-z0-9]{5}\.[A-Za-z0-9]{5}$`))),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourcePassword_Groups_Invalid(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_password" "test" {
+							length = 16
+							groups {
+								count = 4
+								size  = 4
+							}
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute length value must equal the length of the grouped result`),
+			},
+			{
+				Config: `resource "random_password" "test" {
+							length    = 5
+							min_upper = 2
+							min_lower = 2
+							groups {
+								count = 3
+								size  = 1
+							}
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute groups must contain at least the sum of min_upper`),
+			},
+			{
+				Config: `resource "random_password" "test" {
+							length        = 19
+							pinned_prefix = "prd-"
+							groups {
+								count = 4
+								size  = 4
+							}
+						}`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				Config: `resource "random_password" "test" {
+							length = 19
+							groups {
+								count = 4
+							}
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute "groups.size" must be specified when "groups" is specified`),
+			},
+		},
+	})
+}
+
+func TestAccResourcePassword_ImportJSON_Groups(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_password" "test" {
+							length  = 9
+							upper   = false
+							lower   = false
+							special = false
+							groups {
+								count = 2
+								size  = 4
+							}
+						}`,
+				ResourceName:       "random_password.test",
+				ImportStateId:      `{"result": "4821-9937", "upper": false, "lower": false, "special": false, "groups": {"count": 2, "size": 4}}`,
+				ImportState:        true,
+				ImportStatePersist: true,
+			},
+			{
+				Config: `resource "random_password" "test" {
+							length  = 9
+							upper   = false
+							lower   = false
+							special = false
+							groups {
+								count = 2
+								size  = 4
+							}
+						}`,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccResourcePassword_PinnedPrefix_Invalid(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_password" "test" {
+							length        = 4
+							pinned_prefix = "prd-"
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute length value must be greater than the length of pinned_prefix\s+\(4\)`),
+			},
+			{
+				Config: `resource "random_password" "test" {
+							length        = 8
+							min_upper     = 3
+							min_numeric   = 2
+							pinned_prefix = "prd-"
+						}`,
+				ExpectError: regexp.MustCompile(`min_special \(9\), got: 8`),
+			},
+			{
+				Config: `resource "random_password" "test" {
+							length        = 8
+							pinned_prefix = ""
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute pinned_prefix string length must be at least 1`),
+			},
+		},
+	})
+}
+
+func TestAccResourcePassword_LengthRange(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_password" "test" {
+							min_length = 16
+							max_length = 24
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_password.test", tfjsonpath.New("result"), knownvalue.StringRegexp(regexp.MustCompile(`^.{16,24}$`))),
+					statecheck.ExpectKnownValue("random_password.test", tfjsonpath.New("length"), knownvalue.NotNull()),
+				},
+			},
+			{
+				// The chosen length is kept while the range is unchanged.
+				Config: `resource "random_password" "test" {
+							min_length = 16
+							max_length = 24
+						}`,
+				PlanOnly: true,
+			},
+			{
+				Config: `resource "random_password" "test" {
+							min_length = 16
+							max_length = 24
+							keepers = {
+								"key" = "value"
+							}
+						}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("random_password.test", plancheck.ResourceActionReplace),
+						plancheck.ExpectUnknownValue("random_password.test", tfjsonpath.New("length")),
+					},
+				},
+			},
+			{
+				Config: `resource "random_password" "test" {
+							min_length = 12
+							max_length = 12
+						}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("random_password.test", plancheck.ResourceActionReplace),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_password.test", tfjsonpath.New("length"), knownvalue.Int64Exact(12)),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourcePassword_LengthRange_Invalid(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_password" "test" {
+							special = false
+						}`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				Config: `resource "random_password" "test" {
+							length     = 16
+							min_length = 16
+							max_length = 24
+						}`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				Config: `resource "random_password" "test" {
+							length     = 16
+							max_length = 24
+						}`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				Config: `resource "random_password" "test" {
+							min_length = 24
+							max_length = 16
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute max_length value must be at least min_length \(24\), got: 16`),
+			},
+			{
+				Config: `resource "random_password" "test" {
+							min_length  = 4
+							max_length  = 16
+							min_upper   = 3
+							min_numeric = 2
+						}`,
+				ExpectError: regexp.MustCompile(`min_special, which is 5, got: 4`),
+			},
+			{
+				Config: `resource "random_password" "test" {
+							min_length = 16
+							max_length = 24
+							preset     = "aws_rds"
+						}`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
+
+func TestAccResourcePassword_Preset(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_password" "test" {
+							length = 16
+							preset = "azure_sql"
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_password.test", tfjsonpath.New("preset"), knownvalue.StringExact("azure_sql")),
+					statecheck.ExpectKnownValue("random_password.test", tfjsonpath.New("result"), knownvalue.StringRegexp(regexp.MustCompile(`[A-Z]`))),
+					statecheck.ExpectKnownValue("random_password.test", tfjsonpath.New("result"), knownvalue.StringRegexp(regexp.MustCompile(`[a-z]`))),
+					statecheck.ExpectKnownValue("random_password.test", tfjsonpath.New("result"), knownvalue.StringRegexp(regexp.MustCompile(`[0-9]`))),
+					statecheck.ExpectKnownValue("random_password.test", tfjsonpath.New("result"), knownvalue.StringRegexp(regexp.MustCompile(`[^A-Za-z0-9]`))),
+				},
+			},
+			{
+				Config: `resource "random_password" "test" {
+							length = 16
+							preset = "aws_rds"
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_password.test", tfjsonpath.New("result"), knownvalue.StringRegexp(regexp.MustCompile(`^[^/"@ ]{16}$`))),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourcePassword_Preset_Invalid(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_password" "test" {
+							length = 42
+							preset = "aws_rds"
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute length value must be between 8 and 41 when preset is "aws_rds",\s+got: 42`),
+			},
+			{
+				Config: `resource "random_password" "test" {
+							length  = 16
+							special = false
+							preset  = "gcp_sql"
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute special cannot be false when preset is "gcp_sql"`),
+			},
+			{
+				Config: `resource "random_password" "test" {
+							length           = 16
+							override_special = "!"
+							preset           = "azure_sql"
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute "override_special" cannot be specified when "preset" is specified`),
+			},
+			{
+				Config: `resource "random_password" "test" {
+							length = 16
+							preset = "oracle"
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute preset value must be one of`),
 			},
 		},
 	})
 }
 
-// TestAccResourcePassword_OverrideSpecial_FromVersion3_3_2 verifies behaviour
-// when upgrading the provider version from 3.3.2, which set the
-// override_special value to null and should not result in a plan difference.
-// Reference: https://github.com/hashicorp/terraform-provider-random/issues/306
-func TestAccResourcePassword_OverrideSpecial_FromVersion3_3_2(t *testing.T) {
-	// The result attribute values should be the same between test steps
-	assertResultSame := statecheck.CompareValue(compare.ValuesSame())
-
-	resource.ParallelTest(t, resource.TestCase{
+func TestAccResourcePassword_CharsetPreset(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
 		Steps: []resource.TestStep{
 			{
-				ExternalProviders: providerVersion332(),
 				Config: `resource "random_password" "test" {
-							length = 12
+							length         = 32
+							charset_preset = "hex"
 						}`,
 				ConfigStateChecks: []statecheck.StateCheck{
-					statecheck.ExpectKnownValue("random_password.test", tfjsonpath.New("override_special"), knownvalue.Null()),
-					assertResultSame.AddStateValue("random_password.test", tfjsonpath.New("result")),
+					statecheck.ExpectKnownValue("random_password.test", tfjsonpath.New("charset_preset"), knownvalue.StringExact("hex")),
+					statecheck.ExpectKnownValue("random_password.test", tfjsonpath.New("result"), knownvalue.StringRegexp(regexp.MustCompile(`^[0-9a-f]{32}$`))),
+					statecheck.ExpectKnownValue("random_password.test", tfjsonpath.New("entropy_bits"), knownvalue.Float64Exact(128)),
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
 				Config: `resource "random_password" "test" {
-					length = 12
-				}`,
+							length         = 32
+							charset_preset = "base58"
+						}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("random_password.test", plancheck.ResourceActionReplace),
+					},
+				},
 				ConfigStateChecks: []statecheck.StateCheck{
-					statecheck.ExpectKnownValue("random_password.test", tfjsonpath.New("override_special"), knownvalue.Null()),
-					assertResultSame.AddStateValue("random_password.test", tfjsonpath.New("result")),
+					statecheck.ExpectKnownValue("random_password.test", tfjsonpath.New("result"), knownvalue.StringRegexp(regexp.MustCompile(`^[1-9A-HJ-NP-Za-km-z]{32}$`))),
 				},
 			},
 		},
 	})
 }
 
-// TestAccResourcePassword_OverrideSpecial_FromVersion3_4_2 verifies behaviour
-// when upgrading the provider version from 3.4.2, which set the
-// override_special value to "", while other versions do not.
-// Reference: https://github.com/hashicorp/terraform-provider-random/issues/306
-func TestAccResourcePassword_OverrideSpecial_FromVersion3_4_2(t *testing.T) {
-	// The result attribute values should be the same between test steps
-	assertResultSame := statecheck.CompareValue(compare.ValuesSame())
-
-	resource.ParallelTest(t, resource.TestCase{
+func TestAccResourcePassword_CharsetPreset_Invalid(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
 		Steps: []resource.TestStep{
 			{
-				ExternalProviders: providerVersion342(),
 				Config: `resource "random_password" "test" {
-							length = 12
+							length         = 16
+							min_special    = 1
+							charset_preset = "base32"
 						}`,
-				ConfigStateChecks: []statecheck.StateCheck{
-					statecheck.ExpectKnownValue("random_password.test", tfjsonpath.New("override_special"), knownvalue.StringExact("")),
-					assertResultSame.AddStateValue("random_password.test", tfjsonpath.New("result")),
-				},
+				ExpectError: regexp.MustCompile(`Attribute "min_special" cannot be specified when "charset_preset" is specified`),
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
 				Config: `resource "random_password" "test" {
-					length = 12
-				}`,
+							length         = 16
+							preset         = "aws_rds"
+							charset_preset = "alphanumeric"
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute "preset" cannot be specified when "charset_preset" is specified`),
+			},
+			{
+				Config: `resource "random_password" "test" {
+							length         = 16
+							charset_preset = "base64"
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute charset_preset value must be one of`),
+			},
+		},
+	})
+}
+
+func TestAccResourcePassword_OverrideSpecialList(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_password" "test" {
+							length                = 16
+							upper                 = false
+							lower                 = false
+							numeric               = false
+							override_special_list = ["\"", "\\", "$", "$"]
+						}`,
 				ConfigStateChecks: []statecheck.StateCheck{
-					statecheck.ExpectKnownValue("random_password.test", tfjsonpath.New("override_special"), knownvalue.Null()),
-					assertResultSame.AddStateValue("random_password.test", tfjsonpath.New("result")),
+					statecheck.ExpectKnownValue("random_password.test", tfjsonpath.New("result"), knownvalue.StringRegexp(regexp.MustCompile(`^["\\$]{16}$`))),
 				},
 			},
 		},
 	})
 }
 
-func TestAccResourcePassword_ImportWithoutKeepersProducesNoPlannedChanges(t *testing.T) {
+func TestAccResourcePassword_OverrideSpecialList_Invalid(t *testing.T) {
 	resource.UnitTest(t, resource.TestCase{
-		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: `resource "random_password" "test" {
-							length = 12
+							length                = 16
+							override_special_list = ["!", "@#"]
 						}`,
-				ResourceName:       "random_password.test",
-				ImportStateId:      "Z=:cbrJE?Ltg",
-				ImportState:        true,
-				ImportStatePersist: true,
+				ExpectError: regexp.MustCompile(`must be a single character`),
 			},
 			{
 				Config: `resource "random_password" "test" {
-							length = 12
+							length                = 16
+							override_special      = "!"
+							override_special_list = ["@"]
 						}`,
-				PlanOnly: true,
+				ExpectError: regexp.MustCompile(`Attribute "override_special" cannot be specified when "override_special_list"\s+is specified`),
 			},
 		},
 	})
@@ -398,7 +2078,7 @@ func TestAccResourcePassword_Import_FromVersion3_1_3(t *testing.T) {
 				),
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_password" "test" {
 					length = 12
 				}`,
@@ -461,14 +2141,14 @@ func TestAccResourcePassword_Import_FromVersion3_2_0(t *testing.T) {
 				),
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_password" "test" {
 					length = 12
 				}`,
 				PlanOnly: true,
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_password" "test" {
 							length = 12
 						}`,
@@ -527,7 +2207,7 @@ func TestAccResourcePassword_Import_FromVersion3_4_2(t *testing.T) {
 				),
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_password" "test" {
 							length = 12
 						}`,
@@ -775,7 +2455,7 @@ func TestAccResourcePassword_StateUpgradeV0toV3(t *testing.T) {
 						ConfigStateChecks: c.beforeUpgradeStateChecks,
 					},
 					{
-						ProtoV5ProviderFactories: protoV5ProviderFactories(),
+						ProtoV6ProviderFactories: protoV6ProviderFactories(),
 						Config:                   c.configDuringUpgrade,
 						ConfigStateChecks:        c.afterUpgradeStateChecks,
 					},
@@ -1021,7 +2701,7 @@ func TestAccResourcePassword_StateUpgradeV1toV3(t *testing.T) {
 						ConfigStateChecks: c.beforeUpgradeStateChecks,
 					},
 					{
-						ProtoV5ProviderFactories: protoV5ProviderFactories(),
+						ProtoV6ProviderFactories: protoV6ProviderFactories(),
 						Config:                   c.configDuringUpgrade,
 						ConfigStateChecks:        c.afterUpgradeStateChecks,
 					},
@@ -1033,7 +2713,7 @@ func TestAccResourcePassword_StateUpgradeV1toV3(t *testing.T) {
 
 func TestAccResourcePassword_Min(t *testing.T) {
 	resource.UnitTest(t, resource.TestCase{
-		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: `resource "random_password" "min" {
@@ -1056,6 +2736,24 @@ func TestAccResourcePassword_Min(t *testing.T) {
 	})
 }
 
+func TestAccResourcePassword_MinCharacterClasses(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_password" "test" {
+							length                = 3
+							min_character_classes = 3
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_password.test", tfjsonpath.New("result"), randomtest.StringLengthExact(3)),
+					statecheck.ExpectKnownValue("random_password.test", tfjsonpath.New("result"), randomtest.StringMinCharacterClasses(3)),
+				},
+			},
+		},
+	})
+}
+
 // TestAccResourcePassword_UpgradeFromVersion2_2_1 verifies behaviour when upgrading state from schema V0 to V3.
 func TestAccResourcePassword_UpgradeFromVersion2_2_1(t *testing.T) {
 	resource.Test(t, resource.TestCase{
@@ -1092,7 +2790,7 @@ func TestAccResourcePassword_UpgradeFromVersion2_2_1(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_password" "min" {
 							length = 12
 							override_special = "!#@"
@@ -1160,7 +2858,7 @@ func TestAccResourcePassword_UpgradeFromVersion3_2_0(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_password" "min" {
 							length = 12
 							override_special = "!#@"
@@ -1172,7 +2870,7 @@ func TestAccResourcePassword_UpgradeFromVersion3_2_0(t *testing.T) {
 				PlanOnly: true,
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_password" "min" {
 							length = 12
 							override_special = "!#@"
@@ -1236,7 +2934,7 @@ func TestAccResourcePassword_UpgradeFromVersion3_3_2(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_password" "min" {
 							length = 12
 							override_special = "!#@"
@@ -1248,7 +2946,7 @@ func TestAccResourcePassword_UpgradeFromVersion3_3_2(t *testing.T) {
 				PlanOnly: true,
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_password" "min" {
 							length = 12
 							override_special = "!#@"
@@ -1279,7 +2977,25 @@ func TestAccResourcePassword_UpgradeFromVersion3_3_2(t *testing.T) {
 	})
 }
 
-func TestUpgradePasswordStateV0toV3(t *testing.T) {
+var passwordGroupsTfType = tftypes.Object{
+	AttributeTypes: map[string]tftypes.Type{
+		"count":     tftypes.Number,
+		"size":      tftypes.Number,
+		"separator": tftypes.String,
+	},
+}
+
+var passwordPHCHashTfType = tftypes.Object{
+	AttributeTypes: map[string]tftypes.Type{
+		"algorithm":   tftypes.String,
+		"memory":      tftypes.Number,
+		"iterations":  tftypes.Number,
+		"parallelism": tftypes.Number,
+		"hash":        tftypes.String,
+	},
+}
+
+func TestUpgradePasswordStateV0toV4(t *testing.T) {
 	t.Parallel()
 
 	req := res.UpgradeStateRequest{
@@ -1321,50 +3037,130 @@ func TestUpgradePasswordStateV0toV3(t *testing.T) {
 
 	resp := &res.UpgradeStateResponse{
 		State: tfsdk.State{
-			Schema: passwordSchemaV3(),
+			Schema: passwordSchemaV4(),
 		},
 	}
 
-	upgradePasswordStateV0toV3(context.Background(), req, resp)
+	upgradePasswordStateV0toV4(context.Background(), req, resp)
 
 	expectedResp := &res.UpgradeStateResponse{
 		State: tfsdk.State{
 			Raw: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
-					"bcrypt_hash":      tftypes.String,
-					"id":               tftypes.String,
-					"keepers":          tftypes.Map{ElementType: tftypes.String},
-					"length":           tftypes.Number,
-					"lower":            tftypes.Bool,
-					"min_lower":        tftypes.Number,
-					"min_numeric":      tftypes.Number,
-					"min_special":      tftypes.Number,
-					"min_upper":        tftypes.Number,
-					"number":           tftypes.Bool,
-					"numeric":          tftypes.Bool,
-					"override_special": tftypes.String,
-					"result":           tftypes.String,
-					"special":          tftypes.Bool,
-					"upper":            tftypes.Bool,
+					"bcrypt_hash":              tftypes.String,
+					"created_at":               tftypes.String,
+					"keepers_hash":             tftypes.String,
+					"entropy_bits":             tftypes.Number,
+					"id":                       tftypes.String,
+					"keepers":                  tftypes.Map{ElementType: tftypes.String},
+					"length":                   tftypes.Number,
+					"min_length":               tftypes.Number,
+					"min_character_classes":    tftypes.Number,
+					"max_length":               tftypes.Number,
+					"lower":                    tftypes.Bool,
+					"min_lower":                tftypes.Number,
+					"min_numeric":              tftypes.Number,
+					"min_special":              tftypes.Number,
+					"min_upper":                tftypes.Number,
+					"number":                   tftypes.Bool,
+					"numeric":                  tftypes.Bool,
+					"override_special":         tftypes.String,
+					"pbkdf2_hash":              tftypes.String,
+					"groups":                   passwordGroupsTfType,
+					"pinned_prefix":            tftypes.String,
+					"phc_hash":                 passwordPHCHashTfType,
+					"max_repeat":               tftypes.Number,
+					"distinct":                 tftypes.Bool,
+					"no_leading_numeric":       tftypes.Bool,
+					"no_leading_special":       tftypes.Bool,
+					"no_trailing_numeric":      tftypes.Bool,
+					"no_trailing_special":      tftypes.Bool,
+					"count_results":            tftypes.Number,
+					"results":                  tftypes.List{ElementType: tftypes.String},
+					"bcrypt_hashes":            tftypes.List{ElementType: tftypes.String},
+					"crypt_salt":               tftypes.String,
+					"sha256_crypt":             tftypes.String,
+					"sha512_crypt":             tftypes.String,
+					"preset":                   tftypes.String,
+					"generate_bcrypt_hash":     tftypes.Bool,
+					"provider_version":         tftypes.String,
+					"encrypted_result":         tftypes.String,
+					"ssha_hash":                tftypes.String,
+					"ssha512":                  tftypes.String,
+					"enable_legacy_hashes":     tftypes.Bool,
+					"ntlm_hash":                tftypes.String,
+					"override_special_list":    tftypes.List{ElementType: tftypes.String},
+					"charset_preset":           tftypes.String,
+					"spec":                     specTfType,
+					"recipient_public_key":     tftypes.String,
+					"wrapped_result":           tftypes.String,
+					"fingerprint":              tftypes.String,
+					"lifecycle_guard":          tftypes.Bool,
+					"enable_preview":           tftypes.Bool,
+					"result_preview":           tftypes.String,
+					"allow_regeneration_token": tftypes.String,
+					"result":                   tftypes.String,
+					"special":                  tftypes.Bool,
+					"upper":                    tftypes.Bool,
 				},
 			}, map[string]tftypes.Value{
-				"bcrypt_hash":      tftypes.NewValue(tftypes.String, "hash"),
-				"id":               tftypes.NewValue(tftypes.String, "none"),
-				"keepers":          tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
-				"length":           tftypes.NewValue(tftypes.Number, 16),
-				"lower":            tftypes.NewValue(tftypes.Bool, true),
-				"min_lower":        tftypes.NewValue(tftypes.Number, 0),
-				"min_numeric":      tftypes.NewValue(tftypes.Number, 0),
-				"min_special":      tftypes.NewValue(tftypes.Number, 0),
-				"min_upper":        tftypes.NewValue(tftypes.Number, 0),
-				"number":           tftypes.NewValue(tftypes.Bool, true),
-				"numeric":          tftypes.NewValue(tftypes.Bool, true),
-				"override_special": tftypes.NewValue(tftypes.String, "!#$%\u0026*()-_=+[]{}\u003c\u003e:?"),
-				"result":           tftypes.NewValue(tftypes.String, "DZy_3*tnonj%Q%Yx"),
-				"special":          tftypes.NewValue(tftypes.Bool, true),
-				"upper":            tftypes.NewValue(tftypes.Bool, true),
+				"bcrypt_hash":              tftypes.NewValue(tftypes.String, "hash"),
+				"created_at":               tftypes.NewValue(tftypes.String, nil),
+				"keepers_hash":             tftypes.NewValue(tftypes.String, nil),
+				"entropy_bits":             tftypes.NewValue(tftypes.Number, nil),
+				"id":                       tftypes.NewValue(tftypes.String, "none"),
+				"keepers":                  tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"length":                   tftypes.NewValue(tftypes.Number, 16),
+				"min_length":               tftypes.NewValue(tftypes.Number, nil),
+				"min_character_classes":    tftypes.NewValue(tftypes.Number, nil),
+				"max_length":               tftypes.NewValue(tftypes.Number, nil),
+				"lower":                    tftypes.NewValue(tftypes.Bool, true),
+				"min_lower":                tftypes.NewValue(tftypes.Number, 0),
+				"min_numeric":              tftypes.NewValue(tftypes.Number, 0),
+				"min_special":              tftypes.NewValue(tftypes.Number, 0),
+				"min_upper":                tftypes.NewValue(tftypes.Number, 0),
+				"number":                   tftypes.NewValue(tftypes.Bool, true),
+				"numeric":                  tftypes.NewValue(tftypes.Bool, true),
+				"override_special":         tftypes.NewValue(tftypes.String, "!#$%\u0026*()-_=+[]{}\u003c\u003e:?"),
+				"pbkdf2_hash":              tftypes.NewValue(tftypes.String, nil),
+				"groups":                   tftypes.NewValue(passwordGroupsTfType, nil),
+				"pinned_prefix":            tftypes.NewValue(tftypes.String, nil),
+				"phc_hash":                 tftypes.NewValue(passwordPHCHashTfType, nil),
+				"max_repeat":               tftypes.NewValue(tftypes.Number, nil),
+				"distinct":                 tftypes.NewValue(tftypes.Bool, nil),
+				"no_leading_numeric":       tftypes.NewValue(tftypes.Bool, nil),
+				"no_leading_special":       tftypes.NewValue(tftypes.Bool, nil),
+				"no_trailing_numeric":      tftypes.NewValue(tftypes.Bool, nil),
+				"no_trailing_special":      tftypes.NewValue(tftypes.Bool, nil),
+				"count_results":            tftypes.NewValue(tftypes.Number, nil),
+				"results":                  tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+				"bcrypt_hashes":            tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+				"crypt_salt":               tftypes.NewValue(tftypes.String, nil),
+				"sha256_crypt":             tftypes.NewValue(tftypes.String, nil),
+				"sha512_crypt":             tftypes.NewValue(tftypes.String, nil),
+				"preset":                   tftypes.NewValue(tftypes.String, nil),
+				"generate_bcrypt_hash":     tftypes.NewValue(tftypes.Bool, nil),
+				"provider_version":         tftypes.NewValue(tftypes.String, nil),
+				"encrypted_result":         tftypes.NewValue(tftypes.String, nil),
+				"ssha_hash":                tftypes.NewValue(tftypes.String, nil),
+				"ssha512":                  tftypes.NewValue(tftypes.String, nil),
+				"enable_legacy_hashes":     tftypes.NewValue(tftypes.Bool, nil),
+				"ntlm_hash":                tftypes.NewValue(tftypes.String, nil),
+				"override_special_list":    tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+				"charset_preset":           tftypes.NewValue(tftypes.String, nil),
+				"spec":                     tftypes.NewValue(specTfType, nil),
+				"recipient_public_key":     tftypes.NewValue(tftypes.String, nil),
+				"wrapped_result":           tftypes.NewValue(tftypes.String, nil),
+				"fingerprint":              tftypes.NewValue(tftypes.String, nil),
+				"lifecycle_guard":          tftypes.NewValue(tftypes.Bool, nil),
+				"enable_preview":           tftypes.NewValue(tftypes.Bool, nil),
+				"result_preview":           tftypes.NewValue(tftypes.String, nil),
+				"allow_regeneration_token": tftypes.NewValue(tftypes.String, nil),
+				"result":                   tftypes.NewValue(tftypes.String, "DZy_3*tnonj%Q%Yx"),
+				"special":                  tftypes.NewValue(tftypes.Bool, true),
+				"upper":                    tftypes.NewValue(tftypes.Bool, true),
 			}),
-			Schema: passwordSchemaV3(),
+			Schema: passwordSchemaV4(),
 		},
 	}
 
@@ -1404,7 +3200,7 @@ func TestUpgradePasswordStateV0toV3(t *testing.T) {
 	}
 }
 
-func TestUpgradePasswordStateV0toV3_NullValues(t *testing.T) {
+func TestUpgradePasswordStateV0toV4_NullValues(t *testing.T) {
 	t.Parallel()
 
 	req := res.UpgradeStateRequest{
@@ -1446,50 +3242,130 @@ func TestUpgradePasswordStateV0toV3_NullValues(t *testing.T) {
 
 	resp := &res.UpgradeStateResponse{
 		State: tfsdk.State{
-			Schema: passwordSchemaV3(),
+			Schema: passwordSchemaV4(),
 		},
 	}
 
-	upgradePasswordStateV0toV3(context.Background(), req, resp)
+	upgradePasswordStateV0toV4(context.Background(), req, resp)
 
 	expectedResp := &res.UpgradeStateResponse{
 		State: tfsdk.State{
 			Raw: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
-					"bcrypt_hash":      tftypes.String,
-					"id":               tftypes.String,
-					"keepers":          tftypes.Map{ElementType: tftypes.String},
-					"length":           tftypes.Number,
-					"lower":            tftypes.Bool,
-					"min_lower":        tftypes.Number,
-					"min_numeric":      tftypes.Number,
-					"min_special":      tftypes.Number,
-					"min_upper":        tftypes.Number,
-					"number":           tftypes.Bool,
-					"numeric":          tftypes.Bool,
-					"override_special": tftypes.String,
-					"result":           tftypes.String,
-					"special":          tftypes.Bool,
-					"upper":            tftypes.Bool,
+					"bcrypt_hash":              tftypes.String,
+					"created_at":               tftypes.String,
+					"keepers_hash":             tftypes.String,
+					"entropy_bits":             tftypes.Number,
+					"id":                       tftypes.String,
+					"keepers":                  tftypes.Map{ElementType: tftypes.String},
+					"length":                   tftypes.Number,
+					"min_length":               tftypes.Number,
+					"min_character_classes":    tftypes.Number,
+					"max_length":               tftypes.Number,
+					"lower":                    tftypes.Bool,
+					"min_lower":                tftypes.Number,
+					"min_numeric":              tftypes.Number,
+					"min_special":              tftypes.Number,
+					"min_upper":                tftypes.Number,
+					"number":                   tftypes.Bool,
+					"numeric":                  tftypes.Bool,
+					"override_special":         tftypes.String,
+					"pbkdf2_hash":              tftypes.String,
+					"groups":                   passwordGroupsTfType,
+					"pinned_prefix":            tftypes.String,
+					"phc_hash":                 passwordPHCHashTfType,
+					"max_repeat":               tftypes.Number,
+					"distinct":                 tftypes.Bool,
+					"no_leading_numeric":       tftypes.Bool,
+					"no_leading_special":       tftypes.Bool,
+					"no_trailing_numeric":      tftypes.Bool,
+					"no_trailing_special":      tftypes.Bool,
+					"count_results":            tftypes.Number,
+					"results":                  tftypes.List{ElementType: tftypes.String},
+					"bcrypt_hashes":            tftypes.List{ElementType: tftypes.String},
+					"crypt_salt":               tftypes.String,
+					"sha256_crypt":             tftypes.String,
+					"sha512_crypt":             tftypes.String,
+					"preset":                   tftypes.String,
+					"generate_bcrypt_hash":     tftypes.Bool,
+					"provider_version":         tftypes.String,
+					"encrypted_result":         tftypes.String,
+					"ssha_hash":                tftypes.String,
+					"ssha512":                  tftypes.String,
+					"enable_legacy_hashes":     tftypes.Bool,
+					"ntlm_hash":                tftypes.String,
+					"override_special_list":    tftypes.List{ElementType: tftypes.String},
+					"charset_preset":           tftypes.String,
+					"spec":                     specTfType,
+					"recipient_public_key":     tftypes.String,
+					"wrapped_result":           tftypes.String,
+					"fingerprint":              tftypes.String,
+					"lifecycle_guard":          tftypes.Bool,
+					"enable_preview":           tftypes.Bool,
+					"result_preview":           tftypes.String,
+					"allow_regeneration_token": tftypes.String,
+					"result":                   tftypes.String,
+					"special":                  tftypes.Bool,
+					"upper":                    tftypes.Bool,
 				},
 			}, map[string]tftypes.Value{
-				"bcrypt_hash":      tftypes.NewValue(tftypes.String, "hash"),
-				"id":               tftypes.NewValue(tftypes.String, "none"),
-				"keepers":          tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
-				"length":           tftypes.NewValue(tftypes.Number, 16),
-				"lower":            tftypes.NewValue(tftypes.Bool, true),
-				"min_lower":        tftypes.NewValue(tftypes.Number, 0),
-				"min_numeric":      tftypes.NewValue(tftypes.Number, 0),
-				"min_special":      tftypes.NewValue(tftypes.Number, 0),
-				"min_upper":        tftypes.NewValue(tftypes.Number, 0),
-				"number":           tftypes.NewValue(tftypes.Bool, true),
-				"numeric":          tftypes.NewValue(tftypes.Bool, true),
-				"override_special": tftypes.NewValue(tftypes.String, nil),
-				"result":           tftypes.NewValue(tftypes.String, "DZy_3*tnonj%Q%Yx"),
-				"special":          tftypes.NewValue(tftypes.Bool, true),
-				"upper":            tftypes.NewValue(tftypes.Bool, true),
+				"bcrypt_hash":              tftypes.NewValue(tftypes.String, "hash"),
+				"created_at":               tftypes.NewValue(tftypes.String, nil),
+				"keepers_hash":             tftypes.NewValue(tftypes.String, nil),
+				"entropy_bits":             tftypes.NewValue(tftypes.Number, nil),
+				"id":                       tftypes.NewValue(tftypes.String, "none"),
+				"keepers":                  tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"length":                   tftypes.NewValue(tftypes.Number, 16),
+				"min_length":               tftypes.NewValue(tftypes.Number, nil),
+				"min_character_classes":    tftypes.NewValue(tftypes.Number, nil),
+				"max_length":               tftypes.NewValue(tftypes.Number, nil),
+				"lower":                    tftypes.NewValue(tftypes.Bool, true),
+				"min_lower":                tftypes.NewValue(tftypes.Number, 0),
+				"min_numeric":              tftypes.NewValue(tftypes.Number, 0),
+				"min_special":              tftypes.NewValue(tftypes.Number, 0),
+				"min_upper":                tftypes.NewValue(tftypes.Number, 0),
+				"number":                   tftypes.NewValue(tftypes.Bool, true),
+				"numeric":                  tftypes.NewValue(tftypes.Bool, true),
+				"override_special":         tftypes.NewValue(tftypes.String, nil),
+				"pbkdf2_hash":              tftypes.NewValue(tftypes.String, nil),
+				"groups":                   tftypes.NewValue(passwordGroupsTfType, nil),
+				"pinned_prefix":            tftypes.NewValue(tftypes.String, nil),
+				"phc_hash":                 tftypes.NewValue(passwordPHCHashTfType, nil),
+				"max_repeat":               tftypes.NewValue(tftypes.Number, nil),
+				"distinct":                 tftypes.NewValue(tftypes.Bool, nil),
+				"no_leading_numeric":       tftypes.NewValue(tftypes.Bool, nil),
+				"no_leading_special":       tftypes.NewValue(tftypes.Bool, nil),
+				"no_trailing_numeric":      tftypes.NewValue(tftypes.Bool, nil),
+				"no_trailing_special":      tftypes.NewValue(tftypes.Bool, nil),
+				"count_results":            tftypes.NewValue(tftypes.Number, nil),
+				"results":                  tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+				"bcrypt_hashes":            tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+				"crypt_salt":               tftypes.NewValue(tftypes.String, nil),
+				"sha256_crypt":             tftypes.NewValue(tftypes.String, nil),
+				"sha512_crypt":             tftypes.NewValue(tftypes.String, nil),
+				"preset":                   tftypes.NewValue(tftypes.String, nil),
+				"generate_bcrypt_hash":     tftypes.NewValue(tftypes.Bool, nil),
+				"provider_version":         tftypes.NewValue(tftypes.String, nil),
+				"encrypted_result":         tftypes.NewValue(tftypes.String, nil),
+				"ssha_hash":                tftypes.NewValue(tftypes.String, nil),
+				"ssha512":                  tftypes.NewValue(tftypes.String, nil),
+				"enable_legacy_hashes":     tftypes.NewValue(tftypes.Bool, nil),
+				"ntlm_hash":                tftypes.NewValue(tftypes.String, nil),
+				"override_special_list":    tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+				"charset_preset":           tftypes.NewValue(tftypes.String, nil),
+				"spec":                     tftypes.NewValue(specTfType, nil),
+				"recipient_public_key":     tftypes.NewValue(tftypes.String, nil),
+				"wrapped_result":           tftypes.NewValue(tftypes.String, nil),
+				"fingerprint":              tftypes.NewValue(tftypes.String, nil),
+				"lifecycle_guard":          tftypes.NewValue(tftypes.Bool, nil),
+				"enable_preview":           tftypes.NewValue(tftypes.Bool, nil),
+				"result_preview":           tftypes.NewValue(tftypes.String, nil),
+				"allow_regeneration_token": tftypes.NewValue(tftypes.String, nil),
+				"result":                   tftypes.NewValue(tftypes.String, "DZy_3*tnonj%Q%Yx"),
+				"special":                  tftypes.NewValue(tftypes.Bool, true),
+				"upper":                    tftypes.NewValue(tftypes.Bool, true),
 			}),
-			Schema: passwordSchemaV3(),
+			Schema: passwordSchemaV4(),
 		},
 	}
 
@@ -1530,7 +3406,7 @@ func TestUpgradePasswordStateV0toV3_NullValues(t *testing.T) {
 	}
 }
 
-func TestUpgradePasswordStateV1toV3(t *testing.T) {
+func TestUpgradePasswordStateV1toV4(t *testing.T) {
 	t.Parallel()
 
 	req := res.UpgradeStateRequest{
@@ -1574,50 +3450,130 @@ func TestUpgradePasswordStateV1toV3(t *testing.T) {
 
 	resp := &res.UpgradeStateResponse{
 		State: tfsdk.State{
-			Schema: passwordSchemaV3(),
+			Schema: passwordSchemaV4(),
 		},
 	}
 
-	upgradePasswordStateV1toV3(context.Background(), req, resp)
+	upgradePasswordStateV1toV4(context.Background(), req, resp)
 
 	expectedResp := &res.UpgradeStateResponse{
 		State: tfsdk.State{
 			Raw: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
-					"id":               tftypes.String,
-					"keepers":          tftypes.Map{ElementType: tftypes.String},
-					"length":           tftypes.Number,
-					"lower":            tftypes.Bool,
-					"min_lower":        tftypes.Number,
-					"min_numeric":      tftypes.Number,
-					"min_special":      tftypes.Number,
-					"min_upper":        tftypes.Number,
-					"number":           tftypes.Bool,
-					"numeric":          tftypes.Bool,
-					"override_special": tftypes.String,
-					"result":           tftypes.String,
-					"special":          tftypes.Bool,
-					"upper":            tftypes.Bool,
-					"bcrypt_hash":      tftypes.String,
+					"created_at":               tftypes.String,
+					"keepers_hash":             tftypes.String,
+					"entropy_bits":             tftypes.Number,
+					"id":                       tftypes.String,
+					"keepers":                  tftypes.Map{ElementType: tftypes.String},
+					"length":                   tftypes.Number,
+					"min_length":               tftypes.Number,
+					"min_character_classes":    tftypes.Number,
+					"max_length":               tftypes.Number,
+					"lower":                    tftypes.Bool,
+					"min_lower":                tftypes.Number,
+					"min_numeric":              tftypes.Number,
+					"min_special":              tftypes.Number,
+					"min_upper":                tftypes.Number,
+					"number":                   tftypes.Bool,
+					"numeric":                  tftypes.Bool,
+					"override_special":         tftypes.String,
+					"pbkdf2_hash":              tftypes.String,
+					"groups":                   passwordGroupsTfType,
+					"pinned_prefix":            tftypes.String,
+					"phc_hash":                 passwordPHCHashTfType,
+					"max_repeat":               tftypes.Number,
+					"distinct":                 tftypes.Bool,
+					"no_leading_numeric":       tftypes.Bool,
+					"no_leading_special":       tftypes.Bool,
+					"no_trailing_numeric":      tftypes.Bool,
+					"no_trailing_special":      tftypes.Bool,
+					"count_results":            tftypes.Number,
+					"results":                  tftypes.List{ElementType: tftypes.String},
+					"bcrypt_hashes":            tftypes.List{ElementType: tftypes.String},
+					"crypt_salt":               tftypes.String,
+					"sha256_crypt":             tftypes.String,
+					"sha512_crypt":             tftypes.String,
+					"preset":                   tftypes.String,
+					"generate_bcrypt_hash":     tftypes.Bool,
+					"provider_version":         tftypes.String,
+					"encrypted_result":         tftypes.String,
+					"ssha_hash":                tftypes.String,
+					"ssha512":                  tftypes.String,
+					"enable_legacy_hashes":     tftypes.Bool,
+					"ntlm_hash":                tftypes.String,
+					"override_special_list":    tftypes.List{ElementType: tftypes.String},
+					"charset_preset":           tftypes.String,
+					"spec":                     specTfType,
+					"recipient_public_key":     tftypes.String,
+					"wrapped_result":           tftypes.String,
+					"fingerprint":              tftypes.String,
+					"lifecycle_guard":          tftypes.Bool,
+					"enable_preview":           tftypes.Bool,
+					"result_preview":           tftypes.String,
+					"allow_regeneration_token": tftypes.String,
+					"result":                   tftypes.String,
+					"special":                  tftypes.Bool,
+					"upper":                    tftypes.Bool,
+					"bcrypt_hash":              tftypes.String,
 				},
 			}, map[string]tftypes.Value{
-				"id":               tftypes.NewValue(tftypes.String, "none"),
-				"keepers":          tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
-				"length":           tftypes.NewValue(tftypes.Number, 16),
-				"lower":            tftypes.NewValue(tftypes.Bool, true),
-				"min_lower":        tftypes.NewValue(tftypes.Number, 0),
-				"min_numeric":      tftypes.NewValue(tftypes.Number, 0),
-				"min_special":      tftypes.NewValue(tftypes.Number, 0),
-				"min_upper":        tftypes.NewValue(tftypes.Number, 0),
-				"number":           tftypes.NewValue(tftypes.Bool, true),
-				"numeric":          tftypes.NewValue(tftypes.Bool, true),
-				"override_special": tftypes.NewValue(tftypes.String, "!#$%\u0026*()-_=+[]{}\u003c\u003e:?"),
-				"result":           tftypes.NewValue(tftypes.String, "DZy_3*tnonj%Q%Yx"),
-				"special":          tftypes.NewValue(tftypes.Bool, true),
-				"upper":            tftypes.NewValue(tftypes.Bool, true),
-				"bcrypt_hash":      tftypes.NewValue(tftypes.String, "bcrypt_hash"),
+				"created_at":               tftypes.NewValue(tftypes.String, nil),
+				"keepers_hash":             tftypes.NewValue(tftypes.String, nil),
+				"entropy_bits":             tftypes.NewValue(tftypes.Number, nil),
+				"id":                       tftypes.NewValue(tftypes.String, "none"),
+				"keepers":                  tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"length":                   tftypes.NewValue(tftypes.Number, 16),
+				"min_length":               tftypes.NewValue(tftypes.Number, nil),
+				"min_character_classes":    tftypes.NewValue(tftypes.Number, nil),
+				"max_length":               tftypes.NewValue(tftypes.Number, nil),
+				"lower":                    tftypes.NewValue(tftypes.Bool, true),
+				"min_lower":                tftypes.NewValue(tftypes.Number, 0),
+				"min_numeric":              tftypes.NewValue(tftypes.Number, 0),
+				"min_special":              tftypes.NewValue(tftypes.Number, 0),
+				"min_upper":                tftypes.NewValue(tftypes.Number, 0),
+				"number":                   tftypes.NewValue(tftypes.Bool, true),
+				"numeric":                  tftypes.NewValue(tftypes.Bool, true),
+				"override_special":         tftypes.NewValue(tftypes.String, "!#$%\u0026*()-_=+[]{}\u003c\u003e:?"),
+				"pbkdf2_hash":              tftypes.NewValue(tftypes.String, nil),
+				"groups":                   tftypes.NewValue(passwordGroupsTfType, nil),
+				"pinned_prefix":            tftypes.NewValue(tftypes.String, nil),
+				"phc_hash":                 tftypes.NewValue(passwordPHCHashTfType, nil),
+				"max_repeat":               tftypes.NewValue(tftypes.Number, nil),
+				"distinct":                 tftypes.NewValue(tftypes.Bool, nil),
+				"no_leading_numeric":       tftypes.NewValue(tftypes.Bool, nil),
+				"no_leading_special":       tftypes.NewValue(tftypes.Bool, nil),
+				"no_trailing_numeric":      tftypes.NewValue(tftypes.Bool, nil),
+				"no_trailing_special":      tftypes.NewValue(tftypes.Bool, nil),
+				"count_results":            tftypes.NewValue(tftypes.Number, nil),
+				"results":                  tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+				"bcrypt_hashes":            tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+				"crypt_salt":               tftypes.NewValue(tftypes.String, nil),
+				"sha256_crypt":             tftypes.NewValue(tftypes.String, nil),
+				"sha512_crypt":             tftypes.NewValue(tftypes.String, nil),
+				"preset":                   tftypes.NewValue(tftypes.String, nil),
+				"generate_bcrypt_hash":     tftypes.NewValue(tftypes.Bool, nil),
+				"provider_version":         tftypes.NewValue(tftypes.String, nil),
+				"encrypted_result":         tftypes.NewValue(tftypes.String, nil),
+				"ssha_hash":                tftypes.NewValue(tftypes.String, nil),
+				"ssha512":                  tftypes.NewValue(tftypes.String, nil),
+				"enable_legacy_hashes":     tftypes.NewValue(tftypes.Bool, nil),
+				"ntlm_hash":                tftypes.NewValue(tftypes.String, nil),
+				"override_special_list":    tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+				"charset_preset":           tftypes.NewValue(tftypes.String, nil),
+				"spec":                     tftypes.NewValue(specTfType, nil),
+				"recipient_public_key":     tftypes.NewValue(tftypes.String, nil),
+				"wrapped_result":           tftypes.NewValue(tftypes.String, nil),
+				"fingerprint":              tftypes.NewValue(tftypes.String, nil),
+				"lifecycle_guard":          tftypes.NewValue(tftypes.Bool, nil),
+				"enable_preview":           tftypes.NewValue(tftypes.Bool, nil),
+				"result_preview":           tftypes.NewValue(tftypes.String, nil),
+				"allow_regeneration_token": tftypes.NewValue(tftypes.String, nil),
+				"result":                   tftypes.NewValue(tftypes.String, "DZy_3*tnonj%Q%Yx"),
+				"special":                  tftypes.NewValue(tftypes.Bool, true),
+				"upper":                    tftypes.NewValue(tftypes.Bool, true),
+				"bcrypt_hash":              tftypes.NewValue(tftypes.String, "bcrypt_hash"),
 			}),
-			Schema: passwordSchemaV3(),
+			Schema: passwordSchemaV4(),
 		},
 	}
 
@@ -1626,7 +3582,7 @@ func TestUpgradePasswordStateV1toV3(t *testing.T) {
 	}
 }
 
-func TestUpgradePasswordStateV1toV3_NullValues(t *testing.T) {
+func TestUpgradePasswordStateV1toV4_NullValues(t *testing.T) {
 	t.Parallel()
 
 	req := res.UpgradeStateRequest{
@@ -1670,50 +3626,130 @@ func TestUpgradePasswordStateV1toV3_NullValues(t *testing.T) {
 
 	resp := &res.UpgradeStateResponse{
 		State: tfsdk.State{
-			Schema: passwordSchemaV3(),
+			Schema: passwordSchemaV4(),
 		},
 	}
 
-	upgradePasswordStateV1toV3(context.Background(), req, resp)
+	upgradePasswordStateV1toV4(context.Background(), req, resp)
 
 	expectedResp := &res.UpgradeStateResponse{
 		State: tfsdk.State{
 			Raw: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
-					"id":               tftypes.String,
-					"keepers":          tftypes.Map{ElementType: tftypes.String},
-					"length":           tftypes.Number,
-					"lower":            tftypes.Bool,
-					"min_lower":        tftypes.Number,
-					"min_numeric":      tftypes.Number,
-					"min_special":      tftypes.Number,
-					"min_upper":        tftypes.Number,
-					"number":           tftypes.Bool,
-					"numeric":          tftypes.Bool,
-					"override_special": tftypes.String,
-					"result":           tftypes.String,
-					"special":          tftypes.Bool,
-					"upper":            tftypes.Bool,
-					"bcrypt_hash":      tftypes.String,
+					"created_at":               tftypes.String,
+					"keepers_hash":             tftypes.String,
+					"entropy_bits":             tftypes.Number,
+					"id":                       tftypes.String,
+					"keepers":                  tftypes.Map{ElementType: tftypes.String},
+					"length":                   tftypes.Number,
+					"min_length":               tftypes.Number,
+					"min_character_classes":    tftypes.Number,
+					"max_length":               tftypes.Number,
+					"lower":                    tftypes.Bool,
+					"min_lower":                tftypes.Number,
+					"min_numeric":              tftypes.Number,
+					"min_special":              tftypes.Number,
+					"min_upper":                tftypes.Number,
+					"number":                   tftypes.Bool,
+					"numeric":                  tftypes.Bool,
+					"override_special":         tftypes.String,
+					"pbkdf2_hash":              tftypes.String,
+					"groups":                   passwordGroupsTfType,
+					"pinned_prefix":            tftypes.String,
+					"phc_hash":                 passwordPHCHashTfType,
+					"max_repeat":               tftypes.Number,
+					"distinct":                 tftypes.Bool,
+					"no_leading_numeric":       tftypes.Bool,
+					"no_leading_special":       tftypes.Bool,
+					"no_trailing_numeric":      tftypes.Bool,
+					"no_trailing_special":      tftypes.Bool,
+					"count_results":            tftypes.Number,
+					"results":                  tftypes.List{ElementType: tftypes.String},
+					"bcrypt_hashes":            tftypes.List{ElementType: tftypes.String},
+					"crypt_salt":               tftypes.String,
+					"sha256_crypt":             tftypes.String,
+					"sha512_crypt":             tftypes.String,
+					"preset":                   tftypes.String,
+					"generate_bcrypt_hash":     tftypes.Bool,
+					"provider_version":         tftypes.String,
+					"encrypted_result":         tftypes.String,
+					"ssha_hash":                tftypes.String,
+					"ssha512":                  tftypes.String,
+					"enable_legacy_hashes":     tftypes.Bool,
+					"ntlm_hash":                tftypes.String,
+					"override_special_list":    tftypes.List{ElementType: tftypes.String},
+					"charset_preset":           tftypes.String,
+					"spec":                     specTfType,
+					"recipient_public_key":     tftypes.String,
+					"wrapped_result":           tftypes.String,
+					"fingerprint":              tftypes.String,
+					"lifecycle_guard":          tftypes.Bool,
+					"enable_preview":           tftypes.Bool,
+					"result_preview":           tftypes.String,
+					"allow_regeneration_token": tftypes.String,
+					"result":                   tftypes.String,
+					"special":                  tftypes.Bool,
+					"upper":                    tftypes.Bool,
+					"bcrypt_hash":              tftypes.String,
 				},
 			}, map[string]tftypes.Value{
-				"id":               tftypes.NewValue(tftypes.String, "none"),
-				"keepers":          tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
-				"length":           tftypes.NewValue(tftypes.Number, 16),
-				"lower":            tftypes.NewValue(tftypes.Bool, true),
-				"min_lower":        tftypes.NewValue(tftypes.Number, 0),
-				"min_numeric":      tftypes.NewValue(tftypes.Number, 0),
-				"min_special":      tftypes.NewValue(tftypes.Number, 0),
-				"min_upper":        tftypes.NewValue(tftypes.Number, 0),
-				"number":           tftypes.NewValue(tftypes.Bool, true),
-				"numeric":          tftypes.NewValue(tftypes.Bool, true),
-				"override_special": tftypes.NewValue(tftypes.String, nil),
-				"result":           tftypes.NewValue(tftypes.String, "DZy_3*tnonj%Q%Yx"),
-				"special":          tftypes.NewValue(tftypes.Bool, true),
-				"upper":            tftypes.NewValue(tftypes.Bool, true),
-				"bcrypt_hash":      tftypes.NewValue(tftypes.String, "bcrypt_hash"),
+				"created_at":               tftypes.NewValue(tftypes.String, nil),
+				"keepers_hash":             tftypes.NewValue(tftypes.String, nil),
+				"entropy_bits":             tftypes.NewValue(tftypes.Number, nil),
+				"id":                       tftypes.NewValue(tftypes.String, "none"),
+				"keepers":                  tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"length":                   tftypes.NewValue(tftypes.Number, 16),
+				"min_length":               tftypes.NewValue(tftypes.Number, nil),
+				"min_character_classes":    tftypes.NewValue(tftypes.Number, nil),
+				"max_length":               tftypes.NewValue(tftypes.Number, nil),
+				"lower":                    tftypes.NewValue(tftypes.Bool, true),
+				"min_lower":                tftypes.NewValue(tftypes.Number, 0),
+				"min_numeric":              tftypes.NewValue(tftypes.Number, 0),
+				"min_special":              tftypes.NewValue(tftypes.Number, 0),
+				"min_upper":                tftypes.NewValue(tftypes.Number, 0),
+				"number":                   tftypes.NewValue(tftypes.Bool, true),
+				"numeric":                  tftypes.NewValue(tftypes.Bool, true),
+				"override_special":         tftypes.NewValue(tftypes.String, nil),
+				"pbkdf2_hash":              tftypes.NewValue(tftypes.String, nil),
+				"groups":                   tftypes.NewValue(passwordGroupsTfType, nil),
+				"pinned_prefix":            tftypes.NewValue(tftypes.String, nil),
+				"phc_hash":                 tftypes.NewValue(passwordPHCHashTfType, nil),
+				"max_repeat":               tftypes.NewValue(tftypes.Number, nil),
+				"distinct":                 tftypes.NewValue(tftypes.Bool, nil),
+				"no_leading_numeric":       tftypes.NewValue(tftypes.Bool, nil),
+				"no_leading_special":       tftypes.NewValue(tftypes.Bool, nil),
+				"no_trailing_numeric":      tftypes.NewValue(tftypes.Bool, nil),
+				"no_trailing_special":      tftypes.NewValue(tftypes.Bool, nil),
+				"count_results":            tftypes.NewValue(tftypes.Number, nil),
+				"results":                  tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+				"bcrypt_hashes":            tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+				"crypt_salt":               tftypes.NewValue(tftypes.String, nil),
+				"sha256_crypt":             tftypes.NewValue(tftypes.String, nil),
+				"sha512_crypt":             tftypes.NewValue(tftypes.String, nil),
+				"preset":                   tftypes.NewValue(tftypes.String, nil),
+				"generate_bcrypt_hash":     tftypes.NewValue(tftypes.Bool, nil),
+				"provider_version":         tftypes.NewValue(tftypes.String, nil),
+				"encrypted_result":         tftypes.NewValue(tftypes.String, nil),
+				"ssha_hash":                tftypes.NewValue(tftypes.String, nil),
+				"ssha512":                  tftypes.NewValue(tftypes.String, nil),
+				"enable_legacy_hashes":     tftypes.NewValue(tftypes.Bool, nil),
+				"ntlm_hash":                tftypes.NewValue(tftypes.String, nil),
+				"override_special_list":    tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+				"charset_preset":           tftypes.NewValue(tftypes.String, nil),
+				"spec":                     tftypes.NewValue(specTfType, nil),
+				"recipient_public_key":     tftypes.NewValue(tftypes.String, nil),
+				"wrapped_result":           tftypes.NewValue(tftypes.String, nil),
+				"fingerprint":              tftypes.NewValue(tftypes.String, nil),
+				"lifecycle_guard":          tftypes.NewValue(tftypes.Bool, nil),
+				"enable_preview":           tftypes.NewValue(tftypes.Bool, nil),
+				"result_preview":           tftypes.NewValue(tftypes.String, nil),
+				"allow_regeneration_token": tftypes.NewValue(tftypes.String, nil),
+				"result":                   tftypes.NewValue(tftypes.String, "DZy_3*tnonj%Q%Yx"),
+				"special":                  tftypes.NewValue(tftypes.Bool, true),
+				"upper":                    tftypes.NewValue(tftypes.Bool, true),
+				"bcrypt_hash":              tftypes.NewValue(tftypes.String, "bcrypt_hash"),
 			}),
-			Schema: passwordSchemaV3(),
+			Schema: passwordSchemaV4(),
 		},
 	}
 
@@ -1722,7 +3758,7 @@ func TestUpgradePasswordStateV1toV3_NullValues(t *testing.T) {
 	}
 }
 
-func TestUpgradePasswordStateV2toV3(t *testing.T) {
+func TestUpgradePasswordStateV2toV4(t *testing.T) {
 	t.Parallel()
 
 	testCases := map[string]struct {
@@ -1731,47 +3767,7 @@ func TestUpgradePasswordStateV2toV3(t *testing.T) {
 	}{
 		"valid-hash": {
 			request: res.UpgradeStateRequest{
-				State: &tfsdk.State{
-					Raw: tftypes.NewValue(tftypes.Object{
-						AttributeTypes: map[string]tftypes.Type{
-							"bcrypt_hash":      tftypes.String,
-							"id":               tftypes.String,
-							"keepers":          tftypes.Map{ElementType: tftypes.String},
-							"length":           tftypes.Number,
-							"lower":            tftypes.Bool,
-							"min_lower":        tftypes.Number,
-							"min_numeric":      tftypes.Number,
-							"min_special":      tftypes.Number,
-							"min_upper":        tftypes.Number,
-							"number":           tftypes.Bool,
-							"numeric":          tftypes.Bool,
-							"override_special": tftypes.String,
-							"result":           tftypes.String,
-							"special":          tftypes.Bool,
-							"upper":            tftypes.Bool,
-						},
-					}, map[string]tftypes.Value{
-						"bcrypt_hash":      tftypes.NewValue(tftypes.String, "$2a$10$d9zhEkVg.O1jZ6fEIMRlRuu/vMa0/4UIzeK5joaTBhZJlYiIPhWWa"),
-						"id":               tftypes.NewValue(tftypes.String, "none"),
-						"keepers":          tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
-						"length":           tftypes.NewValue(tftypes.Number, 20),
-						"lower":            tftypes.NewValue(tftypes.Bool, true),
-						"min_lower":        tftypes.NewValue(tftypes.Number, 0),
-						"min_numeric":      tftypes.NewValue(tftypes.Number, 0),
-						"min_special":      tftypes.NewValue(tftypes.Number, 0),
-						"min_upper":        tftypes.NewValue(tftypes.Number, 0),
-						"number":           tftypes.NewValue(tftypes.Bool, true),
-						"numeric":          tftypes.NewValue(tftypes.Bool, true),
-						"override_special": tftypes.NewValue(tftypes.String, ""),
-						"result":           tftypes.NewValue(tftypes.String, "n:um[a9kO&x!L=9og[EM"),
-						"special":          tftypes.NewValue(tftypes.Bool, true),
-						"upper":            tftypes.NewValue(tftypes.Bool, true),
-					}),
-					Schema: passwordSchemaV2(),
-				},
-			},
-			expected: &res.UpgradeStateResponse{
-				State: tfsdk.State{
+				State: &tfsdk.State{
 					Raw: tftypes.NewValue(tftypes.Object{
 						AttributeTypes: map[string]tftypes.Type{
 							"bcrypt_hash":      tftypes.String,
@@ -1791,8 +3787,6 @@ func TestUpgradePasswordStateV2toV3(t *testing.T) {
 							"upper":            tftypes.Bool,
 						},
 					}, map[string]tftypes.Value{
-						// The difference checking should compare this actual
-						// value since it should not be updated.
 						"bcrypt_hash":      tftypes.NewValue(tftypes.String, "$2a$10$d9zhEkVg.O1jZ6fEIMRlRuu/vMa0/4UIzeK5joaTBhZJlYiIPhWWa"),
 						"id":               tftypes.NewValue(tftypes.String, "none"),
 						"keepers":          tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
@@ -1809,7 +3803,129 @@ func TestUpgradePasswordStateV2toV3(t *testing.T) {
 						"special":          tftypes.NewValue(tftypes.Bool, true),
 						"upper":            tftypes.NewValue(tftypes.Bool, true),
 					}),
-					Schema: passwordSchemaV3(),
+					Schema: passwordSchemaV2(),
+				},
+			},
+			expected: &res.UpgradeStateResponse{
+				State: tfsdk.State{
+					Raw: tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"bcrypt_hash":              tftypes.String,
+							"created_at":               tftypes.String,
+							"keepers_hash":             tftypes.String,
+							"entropy_bits":             tftypes.Number,
+							"id":                       tftypes.String,
+							"keepers":                  tftypes.Map{ElementType: tftypes.String},
+							"length":                   tftypes.Number,
+							"min_length":               tftypes.Number,
+							"min_character_classes":    tftypes.Number,
+							"max_length":               tftypes.Number,
+							"lower":                    tftypes.Bool,
+							"min_lower":                tftypes.Number,
+							"min_numeric":              tftypes.Number,
+							"min_special":              tftypes.Number,
+							"min_upper":                tftypes.Number,
+							"number":                   tftypes.Bool,
+							"numeric":                  tftypes.Bool,
+							"override_special":         tftypes.String,
+							"pbkdf2_hash":              tftypes.String,
+							"groups":                   passwordGroupsTfType,
+							"pinned_prefix":            tftypes.String,
+							"phc_hash":                 passwordPHCHashTfType,
+							"max_repeat":               tftypes.Number,
+							"distinct":                 tftypes.Bool,
+							"no_leading_numeric":       tftypes.Bool,
+							"no_leading_special":       tftypes.Bool,
+							"no_trailing_numeric":      tftypes.Bool,
+							"no_trailing_special":      tftypes.Bool,
+							"count_results":            tftypes.Number,
+							"results":                  tftypes.List{ElementType: tftypes.String},
+							"bcrypt_hashes":            tftypes.List{ElementType: tftypes.String},
+							"crypt_salt":               tftypes.String,
+							"sha256_crypt":             tftypes.String,
+							"sha512_crypt":             tftypes.String,
+							"preset":                   tftypes.String,
+							"generate_bcrypt_hash":     tftypes.Bool,
+							"provider_version":         tftypes.String,
+							"encrypted_result":         tftypes.String,
+							"ssha_hash":                tftypes.String,
+							"ssha512":                  tftypes.String,
+							"enable_legacy_hashes":     tftypes.Bool,
+							"ntlm_hash":                tftypes.String,
+							"override_special_list":    tftypes.List{ElementType: tftypes.String},
+							"charset_preset":           tftypes.String,
+							"spec":                     specTfType,
+							"recipient_public_key":     tftypes.String,
+							"wrapped_result":           tftypes.String,
+							"fingerprint":              tftypes.String,
+							"lifecycle_guard":          tftypes.Bool,
+							"enable_preview":           tftypes.Bool,
+							"result_preview":           tftypes.String,
+							"allow_regeneration_token": tftypes.String,
+							"result":                   tftypes.String,
+							"special":                  tftypes.Bool,
+							"upper":                    tftypes.Bool,
+						},
+					}, map[string]tftypes.Value{
+						// The difference checking should compare this actual
+						// value since it should not be updated.
+						"bcrypt_hash":              tftypes.NewValue(tftypes.String, "$2a$10$d9zhEkVg.O1jZ6fEIMRlRuu/vMa0/4UIzeK5joaTBhZJlYiIPhWWa"),
+						"created_at":               tftypes.NewValue(tftypes.String, nil),
+						"keepers_hash":             tftypes.NewValue(tftypes.String, nil),
+						"entropy_bits":             tftypes.NewValue(tftypes.Number, nil),
+						"id":                       tftypes.NewValue(tftypes.String, "none"),
+						"keepers":                  tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+						"length":                   tftypes.NewValue(tftypes.Number, 20),
+						"min_length":               tftypes.NewValue(tftypes.Number, nil),
+						"min_character_classes":    tftypes.NewValue(tftypes.Number, nil),
+						"max_length":               tftypes.NewValue(tftypes.Number, nil),
+						"lower":                    tftypes.NewValue(tftypes.Bool, true),
+						"min_lower":                tftypes.NewValue(tftypes.Number, 0),
+						"min_numeric":              tftypes.NewValue(tftypes.Number, 0),
+						"min_special":              tftypes.NewValue(tftypes.Number, 0),
+						"min_upper":                tftypes.NewValue(tftypes.Number, 0),
+						"number":                   tftypes.NewValue(tftypes.Bool, true),
+						"numeric":                  tftypes.NewValue(tftypes.Bool, true),
+						"override_special":         tftypes.NewValue(tftypes.String, ""),
+						"pbkdf2_hash":              tftypes.NewValue(tftypes.String, nil),
+						"groups":                   tftypes.NewValue(passwordGroupsTfType, nil),
+						"pinned_prefix":            tftypes.NewValue(tftypes.String, nil),
+						"phc_hash":                 tftypes.NewValue(passwordPHCHashTfType, nil),
+						"max_repeat":               tftypes.NewValue(tftypes.Number, nil),
+						"distinct":                 tftypes.NewValue(tftypes.Bool, nil),
+						"no_leading_numeric":       tftypes.NewValue(tftypes.Bool, nil),
+						"no_leading_special":       tftypes.NewValue(tftypes.Bool, nil),
+						"no_trailing_numeric":      tftypes.NewValue(tftypes.Bool, nil),
+						"no_trailing_special":      tftypes.NewValue(tftypes.Bool, nil),
+						"count_results":            tftypes.NewValue(tftypes.Number, nil),
+						"results":                  tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+						"bcrypt_hashes":            tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+						"crypt_salt":               tftypes.NewValue(tftypes.String, nil),
+						"sha256_crypt":             tftypes.NewValue(tftypes.String, nil),
+						"sha512_crypt":             tftypes.NewValue(tftypes.String, nil),
+						"preset":                   tftypes.NewValue(tftypes.String, nil),
+						"generate_bcrypt_hash":     tftypes.NewValue(tftypes.Bool, nil),
+						"provider_version":         tftypes.NewValue(tftypes.String, nil),
+						"encrypted_result":         tftypes.NewValue(tftypes.String, nil),
+						"ssha_hash":                tftypes.NewValue(tftypes.String, nil),
+						"ssha512":                  tftypes.NewValue(tftypes.String, nil),
+						"enable_legacy_hashes":     tftypes.NewValue(tftypes.Bool, nil),
+						"ntlm_hash":                tftypes.NewValue(tftypes.String, nil),
+						"override_special_list":    tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+						"charset_preset":           tftypes.NewValue(tftypes.String, nil),
+						"spec":                     tftypes.NewValue(specTfType, nil),
+						"recipient_public_key":     tftypes.NewValue(tftypes.String, nil),
+						"wrapped_result":           tftypes.NewValue(tftypes.String, nil),
+						"fingerprint":              tftypes.NewValue(tftypes.String, nil),
+						"lifecycle_guard":          tftypes.NewValue(tftypes.Bool, nil),
+						"enable_preview":           tftypes.NewValue(tftypes.Bool, nil),
+						"result_preview":           tftypes.NewValue(tftypes.String, nil),
+						"allow_regeneration_token": tftypes.NewValue(tftypes.String, nil),
+						"result":                   tftypes.NewValue(tftypes.String, "n:um[a9kO&x!L=9og[EM"),
+						"special":                  tftypes.NewValue(tftypes.Bool, true),
+						"upper":                    tftypes.NewValue(tftypes.Bool, true),
+					}),
+					Schema: passwordSchemaV4(),
 				},
 			},
 		},
@@ -1858,42 +3974,122 @@ func TestUpgradePasswordStateV2toV3(t *testing.T) {
 				State: tfsdk.State{
 					Raw: tftypes.NewValue(tftypes.Object{
 						AttributeTypes: map[string]tftypes.Type{
-							"bcrypt_hash":      tftypes.String,
-							"id":               tftypes.String,
-							"keepers":          tftypes.Map{ElementType: tftypes.String},
-							"length":           tftypes.Number,
-							"lower":            tftypes.Bool,
-							"min_lower":        tftypes.Number,
-							"min_numeric":      tftypes.Number,
-							"min_special":      tftypes.Number,
-							"min_upper":        tftypes.Number,
-							"number":           tftypes.Bool,
-							"numeric":          tftypes.Bool,
-							"override_special": tftypes.String,
-							"result":           tftypes.String,
-							"special":          tftypes.Bool,
-							"upper":            tftypes.Bool,
+							"bcrypt_hash":              tftypes.String,
+							"created_at":               tftypes.String,
+							"keepers_hash":             tftypes.String,
+							"entropy_bits":             tftypes.Number,
+							"id":                       tftypes.String,
+							"keepers":                  tftypes.Map{ElementType: tftypes.String},
+							"length":                   tftypes.Number,
+							"min_length":               tftypes.Number,
+							"min_character_classes":    tftypes.Number,
+							"max_length":               tftypes.Number,
+							"lower":                    tftypes.Bool,
+							"min_lower":                tftypes.Number,
+							"min_numeric":              tftypes.Number,
+							"min_special":              tftypes.Number,
+							"min_upper":                tftypes.Number,
+							"number":                   tftypes.Bool,
+							"numeric":                  tftypes.Bool,
+							"override_special":         tftypes.String,
+							"pbkdf2_hash":              tftypes.String,
+							"groups":                   passwordGroupsTfType,
+							"pinned_prefix":            tftypes.String,
+							"phc_hash":                 passwordPHCHashTfType,
+							"max_repeat":               tftypes.Number,
+							"distinct":                 tftypes.Bool,
+							"no_leading_numeric":       tftypes.Bool,
+							"no_leading_special":       tftypes.Bool,
+							"no_trailing_numeric":      tftypes.Bool,
+							"no_trailing_special":      tftypes.Bool,
+							"count_results":            tftypes.Number,
+							"results":                  tftypes.List{ElementType: tftypes.String},
+							"bcrypt_hashes":            tftypes.List{ElementType: tftypes.String},
+							"crypt_salt":               tftypes.String,
+							"sha256_crypt":             tftypes.String,
+							"sha512_crypt":             tftypes.String,
+							"preset":                   tftypes.String,
+							"generate_bcrypt_hash":     tftypes.Bool,
+							"provider_version":         tftypes.String,
+							"encrypted_result":         tftypes.String,
+							"ssha_hash":                tftypes.String,
+							"ssha512":                  tftypes.String,
+							"enable_legacy_hashes":     tftypes.Bool,
+							"ntlm_hash":                tftypes.String,
+							"override_special_list":    tftypes.List{ElementType: tftypes.String},
+							"charset_preset":           tftypes.String,
+							"spec":                     specTfType,
+							"recipient_public_key":     tftypes.String,
+							"wrapped_result":           tftypes.String,
+							"fingerprint":              tftypes.String,
+							"lifecycle_guard":          tftypes.Bool,
+							"enable_preview":           tftypes.Bool,
+							"result_preview":           tftypes.String,
+							"allow_regeneration_token": tftypes.String,
+							"result":                   tftypes.String,
+							"special":                  tftypes.Bool,
+							"upper":                    tftypes.Bool,
 						},
 					}, map[string]tftypes.Value{
 						// bcrypt_hash is randomly generated, so the difference checking
 						// will ignore this value.
-						"bcrypt_hash":      tftypes.NewValue(tftypes.String, nil),
-						"id":               tftypes.NewValue(tftypes.String, "none"),
-						"keepers":          tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
-						"length":           tftypes.NewValue(tftypes.Number, 20),
-						"lower":            tftypes.NewValue(tftypes.Bool, true),
-						"min_lower":        tftypes.NewValue(tftypes.Number, 0),
-						"min_numeric":      tftypes.NewValue(tftypes.Number, 0),
-						"min_special":      tftypes.NewValue(tftypes.Number, 0),
-						"min_upper":        tftypes.NewValue(tftypes.Number, 0),
-						"number":           tftypes.NewValue(tftypes.Bool, true),
-						"numeric":          tftypes.NewValue(tftypes.Bool, true),
-						"override_special": tftypes.NewValue(tftypes.String, ""),
-						"result":           tftypes.NewValue(tftypes.String, "$7r>NiN4Z%uAxpU]:DuB"),
-						"special":          tftypes.NewValue(tftypes.Bool, true),
-						"upper":            tftypes.NewValue(tftypes.Bool, true),
+						"bcrypt_hash":              tftypes.NewValue(tftypes.String, nil),
+						"created_at":               tftypes.NewValue(tftypes.String, nil),
+						"keepers_hash":             tftypes.NewValue(tftypes.String, nil),
+						"entropy_bits":             tftypes.NewValue(tftypes.Number, nil),
+						"id":                       tftypes.NewValue(tftypes.String, "none"),
+						"keepers":                  tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+						"length":                   tftypes.NewValue(tftypes.Number, 20),
+						"min_length":               tftypes.NewValue(tftypes.Number, nil),
+						"min_character_classes":    tftypes.NewValue(tftypes.Number, nil),
+						"max_length":               tftypes.NewValue(tftypes.Number, nil),
+						"lower":                    tftypes.NewValue(tftypes.Bool, true),
+						"min_lower":                tftypes.NewValue(tftypes.Number, 0),
+						"min_numeric":              tftypes.NewValue(tftypes.Number, 0),
+						"min_special":              tftypes.NewValue(tftypes.Number, 0),
+						"min_upper":                tftypes.NewValue(tftypes.Number, 0),
+						"number":                   tftypes.NewValue(tftypes.Bool, true),
+						"numeric":                  tftypes.NewValue(tftypes.Bool, true),
+						"override_special":         tftypes.NewValue(tftypes.String, ""),
+						"pbkdf2_hash":              tftypes.NewValue(tftypes.String, nil),
+						"groups":                   tftypes.NewValue(passwordGroupsTfType, nil),
+						"pinned_prefix":            tftypes.NewValue(tftypes.String, nil),
+						"phc_hash":                 tftypes.NewValue(passwordPHCHashTfType, nil),
+						"max_repeat":               tftypes.NewValue(tftypes.Number, nil),
+						"distinct":                 tftypes.NewValue(tftypes.Bool, nil),
+						"no_leading_numeric":       tftypes.NewValue(tftypes.Bool, nil),
+						"no_leading_special":       tftypes.NewValue(tftypes.Bool, nil),
+						"no_trailing_numeric":      tftypes.NewValue(tftypes.Bool, nil),
+						"no_trailing_special":      tftypes.NewValue(tftypes.Bool, nil),
+						"count_results":            tftypes.NewValue(tftypes.Number, nil),
+						"results":                  tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+						"bcrypt_hashes":            tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+						"crypt_salt":               tftypes.NewValue(tftypes.String, nil),
+						"sha256_crypt":             tftypes.NewValue(tftypes.String, nil),
+						"sha512_crypt":             tftypes.NewValue(tftypes.String, nil),
+						"preset":                   tftypes.NewValue(tftypes.String, nil),
+						"generate_bcrypt_hash":     tftypes.NewValue(tftypes.Bool, nil),
+						"provider_version":         tftypes.NewValue(tftypes.String, nil),
+						"encrypted_result":         tftypes.NewValue(tftypes.String, nil),
+						"ssha_hash":                tftypes.NewValue(tftypes.String, nil),
+						"ssha512":                  tftypes.NewValue(tftypes.String, nil),
+						"enable_legacy_hashes":     tftypes.NewValue(tftypes.Bool, nil),
+						"ntlm_hash":                tftypes.NewValue(tftypes.String, nil),
+						"override_special_list":    tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+						"charset_preset":           tftypes.NewValue(tftypes.String, nil),
+						"spec":                     tftypes.NewValue(specTfType, nil),
+						"recipient_public_key":     tftypes.NewValue(tftypes.String, nil),
+						"wrapped_result":           tftypes.NewValue(tftypes.String, nil),
+						"fingerprint":              tftypes.NewValue(tftypes.String, nil),
+						"lifecycle_guard":          tftypes.NewValue(tftypes.Bool, nil),
+						"enable_preview":           tftypes.NewValue(tftypes.Bool, nil),
+						"result_preview":           tftypes.NewValue(tftypes.String, nil),
+						"allow_regeneration_token": tftypes.NewValue(tftypes.String, nil),
+						"result":                   tftypes.NewValue(tftypes.String, "$7r>NiN4Z%uAxpU]:DuB"),
+						"special":                  tftypes.NewValue(tftypes.Bool, true),
+						"upper":                    tftypes.NewValue(tftypes.Bool, true),
 					}),
-					Schema: passwordSchemaV3(),
+					Schema: passwordSchemaV4(),
 				},
 			},
 		},
@@ -1942,42 +4138,122 @@ func TestUpgradePasswordStateV2toV3(t *testing.T) {
 				State: tfsdk.State{
 					Raw: tftypes.NewValue(tftypes.Object{
 						AttributeTypes: map[string]tftypes.Type{
-							"bcrypt_hash":      tftypes.String,
-							"id":               tftypes.String,
-							"keepers":          tftypes.Map{ElementType: tftypes.String},
-							"length":           tftypes.Number,
-							"lower":            tftypes.Bool,
-							"min_lower":        tftypes.Number,
-							"min_numeric":      tftypes.Number,
-							"min_special":      tftypes.Number,
-							"min_upper":        tftypes.Number,
-							"number":           tftypes.Bool,
-							"numeric":          tftypes.Bool,
-							"override_special": tftypes.String,
-							"result":           tftypes.String,
-							"special":          tftypes.Bool,
-							"upper":            tftypes.Bool,
+							"bcrypt_hash":              tftypes.String,
+							"created_at":               tftypes.String,
+							"keepers_hash":             tftypes.String,
+							"entropy_bits":             tftypes.Number,
+							"id":                       tftypes.String,
+							"keepers":                  tftypes.Map{ElementType: tftypes.String},
+							"length":                   tftypes.Number,
+							"min_length":               tftypes.Number,
+							"min_character_classes":    tftypes.Number,
+							"max_length":               tftypes.Number,
+							"lower":                    tftypes.Bool,
+							"min_lower":                tftypes.Number,
+							"min_numeric":              tftypes.Number,
+							"min_special":              tftypes.Number,
+							"min_upper":                tftypes.Number,
+							"number":                   tftypes.Bool,
+							"numeric":                  tftypes.Bool,
+							"override_special":         tftypes.String,
+							"pbkdf2_hash":              tftypes.String,
+							"groups":                   passwordGroupsTfType,
+							"pinned_prefix":            tftypes.String,
+							"phc_hash":                 passwordPHCHashTfType,
+							"max_repeat":               tftypes.Number,
+							"distinct":                 tftypes.Bool,
+							"no_leading_numeric":       tftypes.Bool,
+							"no_leading_special":       tftypes.Bool,
+							"no_trailing_numeric":      tftypes.Bool,
+							"no_trailing_special":      tftypes.Bool,
+							"count_results":            tftypes.Number,
+							"results":                  tftypes.List{ElementType: tftypes.String},
+							"bcrypt_hashes":            tftypes.List{ElementType: tftypes.String},
+							"crypt_salt":               tftypes.String,
+							"sha256_crypt":             tftypes.String,
+							"sha512_crypt":             tftypes.String,
+							"preset":                   tftypes.String,
+							"generate_bcrypt_hash":     tftypes.Bool,
+							"provider_version":         tftypes.String,
+							"encrypted_result":         tftypes.String,
+							"ssha_hash":                tftypes.String,
+							"ssha512":                  tftypes.String,
+							"enable_legacy_hashes":     tftypes.Bool,
+							"ntlm_hash":                tftypes.String,
+							"override_special_list":    tftypes.List{ElementType: tftypes.String},
+							"charset_preset":           tftypes.String,
+							"spec":                     specTfType,
+							"recipient_public_key":     tftypes.String,
+							"wrapped_result":           tftypes.String,
+							"fingerprint":              tftypes.String,
+							"lifecycle_guard":          tftypes.Bool,
+							"enable_preview":           tftypes.Bool,
+							"result_preview":           tftypes.String,
+							"allow_regeneration_token": tftypes.String,
+							"result":                   tftypes.String,
+							"special":                  tftypes.Bool,
+							"upper":                    tftypes.Bool,
 						},
 					}, map[string]tftypes.Value{
 						// The difference checking should compare this actual
 						// value since it should not be updated.
-						"bcrypt_hash":      tftypes.NewValue(tftypes.String, "$2a$10$d9zhEkVg.O1jZ6fEIMRlRuu/vMa0/4UIzeK5joaTBhZJlYiIPhWWa"),
-						"id":               tftypes.NewValue(tftypes.String, "none"),
-						"keepers":          tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
-						"length":           tftypes.NewValue(tftypes.Number, 20),
-						"lower":            tftypes.NewValue(tftypes.Bool, true),
-						"min_lower":        tftypes.NewValue(tftypes.Number, 0),
-						"min_numeric":      tftypes.NewValue(tftypes.Number, 0),
-						"min_special":      tftypes.NewValue(tftypes.Number, 0),
-						"min_upper":        tftypes.NewValue(tftypes.Number, 0),
-						"number":           tftypes.NewValue(tftypes.Bool, true),
-						"numeric":          tftypes.NewValue(tftypes.Bool, true),
-						"override_special": tftypes.NewValue(tftypes.String, ""),
-						"result":           tftypes.NewValue(tftypes.String, "n:um[a9kO&x!L=9og[EM"),
-						"special":          tftypes.NewValue(tftypes.Bool, true),
-						"upper":            tftypes.NewValue(tftypes.Bool, true),
+						"bcrypt_hash":              tftypes.NewValue(tftypes.String, "$2a$10$d9zhEkVg.O1jZ6fEIMRlRuu/vMa0/4UIzeK5joaTBhZJlYiIPhWWa"),
+						"created_at":               tftypes.NewValue(tftypes.String, nil),
+						"keepers_hash":             tftypes.NewValue(tftypes.String, nil),
+						"entropy_bits":             tftypes.NewValue(tftypes.Number, nil),
+						"id":                       tftypes.NewValue(tftypes.String, "none"),
+						"keepers":                  tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+						"length":                   tftypes.NewValue(tftypes.Number, 20),
+						"min_length":               tftypes.NewValue(tftypes.Number, nil),
+						"min_character_classes":    tftypes.NewValue(tftypes.Number, nil),
+						"max_length":               tftypes.NewValue(tftypes.Number, nil),
+						"lower":                    tftypes.NewValue(tftypes.Bool, true),
+						"min_lower":                tftypes.NewValue(tftypes.Number, 0),
+						"min_numeric":              tftypes.NewValue(tftypes.Number, 0),
+						"min_special":              tftypes.NewValue(tftypes.Number, 0),
+						"min_upper":                tftypes.NewValue(tftypes.Number, 0),
+						"number":                   tftypes.NewValue(tftypes.Bool, true),
+						"numeric":                  tftypes.NewValue(tftypes.Bool, true),
+						"override_special":         tftypes.NewValue(tftypes.String, ""),
+						"pbkdf2_hash":              tftypes.NewValue(tftypes.String, nil),
+						"groups":                   tftypes.NewValue(passwordGroupsTfType, nil),
+						"pinned_prefix":            tftypes.NewValue(tftypes.String, nil),
+						"phc_hash":                 tftypes.NewValue(passwordPHCHashTfType, nil),
+						"max_repeat":               tftypes.NewValue(tftypes.Number, nil),
+						"distinct":                 tftypes.NewValue(tftypes.Bool, nil),
+						"no_leading_numeric":       tftypes.NewValue(tftypes.Bool, nil),
+						"no_leading_special":       tftypes.NewValue(tftypes.Bool, nil),
+						"no_trailing_numeric":      tftypes.NewValue(tftypes.Bool, nil),
+						"no_trailing_special":      tftypes.NewValue(tftypes.Bool, nil),
+						"count_results":            tftypes.NewValue(tftypes.Number, nil),
+						"results":                  tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+						"bcrypt_hashes":            tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+						"crypt_salt":               tftypes.NewValue(tftypes.String, nil),
+						"sha256_crypt":             tftypes.NewValue(tftypes.String, nil),
+						"sha512_crypt":             tftypes.NewValue(tftypes.String, nil),
+						"preset":                   tftypes.NewValue(tftypes.String, nil),
+						"generate_bcrypt_hash":     tftypes.NewValue(tftypes.Bool, nil),
+						"provider_version":         tftypes.NewValue(tftypes.String, nil),
+						"encrypted_result":         tftypes.NewValue(tftypes.String, nil),
+						"ssha_hash":                tftypes.NewValue(tftypes.String, nil),
+						"ssha512":                  tftypes.NewValue(tftypes.String, nil),
+						"enable_legacy_hashes":     tftypes.NewValue(tftypes.Bool, nil),
+						"ntlm_hash":                tftypes.NewValue(tftypes.String, nil),
+						"override_special_list":    tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+						"charset_preset":           tftypes.NewValue(tftypes.String, nil),
+						"spec":                     tftypes.NewValue(specTfType, nil),
+						"recipient_public_key":     tftypes.NewValue(tftypes.String, nil),
+						"wrapped_result":           tftypes.NewValue(tftypes.String, nil),
+						"fingerprint":              tftypes.NewValue(tftypes.String, nil),
+						"lifecycle_guard":          tftypes.NewValue(tftypes.Bool, nil),
+						"enable_preview":           tftypes.NewValue(tftypes.Bool, nil),
+						"result_preview":           tftypes.NewValue(tftypes.String, nil),
+						"allow_regeneration_token": tftypes.NewValue(tftypes.String, nil),
+						"result":                   tftypes.NewValue(tftypes.String, "n:um[a9kO&x!L=9og[EM"),
+						"special":                  tftypes.NewValue(tftypes.Bool, true),
+						"upper":                    tftypes.NewValue(tftypes.Bool, true),
 					}),
-					Schema: passwordSchemaV3(),
+					Schema: passwordSchemaV4(),
 				},
 			},
 		},
@@ -1995,7 +4271,7 @@ func TestUpgradePasswordStateV2toV3(t *testing.T) {
 				},
 			}
 
-			upgradePasswordStateV2toV3(context.Background(), testCase.request, &got)
+			upgradePasswordStateV2toV4(context.Background(), testCase.request, &got)
 
 			// Since bcrypt_hash is generated, this test is very involved to
 			// ensure the test case is set up properly and the generated
@@ -2116,9 +4392,187 @@ func TestUpgradePasswordStateV2toV3(t *testing.T) {
 	}
 }
 
+func TestUpgradePasswordStateV3toV4(t *testing.T) {
+	t.Parallel()
+
+	req := res.UpgradeStateRequest{
+		State: &tfsdk.State{
+			Raw: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"bcrypt_hash":      tftypes.String,
+					"id":               tftypes.String,
+					"keepers":          tftypes.Map{ElementType: tftypes.String},
+					"length":           tftypes.Number,
+					"lower":            tftypes.Bool,
+					"min_lower":        tftypes.Number,
+					"min_numeric":      tftypes.Number,
+					"min_special":      tftypes.Number,
+					"min_upper":        tftypes.Number,
+					"number":           tftypes.Bool,
+					"numeric":          tftypes.Bool,
+					"override_special": tftypes.String,
+					"result":           tftypes.String,
+					"special":          tftypes.Bool,
+					"upper":            tftypes.Bool,
+				},
+			}, map[string]tftypes.Value{
+				"bcrypt_hash":      tftypes.NewValue(tftypes.String, "$2a$10$d9zhEkVg.O1jZ6fEIMRlRuu/vMa0/4UIzeK5joaTBhZJlYiIPhWWa"),
+				"id":               tftypes.NewValue(tftypes.String, "none"),
+				"keepers":          tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"length":           tftypes.NewValue(tftypes.Number, 20),
+				"lower":            tftypes.NewValue(tftypes.Bool, true),
+				"min_lower":        tftypes.NewValue(tftypes.Number, 0),
+				"min_numeric":      tftypes.NewValue(tftypes.Number, 0),
+				"min_special":      tftypes.NewValue(tftypes.Number, 0),
+				"min_upper":        tftypes.NewValue(tftypes.Number, 0),
+				"number":           tftypes.NewValue(tftypes.Bool, true),
+				"numeric":          tftypes.NewValue(tftypes.Bool, true),
+				"override_special": tftypes.NewValue(tftypes.String, nil),
+				"result":           tftypes.NewValue(tftypes.String, "n:um[a9kO&x!L=9og[EM"),
+				"special":          tftypes.NewValue(tftypes.Bool, true),
+				"upper":            tftypes.NewValue(tftypes.Bool, true),
+			}),
+			Schema: passwordSchemaV3(),
+		},
+	}
+
+	resp := &res.UpgradeStateResponse{
+		State: tfsdk.State{
+			Schema: passwordSchemaV4(),
+		},
+	}
+
+	upgradePasswordStateV3toV4(context.Background(), req, resp)
+
+	expectedResp := &res.UpgradeStateResponse{
+		State: tfsdk.State{
+			Raw: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"bcrypt_hash":              tftypes.String,
+					"created_at":               tftypes.String,
+					"keepers_hash":             tftypes.String,
+					"entropy_bits":             tftypes.Number,
+					"id":                       tftypes.String,
+					"keepers":                  tftypes.Map{ElementType: tftypes.String},
+					"length":                   tftypes.Number,
+					"min_length":               tftypes.Number,
+					"min_character_classes":    tftypes.Number,
+					"max_length":               tftypes.Number,
+					"lower":                    tftypes.Bool,
+					"min_lower":                tftypes.Number,
+					"min_numeric":              tftypes.Number,
+					"min_special":              tftypes.Number,
+					"min_upper":                tftypes.Number,
+					"number":                   tftypes.Bool,
+					"numeric":                  tftypes.Bool,
+					"override_special":         tftypes.String,
+					"pbkdf2_hash":              tftypes.String,
+					"groups":                   passwordGroupsTfType,
+					"pinned_prefix":            tftypes.String,
+					"phc_hash":                 passwordPHCHashTfType,
+					"max_repeat":               tftypes.Number,
+					"distinct":                 tftypes.Bool,
+					"no_leading_numeric":       tftypes.Bool,
+					"no_leading_special":       tftypes.Bool,
+					"no_trailing_numeric":      tftypes.Bool,
+					"no_trailing_special":      tftypes.Bool,
+					"count_results":            tftypes.Number,
+					"results":                  tftypes.List{ElementType: tftypes.String},
+					"bcrypt_hashes":            tftypes.List{ElementType: tftypes.String},
+					"crypt_salt":               tftypes.String,
+					"sha256_crypt":             tftypes.String,
+					"sha512_crypt":             tftypes.String,
+					"preset":                   tftypes.String,
+					"generate_bcrypt_hash":     tftypes.Bool,
+					"provider_version":         tftypes.String,
+					"encrypted_result":         tftypes.String,
+					"ssha_hash":                tftypes.String,
+					"ssha512":                  tftypes.String,
+					"enable_legacy_hashes":     tftypes.Bool,
+					"ntlm_hash":                tftypes.String,
+					"override_special_list":    tftypes.List{ElementType: tftypes.String},
+					"charset_preset":           tftypes.String,
+					"spec":                     specTfType,
+					"recipient_public_key":     tftypes.String,
+					"wrapped_result":           tftypes.String,
+					"fingerprint":              tftypes.String,
+					"lifecycle_guard":          tftypes.Bool,
+					"enable_preview":           tftypes.Bool,
+					"result_preview":           tftypes.String,
+					"allow_regeneration_token": tftypes.String,
+					"result":                   tftypes.String,
+					"special":                  tftypes.Bool,
+					"upper":                    tftypes.Bool,
+				},
+			}, map[string]tftypes.Value{
+				"bcrypt_hash":              tftypes.NewValue(tftypes.String, "$2a$10$d9zhEkVg.O1jZ6fEIMRlRuu/vMa0/4UIzeK5joaTBhZJlYiIPhWWa"),
+				"created_at":               tftypes.NewValue(tftypes.String, nil),
+				"keepers_hash":             tftypes.NewValue(tftypes.String, nil),
+				"entropy_bits":             tftypes.NewValue(tftypes.Number, nil),
+				"id":                       tftypes.NewValue(tftypes.String, "none"),
+				"keepers":                  tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"length":                   tftypes.NewValue(tftypes.Number, 20),
+				"min_length":               tftypes.NewValue(tftypes.Number, nil),
+				"min_character_classes":    tftypes.NewValue(tftypes.Number, nil),
+				"max_length":               tftypes.NewValue(tftypes.Number, nil),
+				"lower":                    tftypes.NewValue(tftypes.Bool, true),
+				"min_lower":                tftypes.NewValue(tftypes.Number, 0),
+				"min_numeric":              tftypes.NewValue(tftypes.Number, 0),
+				"min_special":              tftypes.NewValue(tftypes.Number, 0),
+				"min_upper":                tftypes.NewValue(tftypes.Number, 0),
+				"number":                   tftypes.NewValue(tftypes.Bool, true),
+				"numeric":                  tftypes.NewValue(tftypes.Bool, true),
+				"override_special":         tftypes.NewValue(tftypes.String, nil),
+				"pbkdf2_hash":              tftypes.NewValue(tftypes.String, nil),
+				"groups":                   tftypes.NewValue(passwordGroupsTfType, nil),
+				"pinned_prefix":            tftypes.NewValue(tftypes.String, nil),
+				"phc_hash":                 tftypes.NewValue(passwordPHCHashTfType, nil),
+				"max_repeat":               tftypes.NewValue(tftypes.Number, nil),
+				"distinct":                 tftypes.NewValue(tftypes.Bool, nil),
+				"no_leading_numeric":       tftypes.NewValue(tftypes.Bool, nil),
+				"no_leading_special":       tftypes.NewValue(tftypes.Bool, nil),
+				"no_trailing_numeric":      tftypes.NewValue(tftypes.Bool, nil),
+				"no_trailing_special":      tftypes.NewValue(tftypes.Bool, nil),
+				"count_results":            tftypes.NewValue(tftypes.Number, nil),
+				"results":                  tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+				"bcrypt_hashes":            tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+				"crypt_salt":               tftypes.NewValue(tftypes.String, nil),
+				"sha256_crypt":             tftypes.NewValue(tftypes.String, nil),
+				"sha512_crypt":             tftypes.NewValue(tftypes.String, nil),
+				"preset":                   tftypes.NewValue(tftypes.String, nil),
+				"generate_bcrypt_hash":     tftypes.NewValue(tftypes.Bool, nil),
+				"provider_version":         tftypes.NewValue(tftypes.String, nil),
+				"encrypted_result":         tftypes.NewValue(tftypes.String, nil),
+				"ssha_hash":                tftypes.NewValue(tftypes.String, nil),
+				"ssha512":                  tftypes.NewValue(tftypes.String, nil),
+				"enable_legacy_hashes":     tftypes.NewValue(tftypes.Bool, nil),
+				"ntlm_hash":                tftypes.NewValue(tftypes.String, nil),
+				"override_special_list":    tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+				"charset_preset":           tftypes.NewValue(tftypes.String, nil),
+				"spec":                     tftypes.NewValue(specTfType, nil),
+				"recipient_public_key":     tftypes.NewValue(tftypes.String, nil),
+				"wrapped_result":           tftypes.NewValue(tftypes.String, nil),
+				"fingerprint":              tftypes.NewValue(tftypes.String, nil),
+				"lifecycle_guard":          tftypes.NewValue(tftypes.Bool, nil),
+				"enable_preview":           tftypes.NewValue(tftypes.Bool, nil),
+				"result_preview":           tftypes.NewValue(tftypes.String, nil),
+				"allow_regeneration_token": tftypes.NewValue(tftypes.String, nil),
+				"result":                   tftypes.NewValue(tftypes.String, "n:um[a9kO&x!L=9og[EM"),
+				"special":                  tftypes.NewValue(tftypes.Bool, true),
+				"upper":                    tftypes.NewValue(tftypes.Bool, true),
+			}),
+			Schema: passwordSchemaV4(),
+		},
+	}
+
+	if diff := cmp.Diff(expectedResp, resp); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
 func TestAccResourcePassword_NumberNumericErrors(t *testing.T) {
 	resource.UnitTest(t, resource.TestCase{
-		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: `resource "random_password" "number_numeric_differ" {
@@ -2132,6 +4586,22 @@ func TestAccResourcePassword_NumberNumericErrors(t *testing.T) {
 	})
 }
 
+func TestAccResourcePassword_LengthErrors(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_password" "invalid_length" {
+							length      = 4
+							min_upper   = 2
+							min_special = 3
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute length value must be at least the sum of min_upper \(2\),\s+min_lower\s+\(0\),\s+min_numeric\s+\(0\)\s+and\s+min_special\s+\(3\),\s+which\s+is\s+5,\s+got:\s+4`),
+			},
+		},
+	})
+}
+
 func TestAccResourcePassword_Keepers_Keep_EmptyMap(t *testing.T) {
 	// The result attribute values should be the same between test steps
 	assertResultSame := statecheck.CompareValue(compare.ValuesSame())
@@ -2139,7 +4609,7 @@ func TestAccResourcePassword_Keepers_Keep_EmptyMap(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_password" "test" {
 					length = 12
 					keepers = {}
@@ -2150,7 +4620,7 @@ func TestAccResourcePassword_Keepers_Keep_EmptyMap(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_password" "test" {
 					length = 12
 					keepers = {}
@@ -2171,7 +4641,7 @@ func TestAccResourcePassword_Keepers_Keep_EmptyMapToNullValue(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_password" "test" {
 					length = 12
 					keepers = {}
@@ -2182,7 +4652,7 @@ func TestAccResourcePassword_Keepers_Keep_EmptyMapToNullValue(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_password" "test" {
 					length = 12
 					keepers = {
@@ -2205,7 +4675,7 @@ func TestAccResourcePassword_Keepers_Keep_NullMap(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_password" "test" {
 					length = 12
 				}`,
@@ -2215,7 +4685,7 @@ func TestAccResourcePassword_Keepers_Keep_NullMap(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_password" "test" {
 					length = 12
 				}`,
@@ -2235,7 +4705,7 @@ func TestAccResourcePassword_Keepers_Keep_NullMapToNullValue(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_password" "test" {
 					length = 12
 				}`,
@@ -2245,7 +4715,7 @@ func TestAccResourcePassword_Keepers_Keep_NullMapToNullValue(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_password" "test" {
 					length = 12
 					keepers = {
@@ -2268,7 +4738,7 @@ func TestAccResourcePassword_Keepers_Keep_NullValue(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_password" "test" {
 					length = 12
 					keepers = {
@@ -2281,7 +4751,7 @@ func TestAccResourcePassword_Keepers_Keep_NullValue(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_password" "test" {
 					length = 12
 					keepers = {
@@ -2304,7 +4774,7 @@ func TestAccResourcePassword_Keepers_Keep_NullValues(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_password" "test" {
 					length = 12
 					keepers = {
@@ -2318,7 +4788,7 @@ func TestAccResourcePassword_Keepers_Keep_NullValues(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_password" "test" {
 					length = 12
 					keepers = {
@@ -2342,7 +4812,7 @@ func TestAccResourcePassword_Keepers_Keep_Value(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_password" "test" {
 					length = 12
 					keepers = {
@@ -2355,7 +4825,7 @@ func TestAccResourcePassword_Keepers_Keep_Value(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_password" "test" {
 					length = 12
 					keepers = {
@@ -2378,7 +4848,7 @@ func TestAccResourcePassword_Keepers_Keep_Values(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_password" "test" {
 					length = 12
 					keepers = {
@@ -2392,7 +4862,7 @@ func TestAccResourcePassword_Keepers_Keep_Values(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_password" "test" {
 					length = 12
 					keepers = {
@@ -2416,7 +4886,7 @@ func TestAccResourcePassword_Keepers_Replace_EmptyMapToValue(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_password" "test" {
 					length = 12
 					keepers = {}
@@ -2427,7 +4897,7 @@ func TestAccResourcePassword_Keepers_Replace_EmptyMapToValue(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_password" "test" {
 					length = 12
 					keepers = {
@@ -2450,7 +4920,7 @@ func TestAccResourcePassword_Keepers_Replace_NullMapToValue(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_password" "test" {
 					length = 12
 				}`,
@@ -2460,7 +4930,7 @@ func TestAccResourcePassword_Keepers_Replace_NullMapToValue(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_password" "test" {
 					length = 12
 					keepers = {
@@ -2483,7 +4953,7 @@ func TestAccResourcePassword_Keepers_Replace_NullValueToValue(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_password" "test" {
 					length = 12
 					keepers = {
@@ -2496,7 +4966,7 @@ func TestAccResourcePassword_Keepers_Replace_NullValueToValue(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_password" "test" {
 					length = 12
 					keepers = {
@@ -2519,7 +4989,7 @@ func TestAccResourcePassword_Keepers_Replace_ValueToEmptyMap(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_password" "test" {
 					length = 12
 					keepers = {
@@ -2532,7 +5002,7 @@ func TestAccResourcePassword_Keepers_Replace_ValueToEmptyMap(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_password" "test" {
 					length = 12
 					keepers = {}
@@ -2553,7 +5023,7 @@ func TestAccResourcePassword_Keepers_Replace_ValueToNullMap(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_password" "test" {
 					length = 12
 					keepers = {
@@ -2566,7 +5036,7 @@ func TestAccResourcePassword_Keepers_Replace_ValueToNullMap(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_password" "test" {
 					length = 12
 				}`,
@@ -2586,7 +5056,7 @@ func TestAccResourcePassword_Keepers_Replace_ValueToNullValue(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_password" "test" {
 					length = 12
 					keepers = {
@@ -2599,7 +5069,7 @@ func TestAccResourcePassword_Keepers_Replace_ValueToNullValue(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_password" "test" {
 					length = 12
 					keepers = {
@@ -2622,7 +5092,7 @@ func TestAccResourcePassword_Keepers_Replace_ValueToNewValue(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_password" "test" {
 					length = 12
 					keepers = {
@@ -2635,7 +5105,7 @@ func TestAccResourcePassword_Keepers_Replace_ValueToNewValue(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_password" "test" {
 					length = 12
 					keepers = {
@@ -2671,7 +5141,7 @@ func TestAccResourcePassword_Keepers_FrameworkMigration_NullMapToNullValue(t *te
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_password" "test" {
 					length = 12
 					keepers = {
@@ -2707,7 +5177,7 @@ func TestAccResourcePassword_Keepers_FrameworkMigration_NullMapToValue(t *testin
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_password" "test" {
 					length = 12
 					keepers = {
@@ -2744,7 +5214,7 @@ func TestAccResourcePassword_Keepers_FrameworkMigration_NullMapToMultipleNullVal
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_password" "test" {
 					length = 12
 					keepers = {
@@ -2782,7 +5252,7 @@ func TestAccResourcePassword_Keepers_FrameworkMigration_NullMapToMultipleValue(t
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_password" "test" {
 					length = 12
 					keepers = {
@@ -2820,7 +5290,7 @@ func TestAccResourcePassword_Keepers_FrameworkMigration_NullMapValue(t *testing.
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_password" "test" {
 					length = 12
 					keepers = {
@@ -2858,7 +5328,7 @@ func TestAccResourcePassword_Keepers_FrameworkMigration_NullMapValueToValue(t *t
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_password" "test" {
 					length = 12
 					keepers = {
@@ -2879,7 +5349,7 @@ func TestAccResourcePassword_NumericFalse(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_password" "test" {
 					length = 12
 					special = false
@@ -2897,7 +5367,7 @@ func TestAccResourcePassword_NumberFalse(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_password" "test" {
 					length = 12
 					special = false
@@ -2915,7 +5385,7 @@ func TestAccResourcePassword_NumericNumberFalse(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_password" "test" {
 					length = 12
 					special = false
@@ -2995,3 +5465,185 @@ func testCheckResourceAttrInstanceState(attributeName string) resource.ImportSta
 		return nil
 	}
 }
+
+func TestAccResourcePassword_ProviderPublisher(t *testing.T) {
+	var published []publish.Secret
+
+	publisher := publish.PublisherFunc(func(_ context.Context, secret publish.Secret) error {
+		published = append(published, secret)
+		return nil
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"random": providerserver.NewProtocol6WithError(NewWithPublisher("test", publisher)()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_password" "test" {
+							length        = 12
+							count_results = 2
+						}`,
+				Check: resource.TestCheckResourceAttrWith("random_password.test", "result", func(value string) error {
+					if len(published) != 1 {
+						return fmt.Errorf("expected 1 published secret, got: %d", len(published))
+					}
+
+					secret := published[0]
+
+					if secret.ResourceType != "random_password" || secret.Result != value || len(secret.Results) != 2 {
+						return errors.New("expected the published secret to contain the results")
+					}
+
+					return nil
+				}),
+			},
+		},
+	})
+}
+
+func TestAccResourcePassword_ProviderPublisher_Failure(t *testing.T) {
+	publisher := publish.PublisherFunc(func(context.Context, publish.Secret) error {
+		return errors.New("store unavailable")
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"random": providerserver.NewProtocol6WithError(NewWithPublisher("test", publisher)()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_password" "test" {
+							length = 12
+						}`,
+				ExpectError: regexp.MustCompile(`(?s)While attempting to publish the generated password.*store unavailable`),
+			},
+		},
+	})
+}
+
+// TestPasswordResource_MoveStringState moves a random_string through the
+// provider server, as random_password has no resource identity to set.
+func TestPasswordResource_MoveStringState(t *testing.T) {
+	t.Parallel()
+
+	server, err := providerserver.NewProtocol6WithError(New("test")())()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	resp, err := server.MoveResourceState(context.Background(), &tfprotov6.MoveResourceStateRequest{
+		SourceProviderAddress: "registry.terraform.io/hashicorp/random",
+		SourceTypeName:        "random_string",
+		SourceSchemaVersion:   3,
+		SourceState: &tfprotov6.RawState{
+			JSON: []byte(`{"id": "abcdefgh", "result": "abcdefgh", "length": 8, "special": false, "upper": true, ` +
+				`"lower": true, "number": true, "numeric": true, "min_special": 0, "min_upper": 0, "min_lower": 0, ` +
+				`"min_numeric": 0}`),
+		},
+		TargetTypeName: "random_password",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for _, diag := range resp.Diagnostics {
+		t.Errorf("unexpected diagnostic: %s: %s", diag.Summary, diag.Detail)
+	}
+
+	if resp.TargetIdentity != nil {
+		t.Errorf("expected no target identity, got: %v", resp.TargetIdentity)
+	}
+
+	if resp.TargetState == nil {
+		t.Fatal("expected target state")
+	}
+}
+
+func TestAccResourcePassword_MoveFromString(t *testing.T) {
+	assertResultSame := statecheck.CompareValue(compare.ValuesSame())
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_string" "test" {
+							length  = 16
+							special = false
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					assertResultSame.AddStateValue("random_string.test", tfjsonpath.New("result")),
+				},
+			},
+			{
+				Config: `moved {
+							from = random_string.test
+							to   = random_password.test
+						}
+
+						resource "random_password" "test" {
+							length  = 16
+							special = false
+						}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("random_password.test", plancheck.ResourceActionNoop),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					assertResultSame.AddStateValue("random_password.test", tfjsonpath.New("result")),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourcePassword_LifecycleMetadata(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_password" "test" {
+							length = 12
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_password.test", tfjsonpath.New("created_at"), knownvalue.StringRegexp(regexp.MustCompile(`^\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}Z$`))),
+					statecheck.ExpectKnownValue("random_password.test", tfjsonpath.New("provider_version"), knownvalue.StringExact("test")),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourcePassword_LifecycleMetadata_UpgradeFromVersion3_6_3(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				ExternalProviders: providerVersion363(),
+				Config: `resource "random_password" "test" {
+							length = 12
+						}`,
+			},
+			{
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
+				Config: `resource "random_password" "test" {
+							length = 12
+						}`,
+				PlanOnly: true,
+			},
+			{
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
+				Config: `resource "random_password" "test" {
+							length = 12
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_password.test", tfjsonpath.New("created_at"), knownvalue.Null()),
+					statecheck.ExpectKnownValue("random_password.test", tfjsonpath.New("provider_version"), knownvalue.Null()),
+				},
+			},
+		},
+	})
+}
@@ -5,86 +5,75 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"math"
 	"strings"
 
 	petname "github.com/dustinkirkland/golang-petname"
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
+	"github.com/terraform-providers/terraform-provider-random/internal/diagnostics"
+	listplanmodifiers "github.com/terraform-providers/terraform-provider-random/internal/planmodifiers/list"
 	mapplanmodifiers "github.com/terraform-providers/terraform-provider-random/internal/planmodifiers/map"
+	"github.com/terraform-providers/terraform-provider-random/internal/random"
 )
 
-var _ resource.Resource = (*petResource)(nil)
+var (
+	_ resource.Resource                   = (*petResource)(nil)
+	_ resource.ResourceWithConfigure      = (*petResource)(nil)
+	_ resource.ResourceWithModifyPlan     = (*petResource)(nil)
+	_ resource.ResourceWithIdentity       = (*petResource)(nil)
+	_ resource.ResourceWithUpgradeState   = (*petResource)(nil)
+	_ resource.ResourceWithValidateConfig = (*petResource)(nil)
+)
 
 func NewPetResource() resource.Resource {
 	return &petResource{}
 }
 
-type petResource struct{}
+type petResource struct {
+	providerVersion string
+	entropy         *random.Source
+	petNames        *petNamePool
+}
 
 func (r *petResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_pet"
 }
 
 func (r *petResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		Description: "The resource `random_pet` generates random pet names that are intended to be used as " +
-			"unique identifiers for other resources.\n" +
-			"\n" +
-			"This resource can be used in conjunction with resources that have the `create_before_destroy` " +
-			"lifecycle flag set, to avoid conflicts with unique names during the brief period where both the old " +
-			"and new resources exist concurrently.",
-		Attributes: map[string]schema.Attribute{
-			"keepers": schema.MapAttribute{
-				Description: "Arbitrary map of values that, when changed, will trigger recreation of " +
-					"resource. See [the main provider documentation](../index.html) for more information.",
-				ElementType: types.StringType,
-				Optional:    true,
-				PlanModifiers: []planmodifier.Map{
-					mapplanmodifiers.RequiresReplaceIfValuesNotNull(),
-				},
-			},
-			"length": schema.Int64Attribute{
-				Description: "The length (in words) of the pet name. Defaults to 2",
-				Optional:    true,
-				Computed:    true,
-				Default:     int64default.StaticInt64(2),
-				PlanModifiers: []planmodifier.Int64{
-					int64planmodifier.RequiresReplace(),
-				},
-			},
-			"prefix": schema.StringAttribute{
-				Description: "A string to prefix the name with.",
-				Optional:    true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
-			},
-			"separator": schema.StringAttribute{
-				Description: "The character to separate words in the pet name. Defaults to \"-\"",
-				Optional:    true,
-				Computed:    true,
-				Default:     stringdefault.StaticString("-"),
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
-			},
-			"id": schema.StringAttribute{
-				Description: "The random pet name.",
-				Computed:    true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.UseStateForUnknown(),
-				},
-			},
-		},
-	}
+	resp.Schema = petSchemaV1()
+}
+
+func (r *petResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identitySchema("The random pet name.")
+}
+
+func (r *petResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	r.providerVersion = providerVersion(req.ProviderData)
+	r.entropy = providerEntropy(req.ProviderData)
+	r.petNames = providerPetNamePool(req.ProviderData)
+}
+
+// ModifyPlan prevents the replacement of the resource when lifecycle_guard is
+// enabled, unless allow_regeneration_token is changed.
+func (r *petResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	guardRegeneration(ctx, req, resp)
 }
 
 func (r *petResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -93,7 +82,7 @@ func (r *petResource) Create(ctx context.Context, req resource.CreateRequest, re
 	// so this call takes care of that.
 	petname.NonDeterministicMode()
 
-	var plan petModelV0
+	var plan petModelV1
 
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -101,41 +90,334 @@ func (r *petResource) Create(ctx context.Context, req resource.CreateRequest, re
 		return
 	}
 
+	if !plan.Template.IsNull() {
+		r.createFromTemplate(ctx, plan, resp)
+		return
+	}
+
 	length := plan.Length.ValueInt64()
-	separator := plan.Separator.ValueString()
-	prefix := plan.Prefix.ValueString()
+	separator := expandPetKeepers(plan.Separator.ValueString(), plan.Keepers)
+	prefix := expandPetKeepers(plan.Prefix.ValueString(), plan.Keepers)
+	language := plan.Language.ValueString()
 
-	pet := strings.ToLower(petname.Generate(int(length), separator))
+	wordCount, suffixDigits := int(length), 0
 
-	pn := petModelV0{
-		Keepers:   plan.Keepers,
-		Length:    types.Int64Value(length),
-		Separator: types.StringValue(separator),
+	if !plan.MinEntropyBits.IsNull() {
+		wordCount, suffixDigits = petLengthForEntropy(language, int(length), float64(plan.MinEntropyBits.ValueInt64()), plan.NumericSuffix.ValueBool())
+	}
+
+	if !plan.Digits.IsNull() {
+		suffixDigits = max(suffixDigits, int(plan.Digits.ValueInt64()))
+	}
+
+	if !plan.ExpectedCardinality.IsNull() {
+		suffixDigits = max(suffixDigits, petDigitsForCardinality(language, wordCount, plan.ExpectedCardinality.ValueInt64()))
+	}
+
+	var pet string
+	var words []string
+
+	for attempt := 1; ; attempt++ {
+		var err error
+
+		pet, words, err = r.createName(ctx, plan, separator, wordCount, suffixDigits)
+		if errors.Is(err, random.ErrEntropyUnavailable) {
+			resp.Diagnostics.Append(diagnostics.EntropyUnavailableError(err)...)
+			return
+		}
+		if err != nil {
+			resp.Diagnostics.Append(diagnostics.RandomReadError(err.Error())...)
+			return
+		}
+
+		name := pet
+
+		if prefix != "" {
+			name = prefix + separator + pet
+		}
+
+		if plan.UniqueWithin.IsNull() || r.petNames.reserve(plan.UniqueWithin.ValueString(), name) {
+			break
+		}
+
+		if attempt == petUniqueAttempts {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("unique_within"),
+				"Pet Name Pool Exhausted",
+				fmt.Sprintf("A pet name which has not already been generated in the %q namespace could not be "+
+					"found after %d attempts. Increase length, digits or expected_cardinality to increase the "+
+					"number of possible pet names.", plan.UniqueWithin.ValueString(), petUniqueAttempts),
+			)
+			return
+		}
+	}
+
+	wordValues := make([]attr.Value, len(words))
+	for i, word := range words {
+		wordValues[i] = types.StringValue(word)
+	}
+
+	pn := petModelV1{
+		Keepers:                plan.Keepers,
+		KeepersHash:            plan.KeepersHash,
+		Length:                 types.Int64Value(length),
+		Separator:              plan.Separator,
+		MinEntropyBits:         plan.MinEntropyBits,
+		NumericSuffix:          plan.NumericSuffix,
+		Digits:                 plan.Digits,
+		ExpectedCardinality:    plan.ExpectedCardinality,
+		UniqueWithin:           plan.UniqueWithin,
+		Deterministic:          plan.Deterministic,
+		Namespace:              plan.Namespace,
+		Template:               types.StringNull(),
+		Language:               plan.Language,
+		Words:                  types.ListValueMust(types.StringType, wordValues),
+		EntropyBits:            types.Float64Value(petLanguageEntropyBits(language, wordCount, suffixDigits)),
+		LifecycleGuard:         plan.LifecycleGuard,
+		AllowRegenerationToken: plan.AllowRegenerationToken,
+	}
+
+	if plan.Deterministic.ValueBool() {
+		pn.EntropyBits = types.Float64Value(0)
 	}
 
 	if prefix != "" {
 		pet = fmt.Sprintf("%s%s%s", prefix, separator, pet)
-		pn.Prefix = types.StringValue(prefix)
+	}
+
+	// The prefix is recorded as configured, with any placeholders, rather
+	// than as it was expanded.
+	if plan.Prefix.ValueString() != "" {
+		pn.Prefix = plan.Prefix
 	} else {
 		pn.Prefix = types.StringNull()
 	}
 
 	pn.ID = types.StringValue(pet)
+	pn.CreatedAt, pn.ProviderVersion = lifecycleValues(r.providerVersion)
 
 	diags = resp.State.Set(ctx, pn)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, pn.ID)...)
+}
+
+// createName returns a pet name of the given number of words joined by the
+// separator, excluding the prefix, followed by the given number of random
+// decimal digits, and the words of the name.
+func (r *petResource) createName(ctx context.Context, plan petModelV1, separator string, wordCount int, suffixDigits int) (string, []string, error) {
+	words, entropy, err := r.createWords(ctx, plan, wordCount)
+	if err != nil {
+		return "", nil, err
+	}
+
+	pet := strings.Join(words, separator)
+
+	if suffixDigits > 0 {
+		suffix, err := entropy.CreateString(random.StringParams{
+			Length:  int64(suffixDigits),
+			Numeric: true,
+		})
+		if err != nil {
+			return "", nil, err
+		}
+
+		pet = pet + separator + string(suffix)
+	}
+
+	return pet, words, nil
+}
+
+// createWords returns the words of a pet name of the given length in the
+// language of the plan, and the source of entropy from which any numeric
+// suffix is to be read. When deterministic is true, both are derived from the
+// keepers rather than chosen at random.
+func (r *petResource) createWords(ctx context.Context, plan petModelV1, length int) ([]string, *random.Source, error) {
+	language := plan.Language.ValueString()
+
+	if plan.Deterministic.ValueBool() {
+		done := logGeneration(ctx, randomSourceDerived, map[string]any{
+			"algorithm": "hkdf-sha256",
+			"language":  language,
+			"length":    length,
+		})
+		defer done()
+
+		entropy, err := petDeterministicSource(plan.Keepers, plan.Namespace)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		words, err := petDeterministicWords(entropy, petLanguageWordLists(language), length)
+
+		return words, entropy, err
+	}
+
+	if !isPetLanguageEnglish(language) {
+		done := logGeneration(ctx, randomSourceCrypto, map[string]any{
+			"language": language,
+			"length":   length,
+		})
+		defer done()
+
+		words, err := petDeterministicWords(r.entropy, petLanguageWordLists(language), length)
+
+		return words, r.entropy, err
+	}
+
+	done := logGeneration(ctx, randomSourceMath, map[string]any{
+		"length": length,
+	})
+	defer done()
+
+	return petWords(length), r.entropy, nil
+}
+
+// createFromTemplate creates a pet name by expanding the template of the plan.
+func (r *petResource) createFromTemplate(ctx context.Context, plan petModelV1, resp *resource.CreateResponse) {
+	// The configuration may have contained unknown values during validation.
+	segments, err := parsePetTemplate(plan.Template.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("template"),
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute template value is invalid: %s", err),
+		)
+		return
+	}
+
+	done := logGeneration(ctx, randomSourceMath, map[string]any{
+		"template": plan.Template.ValueString(),
+	})
+
+	pet, words, err := expandPetTemplate(r.entropy, segments)
+	done()
+
+	if errors.Is(err, random.ErrEntropyUnavailable) {
+		resp.Diagnostics.Append(diagnostics.EntropyUnavailableError(err)...)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.Append(diagnostics.RandomReadError(err.Error())...)
+		return
+	}
+
+	wordValues := make([]attr.Value, len(words))
+	for i, word := range words {
+		wordValues[i] = types.StringValue(word)
+	}
+
+	plan.ID = types.StringValue(pet)
+	plan.Words = types.ListValueMust(types.StringType, wordValues)
+	plan.EntropyBits = types.Float64Value(petTemplateEntropyBits(segments))
+	plan.CreatedAt, plan.ProviderVersion = lifecycleValues(r.providerVersion)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, plan.ID)...)
+}
+
+// petWords returns the lowercased words of a random pet name of the given
+// length, matching the composition used by petname.Generate.
+func petWords(length int) []string {
+	var words []string
+
+	if length == 1 {
+		words = append(words, petname.Name())
+	} else {
+		for i := 0; i < length-2; i++ {
+			words = append(words, petname.Adverb())
+		}
+
+		words = append(words, petname.Adjective(), petname.Name())
+	}
+
+	for i, word := range words {
+		words[i] = strings.ToLower(word)
+	}
+
+	return words
+}
+
+// The number of words in each of the petname word lists, which are not
+// exported by the library. These must be updated with the library.
+const (
+	petAdverbs    = 261
+	petAdjectives = 449
+	petNames      = 452
+)
+
+// petEntropyBits returns the entropy in bits of a pet name of the given number
+// of words, as generated by petWords, followed by the given number of random
+// decimal digits.
+func petEntropyBits(words int, digits int) float64 {
+	bits := math.Log2(petNames) + float64(digits)*math.Log2(10)
+
+	if words != 1 {
+		bits += math.Log2(petAdjectives) + float64(max(words-2, 0))*math.Log2(petAdverbs)
+	}
+
+	return bits
 }
 
-// Read does not need to perform any operations as the state in ReadResourceResponse is already populated.
+// petLengthForEntropy returns the number of words, and the number of digits of
+// a numeric suffix, of the shortest pet name of the language of at least the
+// given length with at least the given entropy. Words are added to the name, unless
+// numericSuffix is true, in which case digits are appended instead.
+func petLengthForEntropy(language string, length int, minEntropyBits float64, numericSuffix bool) (int, int) {
+	words, digits := length, 0
+
+	for petLanguageEntropyBits(language, words, digits) < minEntropyBits {
+		if numericSuffix {
+			digits++
+		} else {
+			words++
+		}
+	}
+
+	return words, digits
+}
+
+// Read does not need to refresh the state as the state in ReadResourceResponse is already populated, other than to
+// populate entropy_bits and keepers_hash for resources created by earlier provider versions. The identity is set from
+// state, as those resources also do not have an identity.
 func (r *petResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var model petModelV1
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if model.EntropyBits.IsNull() {
+		logRefresh(ctx, "entropy_bits")
+
+		model.EntropyBits = model.entropyBits()
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(refreshKeepersHash(ctx, &resp.State)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
-// Update ensures the plan value is copied to the state to complete the update.
+// Update ensures the plan value is copied to the state to complete the update. The entropy_bits value is unknown in
+// the plan if the state was not refreshed since upgrading from an earlier provider version.
 func (r *petResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var model petModelV0
+	var model petModelV1
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
 
@@ -143,6 +425,10 @@ func (r *petResource) Update(ctx context.Context, req resource.UpdateRequest, re
 		return
 	}
 
+	if model.EntropyBits.IsUnknown() {
+		model.EntropyBits = model.entropyBits()
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
 }
 
@@ -151,10 +437,375 @@ func (r *petResource) Update(ctx context.Context, req resource.UpdateRequest, re
 func (r *petResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 }
 
-type petModelV0 struct {
-	ID        types.String `tfsdk:"id"`
-	Keepers   types.Map    `tfsdk:"keepers"`
-	Length    types.Int64  `tfsdk:"length"`
-	Prefix    types.String `tfsdk:"prefix"`
-	Separator types.String `tfsdk:"separator"`
+// ValidateConfig ensures that the template, if set, is valid, and that a
+// namespace is only set when deterministic is true, and warns of placeholders
+// in the prefix and separator which do not name a keeper.
+func (r *petResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var (
+		template      types.String
+		namespace     types.String
+		deterministic types.Bool
+	)
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("template"), &template)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("namespace"), &namespace)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("deterministic"), &deterministic)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The namespace would otherwise be silently ignored.
+	if !namespace.IsNull() && !deterministic.IsUnknown() && !deterministic.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("namespace"),
+			"Invalid Attribute Combination",
+			"Attribute namespace requires deterministic to be true, as only deterministic pet names are derived "+
+				"from the namespace.",
+		)
+	}
+
+	resp.Diagnostics.Append(validatePetKeeperPlaceholders(ctx, req.Config)...)
+
+	if template.IsNull() || template.IsUnknown() {
+		return
+	}
+
+	if _, err := parsePetTemplate(template.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("template"),
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute template value is invalid: %s", err),
+		)
+	}
+}
+
+func (r *petResource) UpgradeState(context.Context) map[int64]resource.StateUpgrader {
+	schemaV0 := petSchemaV0()
+
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema:   &schemaV0,
+			StateUpgrader: upgradePetStateV0toV1,
+		},
+	}
+}
+
+func upgradePetStateV0toV1(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	logStateUpgrade(ctx, 0, 1)
+
+	type modelV0 struct {
+		ID        types.String `tfsdk:"id"`
+		Keepers   types.Map    `tfsdk:"keepers"`
+		Length    types.Int64  `tfsdk:"length"`
+		Prefix    types.String `tfsdk:"prefix"`
+		Separator types.String `tfsdk:"separator"`
+	}
+
+	var petDataV0 modelV0
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &petDataV0)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The creation time and provider version of resources created prior to
+	// schema version 1 are not known, so they are left as null.
+	petDataV1 := petModelV1{
+		ID:                     petDataV0.ID,
+		Keepers:                petDataV0.Keepers,
+		Length:                 petDataV0.Length,
+		Prefix:                 petDataV0.Prefix,
+		Separator:              petDataV0.Separator,
+		MinEntropyBits:         types.Int64Null(),
+		NumericSuffix:          types.BoolNull(),
+		Digits:                 types.Int64Null(),
+		ExpectedCardinality:    types.Int64Null(),
+		UniqueWithin:           types.StringNull(),
+		Deterministic:          types.BoolNull(),
+		Namespace:              types.StringNull(),
+		Template:               types.StringNull(),
+		Language:               types.StringNull(),
+		Words:                  types.ListNull(types.StringType),
+		EntropyBits:            types.Float64Null(),
+		KeepersHash:            types.StringNull(),
+		CreatedAt:              types.StringNull(),
+		ProviderVersion:        types.StringNull(),
+		LifecycleGuard:         types.BoolNull(),
+		AllowRegenerationToken: types.StringNull(),
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, petDataV1)...)
+}
+
+func petSchemaV1() schema.Schema {
+	return schema.Schema{
+		Version: 1,
+		Description: "The resource `random_pet` generates random pet names that are intended to be used as " +
+			"unique identifiers for other resources.\n" +
+			"\n" +
+			"This resource can be used in conjunction with resources that have the `create_before_destroy` " +
+			"lifecycle flag set, to avoid conflicts with unique names during the brief period where both the old " +
+			"and new resources exist concurrently.",
+		Attributes: map[string]schema.Attribute{
+			"keepers": schema.MapAttribute{
+				Description: "Arbitrary map of values that, when changed, will trigger recreation of " +
+					"resource. See [the main provider documentation](../index.html) for more information.",
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifiers.RequiresReplaceIfValuesNotNull(),
+				},
+			},
+			"length": schema.Int64Attribute{
+				Description: "The length (in words) of the pet name. Defaults to 2",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(petDefaultLength),
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"prefix": schema.StringAttribute{
+				Description: "A string to prefix the name with. Placeholders naming a key of `keepers`, such as " +
+					"`{env}`, are replaced by the value of that keeper when the name is generated, so that the " +
+					"name follows the values which trigger its regeneration. Placeholders which do not name a " +
+					"keeper are left unchanged, and return a warning.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"separator": schema.StringAttribute{
+				Description: "The character to separate words in the pet name. Placeholders naming a key of " +
+					"`keepers` are replaced in the same way as in `prefix`. Defaults to \"-\"",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(petDefaultSeparator),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"min_entropy_bits": schema.Int64Attribute{
+				Description: "The minimum entropy, in bits, of the pet name, to reduce the probability of " +
+					"duplicate names in very large fleets. When set, words are added to the pet name beyond " +
+					"`length`, or digits are appended if `numeric_suffix` is `true`, until the entropy of the name " +
+					"is at least this value. As a rule of thumb, duplicates become likely once the number of names " +
+					"approaches 2^(`min_entropy_bits` / 2), such as around a million names for a value of `40`.",
+				Optional: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"numeric_suffix": schema.BoolAttribute{
+				Description: "Append random decimal digits to the pet name, separated by `separator`, rather than " +
+					"adding words, to meet `min_entropy_bits`. Requires `min_entropy_bits`. Default value is " +
+					"`false`.",
+				Optional: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Bool{
+					boolvalidator.AlsoRequires(path.MatchRoot("min_entropy_bits")),
+				},
+			},
+			"digits": schema.Int64Attribute{
+				Description: "The number of random decimal digits to append to the pet name, separated by " +
+					"`separator`, such as to replace a `random_integer` suffix in naming modules. When " +
+					"`min_entropy_bits` or `expected_cardinality` require more digits, the larger number of " +
+					"digits is appended. Conflicts with `template`.",
+				Optional: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+					int64validator.ConflictsWith(path.MatchRoot("template")),
+				},
+			},
+			"expected_cardinality": schema.Int64Attribute{
+				Description: "The number of pet names expected to share the same `length` and `separator`, such " +
+					"as the number of names in a fleet. When set, random decimal digits are appended to the pet " +
+					"name, separated by `separator`, until the probability of any two of the names being the " +
+					"same is at most 1%. Conflicts with `template`.",
+				Optional: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+					int64validator.ConflictsWith(path.MatchRoot("template")),
+				},
+			},
+			"unique_within": schema.StringAttribute{
+				Description: "A namespace within which pet names are coordinated, such as the name of a naming " +
+					"module. Pet names generated with the same `unique_within` during the same Terraform " +
+					"operation are never the same, as a name which has already been generated in the namespace " +
+					"is generated again. Pet names generated in earlier operations are not known, so use " +
+					"`digits` or `expected_cardinality` to make collisions with them unlikely. Conflicts with " +
+					"`deterministic` and `template`.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.ConflictsWith(
+						path.MatchRoot("deterministic"),
+						path.MatchRoot("template"),
+					),
+				},
+			},
+			"deterministic": schema.BoolAttribute{
+				Description: "Derive the pet name, including any numeric suffix, from the contents of `keepers`, " +
+					"and `namespace` if set, using HKDF-SHA256, rather than choosing it at random, so that the same keepers produce the " +
+					"same name in every workspace. Changing `keepers` replaces the resource with a new name as " +
+					"usual. Keys with null values are ignored. The name is only as difficult to guess as the " +
+					"keepers, and `entropy_bits` is `0`. Requires `keepers` and conflicts with `template`. " +
+					"Default value is `false`.",
+				Optional: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Bool{
+					boolvalidator.AlsoRequires(path.MatchRoot("keepers")),
+					boolvalidator.ConflictsWith(path.MatchRoot("template")),
+				},
+			},
+			"namespace": schema.StringAttribute{
+				Description: "A namespace mixed into the derivation of the pet name when `deterministic` is " +
+					"`true`, such as the name of an organization or environment. Workspaces which use the same " +
+					"namespace and keepers share the same pet names, while those with different namespaces " +
+					"produce different pet names from the same keepers. Pet names derived without a namespace " +
+					"are unchanged. Requires `deterministic` to be `true`.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"template": schema.StringAttribute{
+				Description: "A template from which to compose the pet name, as an alternative to `length`, " +
+					"`prefix` and `separator`. Placeholders in braces are replaced with a random word or number, " +
+					"and any other text is kept as is. The placeholders are `{adverb}`, `{adjective}`, " +
+					"`{animal}`, and `{number:N}` for `N` random decimal digits, up to 18, and may be repeated " +
+					"in any order. For example, `\"{adjective}-{animal}-{number:3}\"` generates names such as " +
+					"`cute-cat-042`. Conflicts with `length`, `prefix`, `separator` and `min_entropy_bits`.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(
+						path.MatchRoot("length"),
+						path.MatchRoot("prefix"),
+						path.MatchRoot("separator"),
+						path.MatchRoot("min_entropy_bits"),
+					),
+				},
+			},
+			"language": languageAttribute(),
+			"words": schema.ListAttribute{
+				Description: "The words of the pet name, excluding the prefix and any numeric suffix. For " +
+					"example, the pet name `cute-cat` has the words `[\"cute\", \"cat\"]`. This value is `null` for " +
+					"resources which were created by a provider version which did not record this information.",
+				ElementType: types.StringType,
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifiers.UseStateForUnknownIncludingNull(),
+				},
+			},
+			"entropy_bits": entropyBitsAttribute("The entropy of the pet name in bits, calculated from the " +
+				"number of words which may be chosen for each word of the name, and any numeric suffix. The " +
+				"prefix is not random, so is excluded. This value is `0` when `deterministic` is `true`."),
+			"keepers_hash":             keepersHashAttribute(),
+			"created_at":               createdAtAttribute(),
+			"provider_version":         providerVersionAttribute(),
+			"lifecycle_guard":          lifecycleGuardAttribute(),
+			"allow_regeneration_token": allowRegenerationTokenAttribute(),
+			"id": schema.StringAttribute{
+				Description: "The random pet name.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func petSchemaV0() schema.Schema {
+	return schema.Schema{
+		Description: "The resource `random_pet` generates random pet names that are intended to be used as " +
+			"unique identifiers for other resources.\n" +
+			"\n" +
+			"This resource can be used in conjunction with resources that have the `create_before_destroy` " +
+			"lifecycle flag set, to avoid conflicts with unique names during the brief period where both the old " +
+			"and new resources exist concurrently.",
+		Attributes: map[string]schema.Attribute{
+			"keepers": schema.MapAttribute{
+				Description: "Arbitrary map of values that, when changed, will trigger recreation of " +
+					"resource. See [the main provider documentation](../index.html) for more information.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"length": schema.Int64Attribute{
+				Description: "The length (in words) of the pet name. Defaults to 2",
+				Optional:    true,
+				Computed:    true,
+			},
+			"prefix": schema.StringAttribute{
+				Description: "A string to prefix the name with.",
+				Optional:    true,
+			},
+			"separator": schema.StringAttribute{
+				Description: "The character to separate words in the pet name. Defaults to \"-\"",
+				Optional:    true,
+				Computed:    true,
+			},
+			"id": schema.StringAttribute{
+				Description: "The random pet name.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+type petModelV1 struct {
+	ID                     types.String  `tfsdk:"id"`
+	Keepers                types.Map     `tfsdk:"keepers"`
+	KeepersHash            types.String  `tfsdk:"keepers_hash"`
+	Length                 types.Int64   `tfsdk:"length"`
+	Prefix                 types.String  `tfsdk:"prefix"`
+	Separator              types.String  `tfsdk:"separator"`
+	MinEntropyBits         types.Int64   `tfsdk:"min_entropy_bits"`
+	NumericSuffix          types.Bool    `tfsdk:"numeric_suffix"`
+	Digits                 types.Int64   `tfsdk:"digits"`
+	ExpectedCardinality    types.Int64   `tfsdk:"expected_cardinality"`
+	UniqueWithin           types.String  `tfsdk:"unique_within"`
+	Deterministic          types.Bool    `tfsdk:"deterministic"`
+	Namespace              types.String  `tfsdk:"namespace"`
+	Template               types.String  `tfsdk:"template"`
+	Language               types.String  `tfsdk:"language"`
+	Words                  types.List    `tfsdk:"words"`
+	EntropyBits            types.Float64 `tfsdk:"entropy_bits"`
+	CreatedAt              types.String  `tfsdk:"created_at"`
+	ProviderVersion        types.String  `tfsdk:"provider_version"`
+	LifecycleGuard         types.Bool    `tfsdk:"lifecycle_guard"`
+	AllowRegenerationToken types.String  `tfsdk:"allow_regeneration_token"`
+}
+
+// entropyBits returns the entropy of pet names created without a numeric
+// suffix, which are the only pet names which do not have the value recorded
+// in state.
+func (m petModelV1) entropyBits() types.Float64 {
+	if m.Length.IsNull() || m.Length.IsUnknown() {
+		return types.Float64Null()
+	}
+
+	return types.Float64Value(petEntropyBits(int(m.Length.ValueInt64()), 0))
 }
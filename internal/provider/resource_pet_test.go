@@ -4,19 +4,23 @@
 package provider
 
 import (
+	"math"
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/compare"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 func TestAccResourcePet(t *testing.T) {
 	resource.UnitTest(t, resource.TestCase{
-		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: `resource "random_pet" "pet_1" {
@@ -36,7 +40,7 @@ func TestAccResourcePet_Keepers_Keep_EmptyMap(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_pet" "test" {
 					keepers = {}
 				}`,
@@ -46,7 +50,7 @@ func TestAccResourcePet_Keepers_Keep_EmptyMap(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_pet" "test" {
 					keepers = {}
 				}`,
@@ -66,7 +70,7 @@ func TestAccResourcePet_Keepers_Keep_EmptyMapToNullValue(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_pet" "test" {
 					keepers = {}
 				}`,
@@ -76,7 +80,7 @@ func TestAccResourcePet_Keepers_Keep_EmptyMapToNullValue(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_pet" "test" {
 					keepers = {
 						"key" = null
@@ -98,7 +102,7 @@ func TestAccResourcePet_Keepers_Keep_NullMap(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_pet" "test" {
 				}`,
 				ConfigStateChecks: []statecheck.StateCheck{
@@ -107,7 +111,7 @@ func TestAccResourcePet_Keepers_Keep_NullMap(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_pet" "test" {
 				}`,
 				ConfigStateChecks: []statecheck.StateCheck{
@@ -126,7 +130,7 @@ func TestAccResourcePet_Keepers_Keep_NullMapToNullValue(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_pet" "test" {
 				}`,
 				ConfigStateChecks: []statecheck.StateCheck{
@@ -135,7 +139,7 @@ func TestAccResourcePet_Keepers_Keep_NullMapToNullValue(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_pet" "test" {
 					keepers = {
 						"key" = null
@@ -157,7 +161,7 @@ func TestAccResourcePet_Keepers_Keep_NullValue(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_pet" "test" {
 					keepers = {
 						"key" = null
@@ -169,7 +173,7 @@ func TestAccResourcePet_Keepers_Keep_NullValue(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_pet" "test" {
 					keepers = {
 						"key" = null
@@ -191,7 +195,7 @@ func TestAccResourcePet_Keepers_Keep_NullValues(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_pet" "test" {
 					keepers = {
 						"key1" = null
@@ -204,7 +208,7 @@ func TestAccResourcePet_Keepers_Keep_NullValues(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_pet" "test" {
 					keepers = {
 						"key1" = null
@@ -227,7 +231,7 @@ func TestAccResourcePet_Keepers_Keep_Value(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_pet" "test" {
 					keepers = {
 						"key" = "123"
@@ -239,7 +243,7 @@ func TestAccResourcePet_Keepers_Keep_Value(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_pet" "test" {
 					keepers = {
 						"key" = "123"
@@ -261,7 +265,7 @@ func TestAccResourcePet_Keepers_Keep_Values(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_pet" "test" {
 					keepers = {
 						"key1" = "123"
@@ -274,7 +278,7 @@ func TestAccResourcePet_Keepers_Keep_Values(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_pet" "test" {
 					keepers = {
 						"key1" = "123"
@@ -297,7 +301,7 @@ func TestAccResourcePet_Keepers_Replace_EmptyMapToValue(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_pet" "test" {
 					keepers = {}
 				}`,
@@ -307,7 +311,7 @@ func TestAccResourcePet_Keepers_Replace_EmptyMapToValue(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_pet" "test" {
 					keepers = {
 						"key" = "123"
@@ -329,7 +333,7 @@ func TestAccResourcePet_Keepers_Replace_NullMapToValue(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_pet" "test" {
 				}`,
 				ConfigStateChecks: []statecheck.StateCheck{
@@ -338,7 +342,7 @@ func TestAccResourcePet_Keepers_Replace_NullMapToValue(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_pet" "test" {
 					keepers = {
 						"key" = "123"
@@ -360,7 +364,7 @@ func TestAccResourcePet_Keepers_Replace_NullValueToValue(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_pet" "test" {
 					keepers = {
 						"key" = null
@@ -372,7 +376,7 @@ func TestAccResourcePet_Keepers_Replace_NullValueToValue(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_pet" "test" {
 					keepers = {
 						"key" = "123"
@@ -394,7 +398,7 @@ func TestAccResourcePet_Keepers_Replace_ValueToEmptyMap(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_pet" "test" {
 					keepers = {
 						"key" = "123"
@@ -406,7 +410,7 @@ func TestAccResourcePet_Keepers_Replace_ValueToEmptyMap(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_pet" "test" {
 					keepers = {}
 				}`,
@@ -426,7 +430,7 @@ func TestAccResourcePet_Keepers_Replace_ValueToNullMap(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_pet" "test" {
 					keepers = {
 						"key" = "123"
@@ -438,7 +442,7 @@ func TestAccResourcePet_Keepers_Replace_ValueToNullMap(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_pet" "test" {
 				}`,
 				ConfigStateChecks: []statecheck.StateCheck{
@@ -457,7 +461,7 @@ func TestAccResourcePet_Keepers_Replace_ValueToNullValue(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_pet" "test" {
 					keepers = {
 						"key" = "123"
@@ -469,7 +473,7 @@ func TestAccResourcePet_Keepers_Replace_ValueToNullValue(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_pet" "test" {
 					keepers = {
 						"key" = null
@@ -491,7 +495,7 @@ func TestAccResourcePet_Keepers_Replace_ValueToNewValue(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_pet" "test" {
 					keepers = {
 						"key" = "123"
@@ -503,7 +507,7 @@ func TestAccResourcePet_Keepers_Replace_ValueToNewValue(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_pet" "test" {
 					keepers = {
 						"key" = "456"
@@ -537,7 +541,7 @@ func TestAccResourcePet_Keepers_FrameworkMigration_NullMapToNullValue(t *testing
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_pet" "test" {
 					keepers = {
 						"key" = null
@@ -571,7 +575,7 @@ func TestAccResourcePet_Keepers_FrameworkMigration_NullMapToValue(t *testing.T)
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_pet" "test" {
 					keepers = {
 						"key" = "123"
@@ -606,7 +610,7 @@ func TestAccResourcePet_Keepers_FrameworkMigration_NullMapToMultipleNullValue(t
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_pet" "test" {
 					keepers = {
 						"key1" = null
@@ -642,7 +646,7 @@ func TestAccResourcePet_Keepers_FrameworkMigration_NullMapToMultipleValue(t *tes
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_pet" "test" {
 					keepers = {
 						"key1" = "123"
@@ -678,7 +682,7 @@ func TestAccResourcePet_Keepers_FrameworkMigration_NullMapValue(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_pet" "test" {
 					keepers = {
 						"key1" = "123"
@@ -714,7 +718,7 @@ func TestAccResourcePet_Keepers_FrameworkMigration_NullMapValueToValue(t *testin
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_pet" "test" {
 					keepers = {
 						"key1" = "123"
@@ -732,7 +736,7 @@ func TestAccResourcePet_Keepers_FrameworkMigration_NullMapValueToValue(t *testin
 
 func TestAccResourcePet_Length(t *testing.T) {
 	resource.UnitTest(t, resource.TestCase{
-		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: `resource "random_pet" "pet_1" {
@@ -748,7 +752,7 @@ func TestAccResourcePet_Length(t *testing.T) {
 
 func TestAccResourcePet_Prefix(t *testing.T) {
 	resource.UnitTest(t, resource.TestCase{
-		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: `resource "random_pet" "pet_1" {
@@ -762,9 +766,45 @@ func TestAccResourcePet_Prefix(t *testing.T) {
 	})
 }
 
+func TestAccResourcePet_Prefix_KeeperPlaceholders(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_pet" "pet_1" {
+							keepers = {
+								env = "prod"
+								sep = "_"
+							}
+							prefix    = "{env}-app"
+							separator = "{sep}"
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_pet.pet_1", tfjsonpath.New("id"), knownvalue.StringRegexp(regexp.MustCompile(`^prod-app_[a-z]+_[a-z]+$`))),
+					statecheck.ExpectKnownValue("random_pet.pet_1", tfjsonpath.New("prefix"), knownvalue.StringExact("{env}-app")),
+					statecheck.ExpectKnownValue("random_pet.pet_1", tfjsonpath.New("separator"), knownvalue.StringExact("{sep}")),
+				},
+			},
+			{
+				Config: `resource "random_pet" "pet_1" {
+							keepers = {
+								env = "staging"
+								sep = "_"
+							}
+							prefix    = "{env}-app"
+							separator = "{sep}"
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_pet.pet_1", tfjsonpath.New("id"), knownvalue.StringRegexp(regexp.MustCompile(`^staging-app_[a-z]+_[a-z]+$`))),
+				},
+			},
+		},
+	})
+}
+
 func TestAccResourcePet_Separator(t *testing.T) {
 	resource.UnitTest(t, resource.TestCase{
-		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: `resource "random_pet" "pet_1" {
@@ -779,6 +819,444 @@ func TestAccResourcePet_Separator(t *testing.T) {
 	})
 }
 
+func TestAccResourcePet_Words(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_pet" "pet_1" {
+							length    = 3
+							prefix    = "consul"
+							separator = "_"
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_pet.pet_1", tfjsonpath.New("words"), knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.StringRegexp(regexp.MustCompile(`^[a-z]+$`)),
+						knownvalue.StringRegexp(regexp.MustCompile(`^[a-z]+$`)),
+						knownvalue.StringRegexp(regexp.MustCompile(`^[a-z]+$`)),
+					})),
+					statecheck.ExpectKnownValue("random_pet.pet_1", tfjsonpath.New("id"), knownvalue.StringRegexp(regexp.MustCompile(`^consul_[a-z]+_[a-z]+_[a-z]+$`))),
+				},
+			},
+		},
+	})
+}
+
+func TestPetWords(t *testing.T) {
+	testCases := map[string]struct {
+		length        int
+		expectedWords int
+	}{
+		"zero": {
+			length:        0,
+			expectedWords: 2,
+		},
+		"one": {
+			length:        1,
+			expectedWords: 1,
+		},
+		"two": {
+			length:        2,
+			expectedWords: 2,
+		},
+		"four": {
+			length:        4,
+			expectedWords: 4,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			words := petWords(testCase.length)
+
+			if len(words) != testCase.expectedWords {
+				t.Fatalf("expected %d words, got %d: %v", testCase.expectedWords, len(words), words)
+			}
+
+			for _, word := range words {
+				if word == "" || word != strings.ToLower(word) {
+					t.Errorf("expected non-empty lowercase word, got %q", word)
+				}
+			}
+		})
+	}
+}
+
+func TestPetLengthForEntropy(t *testing.T) {
+	testCases := map[string]struct {
+		length         int
+		minEntropyBits float64
+		numericSuffix  bool
+		expectedWords  int
+		expectedDigits int
+	}{
+		"met-by-length": {
+			length:         2,
+			minEntropyBits: 17,
+			expectedWords:  2,
+		},
+		"words": {
+			length:         2,
+			minEntropyBits: 40,
+			expectedWords:  5,
+		},
+		"numeric-suffix": {
+			length:         2,
+			minEntropyBits: 40,
+			numericSuffix:  true,
+			expectedWords:  2,
+			expectedDigits: 7,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			words, digits := petLengthForEntropy("", testCase.length, testCase.minEntropyBits, testCase.numericSuffix)
+
+			if words != testCase.expectedWords || digits != testCase.expectedDigits {
+				t.Fatalf("expected %d words and %d digits, got %d words and %d digits", testCase.expectedWords, testCase.expectedDigits, words, digits)
+			}
+
+			if bits := petEntropyBits(words, digits); bits < testCase.minEntropyBits {
+				t.Errorf("expected at least %f bits, got %f", testCase.minEntropyBits, bits)
+			}
+		})
+	}
+}
+
+func TestAccResourcePet_MinEntropyBits(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_pet" "test" {
+							min_entropy_bits = 40
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_pet.test", tfjsonpath.New("id"), knownvalue.StringRegexp(regexp.MustCompile(`^[a-z]+(-[a-z]+){4}$`))),
+					statecheck.ExpectKnownValue("random_pet.test", tfjsonpath.New("length"), knownvalue.Int64Exact(2)),
+					statecheck.ExpectKnownValue("random_pet.test", tfjsonpath.New("words"), knownvalue.ListSizeExact(5)),
+					statecheck.ExpectKnownValue("random_pet.test", tfjsonpath.New("entropy_bits"), knownvalue.Float64Exact(petEntropyBits(5, 0))),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourcePet_MinEntropyBits_NumericSuffix(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_pet" "test" {
+							prefix           = "web"
+							min_entropy_bits = 40
+							numeric_suffix   = true
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_pet.test", tfjsonpath.New("id"), knownvalue.StringRegexp(regexp.MustCompile(`^web-[a-z]+-[a-z]+-\d{7}$`))),
+					statecheck.ExpectKnownValue("random_pet.test", tfjsonpath.New("words"), knownvalue.ListSizeExact(2)),
+					statecheck.ExpectKnownValue("random_pet.test", tfjsonpath.New("entropy_bits"), knownvalue.Float64Exact(petEntropyBits(2, 7))),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourcePet_NumericSuffix_Invalid(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_pet" "test" {
+							numeric_suffix = true
+						}`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
+
+func TestAccResourcePet_Digits(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_pet" "test" {
+							digits = 4
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_pet.test", tfjsonpath.New("id"), knownvalue.StringRegexp(regexp.MustCompile(`^[a-z]+-[a-z]+-\d{4}$`))),
+					statecheck.ExpectKnownValue("random_pet.test", tfjsonpath.New("words"), knownvalue.ListSizeExact(2)),
+					statecheck.ExpectKnownValue("random_pet.test", tfjsonpath.New("entropy_bits"), knownvalue.Float64Exact(petEntropyBits(2, 4))),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourcePet_ExpectedCardinality(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_pet" "test" {
+							digits               = 1
+							expected_cardinality = 1000
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_pet.test", tfjsonpath.New("id"), knownvalue.StringRegexp(regexp.MustCompile(`^[a-z]+-[a-z]+-\d{3}$`))),
+					statecheck.ExpectKnownValue("random_pet.test", tfjsonpath.New("entropy_bits"), knownvalue.Float64Exact(petEntropyBits(2, 3))),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourcePet_UniqueWithin(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				// With 452 possible names, 100 names are almost certain to
+				// contain duplicates unless they are coordinated.
+				Config: `resource "random_pet" "test" {
+							count         = 100
+							length        = 1
+							unique_within = "test"
+						}
+
+						output "distinct" {
+							value = length(distinct(random_pet.test[*].id))
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("distinct", knownvalue.Int64Exact(100)),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourcePet_UniqueWithin_Invalid(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_pet" "test" {
+							keepers = {
+								key = "value"
+							}
+							deterministic = true
+							unique_within = "test"
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute "deterministic" cannot be specified when "unique_within" is specified`),
+			},
+		},
+	})
+}
+
+func TestAccResourcePet_Namespace(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_pet" "one" {
+							deterministic = true
+							namespace     = "platform"
+							keepers = {
+								"tenant" = "example"
+							}
+						}
+						resource "random_pet" "two" {
+							deterministic = true
+							namespace     = "platform"
+							keepers = {
+								"tenant" = "example"
+							}
+						}
+						resource "random_pet" "three" {
+							deterministic = true
+							namespace     = "other"
+							keepers = {
+								"tenant" = "example"
+							}
+						}
+						resource "random_pet" "four" {
+							deterministic = true
+							keepers = {
+								"tenant" = "example"
+							}
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.CompareValuePairs("random_pet.one", tfjsonpath.New("id"), "random_pet.two", tfjsonpath.New("id"), compare.ValuesSame()),
+					statecheck.CompareValuePairs("random_pet.one", tfjsonpath.New("id"), "random_pet.three", tfjsonpath.New("id"), compare.ValuesDiffer()),
+					statecheck.CompareValuePairs("random_pet.one", tfjsonpath.New("id"), "random_pet.four", tfjsonpath.New("id"), compare.ValuesDiffer()),
+					statecheck.ExpectKnownValue("random_pet.one", tfjsonpath.New("namespace"), knownvalue.StringExact("platform")),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourcePet_Namespace_RequiresDeterministic(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_pet" "test" {
+							namespace = "platform"
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute namespace requires deterministic to be true`),
+			},
+		},
+	})
+}
+
+func TestAccResourcePet_Template(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_pet" "test" {
+							template = "{adjective}-{animal}-{number:3}"
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_pet.test", tfjsonpath.New("id"), knownvalue.StringRegexp(regexp.MustCompile(`^[a-z]+-[a-z]+-\d{3}$`))),
+					statecheck.ExpectKnownValue("random_pet.test", tfjsonpath.New("words"), knownvalue.ListSizeExact(2)),
+					statecheck.ExpectKnownValue("random_pet.test", tfjsonpath.New("entropy_bits"), knownvalue.Float64Exact(math.Log2(petAdjectives)+math.Log2(petNames)+3*math.Log2(10))),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourcePet_Template_Invalid(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_pet" "test" {
+							template = "{colour}-{animal}"
+						}`,
+				ExpectError: regexp.MustCompile(`unknown placeholder {colour}`),
+			},
+			{
+				Config: `resource "random_pet" "test" {
+							template = "{animal}"
+							prefix   = "web"
+						}`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
+
+func TestAccResourcePet_Deterministic(t *testing.T) {
+	// The id attribute values should differ when the keepers change
+	assertIDDiffer := statecheck.CompareValue(compare.ValuesDiffer())
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_pet" "one" {
+							deterministic = true
+							keepers = {
+								"tenant" = "example"
+							}
+						}
+						resource "random_pet" "two" {
+							deterministic    = true
+							min_entropy_bits = 40
+							numeric_suffix   = true
+							keepers = {
+								"tenant" = "example"
+							}
+						}
+						resource "random_pet" "three" {
+							deterministic    = true
+							min_entropy_bits = 40
+							numeric_suffix   = true
+							keepers = {
+								"tenant" = "example"
+								"unset"  = null
+							}
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					assertIDDiffer.AddStateValue("random_pet.one", tfjsonpath.New("id")),
+					statecheck.ExpectKnownValue("random_pet.one", tfjsonpath.New("entropy_bits"), knownvalue.Float64Exact(0)),
+					statecheck.ExpectKnownValue("random_pet.two", tfjsonpath.New("id"), knownvalue.StringRegexp(regexp.MustCompile(`^[a-z]+-[a-z]+-\d{7}$`))),
+					statecheck.CompareValuePairs("random_pet.two", tfjsonpath.New("id"), "random_pet.three", tfjsonpath.New("id"), compare.ValuesSame()),
+				},
+			},
+			{
+				Config: `resource "random_pet" "one" {
+							deterministic = true
+							keepers = {
+								"tenant" = "other"
+							}
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					assertIDDiffer.AddStateValue("random_pet.one", tfjsonpath.New("id")),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourcePet_Deterministic_Invalid(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_pet" "test" {
+							deterministic = true
+						}`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				Config: `resource "random_pet" "test" {
+							deterministic = true
+							template      = "{animal}"
+							keepers = {
+								"tenant" = "example"
+							}
+						}`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
+
+func TestAccResourcePet_EntropyBits_UpgradeFromVersion3_6_3(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				ExternalProviders: providerVersion363(),
+				Config: `resource "random_pet" "test" {
+							length = 3
+						}`,
+			},
+			{
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
+				Config: `resource "random_pet" "test" {
+							length = 3
+						}`,
+				PlanOnly: true,
+			},
+			{
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
+				Config: `resource "random_pet" "test" {
+							length = 3
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_pet.test", tfjsonpath.New("entropy_bits"), knownvalue.Float64Exact(petEntropyBits(3, 0))),
+				},
+			},
+		},
+	})
+}
+
 func TestAccResourcePet_UpgradeFromVersion3_3_2(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		Steps: []resource.TestStep{
@@ -792,14 +1270,14 @@ func TestAccResourcePet_UpgradeFromVersion3_3_2(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_pet" "pet_1" {
   							prefix = "consul"
 						}`,
 				PlanOnly: true,
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_pet" "pet_1" {
   							prefix = "consul"
 						}`,
@@ -810,3 +1288,121 @@ func TestAccResourcePet_UpgradeFromVersion3_3_2(t *testing.T) {
 		},
 	})
 }
+
+func TestAccResourcePet_LifecycleMetadata(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_pet" "test" {
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_pet.test", tfjsonpath.New("created_at"), knownvalue.StringRegexp(regexp.MustCompile(`^\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}Z$`))),
+					statecheck.ExpectKnownValue("random_pet.test", tfjsonpath.New("provider_version"), knownvalue.StringExact("test")),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourcePet_LifecycleMetadata_UpgradeFromVersion3_6_3(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				ExternalProviders: providerVersion363(),
+				Config: `resource "random_pet" "test" {
+						}`,
+			},
+			{
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
+				Config: `resource "random_pet" "test" {
+						}`,
+				PlanOnly: true,
+			},
+			{
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
+				Config: `resource "random_pet" "test" {
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_pet.test", tfjsonpath.New("created_at"), knownvalue.Null()),
+					statecheck.ExpectKnownValue("random_pet.test", tfjsonpath.New("provider_version"), knownvalue.Null()),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourcePet_Identity(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_pet" "test" {}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentity("random_pet.test", map[string]knownvalue.Check{
+						"id": knownvalue.NotNull(),
+					}),
+					statecheck.ExpectIdentityValueMatchesState("random_pet.test", tfjsonpath.New("id")),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourcePet_Language(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_pet" "test" {
+							length   = 3
+							language = "de"
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_pet.test", tfjsonpath.New("id"), knownvalue.StringRegexp(regexp.MustCompile(`^[a-z]+-[a-z]+-[a-z]+$`))),
+					statecheck.ExpectKnownValue("random_pet.test", tfjsonpath.New("language"), knownvalue.StringExact("de")),
+					statecheck.ExpectKnownValue("random_pet.test", tfjsonpath.New("words"), knownvalue.ListSizeExact(3)),
+					statecheck.ExpectKnownValue("random_pet.test", tfjsonpath.New("entropy_bits"), knownvalue.Float64Exact(petLanguageEntropyBits("de", 3, 0))),
+				},
+			},
+			{
+				Config: `resource "random_pet" "test" {
+							length   = 3
+							language = "fr"
+						}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("random_pet.test", plancheck.ResourceActionReplace),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_pet.test", tfjsonpath.New("id"), knownvalue.StringRegexp(regexp.MustCompile(`^[a-z]+-[a-z]+-[a-z]+$`))),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourcePet_Language_Invalid(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_pet" "test" {
+							language = "it"
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute language value must be one of`),
+			},
+			{
+				Config: `resource "random_pet" "test" {
+							template = "{adjective}-{animal}"
+							language = "es"
+						}`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
@@ -0,0 +1,265 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/terraform-providers/terraform-provider-random/internal/diagnostics"
+	mapplanmodifiers "github.com/terraform-providers/terraform-provider-random/internal/planmodifiers/map"
+	"github.com/terraform-providers/terraform-provider-random/internal/random"
+)
+
+// recoveryCodesMaxQuantity is the maximum number of recovery codes, which is far
+// more than any MFA system issues at once.
+const recoveryCodesMaxQuantity = 100
+
+var (
+	_ resource.Resource               = (*recoveryCodesResource)(nil)
+	_ resource.ResourceWithConfigure  = (*recoveryCodesResource)(nil)
+	_ resource.ResourceWithModifyPlan = (*recoveryCodesResource)(nil)
+)
+
+func NewRecoveryCodesResource() resource.Resource {
+	return &recoveryCodesResource{}
+}
+
+type recoveryCodesResource struct {
+	providerVersion string
+	entropy         *random.Source
+}
+
+func (r *recoveryCodesResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_recovery_codes"
+}
+
+func (r *recoveryCodesResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = recoveryCodesSchemaV0()
+}
+
+func (r *recoveryCodesResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	r.providerVersion = providerVersion(req.ProviderData)
+	r.entropy = providerEntropy(req.ProviderData)
+}
+
+// ModifyPlan prevents the replacement of the resource when lifecycle_guard is
+// enabled, unless allow_regeneration_token is changed.
+func (r *recoveryCodesResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	guardRegeneration(ctx, req, resp)
+}
+
+func (r *recoveryCodesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan recoveryCodesModelV0
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := random.RecoveryCodeParams{
+		Count:       plan.Quantity.ValueInt64(),
+		GroupCount:  plan.GroupCount.ValueInt64(),
+		GroupLength: plan.GroupLength.ValueInt64(),
+		Separator:   plan.Separator.ValueString(),
+	}
+
+	done := logGeneration(ctx, randomSourceCrypto, map[string]any{
+		"quantity":     params.Count,
+		"group_count":  params.GroupCount,
+		"group_length": params.GroupLength,
+	})
+
+	codes, err := r.entropy.CreateRecoveryCodes(params)
+	done()
+
+	if errors.Is(err, random.ErrEntropyUnavailable) {
+		resp.Diagnostics.Append(diagnostics.EntropyUnavailableError(err)...)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Create Random Recovery Codes Error",
+			"There was an error during generation of the recovery codes.\n\n"+
+				diagnostics.RetryMsg+
+				fmt.Sprintf("Original Error: %s", err),
+		)
+		return
+	}
+
+	results := make([]attr.Value, len(codes))
+	for i, code := range codes {
+		results[i] = types.StringValue(code)
+	}
+
+	c := &recoveryCodesModelV0{
+		Keepers:                plan.Keepers,
+		KeepersHash:            plan.KeepersHash,
+		Quantity:               plan.Quantity,
+		GroupCount:             plan.GroupCount,
+		GroupLength:            plan.GroupLength,
+		Separator:              plan.Separator,
+		Results:                types.ListValueMust(types.StringType, results),
+		EntropyBits:            types.Float64Value(recoveryCodeEntropyBits(params.GroupCount * params.GroupLength)),
+		LifecycleGuard:         plan.LifecycleGuard,
+		AllowRegenerationToken: plan.AllowRegenerationToken,
+	}
+
+	c.CreatedAt, c.ProviderVersion = lifecycleValues(r.providerVersion)
+
+	diags = resp.State.Set(ctx, c)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read does not need to perform any operations as the state in ReadResourceResponse is already populated.
+func (r *recoveryCodesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+}
+
+// Update ensures the plan value is copied to the state to complete the update.
+func (r *recoveryCodesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var model recoveryCodesModelV0
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+// Delete does not need to explicitly call resp.State.RemoveResource() as this is automatically handled by the
+// [framework](https://github.com/hashicorp/terraform-plugin-framework/pull/301).
+func (r *recoveryCodesResource) Delete(context.Context, resource.DeleteRequest, *resource.DeleteResponse) {
+}
+
+// recoveryCodeEntropyBits returns the entropy of a recovery code of the given
+// number of random characters, excluding the separators.
+func recoveryCodeEntropyBits(chars int64) float64 {
+	return float64(chars) * math.Log2(float64(len(random.RecoveryCodeChars)))
+}
+
+func recoveryCodesSchemaV0() schema.Schema {
+	return schema.Schema{
+		Description: "The resource `random_recovery_codes` generates a list of one-time recovery codes in the " +
+			"familiar grouped format, such as `x7k2q-9fm4w`, for seeding multi-factor authentication recovery " +
+			"workflows. Each code is generated independently, and every code is distinct.\n" +
+			"\n" +
+			"This resource *does* use a cryptographic random number generator.",
+		Attributes: map[string]schema.Attribute{
+			"keepers": schema.MapAttribute{
+				Description: "Arbitrary map of values that, when changed, will trigger recreation of " +
+					"resource. See [the main provider documentation](../index.html) for more information.",
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifiers.RequiresReplaceIfValuesNotNull(),
+				},
+			},
+			"quantity": schema.Int64Attribute{
+				Description: fmt.Sprintf("The number of recovery codes to generate, between 1 and %d. "+
+					"Default value is `10`.", recoveryCodesMaxQuantity),
+				Optional: true,
+				Computed: true,
+				Default:  int64default.StaticInt64(10),
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(1, recoveryCodesMaxQuantity),
+				},
+			},
+			"group_count": schema.Int64Attribute{
+				Description: "The number of groups of characters in each recovery code. Default value is `2`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(2),
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"group_length": schema.Int64Attribute{
+				Description: "The number of characters in each group of a recovery code, each a lowercase " +
+					"letter or digit. The minimum value is 4. Default value is `5`.",
+				Optional: true,
+				Computed: true,
+				Default:  int64default.StaticInt64(5),
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(4),
+				},
+			},
+			"separator": schema.StringAttribute{
+				Description: "The string which separates the groups of a recovery code, which must not contain " +
+					"lowercase letters or digits. Default value is `-`.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("-"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[^a-z0-9]*$`), "must not contain lowercase letters or digits"),
+				},
+			},
+			"keepers_hash":             keepersHashAttribute(),
+			"created_at":               createdAtAttribute(),
+			"provider_version":         providerVersionAttribute(),
+			"lifecycle_guard":          lifecycleGuardAttribute(),
+			"allow_regeneration_token": allowRegenerationTokenAttribute(),
+			"entropy_bits": entropyBitsAttribute("The entropy of each recovery code in bits, calculated from " +
+				"the number of random characters, excluding the separators."),
+			"results": schema.ListAttribute{
+				Description: "The generated recovery codes.",
+				ElementType: types.StringType,
+				Computed:    true,
+				Sensitive:   true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+type recoveryCodesModelV0 struct {
+	Keepers                types.Map     `tfsdk:"keepers"`
+	KeepersHash            types.String  `tfsdk:"keepers_hash"`
+	Quantity               types.Int64   `tfsdk:"quantity"`
+	GroupCount             types.Int64   `tfsdk:"group_count"`
+	GroupLength            types.Int64   `tfsdk:"group_length"`
+	Separator              types.String  `tfsdk:"separator"`
+	Results                types.List    `tfsdk:"results"`
+	EntropyBits            types.Float64 `tfsdk:"entropy_bits"`
+	CreatedAt              types.String  `tfsdk:"created_at"`
+	ProviderVersion        types.String  `tfsdk:"provider_version"`
+	LifecycleGuard         types.Bool    `tfsdk:"lifecycle_guard"`
+	AllowRegenerationToken types.String  `tfsdk:"allow_regeneration_token"`
+}
@@ -0,0 +1,163 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"math"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/compare"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	"github.com/terraform-providers/terraform-provider-random/internal/random"
+)
+
+func TestCreateRecoveryCodes(t *testing.T) {
+	testCases := map[string]struct {
+		params          random.RecoveryCodeParams
+		expectedPattern *regexp.Regexp
+	}{
+		"default": {
+			params: random.RecoveryCodeParams{
+				Count:       10,
+				GroupCount:  2,
+				GroupLength: 5,
+				Separator:   "-",
+			},
+			expectedPattern: regexp.MustCompile(`^[a-z0-9]{5}-[a-z0-9]{5}$`),
+		},
+		"no-separator": {
+			params: random.RecoveryCodeParams{
+				Count:       3,
+				GroupCount:  4,
+				GroupLength: 4,
+			},
+			expectedPattern: regexp.MustCompile(`^[a-z0-9]{16}$`),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			codes, err := random.NewSource(nil).CreateRecoveryCodes(testCase.params)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if int64(len(codes)) != testCase.params.Count {
+				t.Fatalf("expected %d codes, got: %d", testCase.params.Count, len(codes))
+			}
+
+			seen := make(map[string]bool, len(codes))
+
+			for _, code := range codes {
+				if !testCase.expectedPattern.MatchString(code) {
+					t.Errorf("code %q does not match %s", code, testCase.expectedPattern)
+				}
+
+				if seen[code] {
+					t.Errorf("expected distinct codes, got %q more than once", code)
+				}
+
+				seen[code] = true
+			}
+		})
+	}
+}
+
+func TestAccResourceRecoveryCodes(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_recovery_codes" "test" {}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_recovery_codes.test", tfjsonpath.New("results"), knownvalue.ListSizeExact(10)),
+					statecheck.ExpectKnownValue("random_recovery_codes.test", tfjsonpath.New("results").AtSliceIndex(0), knownvalue.StringRegexp(regexp.MustCompile(`^[a-z0-9]{5}-[a-z0-9]{5}$`))),
+					statecheck.ExpectKnownValue("random_recovery_codes.test", tfjsonpath.New("entropy_bits"), knownvalue.Float64Exact(10*math.Log2(36))),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceRecoveryCodes_Format(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_recovery_codes" "test" {
+							quantity     = 16
+							group_count  = 3
+							group_length = 4
+							separator    = " "
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_recovery_codes.test", tfjsonpath.New("results"), knownvalue.ListSizeExact(16)),
+					statecheck.ExpectKnownValue("random_recovery_codes.test", tfjsonpath.New("results").AtSliceIndex(15), knownvalue.StringRegexp(regexp.MustCompile(`^[a-z0-9]{4} [a-z0-9]{4} [a-z0-9]{4}$`))),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceRecoveryCodes_Validation(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_recovery_codes" "test" {
+							quantity = 101
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute quantity value must be between 1 and 100, got: 101`),
+			},
+			{
+				Config: `resource "random_recovery_codes" "test" {
+							group_length = 3
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute group_length value must be at least 4, got: 3`),
+			},
+			{
+				Config: `resource "random_recovery_codes" "test" {
+							separator = "x"
+						}`,
+				ExpectError: regexp.MustCompile(`must not contain lowercase letters or digits`),
+			},
+		},
+	})
+}
+
+func TestAccResourceRecoveryCodes_Keepers_Keep_Value(t *testing.T) {
+	// The results attribute values should be the same between test steps
+	assertResultsSame := statecheck.CompareValue(compare.ValuesSame())
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_recovery_codes" "test" {
+							keepers = {
+								"key" = "123"
+							}
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					assertResultsSame.AddStateValue("random_recovery_codes.test", tfjsonpath.New("results")),
+				},
+			},
+			{
+				Config: `resource "random_recovery_codes" "test" {
+							keepers = {
+								"key" = "123"
+							}
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					assertResultsSame.AddStateValue("random_recovery_codes.test", tfjsonpath.New("results")),
+				},
+			},
+		},
+	})
+}
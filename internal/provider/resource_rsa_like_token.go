@@ -0,0 +1,226 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/terraform-providers/terraform-provider-random/internal/diagnostics"
+	mapplanmodifiers "github.com/terraform-providers/terraform-provider-random/internal/planmodifiers/map"
+	"github.com/terraform-providers/terraform-provider-random/internal/random"
+)
+
+var (
+	_ resource.Resource               = (*rsaLikeTokenResource)(nil)
+	_ resource.ResourceWithConfigure  = (*rsaLikeTokenResource)(nil)
+	_ resource.ResourceWithModifyPlan = (*rsaLikeTokenResource)(nil)
+)
+
+func NewRsaLikeTokenResource() resource.Resource {
+	return &rsaLikeTokenResource{}
+}
+
+type rsaLikeTokenResource struct {
+	providerVersion string
+	entropy         *random.Source
+}
+
+func (r *rsaLikeTokenResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_rsa_like_token"
+}
+
+func (r *rsaLikeTokenResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = rsaLikeTokenSchemaV0()
+}
+
+func (r *rsaLikeTokenResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	r.providerVersion = providerVersion(req.ProviderData)
+	r.entropy = providerEntropy(req.ProviderData)
+}
+
+// ModifyPlan prevents the replacement of the resource when lifecycle_guard is
+// enabled, unless allow_regeneration_token is changed.
+func (r *rsaLikeTokenResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	guardRegeneration(ctx, req, resp)
+}
+
+func (r *rsaLikeTokenResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan rsaLikeTokenModelV0
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := random.TokenParams{
+		Prefix:            plan.Prefix.ValueString(),
+		EntropyBytes:      plan.EntropyBytes.ValueInt64(),
+		ChecksumAlgorithm: plan.ChecksumAlgorithm.ValueString(),
+	}
+
+	done := logGeneration(ctx, randomSourceCrypto, map[string]any{
+		"entropy_bytes": params.EntropyBytes,
+	})
+
+	result, err := r.entropy.CreateToken(params)
+	done()
+
+	if errors.Is(err, random.ErrEntropyUnavailable) {
+		resp.Diagnostics.Append(diagnostics.EntropyUnavailableError(err)...)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Create Random Token Error",
+			"There was an error during generation of the token.\n\n"+
+				diagnostics.RetryMsg+
+				fmt.Sprintf("Original Error: %s", err),
+		)
+		return
+	}
+
+	t := &rsaLikeTokenModelV0{
+		Keepers:                plan.Keepers,
+		KeepersHash:            plan.KeepersHash,
+		Prefix:                 plan.Prefix,
+		EntropyBytes:           plan.EntropyBytes,
+		ChecksumAlgorithm:      plan.ChecksumAlgorithm,
+		Result:                 types.StringValue(result),
+		LifecycleGuard:         plan.LifecycleGuard,
+		AllowRegenerationToken: plan.AllowRegenerationToken,
+	}
+
+	t.CreatedAt, t.ProviderVersion = lifecycleValues(r.providerVersion)
+
+	diags = resp.State.Set(ctx, t)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read does not need to perform any operations as the state in ReadResourceResponse is already populated.
+func (r *rsaLikeTokenResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+}
+
+// Update ensures the plan value is copied to the state to complete the update.
+func (r *rsaLikeTokenResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var model rsaLikeTokenModelV0
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+// Delete does not need to explicitly call resp.State.RemoveResource() as this is automatically handled by the
+// [framework](https://github.com/hashicorp/terraform-plugin-framework/pull/301).
+func (r *rsaLikeTokenResource) Delete(context.Context, resource.DeleteRequest, *resource.DeleteResponse) {
+}
+
+func rsaLikeTokenSchemaV0() schema.Schema {
+	return schema.Schema{
+		Description: "The resource `random_rsa_like_token` generates a structured API token of the form " +
+			"`<prefix>_<random>_<checksum>`, similar to the tokens issued by GitHub. The random segment is " +
+			"base62 encoded, and the checksum segment is calculated from the random segment, so that leaked " +
+			"tokens can be recognised and validated by secret scanners without access to the issuing system.\n" +
+			"\n" +
+			"This resource *does* use a cryptographic random number generator.",
+		Attributes: map[string]schema.Attribute{
+			"keepers": schema.MapAttribute{
+				Description: "Arbitrary map of values that, when changed, will trigger recreation of " +
+					"resource. See [the main provider documentation](../index.html) for more information.",
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifiers.RequiresReplaceIfValuesNotNull(),
+				},
+			},
+			"prefix": schema.StringAttribute{
+				Description: "The prefix identifying the type of token, such as `ghp`. The prefix may only " +
+					"contain letters and digits.",
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[A-Za-z0-9]+$`), "must only contain letters and digits"),
+				},
+			},
+			"entropy_bytes": schema.Int64Attribute{
+				Description: "The number of random bytes encoded in the random segment of the token. The " +
+					"minimum value is 16, which produces 128 bits of randomness. Default value is `20`.",
+				Optional: true,
+				Computed: true,
+				Default:  int64default.StaticInt64(20),
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(16),
+				},
+			},
+			"checksum_algorithm": schema.StringAttribute{
+				Description: "The algorithm used to calculate the checksum segment of the token. Valid values " +
+					"are `crc32`, which appends the CRC-32 checksum of the random segment as six base62 " +
+					"characters, and `luhn`, which appends a single base62 Luhn mod N check character. Default " +
+					"value is `crc32`.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(random.TokenChecksumCRC32),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(random.TokenChecksumCRC32, random.TokenChecksumLuhn),
+				},
+			},
+			"keepers_hash":             keepersHashAttribute(),
+			"created_at":               createdAtAttribute(),
+			"provider_version":         providerVersionAttribute(),
+			"lifecycle_guard":          lifecycleGuardAttribute(),
+			"allow_regeneration_token": allowRegenerationTokenAttribute(),
+			"result": schema.StringAttribute{
+				Description: "The generated token.",
+				Computed:    true,
+				Sensitive:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+type rsaLikeTokenModelV0 struct {
+	Keepers                types.Map    `tfsdk:"keepers"`
+	KeepersHash            types.String `tfsdk:"keepers_hash"`
+	Prefix                 types.String `tfsdk:"prefix"`
+	EntropyBytes           types.Int64  `tfsdk:"entropy_bytes"`
+	ChecksumAlgorithm      types.String `tfsdk:"checksum_algorithm"`
+	Result                 types.String `tfsdk:"result"`
+	CreatedAt              types.String `tfsdk:"created_at"`
+	ProviderVersion        types.String `tfsdk:"provider_version"`
+	LifecycleGuard         types.Bool   `tfsdk:"lifecycle_guard"`
+	AllowRegenerationToken types.String `tfsdk:"allow_regeneration_token"`
+}
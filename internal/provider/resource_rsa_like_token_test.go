@@ -0,0 +1,221 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"hash/crc32"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/compare"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	"github.com/terraform-providers/terraform-provider-random/internal/random"
+)
+
+func TestCreateToken(t *testing.T) {
+	const base62Chars = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+
+	testCases := map[string]struct {
+		params          random.TokenParams
+		expectedPattern *regexp.Regexp
+	}{
+		"crc32": {
+			params: random.TokenParams{
+				Prefix:            "ghp",
+				EntropyBytes:      20,
+				ChecksumAlgorithm: random.TokenChecksumCRC32,
+			},
+			expectedPattern: regexp.MustCompile(`^ghp_[0-9A-Za-z]{27}_[0-9A-Za-z]{6}$`),
+		},
+		"luhn": {
+			params: random.TokenParams{
+				Prefix:            "tok",
+				EntropyBytes:      32,
+				ChecksumAlgorithm: random.TokenChecksumLuhn,
+			},
+			expectedPattern: regexp.MustCompile(`^tok_[0-9A-Za-z]{43}_[0-9A-Za-z]$`),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			token, err := random.NewSource(nil).CreateToken(testCase.params)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !testCase.expectedPattern.MatchString(token) {
+				t.Fatalf("token %q does not match %s", token, testCase.expectedPattern)
+			}
+
+			segments := strings.Split(token, "_")
+			secret, checksum := segments[1], segments[2]
+
+			switch testCase.params.ChecksumAlgorithm {
+			case random.TokenChecksumCRC32:
+				var decoded uint64
+
+				for _, c := range checksum {
+					decoded = decoded*62 + uint64(strings.IndexRune(base62Chars, c))
+				}
+
+				if decoded != uint64(crc32.ChecksumIEEE([]byte(secret))) {
+					t.Errorf("checksum %q does not match the CRC-32 of %q", checksum, secret)
+				}
+			case random.TokenChecksumLuhn:
+				// A valid Luhn mod N value sums to a multiple of N when the
+				// check character is included.
+				value := secret + checksum
+				factor := 1
+				sum := 0
+
+				for i := len(value) - 1; i >= 0; i-- {
+					addend := factor * strings.IndexByte(base62Chars, value[i])
+					sum += addend/62 + addend%62
+					factor = 3 - factor
+				}
+
+				if sum%62 != 0 {
+					t.Errorf("check character %q is not valid for %q", checksum, secret)
+				}
+			}
+		})
+	}
+}
+
+func TestTokenChecksum_Unsupported(t *testing.T) {
+	_, err := random.TokenChecksum("md5", "abc")
+	if err == nil {
+		t.Fatal("expected error, got none")
+	}
+}
+
+func TestAccResourceRsaLikeToken(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_rsa_like_token" "test" {
+							prefix = "ghp"
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_rsa_like_token.test", tfjsonpath.New("result"), knownvalue.StringRegexp(regexp.MustCompile(`^ghp_[0-9A-Za-z]{27}_[0-9A-Za-z]{6}$`))),
+					statecheck.ExpectKnownValue("random_rsa_like_token.test", tfjsonpath.New("entropy_bytes"), knownvalue.Int64Exact(20)),
+					statecheck.ExpectKnownValue("random_rsa_like_token.test", tfjsonpath.New("checksum_algorithm"), knownvalue.StringExact("crc32")),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceRsaLikeToken_Luhn(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_rsa_like_token" "test" {
+							prefix             = "acme"
+							entropy_bytes      = 32
+							checksum_algorithm = "luhn"
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_rsa_like_token.test", tfjsonpath.New("result"), knownvalue.StringRegexp(regexp.MustCompile(`^acme_[0-9A-Za-z]{43}_[0-9A-Za-z]$`))),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceRsaLikeToken_Validation(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_rsa_like_token" "test" {
+							prefix = "gh_p"
+						}`,
+				ExpectError: regexp.MustCompile(`must only contain letters and digits`),
+			},
+			{
+				Config: `resource "random_rsa_like_token" "test" {
+							prefix        = "ghp"
+							entropy_bytes = 8
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute entropy_bytes value must be at least 16, got: 8`),
+			},
+			{
+				Config: `resource "random_rsa_like_token" "test" {
+							prefix             = "ghp"
+							checksum_algorithm = "md5"
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute checksum_algorithm value must be one of`),
+			},
+		},
+	})
+}
+
+func TestAccResourceRsaLikeToken_Prefix_ForceReplacement(t *testing.T) {
+	// The result attribute values should differ between test steps
+	assertResultDiffer := statecheck.CompareValue(compare.ValuesDiffer())
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_rsa_like_token" "test" {
+							prefix = "ghp"
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					assertResultDiffer.AddStateValue("random_rsa_like_token.test", tfjsonpath.New("result")),
+				},
+			},
+			{
+				Config: `resource "random_rsa_like_token" "test" {
+							prefix = "gho"
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					assertResultDiffer.AddStateValue("random_rsa_like_token.test", tfjsonpath.New("result")),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceRsaLikeToken_Keepers_Keep_Value(t *testing.T) {
+	// The result attribute values should be the same between test steps
+	assertResultSame := statecheck.CompareValue(compare.ValuesSame())
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_rsa_like_token" "test" {
+							prefix = "ghp"
+							keepers = {
+								"key" = "123"
+							}
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					assertResultSame.AddStateValue("random_rsa_like_token.test", tfjsonpath.New("result")),
+				},
+			},
+			{
+				Config: `resource "random_rsa_like_token" "test" {
+							prefix = "ghp"
+							keepers = {
+								"key" = "123"
+							}
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					assertResultSame.AddStateValue("random_rsa_like_token.test", tfjsonpath.New("result")),
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,269 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	mapplanmodifiers "github.com/terraform-providers/terraform-provider-random/internal/planmodifiers/map"
+	"github.com/terraform-providers/terraform-provider-random/internal/random"
+)
+
+var (
+	_ resource.Resource                   = (*sampleMapResource)(nil)
+	_ resource.ResourceWithConfigure      = (*sampleMapResource)(nil)
+	_ resource.ResourceWithModifyPlan     = (*sampleMapResource)(nil)
+	_ resource.ResourceWithValidateConfig = (*sampleMapResource)(nil)
+)
+
+func NewSampleMapResource() resource.Resource {
+	return &sampleMapResource{}
+}
+
+type sampleMapResource struct {
+	providerVersion string
+	entropy         *random.Source
+}
+
+func (r *sampleMapResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sample_map"
+}
+
+func (r *sampleMapResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = sampleMapSchemaV0()
+}
+
+func (r *sampleMapResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	r.providerVersion = providerVersion(req.ProviderData)
+	r.entropy = providerEntropy(req.ProviderData)
+}
+
+// ModifyPlan prevents the replacement of the resource when lifecycle_guard is
+// enabled, unless allow_regeneration_token is changed.
+func (r *sampleMapResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	guardRegeneration(ctx, req, resp)
+}
+
+func (r *sampleMapResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan sampleMapModelV0
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	inputElements := plan.Input.Elements()
+	resultCount := plan.ResultCount.ValueInt64()
+
+	// The configuration may have contained unknown values during validation.
+	if resultCount > int64(len(inputElements)) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("result_count"),
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute result_count value must not exceed the number of elements in input (%d), "+
+				"got: %d", len(inputElements), resultCount),
+		)
+		return
+	}
+
+	done := logGeneration(ctx, pseudoRandomSource(plan.Seed.ValueString()), map[string]any{
+		"input_count":  len(inputElements),
+		"result_count": resultCount,
+	})
+
+	resultElements, err := sampleMapElements(r.entropy, inputElements, resultCount, plan.Seed.ValueString())
+	done()
+
+	if err != nil {
+		resp.Diagnostics.Append(entropyDiagnostics(err)...)
+		return
+	}
+
+	result, diags := types.MapValue(types.StringType, resultElements)
+
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Result = result
+	plan.CreatedAt, plan.ProviderVersion = lifecycleValues(r.providerVersion)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read does not need to perform any operations as the state in ReadResourceResponse is already populated.
+func (r *sampleMapResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+}
+
+// Update ensures the plan value is copied to the state to complete the update.
+func (r *sampleMapResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var model sampleMapModelV0
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+// Delete does not need to explicitly call resp.State.RemoveResource() as this is automatically handled by the
+// [framework](https://github.com/hashicorp/terraform-plugin-framework/pull/301).
+func (r *sampleMapResource) Delete(context.Context, resource.DeleteRequest, *resource.DeleteResponse) {
+}
+
+// ValidateConfig ensures that no more elements are requested than are present in input, as elements of a map cannot
+// be repeated in the result.
+func (r *sampleMapResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config sampleMapModelV0
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The number of elements in the input is not known until it is known as a whole.
+	if config.Input.IsNull() || config.Input.IsUnknown() || config.ResultCount.IsNull() || config.ResultCount.IsUnknown() {
+		return
+	}
+
+	inputCount := len(config.Input.Elements())
+
+	if resultCount := config.ResultCount.ValueInt64(); resultCount > int64(inputCount) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("result_count"),
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute result_count value must not exceed the number of elements in input (%d), "+
+				"got: %d", inputCount, resultCount),
+		)
+	}
+}
+
+// sampleMapElements returns resultCount elements of the input map, chosen at
+// random without repetition. The keys are sorted before sampling so that the
+// same elements are always chosen for the same non-empty seed.
+func sampleMapElements(entropy *random.Source, inputElements map[string]attr.Value, resultCount int64, seed string) (map[string]attr.Value, error) {
+	resultElements := make(map[string]attr.Value, max(resultCount, 0))
+
+	if resultCount <= 0 || len(inputElements) == 0 {
+		return resultElements, nil
+	}
+
+	keys := make([]string, 0, len(inputElements))
+
+	for key := range inputElements {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	rand, err := entropy.NewRand(seed)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, i := range rand.Perm(len(keys)) {
+		if int64(len(resultElements)) >= resultCount {
+			break
+		}
+
+		resultElements[keys[i]] = inputElements[keys[i]]
+	}
+
+	return resultElements, nil
+}
+
+func sampleMapSchemaV0() schema.Schema {
+	return schema.Schema{
+		Description: "The resource `random_sample_map` chooses a random subset of the elements of a map of " +
+			"strings given as an argument, preserving the pairing of each key with its value.\n" +
+			"\n" +
+			"This resource does not use a cryptographic random number generator.",
+		Attributes: map[string]schema.Attribute{
+			"keepers": schema.MapAttribute{
+				Description: "Arbitrary map of values that, when changed, will trigger recreation of " +
+					"resource. See [the main provider documentation](../index.html) for more information.",
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifiers.RequiresReplaceIfValuesNotNull(),
+				},
+			},
+			"seed": schema.StringAttribute{
+				Description: "Arbitrary string with which to seed the random number generator, in order to " +
+					"produce less-volatile samples.\n" +
+					"\n" +
+					"**Important:** Even with an identical seed, it is not guaranteed that the same elements " +
+					"will be chosen across different versions of Terraform. This argument causes the " +
+					"result to be *less volatile*, but not fixed for all time.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"input": schema.MapAttribute{
+				Description: "The map of strings to choose elements from.",
+				ElementType: types.StringType,
+				Required:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"result_count": schema.Int64Attribute{
+				Description: "The number of elements to choose from `input`. Elements are never repeated, so " +
+					"the value must not exceed the number of elements in `input`. The minimum value is 0.",
+				Required: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"keepers_hash":             keepersHashAttribute(),
+			"created_at":               createdAtAttribute(),
+			"provider_version":         providerVersionAttribute(),
+			"lifecycle_guard":          lifecycleGuardAttribute(),
+			"allow_regeneration_token": allowRegenerationTokenAttribute(),
+			"result": schema.MapAttribute{
+				Description: "The elements chosen from `input`, with the same keys and values. The number of " +
+					"elements is determined by `result_count`.",
+				ElementType: types.StringType,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+type sampleMapModelV0 struct {
+	Keepers                types.Map    `tfsdk:"keepers"`
+	KeepersHash            types.String `tfsdk:"keepers_hash"`
+	Seed                   types.String `tfsdk:"seed"`
+	Input                  types.Map    `tfsdk:"input"`
+	ResultCount            types.Int64  `tfsdk:"result_count"`
+	Result                 types.Map    `tfsdk:"result"`
+	CreatedAt              types.String `tfsdk:"created_at"`
+	ProviderVersion        types.String `tfsdk:"provider_version"`
+	LifecycleGuard         types.Bool   `tfsdk:"lifecycle_guard"`
+	AllowRegenerationToken types.String `tfsdk:"allow_regeneration_token"`
+}
@@ -0,0 +1,145 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestSampleMapElements(t *testing.T) {
+	t.Parallel()
+
+	input := map[string]attr.Value{
+		"a": types.StringValue("1"),
+		"b": types.StringValue("2"),
+		"c": types.StringValue("3"),
+		"d": types.StringValue("4"),
+		"e": types.StringValue("5"),
+	}
+
+	t.Run("pairing", func(t *testing.T) {
+		t.Parallel()
+
+		for i := 0; i < 100; i++ {
+			result, err := sampleMapElements(nil, input, 3, "")
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if len(result) != 3 {
+				t.Fatalf("expected 3 elements, got: %d", len(result))
+			}
+
+			for key, value := range result {
+				if !value.Equal(input[key]) {
+					t.Fatalf("expected key %q to have value %s, got: %s", key, input[key], value)
+				}
+			}
+		}
+	})
+
+	t.Run("seeded", func(t *testing.T) {
+		t.Parallel()
+
+		first, err := sampleMapElements(nil, input, 2, "seed")
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		for i := 0; i < 10; i++ {
+			result, err := sampleMapElements(nil, input, 2, "seed")
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			for key := range first {
+				if _, ok := result[key]; !ok {
+					t.Fatalf("expected the same keys for the same seed, got: %v and %v", first, result)
+				}
+			}
+		}
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := sampleMapElements(nil, input, 0, "")
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if len(result) != 0 {
+			t.Fatalf("expected no elements, got: %v", result)
+		}
+	})
+}
+
+func TestAccResourceSampleMap(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_sample_map" "test" {
+							input = {
+								a = "1"
+								b = "2"
+								c = "3"
+							}
+							result_count = 2
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_sample_map.test", tfjsonpath.New("result"), knownvalue.MapSizeExact(2)),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceSampleMap_All(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_sample_map" "test" {
+							input = {
+								a = "1"
+								b = "2"
+							}
+							result_count = 2
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_sample_map.test", tfjsonpath.New("result"), knownvalue.MapExact(map[string]knownvalue.Check{
+						"a": knownvalue.StringExact("1"),
+						"b": knownvalue.StringExact("2"),
+					})),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceSampleMap_ResultCount_Invalid(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_sample_map" "test" {
+							input = {
+								a = "1"
+							}
+							result_count = 2
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute result_count value must not exceed the number of elements in\s+input \(1\), got: 2`),
+			},
+		},
+	})
+}
@@ -5,34 +5,691 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"sort"
+	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/dynamicplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
+	dynamicplanmodifiers "github.com/terraform-providers/terraform-provider-random/internal/planmodifiers/dynamic"
+	listplanmodifiers "github.com/terraform-providers/terraform-provider-random/internal/planmodifiers/list"
 	mapplanmodifiers "github.com/terraform-providers/terraform-provider-random/internal/planmodifiers/map"
 	"github.com/terraform-providers/terraform-provider-random/internal/random"
 )
 
-var _ resource.Resource = (*shuffleResource)(nil)
+var (
+	_ resource.Resource                   = (*shuffleResource)(nil)
+	_ resource.ResourceWithConfigure      = (*shuffleResource)(nil)
+	_ resource.ResourceWithModifyPlan     = (*shuffleResource)(nil)
+	_ resource.ResourceWithUpgradeState   = (*shuffleResource)(nil)
+	_ resource.ResourceWithValidateConfig = (*shuffleResource)(nil)
+)
+
+// shuffleResultChunksType is the type of the result_chunks attribute.
+var shuffleResultChunksType = types.ListType{ElemType: types.StringType}
 
 func NewShuffleResource() resource.Resource {
 	return &shuffleResource{}
 }
 
-type shuffleResource struct{}
+type shuffleResource struct {
+	providerVersion string
+	entropy         *random.Source
+}
 
 func (r *shuffleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_shuffle"
 }
 
 func (r *shuffleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resp.Schema = schema.Schema{
+	resp.Schema = shuffleSchemaV1()
+}
+
+func (r *shuffleResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	r.providerVersion = providerVersion(req.ProviderData)
+	r.entropy = providerEntropy(req.ProviderData)
+}
+
+// ModifyPlan prevents the replacement of the resource when lifecycle_guard is
+// enabled, unless allow_regeneration_token is changed.
+func (r *shuffleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	guardRegeneration(ctx, req, resp)
+}
+
+func (r *shuffleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data shuffleModelV1
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Legacy identifier attribute that is hardcoded. This is not necessary
+	// after Terraform 0.12, but left for compatibility reasons. The attribute
+	// could be removed in a future major version of the provider.
+	data.ID = types.StringValue("-")
+
+	data.CreatedAt, data.ProviderVersion = lifecycleValues(r.providerVersion)
+
+	if data.AlgorithmVersion.IsUnknown() {
+		data.AlgorithmVersion = types.Int64Value(shuffleAlgorithmVersion)
+	}
+
+	algorithm := shuffleAlgorithms[data.AlgorithmVersion.ValueInt64()]
+
+	inputElements := data.inputElements()
+
+	var resultCount int64
+
+	if !data.ResultCount.IsNull() {
+		resultCount = data.ResultCount.ValueInt64()
+	} else {
+		resultCount = int64(len(inputElements))
+	}
+
+	done := logGeneration(ctx, sampledRandomSource(data.Seed.ValueString()), map[string]any{
+		"input_count":       len(inputElements),
+		"result_count":      resultCount,
+		"algorithm_version": data.AlgorithmVersion.ValueInt64(),
+		"permutations":      data.Permutations.ValueInt64(),
+	})
+
+	generate := algorithm.shuffle
+
+	if data.PreserveOrder.ValueBool() {
+		generate = algorithm.sample
+	}
+
+	resultElements, err := generate(r.entropy, inputElements, resultCount, data.Seed.ValueString())
+	if err != nil {
+		done()
+		resp.Diagnostics.Append(entropyDiagnostics(err)...)
+		return
+	}
+
+	data.Results = types.ListNull(shuffleResultsType)
+
+	var diags diag.Diagnostics
+
+	if !data.Permutations.IsNull() {
+		data.Results, diags = shufflePermutations(generate, r.entropy, inputElements, resultElements, resultCount,
+			data.Seed.ValueString(), data.Permutations.ValueInt64())
+
+		resp.Diagnostics.Append(diags...)
+	}
+
+	done()
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Result, diags = types.ListValue(types.StringType, resultElements)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ResultMap = shuffleResultMap(inputElements, resultElements, !data.InputMap.IsNull())
+	data.Discarded = shuffleDiscarded(inputElements, data.ResultMap, !data.InputMap.IsNull())
+	data.ResultValues = types.ListNull(types.StringType)
+	data.ResultObjects = types.DynamicNull()
+	data.ResultChunks = types.ListNull(shuffleResultChunksType)
+
+	if !data.InputMap.IsNull() {
+		data.ResultValues, diags = types.ListValue(types.StringType, shuffleMapValues(data.InputMap, resultElements))
+
+		resp.Diagnostics.Append(diags...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if !data.InputObjects.IsNull() {
+		data.ResultObjects, diags = shuffleObjectValues(ctx, data.InputObjects, resultElements)
+
+		resp.Diagnostics.Append(diags...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if !data.Chunks.IsNull() {
+		chunks := data.Chunks.ValueInt64()
+
+		if chunks > int64(len(resultElements)) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("chunks"),
+				"Invalid Attribute Value",
+				fmt.Sprintf("Attribute chunks value must not exceed the number of results (%d), got: %d. "+
+					"Every chunk must contain at least one element.", len(resultElements), chunks),
+			)
+			return
+		}
+
+		chunkElements := make([]attr.Value, 0, chunks)
+
+		for _, chunk := range shuffleChunks(resultElements, chunks) {
+			chunkElements = append(chunkElements, types.ListValueMust(types.StringType, chunk))
+		}
+
+		data.ResultChunks, diags = types.ListValue(shuffleResultChunksType, chunkElements)
+
+		resp.Diagnostics.Append(diags...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// ValidateConfig reports configurations which can be detected during planning as producing an unexpected result,
+// rather than silently truncating the result or failing during apply.
+func (r *shuffleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data shuffleModelV1
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(algorithmVersionWarning(data.AlgorithmVersion, shuffleAlgorithmVersion)...)
+
+	// The number of elements in the input is not known until it is known as a whole.
+	if data.Input.IsUnknown() || data.InputMap.IsUnknown() || data.InputObjects.IsUnknown() ||
+		data.InputObjects.IsUnderlyingValueUnknown() || data.ResultCount.IsUnknown() {
+		return
+	}
+
+	var inputCount int64
+
+	switch {
+	case !data.Input.IsNull():
+		inputCount = int64(len(data.Input.Elements()))
+	case !data.InputMap.IsNull():
+		inputCount = int64(len(data.InputMap.Elements()))
+	case !data.InputObjects.IsNull():
+		objects, ok := shuffleObjects(data.InputObjects)
+
+		if !ok {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("input_objects"),
+				"Invalid Attribute Value",
+				fmt.Sprintf("Attribute input_objects value must be a list or tuple, got: %s",
+					data.InputObjects.UnderlyingValue().Type(ctx)),
+			)
+			return
+		}
+
+		inputCount = int64(len(objects))
+	default:
+		return
+	}
+
+	resultCount := inputCount
+
+	if !data.ResultCount.IsNull() {
+		resultCount = data.ResultCount.ValueInt64()
+	}
+
+	// Elements are repeated when the result count exceeds the number of input elements, which earlier versions
+	// allowed silently, so this is only an error if allow_duplicates is explicitly false.
+	if inputCount > 0 && !data.AllowDuplicates.IsUnknown() && !data.AllowDuplicates.ValueBool() {
+		switch {
+		case !data.AllowDuplicates.IsNull() && (resultCount < 1 || resultCount > inputCount):
+			resp.Diagnostics.AddAttributeError(
+				path.Root("result_count"),
+				"Invalid Attribute Value",
+				fmt.Sprintf("Attribute result_count value must be between 1 and the number of elements in the "+
+					"input (%d) when allow_duplicates is false, got: %d", inputCount, resultCount),
+			)
+			return
+		case data.AllowDuplicates.IsNull() && resultCount > inputCount:
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("result_count"),
+				"Result Will Contain Duplicates",
+				fmt.Sprintf("Attribute result_count is %d, which exceeds the number of elements in the input "+
+					"(%d), so elements will be repeated in the result. Set allow_duplicates to true if this is "+
+					"intended, or to false to reject it.", resultCount, inputCount),
+			)
+		}
+	}
+
+	if inputCount == 0 && resultCount > 0 {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("result_count"),
+			"Result Will Be Empty",
+			fmt.Sprintf("Attribute result_count is %d, but the input is empty, so the result will contain "+
+				"no elements.", resultCount),
+		)
+
+		resultCount = 0
+	}
+
+	if data.Chunks.IsNull() || data.Chunks.IsUnknown() || resultCount < 0 {
+		return
+	}
+
+	if chunks := data.Chunks.ValueInt64(); chunks > resultCount {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("chunks"),
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute chunks value must not exceed the number of results (%d), got: %d. "+
+				"Every chunk must contain at least one element.", resultCount, chunks),
+		)
+	}
+}
+
+// Read does not need to refresh the state as the state in ReadResourceResponse is already populated, other than to
+// populate result_map, discarded, algorithm_version and keepers_hash for resources created by earlier provider versions.
+func (r *shuffleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var model shuffleModelV1
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if model.ResultMap.IsNull() && !model.Result.IsNull() {
+		logRefresh(ctx, "result_map")
+
+		model.ResultMap = shuffleResultMap(model.inputElements(), model.Result.Elements(), !model.InputMap.IsNull())
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if model.Discarded.IsNull() && !model.Result.IsNull() {
+		logRefresh(ctx, "discarded")
+
+		model.Discarded = shuffleDiscarded(model.inputElements(), model.ResultMap, !model.InputMap.IsNull())
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if model.AlgorithmVersion.IsNull() && !model.Result.IsNull() {
+		logRefresh(ctx, "algorithm_version")
+
+		model.AlgorithmVersion = types.Int64Value(shuffleLegacyAlgorithmVersion)
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(refreshKeepersHash(ctx, &resp.State)...)
+}
+
+// Update ensures the plan value is copied to the state to complete the update. The result_map, discarded and
+// algorithm_version values are unknown in the plan if the state was not refreshed since upgrading from an earlier
+// provider version.
+func (r *shuffleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var model shuffleModelV1
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if model.ResultMap.IsUnknown() {
+		model.ResultMap = shuffleResultMap(model.inputElements(), model.Result.Elements(), !model.InputMap.IsNull())
+	}
+
+	if model.Discarded.IsUnknown() {
+		model.Discarded = shuffleDiscarded(model.inputElements(), model.ResultMap, !model.InputMap.IsNull())
+	}
+
+	if model.AlgorithmVersion.IsUnknown() {
+		model.AlgorithmVersion = types.Int64Value(shuffleLegacyAlgorithmVersion)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+// Delete does not need to explicitly call resp.State.RemoveResource() as this is automatically handled by the
+// [framework](https://github.com/hashicorp/terraform-plugin-framework/pull/301).
+func (r *shuffleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
+
+func (r *shuffleResource) UpgradeState(context.Context) map[int64]resource.StateUpgrader {
+	schemaV0 := shuffleSchemaV0()
+
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema:   &schemaV0,
+			StateUpgrader: upgradeShuffleStateV0toV1,
+		},
+	}
+}
+
+func upgradeShuffleStateV0toV1(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	logStateUpgrade(ctx, 0, 1)
+
+	type modelV0 struct {
+		ID          types.String `tfsdk:"id"`
+		Keepers     types.Map    `tfsdk:"keepers"`
+		Seed        types.String `tfsdk:"seed"`
+		Input       types.List   `tfsdk:"input"`
+		ResultCount types.Int64  `tfsdk:"result_count"`
+		Result      types.List   `tfsdk:"result"`
+	}
+
+	var shuffleDataV0 modelV0
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &shuffleDataV0)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The creation time and provider version of resources created prior to
+	// schema version 1 are not known, so they are left as null.
+	shuffleDataV1 := shuffleModelV1{
+		ID:                     shuffleDataV0.ID,
+		Keepers:                shuffleDataV0.Keepers,
+		Seed:                   shuffleDataV0.Seed,
+		Input:                  shuffleDataV0.Input,
+		InputMap:               types.MapNull(types.StringType),
+		InputObjects:           types.DynamicNull(),
+		ResultCount:            shuffleDataV0.ResultCount,
+		Chunks:                 types.Int64Null(),
+		Permutations:           types.Int64Null(),
+		Result:                 shuffleDataV0.Result,
+		Results:                types.ListNull(shuffleResultsType),
+		ResultMap:              types.MapNull(types.Int64Type),
+		ResultValues:           types.ListNull(types.StringType),
+		ResultObjects:          types.DynamicNull(),
+		ResultChunks:           types.ListNull(shuffleResultChunksType),
+		Discarded:              types.ListNull(types.StringType),
+		PreserveOrder:          types.BoolNull(),
+		AllowDuplicates:        types.BoolNull(),
+		AlgorithmVersion:       types.Int64Null(),
+		KeepersHash:            types.StringNull(),
+		CreatedAt:              types.StringNull(),
+		ProviderVersion:        types.StringNull(),
+		LifecycleGuard:         types.BoolNull(),
+		AllowRegenerationToken: types.StringNull(),
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, shuffleDataV1)...)
+}
+
+// shuffleElements returns resultCount elements chosen from successive random
+// permutations of the input elements, so that elements are only repeated once
+// every input element has been chosen. If the result count is zero or the
+// input has no elements, the result is empty.
+func shuffleElements(entropy *random.Source, inputElements []attr.Value, resultCount int64, seed string) ([]attr.Value, error) {
+	resultElements := make([]attr.Value, 0, max(resultCount, 0))
+
+	if resultCount <= 0 || len(inputElements) == 0 {
+		return resultElements, nil
+	}
+
+	rand, err := newShuffleRand(entropy, seed)
+	if err != nil {
+		return nil, err
+	}
+
+	// Keep producing permutations until we fill our result
+	for {
+		perm := rand.Perm(len(inputElements))
+
+		for _, i := range perm {
+			resultElements = append(resultElements, inputElements[i])
+
+			if int64(len(resultElements)) >= resultCount {
+				return resultElements, shuffleRandErr(rand)
+			}
+		}
+	}
+}
+
+// sampleElements returns resultCount elements chosen in the same way as
+// shuffleElements, except that the elements chosen from each permutation keep
+// their relative order in the input, so that a result count smaller than the
+// number of input elements selects a random subset of the input in order.
+func sampleElements(entropy *random.Source, inputElements []attr.Value, resultCount int64, seed string) ([]attr.Value, error) {
+	resultElements := make([]attr.Value, 0, max(resultCount, 0))
+
+	if resultCount <= 0 || len(inputElements) == 0 {
+		return resultElements, nil
+	}
+
+	rand, err := newShuffleRand(entropy, seed)
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		perm := rand.Perm(len(inputElements))
+		chosen := perm[:min(int64(len(perm)), resultCount-int64(len(resultElements)))]
+
+		sort.Ints(chosen)
+
+		for _, i := range chosen {
+			resultElements = append(resultElements, inputElements[i])
+		}
+
+		if int64(len(resultElements)) >= resultCount {
+			return resultElements, shuffleRandErr(rand)
+		}
+	}
+}
+
+// shuffleMapKeys returns the keys of the map in sorted order, so that the same
+// keys are shuffled in the same way for the same seed.
+func shuffleMapKeys(inputMap types.Map) []attr.Value {
+	keys := make([]string, 0, len(inputMap.Elements()))
+
+	for key := range inputMap.Elements() {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	keyElements := make([]attr.Value, len(keys))
+
+	for i, key := range keys {
+		keyElements[i] = types.StringValue(key)
+	}
+
+	return keyElements
+}
+
+// shuffleMapValues returns the values of the map for each of the keys, in the
+// order of the keys.
+func shuffleMapValues(inputMap types.Map, keys []attr.Value) []attr.Value {
+	values := make([]attr.Value, len(keys))
+
+	for i, key := range keys {
+		values[i] = inputMap.Elements()[key.(types.String).ValueString()]
+	}
+
+	return values
+}
+
+// shuffleObjects returns the elements of input_objects, which may be any list
+// or tuple, and false if it is neither.
+func shuffleObjects(inputObjects types.Dynamic) ([]attr.Value, bool) {
+	switch value := inputObjects.UnderlyingValue().(type) {
+	case types.List:
+		return value.Elements(), true
+	case types.Tuple:
+		return value.Elements(), true
+	default:
+		return nil, false
+	}
+}
+
+// shuffleObjectIndexes returns the indexes of the elements of input_objects as
+// strings, which are shuffled in place of the elements themselves, as elements
+// of any type cannot be held in the list of strings of result.
+func shuffleObjectIndexes(inputObjects types.Dynamic) []attr.Value {
+	objects, _ := shuffleObjects(inputObjects)
+
+	indexes := make([]attr.Value, len(objects))
+
+	for i := range objects {
+		indexes[i] = types.StringValue(strconv.Itoa(i))
+	}
+
+	return indexes
+}
+
+// shuffleObjectValues returns a tuple of the elements of input_objects at each
+// of the indexes, in the order of the indexes. A tuple is returned even if the
+// input is a list, so that the type of the result does not depend on how the
+// input was constructed.
+func shuffleObjectValues(ctx context.Context, inputObjects types.Dynamic, indexes []attr.Value) (types.Dynamic, diag.Diagnostics) {
+	objects, _ := shuffleObjects(inputObjects)
+
+	elementTypes := make([]attr.Type, len(indexes))
+	elements := make([]attr.Value, len(indexes))
+
+	for i, index := range indexes {
+		position, err := strconv.Atoi(index.(types.String).ValueString())
+		if err != nil {
+			var diags diag.Diagnostics
+
+			diags.AddError(
+				"Shuffle Error",
+				"While attempting to choose the elements of input_objects, an unexpected error occurred. "+
+					"Please retry the operation or report this issue to the provider developers.\n\n"+
+					fmt.Sprintf("Original Error: %s", err),
+			)
+
+			return types.DynamicNull(), diags
+		}
+
+		elementTypes[i] = objects[position].Type(ctx)
+		elements[i] = objects[position]
+	}
+
+	tuple, diags := types.TupleValue(elementTypes, elements)
+
+	return types.DynamicValue(tuple), diags
+}
+
+// shuffleResultMap returns the position in the result of each input element,
+// keyed by the index of the element in the input, or by the element itself if
+// byValue is true, as the keys of input_map are distinct. Elements which are
+// repeated in the result are mapped to their first position, and elements not
+// chosen for the result are omitted.
+//
+// The result is made up of successive permutations of the input, so each
+// element of the result is attributed to the first input element with the same
+// value not already attributed within the same permutation. Input elements
+// with the same value are indistinguishable in the result, so this is
+// equivalent to the permutation which was generated.
+func shuffleResultMap(inputElements, resultElements []attr.Value, byValue bool) types.Map {
+	positions := make(map[string]attr.Value, len(inputElements))
+
+	for start := 0; start < len(resultElements); start += len(inputElements) {
+		used := make([]bool, len(inputElements))
+
+		for position := start; position < min(start+len(inputElements), len(resultElements)); position++ {
+			for i, element := range inputElements {
+				if used[i] || !element.Equal(resultElements[position]) {
+					continue
+				}
+
+				used[i] = true
+
+				key := strconv.Itoa(i)
+
+				if byValue {
+					key = element.(types.String).ValueString()
+				}
+
+				if _, ok := positions[key]; !ok {
+					positions[key] = types.Int64Value(int64(position))
+				}
+
+				break
+			}
+		}
+	}
+
+	return types.MapValueMust(types.Int64Type, positions)
+}
+
+// shuffleDiscarded returns the input elements which were not chosen for the
+// result, in their order in the input, given the result_map of the result.
+func shuffleDiscarded(inputElements []attr.Value, resultMap types.Map, byValue bool) types.List {
+	discarded := make([]attr.Value, 0, len(inputElements))
+
+	for i, element := range inputElements {
+		key := strconv.Itoa(i)
+
+		if byValue {
+			key = element.(types.String).ValueString()
+		}
+
+		if _, ok := resultMap.Elements()[key]; !ok {
+			discarded = append(discarded, element)
+		}
+	}
+
+	return types.ListValueMust(types.StringType, discarded)
+}
+
+// shuffleChunks splits the elements into the given number of contiguous
+// chunks, preserving their order. The sizes of the chunks differ by at most
+// one, with any larger chunks first.
+func shuffleChunks(elements []attr.Value, chunks int64) [][]attr.Value {
+	result := make([][]attr.Value, 0, chunks)
+
+	size := int64(len(elements)) / chunks
+	remainder := int64(len(elements)) % chunks
+
+	var start int64
+
+	for i := int64(0); i < chunks; i++ {
+		end := start + size
+
+		if i < remainder {
+			end++
+		}
+
+		result = append(result, elements[start:end])
+		start = end
+	}
+
+	return result
+}
+
+func shuffleSchemaV1() schema.Schema {
+	return schema.Schema{
+		Version: 1,
 		Description: "The resource `random_shuffle` generates a random permutation of a list of strings " +
 			"given as an argument.",
 		Attributes: map[string]schema.Attribute{
@@ -58,31 +715,148 @@ func (r *shuffleResource) Schema(ctx context.Context, req resource.SchemaRequest
 				},
 			},
 			"input": schema.ListAttribute{
-				Description: "The list of strings to shuffle.",
+				Description: "The list of strings to shuffle. A set of strings may also be given, which is " +
+					"converted to a list in sorted order before it is shuffled. Exactly one of `input`, " +
+					"`input_map` or `input_objects` must be set.",
 				ElementType: types.StringType,
-				Required:    true,
+				Optional:    true,
 				PlanModifiers: []planmodifier.List{
 					listplanmodifier.RequiresReplace(),
 				},
+				Validators: []validator.List{
+					listvalidator.ExactlyOneOf(path.MatchRoot("input_map"), path.MatchRoot("input_objects")),
+				},
+			},
+			"input_map": schema.MapAttribute{
+				Description: "A map of strings whose keys are shuffled, as an alternative to `input`. When set, " +
+					"`result` contains the shuffled keys, and `result_values` the value of each of those keys in " +
+					"the same order, so that keys and values need not be shuffled separately.",
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"input_objects": schema.DynamicAttribute{
+				Description: "A list of values of any type, such as objects describing subnets, to shuffle, " +
+					"as an alternative to `input`. When set, `result` contains the index in `input_objects` of " +
+					"each chosen element, as a string, and `result_objects` the chosen elements themselves in " +
+					"the same order, so that the elements need not be looked up by index.",
+				Optional: true,
+				PlanModifiers: []planmodifier.Dynamic{
+					dynamicplanmodifier.RequiresReplace(),
+				},
 			},
 			"result_count": schema.Int64Attribute{
 				Description: "The number of results to return. Defaults to the number of items in the " +
 					"`input` list. If fewer items are requested, some elements will be excluded from the " +
 					"result. If more items are requested, items will be repeated in the result but not more " +
-					"frequently than the number of items in the input list.",
+					"frequently than the number of items in the input list. If the input list is empty, the " +
+					"result is always empty. The minimum value is 0.",
 				Optional: true,
 				PlanModifiers: []planmodifier.Int64{
 					int64planmodifier.RequiresReplace(),
 				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"chunks": schema.Int64Attribute{
+				Description: "The number of groups to split the result into, such as to divide a list of hosts " +
+					"into maintenance batches. When set, `result_chunks` contains the elements of `result` in " +
+					"order, split into this many contiguous groups whose sizes differ by at most one. Must not " +
+					"exceed the number of elements in `result`.",
+				Optional: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
 			},
+			"preserve_order": schema.BoolAttribute{
+				Description: "Keep the elements of `result` in the same relative order as in `input`, so that " +
+					"when `result_count` is less than the number of elements in `input`, `result` is a random " +
+					"subset of `input` rather than a random permutation. When `result_count` exceeds the number " +
+					"of elements in `input`, each repetition of the input elements is in order. Default value " +
+					"is `false`.",
+				Optional: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"allow_duplicates": schema.BoolAttribute{
+				Description: "Allow `result_count` to exceed the number of elements in the input, in which case " +
+					"elements are repeated in `result`. When `false`, `result_count` must be between 1 and the " +
+					"number of elements in the input. When not set, a `result_count` exceeding the number of " +
+					"elements in the input returns a warning, and elements are repeated. Changing this value " +
+					"does not replace the resource.",
+				Optional: true,
+			},
+			"permutations":      shufflePermutationsAttribute(),
+			"algorithm_version": algorithmVersionAttribute(shuffleAlgorithmVersion),
 			"result": schema.ListAttribute{
-				Description: "Random permutation of the list of strings given in `input`. The number of elements is determined by `result_count` if set, or the number of elements in `input`.",
+				Description: "Random permutation of the list of strings given in `input`, of the keys of `input_map`, or of the indexes of `input_objects`. The number of elements is determined by `result_count` if set, or the number of elements in `input`, `input_map` or `input_objects`.",
+				ElementType: types.StringType,
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"results": shuffleResultsAttribute(),
+			"result_map": schema.MapAttribute{
+				Description: "The position in `result` of each element of the input, keyed by the index of the " +
+					"element in `input` or `input_objects`, or by the key of each element of `input_map`, so that " +
+					"the permutation can be inverted without a `for` expression. Elements which are repeated in " +
+					"`result` are mapped to their first position, and elements which are not in `result` are " +
+					"omitted.",
+				ElementType: types.Int64Type,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"result_values": schema.ListAttribute{
+				Description: "The values of `input_map` for each of the keys in `result`, in the same order. " +
+					"Null if `input_map` is not set.",
+				ElementType: types.StringType,
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifiers.UseStateForUnknownIncludingNull(),
+				},
+			},
+			"result_objects": schema.DynamicAttribute{
+				Description: "The elements of `input_objects` for each of the indexes in `result`, in the same " +
+					"order, as a tuple. Null if `input_objects` is not set.",
+				Computed: true,
+				PlanModifiers: []planmodifier.Dynamic{
+					dynamicplanmodifiers.UseStateForUnknownIncludingNull(),
+				},
+			},
+			"discarded": schema.ListAttribute{
+				Description: "The elements of `input`, the keys of `input_map` or the indexes of " +
+					"`input_objects`, which were not chosen for `result`, in their order in the input, so that the complement of the result need not be " +
+					"computed with a `for` expression. Empty if every element was chosen.",
 				ElementType: types.StringType,
 				Computed:    true,
 				PlanModifiers: []planmodifier.List{
 					listplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"result_chunks": schema.ListAttribute{
+				Description: "The elements of `result` split into the number of groups given in `chunks`. " +
+					"Null if `chunks` is not set.",
+				ElementType: shuffleResultChunksType,
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifiers.UseStateForUnknownIncludingNull(),
+				},
+			},
+			"keepers_hash":             keepersHashAttribute(),
+			"created_at":               createdAtAttribute(),
+			"provider_version":         providerVersionAttribute(),
+			"lifecycle_guard":          lifecycleGuardAttribute(),
+			"allow_regeneration_token": allowRegenerationTokenAttribute(),
 			"id": schema.StringAttribute{
 				Description: "A static value used internally by Terraform, this should not be referenced in configurations.",
 				Computed:    true,
@@ -94,97 +868,88 @@ func (r *shuffleResource) Schema(ctx context.Context, req resource.SchemaRequest
 	}
 }
 
-func (r *shuffleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data shuffleModelV0
-
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
-
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	// Legacy identifier attribute that is hardcoded. This is not necessary
-	// after Terraform 0.12, but left for compatibility reasons. The attribute
-	// could be removed in a future major version of the provider.
-	data.ID = types.StringValue("-")
-
-	inputElements := data.Input.Elements()
-
-	var resultCount int64
-
-	if !data.ResultCount.IsNull() {
-		resultCount = data.ResultCount.ValueInt64()
-	} else {
-		resultCount = int64(len(inputElements))
-	}
-
-	// If the practitioner explicitly chose a result count of zero or the input
-	// had no elements, immediately return with an empty list for the result.
-	if resultCount == 0 || len(inputElements) == 0 {
-		data.Result = types.ListValueMust(types.StringType, []attr.Value{})
-
-		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
-
-		return
-	}
-
-	rand := random.NewRand(data.Seed.ValueString())
-	resultElements := make([]attr.Value, 0, resultCount)
-
-	// Keep producing permutations until we fill our result
-Batches:
-	for {
-		perm := rand.Perm(len(inputElements))
-
-		for _, i := range perm {
-			resultElements = append(resultElements, inputElements[i])
-
-			if int64(len(resultElements)) >= resultCount {
-				break Batches
-			}
-		}
-	}
-
-	result, diags := types.ListValue(types.StringType, resultElements)
-
-	resp.Diagnostics.Append(diags...)
-
-	if resp.Diagnostics.HasError() {
-		return
+func shuffleSchemaV0() schema.Schema {
+	return schema.Schema{
+		Description: "The resource `random_shuffle` generates a random permutation of a list of strings " +
+			"given as an argument.",
+		Attributes: map[string]schema.Attribute{
+			"keepers": schema.MapAttribute{
+				Description: "Arbitrary map of values that, when changed, will trigger recreation of " +
+					"resource. See [the main provider documentation](../index.html) for more information.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"seed": schema.StringAttribute{
+				Description: "Arbitrary string with which to seed the random number generator, in order to " +
+					"produce less-volatile permutations of the list.\n" +
+					"\n" +
+					"**Important:** Even with an identical seed, it is not guaranteed that the same permutation " +
+					"will be produced across different versions of Terraform. This argument causes the " +
+					"result to be *less volatile*, but not fixed for all time.",
+				Optional: true,
+			},
+			"input": schema.ListAttribute{
+				Description: "The list of strings to shuffle.",
+				ElementType: types.StringType,
+				Required:    true,
+			},
+			"result_count": schema.Int64Attribute{
+				Description: "The number of results to return. Defaults to the number of items in the " +
+					"`input` list. If fewer items are requested, some elements will be excluded from the " +
+					"result. If more items are requested, items will be repeated in the result but not more " +
+					"frequently than the number of items in the input list.",
+				Optional: true,
+			},
+			"result": schema.ListAttribute{
+				Description: "Random permutation of the list of strings given in `input`. The number of elements is determined by `result_count` if set, or the number of elements in `input`.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"id": schema.StringAttribute{
+				Description: "A static value used internally by Terraform, this should not be referenced in configurations.",
+				Computed:    true,
+			},
+		},
 	}
-
-	data.Result = result
-
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-// Read does not need to perform any operations as the state in ReadResourceResponse is already populated.
-func (r *shuffleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+type shuffleModelV1 struct {
+	ID                     types.String  `tfsdk:"id"`
+	Keepers                types.Map     `tfsdk:"keepers"`
+	KeepersHash            types.String  `tfsdk:"keepers_hash"`
+	Seed                   types.String  `tfsdk:"seed"`
+	Input                  types.List    `tfsdk:"input"`
+	InputMap               types.Map     `tfsdk:"input_map"`
+	InputObjects           types.Dynamic `tfsdk:"input_objects"`
+	ResultCount            types.Int64   `tfsdk:"result_count"`
+	Chunks                 types.Int64   `tfsdk:"chunks"`
+	Permutations           types.Int64   `tfsdk:"permutations"`
+	PreserveOrder          types.Bool    `tfsdk:"preserve_order"`
+	Result                 types.List    `tfsdk:"result"`
+	Results                types.List    `tfsdk:"results"`
+	ResultMap              types.Map     `tfsdk:"result_map"`
+	ResultValues           types.List    `tfsdk:"result_values"`
+	ResultObjects          types.Dynamic `tfsdk:"result_objects"`
+	ResultChunks           types.List    `tfsdk:"result_chunks"`
+	Discarded              types.List    `tfsdk:"discarded"`
+	AllowDuplicates        types.Bool    `tfsdk:"allow_duplicates"`
+	AlgorithmVersion       types.Int64   `tfsdk:"algorithm_version"`
+	CreatedAt              types.String  `tfsdk:"created_at"`
+	ProviderVersion        types.String  `tfsdk:"provider_version"`
+	LifecycleGuard         types.Bool    `tfsdk:"lifecycle_guard"`
+	AllowRegenerationToken types.String  `tfsdk:"allow_regeneration_token"`
 }
 
-// Update ensures the plan value is copied to the state to complete the update.
-func (r *shuffleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var model shuffleModelV0
-
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
-
-	if resp.Diagnostics.HasError() {
-		return
+// inputElements returns the elements of input, the keys of input_map in sorted
+// order, or the indexes of input_objects.
+func (m shuffleModelV1) inputElements() []attr.Value {
+	if !m.InputMap.IsNull() {
+		return shuffleMapKeys(m.InputMap)
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
-}
-
-// Delete does not need to explicitly call resp.State.RemoveResource() as this is automatically handled by the
-// [framework](https://github.com/hashicorp/terraform-plugin-framework/pull/301).
-func (r *shuffleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-}
+	if !m.InputObjects.IsNull() {
+		return shuffleObjectIndexes(m.InputObjects)
+	}
 
-type shuffleModelV0 struct {
-	ID          types.String `tfsdk:"id"`
-	Keepers     types.Map    `tfsdk:"keepers"`
-	Seed        types.String `tfsdk:"seed"`
-	Input       types.List   `tfsdk:"input"`
-	ResultCount types.Int64  `tfsdk:"result_count"`
-	Result      types.List   `tfsdk:"result"`
+	return m.Input.Elements()
 }
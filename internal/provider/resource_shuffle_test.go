@@ -4,15 +4,366 @@
 package provider
 
 import (
+	"context"
+	"fmt"
+	"math/big"
+	"math/rand"
+	"regexp"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/compare"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	"github.com/terraform-providers/terraform-provider-random/internal/random"
+	"github.com/terraform-providers/terraform-provider-random/internal/randomtest"
 )
 
+// TestShuffleElements_Distribution guards against bias in the position of
+// each element in the generated permutations.
+func TestShuffleElements_Distribution(t *testing.T) {
+	t.Parallel()
+
+	entropy := random.NewSource(rand.New(rand.NewSource(1)))
+	input := []attr.Value{
+		types.StringValue("a"),
+		types.StringValue("b"),
+		types.StringValue("c"),
+		types.StringValue("d"),
+	}
+
+	observed := make([]int, len(input))
+
+	for i := 0; i < 4000; i++ {
+		result, err := shuffleElements(entropy, input, int64(len(input)), "")
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		for position, element := range result {
+			if element.Equal(types.StringValue("a")) {
+				observed[position]++
+			}
+		}
+	}
+
+	if err := randomtest.ChiSquaredUniform(observed); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSampleElements(t *testing.T) {
+	t.Parallel()
+
+	input := []attr.Value{
+		types.StringValue("a"),
+		types.StringValue("b"),
+		types.StringValue("c"),
+		types.StringValue("d"),
+		types.StringValue("e"),
+	}
+
+	t.Run("order", func(t *testing.T) {
+		t.Parallel()
+
+		entropy := random.NewSource(rand.New(rand.NewSource(1)))
+
+		for i := 0; i < 100; i++ {
+			result, err := sampleElements(entropy, input, 3, "")
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if len(result) != 3 {
+				t.Fatalf("expected 3 elements, got: %v", result)
+			}
+
+			for j := 1; j < len(result); j++ {
+				if result[j-1].(types.String).ValueString() >= result[j].(types.String).ValueString() {
+					t.Fatalf("expected elements in input order, got: %v", result)
+				}
+			}
+		}
+	})
+
+	t.Run("repeated", func(t *testing.T) {
+		t.Parallel()
+
+		entropy := random.NewSource(rand.New(rand.NewSource(2)))
+		result, err := sampleElements(entropy, input, 7, "")
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if len(result) != 7 {
+			t.Fatalf("expected 7 elements, got: %v", result)
+		}
+
+		for j, element := range result[:len(input)] {
+			if !element.Equal(input[j]) {
+				t.Fatalf("expected the first repetition to equal the input, got: %v", result)
+			}
+		}
+	})
+
+	t.Run("distribution", func(t *testing.T) {
+		t.Parallel()
+
+		entropy := random.NewSource(rand.New(rand.NewSource(3)))
+		observed := make([]int, len(input))
+
+		for i := 0; i < 5000; i++ {
+			result, err := sampleElements(entropy, input, 2, "")
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			for _, element := range result {
+				for j := range input {
+					if element.Equal(input[j]) {
+						observed[j]++
+					}
+				}
+			}
+		}
+
+		if err := randomtest.ChiSquaredUniform(observed); err != nil {
+			t.Error(err)
+		}
+	})
+}
+
+func TestShuffleResultMap(t *testing.T) {
+	t.Parallel()
+
+	stringValues := func(values ...string) []attr.Value {
+		elements := make([]attr.Value, len(values))
+
+		for i, value := range values {
+			elements[i] = types.StringValue(value)
+		}
+
+		return elements
+	}
+
+	positions := func(positions map[string]int64) types.Map {
+		elements := make(map[string]attr.Value, len(positions))
+
+		for key, position := range positions {
+			elements[key] = types.Int64Value(position)
+		}
+
+		return types.MapValueMust(types.Int64Type, elements)
+	}
+
+	testCases := map[string]struct {
+		input    []attr.Value
+		result   []attr.Value
+		byValue  bool
+		expected types.Map
+	}{
+		"permutation": {
+			input:    stringValues("a", "b", "c"),
+			result:   stringValues("c", "a", "b"),
+			expected: positions(map[string]int64{"0": 1, "1": 2, "2": 0}),
+		},
+		"by-value": {
+			input:    stringValues("a", "b", "c"),
+			result:   stringValues("c", "a", "b"),
+			byValue:  true,
+			expected: positions(map[string]int64{"a": 1, "b": 2, "c": 0}),
+		},
+		"duplicates": {
+			input:    stringValues("a", "b", "a"),
+			result:   stringValues("a", "b", "a"),
+			expected: positions(map[string]int64{"0": 0, "1": 1, "2": 2}),
+		},
+		"shorter": {
+			input:    stringValues("a", "b", "c"),
+			result:   stringValues("b"),
+			expected: positions(map[string]int64{"1": 0}),
+		},
+		"longer": {
+			input:    stringValues("a", "b"),
+			result:   stringValues("b", "a", "a", "b", "b"),
+			expected: positions(map[string]int64{"0": 1, "1": 0}),
+		},
+		"empty": {
+			input:    stringValues(),
+			result:   stringValues(),
+			expected: positions(map[string]int64{}),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := shuffleResultMap(testCase.input, testCase.result, testCase.byValue)
+
+			if !got.Equal(testCase.expected) {
+				t.Errorf("expected %s, got: %s", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestShuffleDiscarded(t *testing.T) {
+	t.Parallel()
+
+	stringValues := func(values ...string) []attr.Value {
+		elements := make([]attr.Value, len(values))
+
+		for i, value := range values {
+			elements[i] = types.StringValue(value)
+		}
+
+		return elements
+	}
+
+	testCases := map[string]struct {
+		input    []attr.Value
+		result   []attr.Value
+		byValue  bool
+		expected []attr.Value
+	}{
+		"permutation": {
+			input:    stringValues("a", "b", "c"),
+			result:   stringValues("c", "a", "b"),
+			expected: stringValues(),
+		},
+		"shorter": {
+			input:    stringValues("a", "b", "c", "d"),
+			result:   stringValues("d", "b"),
+			expected: stringValues("a", "c"),
+		},
+		"by-value": {
+			input:    stringValues("a", "b", "c"),
+			result:   stringValues("b"),
+			byValue:  true,
+			expected: stringValues("a", "c"),
+		},
+		"duplicates": {
+			input:    stringValues("a", "b", "a"),
+			result:   stringValues("a"),
+			expected: stringValues("b", "a"),
+		},
+		"longer": {
+			input:    stringValues("a", "b"),
+			result:   stringValues("b", "a", "a"),
+			expected: stringValues(),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resultMap := shuffleResultMap(testCase.input, testCase.result, testCase.byValue)
+			expected := types.ListValueMust(types.StringType, testCase.expected)
+
+			got := shuffleDiscarded(testCase.input, resultMap, testCase.byValue)
+
+			if !got.Equal(expected) {
+				t.Errorf("expected %s, got: %s", expected, got)
+			}
+		})
+	}
+}
+
+func TestShuffleObjectValues(t *testing.T) {
+	t.Parallel()
+
+	subnet := func(name string) attr.Value {
+		return types.ObjectValueMust(
+			map[string]attr.Type{"name": types.StringType},
+			map[string]attr.Value{"name": types.StringValue(name)},
+		)
+	}
+
+	testCases := map[string]struct {
+		input    types.Dynamic
+		indexes  []attr.Value
+		expected types.Dynamic
+	}{
+		"list": {
+			input: types.DynamicValue(types.ListValueMust(types.StringType, []attr.Value{
+				types.StringValue("a"), types.StringValue("b"), types.StringValue("c"),
+			})),
+			indexes: []attr.Value{types.StringValue("2"), types.StringValue("0")},
+			expected: types.DynamicValue(types.TupleValueMust(
+				[]attr.Type{types.StringType, types.StringType},
+				[]attr.Value{types.StringValue("c"), types.StringValue("a")},
+			)),
+		},
+		"tuple": {
+			input: types.DynamicValue(types.TupleValueMust(
+				[]attr.Type{subnet("a").Type(context.Background()), types.NumberType},
+				[]attr.Value{subnet("a"), types.NumberValue(big.NewFloat(1))},
+			)),
+			indexes: []attr.Value{types.StringValue("1"), types.StringValue("0"), types.StringValue("1")},
+			expected: types.DynamicValue(types.TupleValueMust(
+				[]attr.Type{types.NumberType, subnet("a").Type(context.Background()), types.NumberType},
+				[]attr.Value{types.NumberValue(big.NewFloat(1)), subnet("a"), types.NumberValue(big.NewFloat(1))},
+			)),
+		},
+		"empty": {
+			input:    types.DynamicValue(types.TupleValueMust([]attr.Type{}, []attr.Value{})),
+			indexes:  []attr.Value{},
+			expected: types.DynamicValue(types.TupleValueMust([]attr.Type{}, []attr.Value{})),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := shuffleObjectValues(context.Background(), testCase.input, testCase.indexes)
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			if !got.Equal(testCase.expected) {
+				t.Errorf("expected %s, got: %s", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestAccResourceShuffle_PreserveOrder(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_shuffle" "test" {
+							input          = ["a", "b", "c", "d", "e"]
+							result_count   = 5
+							preserve_order = true
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_shuffle.test", tfjsonpath.New("result"),
+						knownvalue.ListExact(
+							[]knownvalue.Check{
+								knownvalue.StringExact("a"),
+								knownvalue.StringExact("b"),
+								knownvalue.StringExact("c"),
+								knownvalue.StringExact("d"),
+								knownvalue.StringExact("e"),
+							},
+						),
+					),
+				},
+			},
+		},
+	})
+}
+
 // These results are current as of Go 1.6. The Go
 // "rand" package does not guarantee that the random
 // number generator will generate the same results
@@ -24,7 +375,7 @@ import (
 // guaranteed consistent across Terraform releases.
 func TestAccResourceShuffle(t *testing.T) {
 	resource.UnitTest(t, resource.TestCase{
-		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: `resource "random_shuffle" "default_length" {
@@ -56,7 +407,7 @@ func TestAccResourceShuffle_Keepers_Keep_EmptyMap(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_shuffle" "test" {
 					input = ["a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k"]
 					keepers = {}
@@ -67,7 +418,7 @@ func TestAccResourceShuffle_Keepers_Keep_EmptyMap(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_shuffle" "test" {
 					input = ["a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k"]
 					keepers = {}
@@ -88,7 +439,7 @@ func TestAccResourceShuffle_Keepers_Keep_EmptyMapToNullValue(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_shuffle" "test" {
 					input = ["a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k"]
 					keepers = {}
@@ -99,7 +450,7 @@ func TestAccResourceShuffle_Keepers_Keep_EmptyMapToNullValue(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_shuffle" "test" {
 					input = ["a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k"]
 					keepers = {
@@ -122,7 +473,7 @@ func TestAccResourceShuffle_Keepers_Keep_NullMap(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_shuffle" "test" {
 					input = ["a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k"]
 				}`,
@@ -132,7 +483,7 @@ func TestAccResourceShuffle_Keepers_Keep_NullMap(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_shuffle" "test" {
 					input = ["a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k"]
 				}`,
@@ -152,7 +503,7 @@ func TestAccResourceShuffle_Keepers_Keep_NullMapToNullValue(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_shuffle" "test" {
 					input = ["a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k"]
 				}`,
@@ -162,7 +513,7 @@ func TestAccResourceShuffle_Keepers_Keep_NullMapToNullValue(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_shuffle" "test" {
 					input = ["a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k"]
 					keepers = {
@@ -185,7 +536,7 @@ func TestAccResourceShuffle_Keepers_Keep_NullValue(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_shuffle" "test" {
 					input = ["a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k"]
 					keepers = {
@@ -198,7 +549,7 @@ func TestAccResourceShuffle_Keepers_Keep_NullValue(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_shuffle" "test" {
 					input = ["a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k"]
 					keepers = {
@@ -221,7 +572,7 @@ func TestAccResourceShuffle_Keepers_Keep_NullValues(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_shuffle" "test" {
 					input = ["a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k"]
 					keepers = {
@@ -235,7 +586,7 @@ func TestAccResourceShuffle_Keepers_Keep_NullValues(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_shuffle" "test" {
 					input = ["a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k"]
 					keepers = {
@@ -259,7 +610,7 @@ func TestAccResourceShuffle_Keepers_Keep_Value(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_shuffle" "test" {
 					input = ["a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k"]
 					keepers = {
@@ -272,7 +623,7 @@ func TestAccResourceShuffle_Keepers_Keep_Value(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_shuffle" "test" {
 					input = ["a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k"]
 					keepers = {
@@ -295,7 +646,7 @@ func TestAccResourceShuffle_Keepers_Keep_Values(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_shuffle" "test" {
 					input = ["a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k"]
 					keepers = {
@@ -309,7 +660,7 @@ func TestAccResourceShuffle_Keepers_Keep_Values(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_shuffle" "test" {
 					input = ["a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k"]
 					keepers = {
@@ -333,7 +684,7 @@ func TestAccResourceShuffle_Keepers_Replace_EmptyMapToValue(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_shuffle" "test" {
 					input = ["a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k"]
 					keepers = {}
@@ -344,7 +695,7 @@ func TestAccResourceShuffle_Keepers_Replace_EmptyMapToValue(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_shuffle" "test" {
 					input = ["a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k"]
 					keepers = {
@@ -367,7 +718,7 @@ func TestAccResourceShuffle_Keepers_Replace_NullMapToValue(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_shuffle" "test" {
 					input = ["a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k"]
 				}`,
@@ -377,7 +728,7 @@ func TestAccResourceShuffle_Keepers_Replace_NullMapToValue(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_shuffle" "test" {
 					input = ["a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k"]
 					keepers = {
@@ -400,7 +751,7 @@ func TestAccResourceShuffle_Keepers_Replace_NullValueToValue(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_shuffle" "test" {
 					input = ["a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k"]
 					keepers = {
@@ -413,7 +764,7 @@ func TestAccResourceShuffle_Keepers_Replace_NullValueToValue(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_shuffle" "test" {
 					input = ["a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k"]
 					keepers = {
@@ -436,7 +787,7 @@ func TestAccResourceShuffle_Keepers_Replace_ValueToEmptyMap(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_shuffle" "test" {
 					input = ["a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k"]
 					keepers = {
@@ -449,7 +800,7 @@ func TestAccResourceShuffle_Keepers_Replace_ValueToEmptyMap(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_shuffle" "test" {
 					input = ["a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k"]
 					keepers = {}
@@ -470,7 +821,7 @@ func TestAccResourceShuffle_Keepers_Replace_ValueToNullMap(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_shuffle" "test" {
 					input = ["a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k"]
 					keepers = {
@@ -483,7 +834,7 @@ func TestAccResourceShuffle_Keepers_Replace_ValueToNullMap(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_shuffle" "test" {
 					input = ["a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k"]
 				}`,
@@ -503,7 +854,7 @@ func TestAccResourceShuffle_Keepers_Replace_ValueToNullValue(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_shuffle" "test" {
 					input = ["a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k"]
 					keepers = {
@@ -516,7 +867,7 @@ func TestAccResourceShuffle_Keepers_Replace_ValueToNullValue(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_shuffle" "test" {
 					input = ["a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k"]
 					keepers = {
@@ -539,7 +890,7 @@ func TestAccResourceShuffle_Keepers_Replace_ValueToNewValue(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_shuffle" "test" {
 					input = ["a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k"]
 					keepers = {
@@ -552,7 +903,7 @@ func TestAccResourceShuffle_Keepers_Replace_ValueToNewValue(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_shuffle" "test" {
 					input = ["a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k"]
 					keepers = {
@@ -588,7 +939,7 @@ func TestAccResourceShuffle_Keepers_FrameworkMigration_NullMapToNullValue(t *tes
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_shuffle" "test" {
 					input = ["a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k"]
 					keepers = {
@@ -624,7 +975,7 @@ func TestAccResourceShuffle_Keepers_FrameworkMigration_NullMapToValue(t *testing
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_shuffle" "test" {
 					input = ["a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k"]
 					keepers = {
@@ -661,7 +1012,7 @@ func TestAccResourceShuffle_Keepers_FrameworkMigration_NullMapToMultipleNullValu
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_shuffle" "test" {
 					input = ["a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k"]
 					keepers = {
@@ -699,7 +1050,7 @@ func TestAccResourceShuffle_Keepers_FrameworkMigration_NullMapToMultipleValue(t
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_shuffle" "test" {
 					input = ["a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k"]
 					keepers = {
@@ -737,7 +1088,7 @@ func TestAccResourceShuffle_Keepers_FrameworkMigration_NullMapValue(t *testing.T
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_shuffle" "test" {
 					input = ["a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k"]
 					keepers = {
@@ -775,7 +1126,7 @@ func TestAccResourceShuffle_Keepers_FrameworkMigration_NullMapValueToValue(t *te
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_shuffle" "test" {
 					input = ["a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k"]
 					keepers = {
@@ -797,7 +1148,7 @@ func TestAccResourceShuffle_ResultCount_Zero(t *testing.T) {
 	t.Parallel()
 
 	resource.UnitTest(t, resource.TestCase{
-		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: `resource "random_shuffle" "test" {
@@ -814,7 +1165,7 @@ func TestAccResourceShuffle_ResultCount_Zero(t *testing.T) {
 
 func TestAccResourceShuffle_ResultCount_Shorter(t *testing.T) {
 	resource.UnitTest(t, resource.TestCase{
-		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: `resource "random_shuffle" "shorter_length" {
@@ -840,7 +1191,7 @@ func TestAccResourceShuffle_ResultCount_Shorter(t *testing.T) {
 
 func TestAccResourceShuffle_ResultCount_Longer(t *testing.T) {
 	resource.UnitTest(t, resource.TestCase{
-		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: `resource "random_shuffle" "longer_length" {
@@ -875,7 +1226,7 @@ func TestAccResourceShuffle_ResultCount_Longer(t *testing.T) {
 
 func TestAccResourceShuffle_Input_Empty(t *testing.T) {
 	resource.UnitTest(t, resource.TestCase{
-		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: `resource "random_shuffle" "empty_length" {
@@ -893,7 +1244,7 @@ func TestAccResourceShuffle_Input_Empty(t *testing.T) {
 
 func TestAccResourceShuffle_Input_One(t *testing.T) {
 	resource.UnitTest(t, resource.TestCase{
-		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: `resource "random_shuffle" "one_length" {
@@ -915,52 +1266,187 @@ func TestAccResourceShuffle_Input_One(t *testing.T) {
 	})
 }
 
-func TestAccResourceShuffle_UpgradeFromVersion3_3_2(t *testing.T) {
-	resource.Test(t, resource.TestCase{
+func TestAccResourceShuffle_ResultCount_Invalid(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
 		Steps: []resource.TestStep{
 			{
-				ExternalProviders: providerVersion332(),
-				Config: `resource "random_shuffle" "default_length" {
-    						input = ["a", "b", "c", "d", "e"]
-    						seed = "-"
+				Config: `resource "random_shuffle" "test" {
+    						input        = ["a", "b", "c", "d", "e"]
+    						result_count = -1
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute result_count value must be at least 0, got: -1`),
+			},
+		},
+	})
+}
+
+func TestAccResourceShuffle_Discarded(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_shuffle" "test" {
+							input        = ["a", "b", "c", "d", "e"]
+							seed         = "-"
+							result_count = 3
 						}`,
 				ConfigStateChecks: []statecheck.StateCheck{
-					statecheck.ExpectKnownValue("random_shuffle.default_length", tfjsonpath.New("result"),
+					statecheck.ExpectKnownValue("random_shuffle.test", tfjsonpath.New("discarded"),
 						knownvalue.ListExact(
 							[]knownvalue.Check{
-								knownvalue.StringExact("a"),
-								knownvalue.StringExact("c"),
-								knownvalue.StringExact("b"),
-								knownvalue.StringExact("e"),
 								knownvalue.StringExact("d"),
+								knownvalue.StringExact("e"),
+							},
+						),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceShuffle_AlgorithmVersion(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_shuffle" "test" {
+							input = ["a", "b", "c"]
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_shuffle.test", tfjsonpath.New("algorithm_version"), knownvalue.Int64Exact(shuffleAlgorithmVersion)),
+				},
+			},
+			{
+				// Setting the version recorded in state does not replace the resource.
+				Config: fmt.Sprintf(`resource "random_shuffle" "test" {
+							input             = ["a", "b", "c"]
+							algorithm_version = %d
+						}`, shuffleAlgorithmVersion),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("random_shuffle.test", plancheck.ResourceActionNoop),
+					},
+				},
+			},
+			{
+				Config: fmt.Sprintf(`resource "random_shuffle" "test" {
+							input             = ["a", "b", "c"]
+							algorithm_version = %d
+						}`, shuffleAlgorithmVersion+1),
+				ExpectError: regexp.MustCompile(`Attribute algorithm_version value must be between 1 and`),
+			},
+		},
+	})
+}
+
+func TestAccResourceShuffle_AllowDuplicates(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_shuffle" "test" {
+							input            = ["a", "b"]
+							result_count     = 5
+							allow_duplicates = true
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_shuffle.test", tfjsonpath.New("result"), knownvalue.ListSizeExact(5)),
+					statecheck.ExpectKnownValue("random_shuffle.test", tfjsonpath.New("discarded"), knownvalue.ListSizeExact(0)),
+				},
+			},
+			{
+				Config: `resource "random_shuffle" "test" {
+							input            = ["a", "b"]
+							result_count     = 5
+							allow_duplicates = false
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute result_count value must be between 1 and the number of elements in\s+the input \(2\) when allow_duplicates is false, got: 5`),
+			},
+			{
+				Config: `resource "random_shuffle" "test" {
+							input            = ["a", "b"]
+							result_count     = 0
+							allow_duplicates = false
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute result_count value must be between 1 and the number of elements in\s+the input \(2\) when allow_duplicates is false, got: 0`),
+			},
+		},
+	})
+}
+
+func TestAccResourceShuffle_Chunks(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_shuffle" "test" {
+    						input  = ["a", "b", "c", "d", "e"]
+    						seed   = "-"
+    						chunks = 2
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_shuffle.test", tfjsonpath.New("result_chunks"),
+						knownvalue.ListExact(
+							[]knownvalue.Check{
+								knownvalue.ListExact(
+									[]knownvalue.Check{
+										knownvalue.StringExact("a"),
+										knownvalue.StringExact("c"),
+										knownvalue.StringExact("b"),
+									},
+								),
+								knownvalue.ListExact(
+									[]knownvalue.Check{
+										knownvalue.StringExact("e"),
+										knownvalue.StringExact("d"),
+									},
+								),
 							},
 						),
 					),
 				},
 			},
+		},
+	})
+}
+
+func TestAccResourceShuffle_Chunks_Null(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
-				Config: `resource "random_shuffle" "default_length" {
+				Config: `resource "random_shuffle" "test" {
     						input = ["a", "b", "c", "d", "e"]
-    						seed = "-"
 						}`,
-				PlanOnly: true,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_shuffle.test", tfjsonpath.New("result_chunks"), knownvalue.Null()),
+				},
 			},
+		},
+	})
+}
+
+func TestAccResourceShuffle_Chunks_ResultCount(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
-				Config: `resource "random_shuffle" "default_length" {
-    						input = ["a", "b", "c", "d", "e"]
-    						seed = "-"
+				Config: `resource "random_shuffle" "test" {
+    						input        = ["a", "b", "c", "d", "e"]
+    						result_count = 12
+    						chunks       = 5
 						}`,
 				ConfigStateChecks: []statecheck.StateCheck{
-					statecheck.ExpectKnownValue("random_shuffle.default_length", tfjsonpath.New("result"),
+					statecheck.ExpectKnownValue("random_shuffle.test", tfjsonpath.New("result_chunks"),
 						knownvalue.ListExact(
 							[]knownvalue.Check{
-								knownvalue.StringExact("a"),
-								knownvalue.StringExact("c"),
-								knownvalue.StringExact("b"),
-								knownvalue.StringExact("e"),
-								knownvalue.StringExact("d"),
+								knownvalue.ListSizeExact(3),
+								knownvalue.ListSizeExact(3),
+								knownvalue.ListSizeExact(2),
+								knownvalue.ListSizeExact(2),
+								knownvalue.ListSizeExact(2),
 							},
 						),
 					),
@@ -969,3 +1455,568 @@ func TestAccResourceShuffle_UpgradeFromVersion3_3_2(t *testing.T) {
 		},
 	})
 }
+
+func TestAccResourceShuffle_Chunks_Invalid(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_shuffle" "test" {
+    						input  = ["a", "b", "c"]
+    						chunks = 0
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute chunks value must be at least 1, got: 0`),
+			},
+			{
+				Config: `resource "random_shuffle" "test" {
+    						input  = ["a", "b", "c"]
+    						chunks = 4
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute chunks value must not exceed the number of results \(3\)`),
+			},
+			{
+				Config: `resource "random_shuffle" "test" {
+    						input        = []
+    						result_count = 3
+    						chunks       = 1
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute chunks value must not exceed the number of results \(0\)`),
+			},
+		},
+	})
+}
+
+func TestAccResourceShuffle_Permutations(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_shuffle" "test" {
+    						input        = ["a", "b", "c", "d", "e"]
+    						result_count = 3
+    						permutations = 4
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_shuffle.test", tfjsonpath.New("results"), knownvalue.ListSizeExact(4)),
+					statecheck.ExpectKnownValue("random_shuffle.test", tfjsonpath.New("results").AtSliceIndex(3), knownvalue.ListSizeExact(3)),
+					statecheck.CompareValuePairs("random_shuffle.test", tfjsonpath.New("result"), "random_shuffle.test", tfjsonpath.New("results").AtSliceIndex(0), compare.ValuesSame()),
+				},
+			},
+			{
+				Config: `resource "random_shuffle" "test" {
+    						input        = ["a", "b", "c", "d", "e"]
+    						result_count = 3
+    						permutations = 4
+						}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				Config: `resource "random_shuffle" "test" {
+    						input        = ["a", "b", "c", "d", "e"]
+    						result_count = 3
+    						permutations = 2
+						}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("random_shuffle.test", plancheck.ResourceActionReplace),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_shuffle.test", tfjsonpath.New("results"), knownvalue.ListSizeExact(2)),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceShuffle_Permutations_Seed(t *testing.T) {
+	assertResultSame := statecheck.CompareValue(compare.ValuesSame())
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_shuffle" "test" {
+    						input = ["a", "b", "c", "d", "e"]
+    						seed  = "-"
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					assertResultSame.AddStateValue("random_shuffle.test", tfjsonpath.New("result")),
+					statecheck.ExpectKnownValue("random_shuffle.test", tfjsonpath.New("results"), knownvalue.Null()),
+				},
+			},
+			{
+				Config: `resource "random_shuffle" "test" {
+    						input        = ["a", "b", "c", "d", "e"]
+    						seed         = "-"
+    						permutations = 3
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					assertResultSame.AddStateValue("random_shuffle.test", tfjsonpath.New("result")),
+					assertResultSame.AddStateValue("random_shuffle.test", tfjsonpath.New("results").AtSliceIndex(0)),
+					statecheck.ExpectKnownValue("random_shuffle.test", tfjsonpath.New("results"), knownvalue.ListSizeExact(3)),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceShuffle_Permutations_Invalid(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_shuffle" "test" {
+    						input        = ["a", "b", "c"]
+    						permutations = 0
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute permutations value must be at least 1, got: 0`),
+			},
+		},
+	})
+}
+
+func TestAccResourceShuffle_InputSet(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_shuffle" "test" {
+    						input          = toset(["c", "a", "b"])
+    						preserve_order = true
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_shuffle.test", tfjsonpath.New("result"),
+						knownvalue.ListExact(
+							[]knownvalue.Check{
+								knownvalue.StringExact("a"),
+								knownvalue.StringExact("b"),
+								knownvalue.StringExact("c"),
+							},
+						),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceShuffle_InputMap(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_shuffle" "test" {
+    						input_map = {
+    							"e" = "E"
+    							"d" = "D"
+    							"c" = "C"
+    							"b" = "B"
+    							"a" = "A"
+    						}
+    						seed = "-"
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_shuffle.test", tfjsonpath.New("result"),
+						knownvalue.ListExact(
+							[]knownvalue.Check{
+								knownvalue.StringExact("a"),
+								knownvalue.StringExact("c"),
+								knownvalue.StringExact("b"),
+								knownvalue.StringExact("e"),
+								knownvalue.StringExact("d"),
+							},
+						),
+					),
+					statecheck.ExpectKnownValue("random_shuffle.test", tfjsonpath.New("result_values"),
+						knownvalue.ListExact(
+							[]knownvalue.Check{
+								knownvalue.StringExact("A"),
+								knownvalue.StringExact("C"),
+								knownvalue.StringExact("B"),
+								knownvalue.StringExact("E"),
+								knownvalue.StringExact("D"),
+							},
+						),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceShuffle_ResultMap(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_shuffle" "test" {
+							input = ["a", "b", "c", "d", "e"]
+							seed  = "-"
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_shuffle.test", tfjsonpath.New("result_map"),
+						knownvalue.MapExact(
+							map[string]knownvalue.Check{
+								"0": knownvalue.Int64Exact(0),
+								"1": knownvalue.Int64Exact(2),
+								"2": knownvalue.Int64Exact(1),
+								"3": knownvalue.Int64Exact(4),
+								"4": knownvalue.Int64Exact(3),
+							},
+						),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceShuffle_ResultMap_InputMap(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_shuffle" "test" {
+							input_map = {
+								"e" = "E"
+								"d" = "D"
+								"c" = "C"
+								"b" = "B"
+								"a" = "A"
+							}
+							seed = "-"
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_shuffle.test", tfjsonpath.New("result_map"),
+						knownvalue.MapExact(
+							map[string]knownvalue.Check{
+								"a": knownvalue.Int64Exact(0),
+								"b": knownvalue.Int64Exact(2),
+								"c": knownvalue.Int64Exact(1),
+								"d": knownvalue.Int64Exact(4),
+								"e": knownvalue.Int64Exact(3),
+							},
+						),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceShuffle_InputMap_ResultCount(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_shuffle" "test" {
+    						input_map = {
+    							"a" = "A"
+    							"b" = "B"
+    						}
+    						result_count = 3
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_shuffle.test", tfjsonpath.New("result"), knownvalue.ListSizeExact(3)),
+					statecheck.ExpectKnownValue("random_shuffle.test", tfjsonpath.New("result_values"), knownvalue.ListSizeExact(3)),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceShuffle_InputMap_Null(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_shuffle" "test" {
+    						input = ["a", "b", "c"]
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_shuffle.test", tfjsonpath.New("result_values"), knownvalue.Null()),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceShuffle_InputMap_Invalid(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_shuffle" "test" {
+    						result_count = 1
+						}`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				Config: `resource "random_shuffle" "test" {
+    						input     = ["a"]
+    						input_map = {
+    							"a" = "A"
+    						}
+						}`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
+
+func TestAccResourceShuffle_InputObjects(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_shuffle" "test" {
+    						input_objects = [
+    							{ name = "a", cidr = "10.0.0.0/24" },
+    							{ name = "b", cidr = "10.0.1.0/24" },
+    							{ name = "c", cidr = "10.0.2.0/24" },
+    							{ name = "d", cidr = "10.0.3.0/24" },
+    							{ name = "e", cidr = "10.0.4.0/24" },
+    						]
+    						result_count = 3
+    						seed         = "-"
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_shuffle.test", tfjsonpath.New("result"),
+						knownvalue.ListExact(
+							[]knownvalue.Check{
+								knownvalue.StringExact("0"),
+								knownvalue.StringExact("2"),
+								knownvalue.StringExact("1"),
+							},
+						),
+					),
+					statecheck.ExpectKnownValue("random_shuffle.test", tfjsonpath.New("result_objects"),
+						knownvalue.TupleExact(
+							[]knownvalue.Check{
+								knownvalue.ObjectExact(map[string]knownvalue.Check{
+									"name": knownvalue.StringExact("a"),
+									"cidr": knownvalue.StringExact("10.0.0.0/24"),
+								}),
+								knownvalue.ObjectExact(map[string]knownvalue.Check{
+									"name": knownvalue.StringExact("c"),
+									"cidr": knownvalue.StringExact("10.0.2.0/24"),
+								}),
+								knownvalue.ObjectExact(map[string]knownvalue.Check{
+									"name": knownvalue.StringExact("b"),
+									"cidr": knownvalue.StringExact("10.0.1.0/24"),
+								}),
+							},
+						),
+					),
+					statecheck.ExpectKnownValue("random_shuffle.test", tfjsonpath.New("discarded"),
+						knownvalue.ListExact(
+							[]knownvalue.Check{
+								knownvalue.StringExact("3"),
+								knownvalue.StringExact("4"),
+							},
+						),
+					),
+				},
+			},
+			{
+				// Updating an attribute which does not replace the resource keeps the chosen elements.
+				Config: `resource "random_shuffle" "test" {
+    						input_objects = [
+    							{ name = "a", cidr = "10.0.0.0/24" },
+    							{ name = "b", cidr = "10.0.1.0/24" },
+    							{ name = "c", cidr = "10.0.2.0/24" },
+    							{ name = "d", cidr = "10.0.3.0/24" },
+    							{ name = "e", cidr = "10.0.4.0/24" },
+    						]
+    						result_count     = 3
+    						seed             = "-"
+    						allow_duplicates = false
+						}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("random_shuffle.test", plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue("random_shuffle.test", tfjsonpath.New("result_objects").AtSliceIndex(0).AtMapKey("name"), knownvalue.StringExact("a")),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceShuffle_InputObjects_Null(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_shuffle" "test" {
+    						input = ["a", "b", "c"]
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_shuffle.test", tfjsonpath.New("result_objects"), knownvalue.Null()),
+				},
+			},
+			{
+				// Updating an attribute which does not replace the resource does not plan result_objects as unknown.
+				Config: `resource "random_shuffle" "test" {
+    						input            = ["a", "b", "c"]
+    						allow_duplicates = false
+						}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("random_shuffle.test", plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue("random_shuffle.test", tfjsonpath.New("result_objects"), knownvalue.Null()),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceShuffle_InputObjects_Invalid(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_shuffle" "test" {
+    						input_objects = { name = "a" }
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute input_objects value must be a list or tuple`),
+			},
+			{
+				Config: `resource "random_shuffle" "test" {
+    						input         = ["a"]
+    						input_objects = [{ name = "a" }]
+						}`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
+
+func TestAccResourceShuffle_UpgradeFromVersion3_3_2(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				ExternalProviders: providerVersion332(),
+				Config: `resource "random_shuffle" "default_length" {
+    						input = ["a", "b", "c", "d", "e"]
+    						seed = "-"
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_shuffle.default_length", tfjsonpath.New("result"),
+						knownvalue.ListExact(
+							[]knownvalue.Check{
+								knownvalue.StringExact("a"),
+								knownvalue.StringExact("c"),
+								knownvalue.StringExact("b"),
+								knownvalue.StringExact("e"),
+								knownvalue.StringExact("d"),
+							},
+						),
+					),
+				},
+			},
+			{
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
+				Config: `resource "random_shuffle" "default_length" {
+    						input = ["a", "b", "c", "d", "e"]
+    						seed = "-"
+						}`,
+				PlanOnly: true,
+			},
+			{
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
+				Config: `resource "random_shuffle" "default_length" {
+    						input = ["a", "b", "c", "d", "e"]
+    						seed = "-"
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_shuffle.default_length", tfjsonpath.New("result"),
+						knownvalue.ListExact(
+							[]knownvalue.Check{
+								knownvalue.StringExact("a"),
+								knownvalue.StringExact("c"),
+								knownvalue.StringExact("b"),
+								knownvalue.StringExact("e"),
+								knownvalue.StringExact("d"),
+							},
+						),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceShuffle_LifecycleMetadata(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_shuffle" "test" {
+							input = ["a", "b", "c"]
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_shuffle.test", tfjsonpath.New("created_at"), knownvalue.StringRegexp(regexp.MustCompile(`^\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}Z$`))),
+					statecheck.ExpectKnownValue("random_shuffle.test", tfjsonpath.New("provider_version"), knownvalue.StringExact("test")),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceShuffle_LifecycleMetadata_UpgradeFromVersion3_6_3(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				ExternalProviders: providerVersion363(),
+				Config: `resource "random_shuffle" "test" {
+							input = ["a", "b", "c"]
+						}`,
+			},
+			{
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
+				Config: `resource "random_shuffle" "test" {
+							input = ["a", "b", "c"]
+						}`,
+				PlanOnly: true,
+			},
+			{
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
+				Config: `resource "random_shuffle" "test" {
+							input = ["a", "b", "c"]
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_shuffle.test", tfjsonpath.New("created_at"), knownvalue.Null()),
+					statecheck.ExpectKnownValue("random_shuffle.test", tfjsonpath.New("provider_version"), knownvalue.Null()),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceShuffle_LifecycleGuard_InputObjects(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_shuffle" "test" {
+					input_objects   = [{ name = "a" }, { name = "b" }]
+					lifecycle_guard = true
+				}`,
+			},
+			{
+				Config: `resource "random_shuffle" "test" {
+					input_objects   = [{ name = "a" }, { name = "c" }]
+					lifecycle_guard = true
+				}`,
+				ExpectError: regexp.MustCompile(`(?s)Regeneration Prevented.*changes to input_objects`),
+			},
+		},
+	})
+}
@@ -5,48 +5,78 @@ package provider
 
 import (
 	"context"
-
-	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/terraform-providers/terraform-provider-random/internal/diagnostics"
-	boolplanmodifiers "github.com/terraform-providers/terraform-provider-random/internal/planmodifiers/bool"
-	mapplanmodifiers "github.com/terraform-providers/terraform-provider-random/internal/planmodifiers/map"
-	stringplanmodifiers "github.com/terraform-providers/terraform-provider-random/internal/planmodifiers/string"
+	"github.com/terraform-providers/terraform-provider-random/internal/encrypt"
 	"github.com/terraform-providers/terraform-provider-random/internal/random"
-	"github.com/terraform-providers/terraform-provider-random/internal/validators"
 )
 
 var (
-	_ resource.Resource                 = (*stringResource)(nil)
-	_ resource.ResourceWithImportState  = (*stringResource)(nil)
-	_ resource.ResourceWithUpgradeState = (*stringResource)(nil)
+	_ resource.Resource                   = (*stringResource)(nil)
+	_ resource.ResourceWithConfigure      = (*stringResource)(nil)
+	_ resource.ResourceWithIdentity       = (*stringResource)(nil)
+	_ resource.ResourceWithImportState    = (*stringResource)(nil)
+	_ resource.ResourceWithModifyPlan     = (*stringResource)(nil)
+	_ resource.ResourceWithUpgradeState   = (*stringResource)(nil)
+	_ resource.ResourceWithValidateConfig = (*stringResource)(nil)
 )
 
 func NewStringResource() resource.Resource {
 	return &stringResource{}
 }
 
-type stringResource struct{}
+type stringResource struct {
+	providerVersion string
+	entropy         *random.Source
+	encryptionKey   *encrypt.Key
+}
 
 func (r *stringResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_string"
+	// The identity is the result, which is updated in-place when the length
+	// is increased with grow_in_place.
+	resp.ResourceBehavior.MutableIdentity = true
 }
 
 func (r *stringResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = stringSchemaV3()
 }
 
+func (r *stringResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identitySchema("The generated random string.")
+}
+
+func (r *stringResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	r.providerVersion = providerVersion(req.ProviderData)
+	r.entropy = providerEntropy(req.ProviderData)
+	r.encryptionKey = providerEncryptionKey(req.ProviderData)
+}
+
+// ModifyPlan prevents the replacement of the resource when lifecycle_guard is
+// enabled, unless allow_regeneration_token is changed.
+func (r *stringResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	guardRegeneration(ctx, req, resp)
+}
+
 func (r *stringResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan stringModelV3
 
@@ -56,46 +86,285 @@ func (r *stringResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	params := random.StringParams{
-		Length:          plan.Length.ValueInt64(),
-		Upper:           plan.Upper.ValueBool(),
-		MinUpper:        plan.MinUpper.ValueInt64(),
-		Lower:           plan.Lower.ValueBool(),
-		MinLower:        plan.MinLower.ValueInt64(),
-		Numeric:         plan.Numeric.ValueBool(),
-		MinNumeric:      plan.MinNumeric.ValueInt64(),
-		Special:         plan.Special.ValueBool(),
-		MinSpecial:      plan.MinSpecial.ValueInt64(),
-		OverrideSpecial: plan.OverrideSpecial.ValueString(),
+	// The configuration may have contained unknown values during validation.
+	resp.Diagnostics.Append(validateLengthAtLeastMins(plan.Length, plan.mins()...)...)
+	resp.Diagnostics.Append(validateMinCharacterClasses(plan.MinCharacterClasses, plan.Length, "length", plan.classes(), plan.mins())...)
+
+	pattern, diags := mustMatchPattern(plan.MustMatch)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	result, err := random.CreateString(params)
+	done := logGeneration(ctx, randomSourceCrypto, map[string]any{
+		"length": plan.Length.ValueInt64(),
+	})
+
+	result, matched, err := createMatchingString(r.entropy, plan.params(), pattern)
+	done()
+
+	if errors.Is(err, random.ErrEntropyUnavailable) {
+		resp.Diagnostics.Append(diagnostics.EntropyUnavailableError(err)...)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.Append(diagnostics.RandomReadError(err.Error())...)
 		return
 	}
+	if !matched {
+		resp.Diagnostics.Append(mustMatchError(plan.MustMatch)...)
+		return
+	}
 
 	plan.ID = types.StringValue(string(result))
 	plan.Result = types.StringValue(string(result))
+	plan.EntropyBits = plan.entropyBits()
+	plan.Spec = plan.spec()
+
+	plan.EncryptedResult, diags = encryptResult(r.encryptionKey, r.entropy, string(result))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.CreatedAt, plan.ProviderVersion = lifecycleValues(r.providerVersion)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, plan.ID)...)
 }
 
-// Read does not need to perform any operations as the state in ReadResourceResponse is already populated.
+// Read does not need to refresh the state as the state in ReadResourceResponse is already populated, other than to
+// populate entropy_bits and keepers_hash for resources created by earlier provider versions, and encrypted_result for
+// resources created before the provider was configured with result_encryption_key. The identity is set from state, as
+// those resources also do not have an identity.
 func (r *stringResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var model stringModelV3
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	refresh := false
+
+	if model.EntropyBits.IsNull() {
+		logRefresh(ctx, "entropy_bits")
+
+		model.EntropyBits = model.entropyBits()
+		refresh = true
+	}
+
+	if model.Spec.IsNull() {
+		logRefresh(ctx, "spec")
+
+		model.Spec = model.spec()
+		refresh = true
+	}
+
+	if r.encryptionKey != nil && model.EncryptedResult.IsNull() && !model.Result.IsNull() {
+		logRefresh(ctx, "encrypted_result")
+
+		var diags diag.Diagnostics
+
+		model.EncryptedResult, diags = encryptResult(r.encryptionKey, r.entropy, model.Result.ValueString())
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		refresh = true
+	}
+
+	if refresh {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(refreshKeepersHash(ctx, &resp.State)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
-// Update ensures the plan value is copied to the state to complete the update.
+// Update ensures the plan value is copied to the state to complete the update. The result is unknown in the plan when
+// the length is increased with grow_in_place, in which case newly generated characters are appended to the prior
+// result, and encrypted_result is then also unknown. The entropy_bits value is also unknown in the plan if the state
+// was not refreshed since upgrading from an earlier provider version.
 func (r *stringResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var model stringModelV3
+	var model, state stringModelV3
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	if model.Result.IsUnknown() {
+		pattern, diags := mustMatchPattern(model.MustMatch)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		done := logGeneration(ctx, randomSourceCrypto, map[string]any{
+			"length": model.Length.ValueInt64() - int64(utf8.RuneCountInString(state.Result.ValueString())),
+		})
+
+		result, matched, err := growString(r.entropy, state.Result.ValueString(), model.params(), pattern)
+		done()
+
+		if errors.Is(err, random.ErrEntropyUnavailable) {
+			resp.Diagnostics.Append(diagnostics.EntropyUnavailableError(err)...)
+			return
+		}
+		if err != nil {
+			resp.Diagnostics.Append(diagnostics.RandomReadError(err.Error())...)
+			return
+		}
+
+		if !matched {
+			resp.Diagnostics.Append(mustMatchError(model.MustMatch)...)
+			return
+		}
+
+		model.Result = types.StringValue(result)
+		model.ID = model.Result
+	}
+
+	if model.EntropyBits.IsUnknown() {
+		model.EntropyBits = model.entropyBits()
+	}
+
+	if model.Spec.IsUnknown() {
+		model.Spec = model.spec()
+	}
+
+	if model.EncryptedResult.IsUnknown() {
+		var diags diag.Diagnostics
+
+		model.EncryptedResult, diags = encryptResult(r.encryptionKey, r.entropy, model.Result.ValueString())
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, model.ID)...)
+}
+
+// growString returns the prior result followed by characters generated from the params, to the length of the params,
+// which matches the pattern, or false if none of stringMustMatchAttempts results matched. A nil pattern matches every
+// result. The minimum number of characters of each class, and of character classes, are satisfied by the prior
+// result, so are not required of the generated characters, of which there may be fewer than the minimums.
+func growString(entropy *random.Source, prior string, params random.StringParams, pattern *regexp.Regexp) (string, bool, error) {
+	params.Length -= int64(utf8.RuneCountInString(prior))
+	params.MinUpper, params.MinLower, params.MinNumeric, params.MinSpecial = 0, 0, 0, 0
+	params.MinCharacterClasses = 0
+
+	for attempt := 1; attempt <= stringMustMatchAttempts; attempt++ {
+		suffix, err := entropy.CreateString(params)
+		if err != nil {
+			return "", false, err
+		}
+
+		result := prior + string(suffix)
+
+		if pattern == nil || pattern.MatchString(result) {
+			return result, true, nil
+		}
+	}
+
+	return "", false, nil
+}
+
+// stringGrowsInPlace returns whether the plan increases the length of the
+// result with grow_in_place, so that characters are appended to the prior
+// result rather than replacing it.
+func stringGrowsInPlace(ctx context.Context, plan tfsdk.Plan, state tfsdk.State) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// The resource is being created or destroyed.
+	if state.Raw.IsNull() || plan.Raw.IsNull() {
+		return false, diags
+	}
+
+	var growInPlace types.Bool
+	var planLength, stateLength types.Int64
+
+	diags.Append(plan.GetAttribute(ctx, path.Root("grow_in_place"), &growInPlace)...)
+	diags.Append(plan.GetAttribute(ctx, path.Root("length"), &planLength)...)
+	diags.Append(state.GetAttribute(ctx, path.Root("length"), &stateLength)...)
+
+	if diags.HasError() || !growInPlace.ValueBool() || planLength.IsUnknown() {
+		return false, diags
+	}
+
+	return planLength.ValueInt64() > stateLength.ValueInt64(), diags
+}
+
+// stringLengthRequiresReplace requires replacement when the length changes,
+// unless the length is increased with grow_in_place.
+func stringLengthRequiresReplace(ctx context.Context, req planmodifier.Int64Request, resp *int64planmodifier.RequiresReplaceIfFuncResponse) {
+	grows, diags := stringGrowsInPlace(ctx, req.Plan, req.State)
+	resp.Diagnostics.Append(diags...)
+
+	resp.RequiresReplace = !grows
+}
+
+// stringGrowPlanModifier returns a plan modifier for the attributes derived
+// from the result, which plans an unknown value when the length is increased
+// with grow_in_place. It follows UseStateForUnknown, which otherwise plans the
+// prior state value.
+func stringGrowPlanModifier() stringGrowModifier {
+	return stringGrowModifier{}
+}
+
+type stringGrowModifier struct{}
+
+func (m stringGrowModifier) Description(ctx context.Context) string {
+	return m.MarkdownDescription(ctx)
+}
+
+func (m stringGrowModifier) MarkdownDescription(context.Context) string {
+	return "The value is unknown when the length is increased with grow_in_place."
+}
+
+func (m stringGrowModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	grows, diags := stringGrowsInPlace(ctx, req.Plan, req.State)
+	resp.Diagnostics.Append(diags...)
+
+	if grows {
+		resp.PlanValue = types.StringUnknown()
+	}
+}
+
+func (m stringGrowModifier) PlanModifyFloat64(ctx context.Context, req planmodifier.Float64Request, resp *planmodifier.Float64Response) {
+	grows, diags := stringGrowsInPlace(ctx, req.Plan, req.State)
+	resp.Diagnostics.Append(diags...)
+
+	if grows {
+		resp.PlanValue = types.Float64Unknown()
+	}
+}
+
+func (m stringGrowModifier) PlanModifyObject(ctx context.Context, req planmodifier.ObjectRequest, resp *planmodifier.ObjectResponse) {
+	grows, diags := stringGrowsInPlace(ctx, req.Plan, req.State)
+	resp.Diagnostics.Append(diags...)
+
+	if grows {
+		resp.PlanValue = types.ObjectUnknown(specAttrTypes)
+	}
 }
 
 // Delete does not need to explicitly call resp.State.RemoveResource() as this is automatically handled by the
@@ -103,31 +372,243 @@ func (r *stringResource) Update(ctx context.Context, req resource.UpdateRequest,
 func (r *stringResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 }
 
+// stringCharacterClassAttributes are the attributes which configure the characters of the result, which cannot be
+// configured when dns_label is true.
+var stringCharacterClassAttributes = []string{
+	"special",
+	"upper",
+	"lower",
+	"number",
+	"numeric",
+	"min_numeric",
+	"min_upper",
+	"min_lower",
+	"min_special",
+	"min_character_classes",
+	"override_special",
+	"override_special_list",
+	"charset_preset",
+}
+
+// ValidateConfig ensures that the length is at least the sum of the minimum number of characters of each class, that
+// min_character_classes can be satisfied by the enabled classes and the length, that a DNS label is not longer than allowed by RFC 1123, and that the characters of a DNS label are not also configured
+// using the character class attributes.
+func (r *stringResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config stringModelV3
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateLengthAtLeastMins(config.Length, config.mins()...)...)
+	resp.Diagnostics.Append(validateMinCharacterClasses(config.MinCharacterClasses, config.Length, "length", config.classes(), config.mins())...)
+	overrideSpecial, overrideSpecialPath := overrideSpecialValue(config.OverrideSpecial, config.OverrideSpecialList)
+
+	resp.Diagnostics.Append(validateOverrideSpecial(overrideSpecial, overrideSpecialPath, config.LengthUnit, config.Normalization)...)
+
+	if !config.DNSLabel.ValueBool() {
+		return
+	}
+
+	if !config.Length.IsNull() && !config.Length.IsUnknown() && config.Length.ValueInt64() > random.DNSLabelMaxLength {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("length"),
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute length value must be at most %d when dns_label is true, got: %d",
+				random.DNSLabelMaxLength, config.Length.ValueInt64()),
+		)
+	}
+
+	for _, name := range stringCharacterClassAttributes {
+		var value attr.Value
+
+		diags := req.Config.GetAttribute(ctx, path.Root(name), &value)
+		resp.Diagnostics.Append(diags...)
+		if diags.HasError() {
+			return
+		}
+
+		if !value.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Invalid Attribute Combination",
+				fmt.Sprintf("Attribute %s cannot be configured when dns_label is true, as the characters of a "+
+					"DNS label are determined by RFC 1123.", name),
+			)
+		}
+	}
+}
+
 func (r *stringResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	id := req.ID
+	var id string
+
+	// The identity contains only the string, so attribute values are only
+	// supported when importing using the import identifier.
+	if req.ID != "" {
+		id = req.ID
+	} else {
+		var diags diag.Diagnostics
+
+		id, diags = importID(ctx, req)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 
 	state := stringModelV3{
+		stringSharedModel: stringSharedModel{
+			Result:                 types.StringValue(id),
+			Length:                 types.Int64Value(importLength(id)),
+			Special:                types.BoolValue(true),
+			Upper:                  types.BoolValue(true),
+			Lower:                  types.BoolValue(true),
+			Number:                 types.BoolValue(true),
+			Numeric:                types.BoolValue(true),
+			MinSpecial:             types.Int64Value(0),
+			MinUpper:               types.Int64Value(0),
+			MinLower:               types.Int64Value(0),
+			MinNumeric:             types.Int64Value(0),
+			OverrideSpecial:        types.StringNull(),
+			OverrideSpecialList:    types.ListNull(types.StringType),
+			CharsetPreset:          types.StringNull(),
+			MinCharacterClasses:    types.Int64Null(),
+			Keepers:                types.MapNull(types.StringType),
+			KeepersHash:            keepersHash(types.MapNull(types.StringType)),
+			CreatedAt:              types.StringNull(),
+			ProviderVersion:        types.StringNull(),
+			LifecycleGuard:         types.BoolNull(),
+			AllowRegenerationToken: types.StringNull(),
+		},
 		ID:              types.StringValue(id),
-		Result:          types.StringValue(id),
-		Length:          types.Int64Value(int64(len(id))),
-		Special:         types.BoolValue(true),
-		Upper:           types.BoolValue(true),
-		Lower:           types.BoolValue(true),
-		Number:          types.BoolValue(true),
-		Numeric:         types.BoolValue(true),
-		MinSpecial:      types.Int64Value(0),
-		MinUpper:        types.Int64Value(0),
-		MinLower:        types.Int64Value(0),
-		MinNumeric:      types.Int64Value(0),
-		OverrideSpecial: types.StringNull(),
-		Keepers:         types.MapNull(types.StringType),
-	}
-
-	diags := resp.State.Set(ctx, &state)
+		LengthUnit:      types.StringNull(),
+		Normalization:   types.StringNull(),
+		DNSLabel:        types.BoolNull(),
+		GrowInPlace:     types.BoolNull(),
+		MustMatch:       types.StringNull(),
+		EncryptedResult: types.StringNull(),
+	}
+
+	if req.ID != "" && isImportJSON(id) {
+		var data stringImportJSON
+
+		if err := data.decode(id); err != nil {
+			resp.Diagnostics.AddError(
+				"Import Random String Error",
+				"While attempting to import a random string, the import identifier could not be decoded as JSON. "+
+					"Either supply the string as the import identifier, or a JSON object containing at least "+
+					"the \"result\" key, for example: {\"result\": \"string\", \"length\": 6, \"special\": false}\n\n"+
+					fmt.Sprintf("Original Error: %s", err),
+			)
+			return
+		}
+
+		data.apply(&state)
+		id = state.Result.ValueString()
+	}
+
+	state.EntropyBits = state.entropyBits()
+	state.Spec = state.spec()
+
+	var diags diag.Diagnostics
+
+	state.EncryptedResult, diags = encryptResult(r.encryptionKey, r.entropy, id)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	logImport(ctx, map[string]any{
+		"length": state.Length.ValueInt64(),
+	})
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, state.ID)...)
+}
+
+// stringImportJSON is the JSON object which can be supplied as the import
+// identifier, in place of the bare string, so that the imported state can
+// match the arguments used in configuration. Keys which are omitted retain the
+// defaults used when importing a bare string.
+type stringImportJSON struct {
+	stringSharedImportJSON
+
+	LengthUnit    *string `json:"length_unit"`
+	Normalization *string `json:"normalization"`
+	DNSLabel      *bool   `json:"dns_label"`
+	GrowInPlace   *bool   `json:"grow_in_place"`
+	MustMatch     *string `json:"must_match"`
+}
+
+func (d *stringImportJSON) decode(id string) error {
+	if err := decodeImportJSON(id, d); err != nil {
+		return err
+	}
+
+	if err := d.validate("string"); err != nil {
+		return err
+	}
+
+	if d.LengthUnit != nil && *d.LengthUnit != stringLengthUnitRunes && *d.LengthUnit != stringLengthUnitBytes {
+		return fmt.Errorf(`the "length_unit" key must be one of %q, got: %q`,
+			[]string{stringLengthUnitBytes, stringLengthUnitRunes}, *d.LengthUnit)
+	}
+
+	if d.Normalization != nil {
+		if _, ok := stringNormalizationForms[*d.Normalization]; !ok {
+			return fmt.Errorf(`the "normalization" key must be one of %q, got: %q`, []string{"NFC", "NFKC"}, *d.Normalization)
+		}
+	}
+
+	if d.MustMatch != nil {
+		pattern, err := regexp.Compile(*d.MustMatch)
+		if err != nil {
+			return fmt.Errorf(`the "must_match" key must be a valid RE2 regular expression: %w`, err)
+		}
+
+		if !pattern.MatchString(*d.Result) {
+			return errors.New(`the "result" key must match the "must_match" regular expression`)
+		}
+	}
+
+	return nil
+}
+
+func (d *stringImportJSON) apply(state *stringModelV3) {
+	d.stringSharedImportJSON.apply(&state.stringSharedModel)
+
+	state.ID = state.Result
+
+	if d.LengthUnit != nil {
+		state.LengthUnit = types.StringValue(*d.LengthUnit)
+
+		// The length of the result is measured in the configured unit.
+		if *d.LengthUnit == stringLengthUnitBytes && d.Length == nil {
+			state.Length = types.Int64Value(int64(len(*d.Result)))
+		}
+	}
+
+	if d.Normalization != nil {
+		state.Normalization = types.StringValue(*d.Normalization)
+	}
+
+	if d.DNSLabel != nil {
+		state.DNSLabel = types.BoolValue(*d.DNSLabel)
+	}
+
+	if d.GrowInPlace != nil {
+		state.GrowInPlace = types.BoolValue(*d.GrowInPlace)
+	}
+
+	if d.MustMatch != nil {
+		state.MustMatch = types.StringValue(*d.MustMatch)
+	}
 }
 
 func (r *stringResource) UpgradeState(context.Context) map[int64]resource.StateUpgrader {
@@ -147,6 +628,8 @@ func (r *stringResource) UpgradeState(context.Context) map[int64]resource.StateU
 }
 
 func upgradeStringStateV1toV3(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	logStateUpgrade(ctx, 1, 3)
+
 	type modelV1 struct {
 		ID              types.String `tfsdk:"id"`
 		Keepers         types.Map    `tfsdk:"keepers"`
@@ -229,20 +712,25 @@ func upgradeStringStateV1toV3(ctx context.Context, req resource.UpgradeStateRequ
 	}
 
 	stringDataV3 := stringModelV3{
-		Keepers:         stringDataV1.Keepers,
-		Length:          length,
-		Special:         special,
-		Upper:           upper,
-		Lower:           lower,
-		Number:          number,
-		Numeric:         number,
-		MinNumeric:      minNumeric,
-		MinUpper:        minUpper,
-		MinLower:        minLower,
-		MinSpecial:      minSpecial,
-		OverrideSpecial: stringDataV1.OverrideSpecial,
-		Result:          stringDataV1.Result,
-		ID:              stringDataV1.ID,
+		stringSharedModel: stringSharedModel{
+			Keepers:             stringDataV1.Keepers,
+			Length:              length,
+			Special:             special,
+			Upper:               upper,
+			Lower:               lower,
+			Number:              number,
+			Numeric:             number,
+			MinNumeric:          minNumeric,
+			MinUpper:            minUpper,
+			MinLower:            minLower,
+			MinSpecial:          minSpecial,
+			OverrideSpecial:     stringDataV1.OverrideSpecial,
+			OverrideSpecialList: types.ListNull(types.StringType),
+			CharsetPreset:       types.StringNull(),
+			Result:              stringDataV1.Result,
+		},
+		ID:   stringDataV1.ID,
+		Spec: types.ObjectNull(specAttrTypes),
 	}
 
 	diags := resp.State.Set(ctx, stringDataV3)
@@ -250,6 +738,8 @@ func upgradeStringStateV1toV3(ctx context.Context, req resource.UpgradeStateRequ
 }
 
 func upgradeStringStateV2toV3(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	logStateUpgrade(ctx, 2, 3)
+
 	type modelV2 struct {
 		ID              types.String `tfsdk:"id"`
 		Keepers         types.Map    `tfsdk:"keepers"`
@@ -333,20 +823,25 @@ func upgradeStringStateV2toV3(ctx context.Context, req resource.UpgradeStateRequ
 	}
 
 	stringDataV3 := stringModelV3{
-		Keepers:         stringDataV2.Keepers,
-		Length:          length,
-		Special:         special,
-		Upper:           upper,
-		Lower:           lower,
-		Number:          number,
-		Numeric:         number,
-		MinNumeric:      minNumeric,
-		MinUpper:        minUpper,
-		MinLower:        minLower,
-		MinSpecial:      minSpecial,
-		OverrideSpecial: stringDataV2.OverrideSpecial,
-		Result:          stringDataV2.Result,
-		ID:              stringDataV2.ID,
+		stringSharedModel: stringSharedModel{
+			Keepers:             stringDataV2.Keepers,
+			Length:              length,
+			Special:             special,
+			Upper:               upper,
+			Lower:               lower,
+			Number:              number,
+			Numeric:             number,
+			MinNumeric:          minNumeric,
+			MinUpper:            minUpper,
+			MinLower:            minLower,
+			MinSpecial:          minSpecial,
+			OverrideSpecial:     stringDataV2.OverrideSpecial,
+			OverrideSpecialList: types.ListNull(types.StringType),
+			CharsetPreset:       types.StringNull(),
+			Result:              stringDataV2.Result,
+		},
+		ID:   stringDataV2.ID,
+		Spec: types.ObjectNull(specAttrTypes),
 	}
 
 	diags := resp.State.Set(ctx, stringDataV3)
@@ -355,7 +850,7 @@ func upgradeStringStateV2toV3(ctx context.Context, req resource.UpgradeStateRequ
 
 func stringSchemaV3() schema.Schema {
 	return schema.Schema{
-		Version: 2,
+		Version: 3,
 		Description: "The resource `random_string` generates a random permutation of alphanumeric " +
 			"characters and optionally special characters.\n" +
 			"\n" +
@@ -364,176 +859,118 @@ func stringSchemaV3() schema.Schema {
 			"Historically this resource's intended usage has been ambiguous as the original example used " +
 			"it in a password. For backwards compatibility it will continue to exist. For unique ids please " +
 			"use [random_id](id.html), for sensitive random values please use [random_password](password.html).",
-		Attributes: map[string]schema.Attribute{
-			"keepers": schema.MapAttribute{
-				Description: "Arbitrary map of values that, when changed, will trigger recreation of " +
-					"resource. See [the main provider documentation](../index.html) for more information.",
-				ElementType: types.StringType,
-				Optional:    true,
-				PlanModifiers: []planmodifier.Map{
-					mapplanmodifiers.RequiresReplaceIfValuesNotNull(),
-				},
+		Attributes: stringSchemaAttributes(stringSchemaOptions{
+			LengthPlanModifier: int64planmodifier.RequiresReplaceIf(
+				stringLengthRequiresReplace,
+				"Replaces the resource unless the length is increased with grow_in_place.",
+				"Replaces the resource unless the length is increased with `grow_in_place`.",
+			),
+			ResultPlanModifiers: []planmodifier.String{
+				stringGrowPlanModifier(),
 			},
+		}, stringAttributes()),
+	}
+}
 
-			"length": schema.Int64Attribute{
-				Description: "The length of the string desired. The minimum value for length is 1 and, length " +
-					"must also be >= (`min_upper` + `min_lower` + `min_numeric` + `min_special`).",
-				Required: true,
-				PlanModifiers: []planmodifier.Int64{
-					int64planmodifier.RequiresReplace(),
-				},
-				Validators: []validator.Int64{
-					int64validator.AtLeast(1),
-					int64validator.AtLeastSumOf(
-						path.MatchRoot("min_upper"),
-						path.MatchRoot("min_lower"),
-						path.MatchRoot("min_numeric"),
-						path.MatchRoot("min_special"),
-					),
-				},
+// stringAttributes returns the attributes of random_string which are not shared with
+// random_password.
+func stringAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"length_unit": schema.StringAttribute{
+			Description: "The unit in which `length` is measured, either `runes`, where each Unicode character " +
+				"counts as one, or `bytes`, where the length of the UTF-8 encoded result is measured. Characters " +
+				"of `override_special` outside of ASCII are encoded as more than one byte, so must not be " +
+				"supplied when this is `bytes`. Default value is `runes`.",
+			Optional: true,
+			Validators: []validator.String{
+				stringvalidator.OneOf(stringLengthUnitRunes, stringLengthUnitBytes),
 			},
+		},
 
-			"special": schema.BoolAttribute{
-				Description: "Include special characters in the result. These are `!@#$%&*()-_=+[]{}<>:?`. Default value is `true`.",
-				Optional:    true,
-				Computed:    true,
-				Default:     booldefault.StaticBool(true),
-				PlanModifiers: []planmodifier.Bool{
-					boolplanmodifier.RequiresReplace(),
-				},
+		"normalization": schema.StringAttribute{
+			Description: "The Unicode normalization form, `NFC` or `NFKC`, to which each character of " +
+				"`override_special` is normalized before the string is generated, so that characters which " +
+				"may be written in more than one way are generated in a consistent form, and the result is " +
+				"normalized. Characters which are not a single character once normalized cannot be supplied.",
+			Optional: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
 			},
-
-			"upper": schema.BoolAttribute{
-				Description: "Include uppercase alphabet characters in the result. Default value is `true`.",
-				Optional:    true,
-				Computed:    true,
-				Default:     booldefault.StaticBool(true),
-				PlanModifiers: []planmodifier.Bool{
-					boolplanmodifier.RequiresReplace(),
-				},
+			Validators: []validator.String{
+				stringvalidator.OneOf("NFC", "NFKC"),
 			},
+		},
 
-			"lower": schema.BoolAttribute{
-				Description: "Include lowercase alphabet characters in the result. Default value is `true`.",
-				Optional:    true,
-				Computed:    true,
-				Default:     booldefault.StaticBool(true),
-				PlanModifiers: []planmodifier.Bool{
-					boolplanmodifier.RequiresReplace(),
-				},
+		"dns_label": schema.BoolAttribute{
+			Description: "Generate a valid DNS label as defined by RFC 1123, consisting of lowercase " +
+				"alphabet characters, numeric characters and hyphens, which starts with a lowercase " +
+				"alphabet character and does not end with a hyphen. The `length` must be at most 63, and " +
+				"`special`, `upper`, `lower`, `numeric`, `number`, `override_special`, " +
+				"`override_special_list`, `charset_preset` and the `min_*` arguments cannot be configured. " +
+				"Default value is `false`.",
+			Optional: true,
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.RequiresReplace(),
 			},
+		},
 
-			"number": schema.BoolAttribute{
-				Description: "Include numeric characters in the result. Default value is `true`. " +
-					"If `number`, `upper`, `lower`, and `special` are all configured, at least one " +
-					"of them must be set to `true`. " +
-					"**NOTE**: This is deprecated, use `numeric` instead.",
-				Optional: true,
-				Computed: true,
-				PlanModifiers: []planmodifier.Bool{
-					boolplanmodifiers.NumberNumericAttributePlanModifier(),
-					boolplanmodifier.RequiresReplace(),
-				},
-				DeprecationMessage: "**NOTE**: This is deprecated, use `numeric` instead.",
-				Validators: []validator.Bool{
-					validators.AtLeastOneOfTrue(
-						path.MatchRoot("special"),
-						path.MatchRoot("upper"),
-						path.MatchRoot("lower"),
-					),
-				},
-			},
+		"grow_in_place": schema.BoolAttribute{
+			Description: "Increasing `length` appends newly generated characters to the existing result, " +
+				"rather than replacing it, so that the existing characters are preserved, such as where " +
+				"they are embedded in the names of other resources. Decreasing `length` still replaces the " +
+				"result. The appended characters of a DNS label begin with a lowercase alphabet character. " +
+				"Default value is `false`.",
+			Optional: true,
+		},
 
-			"numeric": schema.BoolAttribute{
-				Description: "Include numeric characters in the result. Default value is `true`. " +
-					"If `numeric`, `upper`, `lower`, and `special` are all configured, at least one " +
-					"of them must be set to `true`.",
-				Optional: true,
-				Computed: true,
-				PlanModifiers: []planmodifier.Bool{
-					boolplanmodifiers.NumberNumericAttributePlanModifier(),
-					boolplanmodifier.RequiresReplace(),
-				},
-				Validators: []validator.Bool{
-					validators.AtLeastOneOfTrue(
-						path.MatchRoot("special"),
-						path.MatchRoot("upper"),
-						path.MatchRoot("lower"),
-					),
-				},
-			},
+		"must_match": mustMatchAttribute(),
 
-			"min_numeric": schema.Int64Attribute{
-				Description: "Minimum number of numeric characters in the result. Default value is `0`.",
-				Optional:    true,
-				Computed:    true,
-				Default:     int64default.StaticInt64(0),
-				PlanModifiers: []planmodifier.Int64{
-					int64planmodifier.RequiresReplace(),
-				},
-			},
+		"entropy_bits": stringEntropyBitsAttribute(),
+		"spec":         stringSpecAttribute(),
 
-			"min_upper": schema.Int64Attribute{
-				Description: "Minimum number of uppercase alphabet characters in the result. Default value is `0`.",
-				Optional:    true,
-				Computed:    true,
-				Default:     int64default.StaticInt64(0),
-				PlanModifiers: []planmodifier.Int64{
-					int64planmodifier.RequiresReplace(),
-				},
-			},
+		"encrypted_result": stringEncryptedResultAttribute(),
 
-			"min_lower": schema.Int64Attribute{
-				Description: "Minimum number of lowercase alphabet characters in the result. Default value is `0`.",
-				Optional:    true,
-				Computed:    true,
-				Default:     int64default.StaticInt64(0),
-				PlanModifiers: []planmodifier.Int64{
-					int64planmodifier.RequiresReplace(),
-				},
+		"id": schema.StringAttribute{
+			Description: "The generated random string.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+				stringGrowPlanModifier(),
 			},
+		},
+	}
+}
 
-			"min_special": schema.Int64Attribute{
-				Description: "Minimum number of special characters in the result. Default value is `0`.",
-				Optional:    true,
-				Computed:    true,
-				Default:     int64default.StaticInt64(0),
-				PlanModifiers: []planmodifier.Int64{
-					int64planmodifier.RequiresReplace(),
-				},
-			},
+// stringEntropyBitsAttribute returns the entropy_bits attribute, which is
+// unknown in the plan when the length is increased with grow_in_place.
+func stringEntropyBitsAttribute() schema.Float64Attribute {
+	attribute := entropyBitsAttribute("The entropy of the result in bits, calculated as `length` " +
+		"multiplied by the base 2 logarithm of the number of distinct characters which may be chosen, " +
+		"accounting for the restricted first and last characters of a DNS label.")
 
-			"override_special": schema.StringAttribute{
-				Description: "Supply your own list of special characters to use for string generation.  This " +
-					"overrides the default character list in the special argument.  The `special` argument must " +
-					"still be set to true for any overwritten characters to be used in generation.",
-				Optional: true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplaceIf(
-						stringplanmodifiers.RequiresReplaceUnlessEmptyStringToNull(),
-						"Replace on modification unless updating from empty string (\"\") to null.",
-						"Replace on modification unless updating from empty string (`\"\"`) to `null`.",
-					),
-				},
-			},
+	attribute.PlanModifiers = append(attribute.PlanModifiers, stringGrowPlanModifier())
 
-			"result": schema.StringAttribute{
-				Description: "The generated random string.",
-				Computed:    true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.UseStateForUnknown(),
-				},
-			},
+	return attribute
+}
 
-			"id": schema.StringAttribute{
-				Description: "The generated random string.",
-				Computed:    true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.UseStateForUnknown(),
-				},
-			},
-		},
-	}
+// stringSpecAttribute returns the spec attribute, which is unknown in the plan
+// when the length is increased with grow_in_place.
+func stringSpecAttribute() schema.ObjectAttribute {
+	attribute := specAttribute("result", true)
+
+	attribute.PlanModifiers = append(attribute.PlanModifiers, stringGrowPlanModifier())
+
+	return attribute
+}
+
+// stringEncryptedResultAttribute returns the encrypted_result attribute, which
+// is unknown in the plan when the length is increased with grow_in_place.
+func stringEncryptedResultAttribute() schema.StringAttribute {
+	attribute := encryptedResultAttribute()
+
+	attribute.PlanModifiers = append(attribute.PlanModifiers, stringGrowPlanModifier())
+
+	return attribute
 }
 
 func stringSchemaV2() schema.Schema {
@@ -732,18 +1169,90 @@ func stringSchemaV1() schema.Schema {
 }
 
 type stringModelV3 struct {
-	ID              types.String `tfsdk:"id"`
-	Keepers         types.Map    `tfsdk:"keepers"`
-	Length          types.Int64  `tfsdk:"length"`
-	Special         types.Bool   `tfsdk:"special"`
-	Upper           types.Bool   `tfsdk:"upper"`
-	Lower           types.Bool   `tfsdk:"lower"`
-	Number          types.Bool   `tfsdk:"number"`
-	Numeric         types.Bool   `tfsdk:"numeric"`
-	MinNumeric      types.Int64  `tfsdk:"min_numeric"`
-	MinUpper        types.Int64  `tfsdk:"min_upper"`
-	MinLower        types.Int64  `tfsdk:"min_lower"`
-	MinSpecial      types.Int64  `tfsdk:"min_special"`
-	OverrideSpecial types.String `tfsdk:"override_special"`
-	Result          types.String `tfsdk:"result"`
+	stringSharedModel
+
+	ID              types.String  `tfsdk:"id"`
+	LengthUnit      types.String  `tfsdk:"length_unit"`
+	Normalization   types.String  `tfsdk:"normalization"`
+	DNSLabel        types.Bool    `tfsdk:"dns_label"`
+	GrowInPlace     types.Bool    `tfsdk:"grow_in_place"`
+	MustMatch       types.String  `tfsdk:"must_match"`
+	EntropyBits     types.Float64 `tfsdk:"entropy_bits"`
+	Spec            types.Object  `tfsdk:"spec"`
+	EncryptedResult types.String  `tfsdk:"encrypted_result"`
+}
+
+// mins returns the minimum number of uppercase, lowercase, numeric and special
+// characters, in that order.
+func (m stringModelV3) mins() []types.Int64 {
+	return []types.Int64{m.MinUpper, m.MinLower, m.MinNumeric, m.MinSpecial}
+}
+
+// stringMinAttributes are the names of the attributes of the minimum number of
+// characters of each class, in the order of the values returned by mins.
+var stringMinAttributes = []string{"min_upper", "min_lower", "min_numeric", "min_special"}
+
+// validateLengthAtLeastMins returns an error diagnostic if the length is less than the sum of the minimum number of
+// characters of each class, given in the order of stringMinAttributes, including the value of each in the diagnostic.
+// Null minimums are treated as zero. Nothing is validated if any value is unknown, as the configuration is validated
+// again with known values during apply.
+func validateLengthAtLeastMins(length types.Int64, mins ...types.Int64) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if length.IsNull() || length.IsUnknown() {
+		return diags
+	}
+
+	var (
+		sumOfMins int64
+		terms     []string
+	)
+
+	for i, m := range mins {
+		if m.IsUnknown() {
+			return diags
+		}
+
+		sumOfMins += m.ValueInt64()
+		terms = append(terms, fmt.Sprintf("%s (%d)", stringMinAttributes[i], m.ValueInt64()))
+	}
+
+	if length.ValueInt64() < sumOfMins {
+		diags.AddAttributeError(
+			path.Root("length"),
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute length value must be at least the sum of %s and %s, which is %d, got: %d",
+				strings.Join(terms[:len(terms)-1], ", "), terms[len(terms)-1], sumOfMins, length.ValueInt64()),
+		)
+	}
+
+	return diags
+}
+
+func (m stringModelV3) params() random.StringParams {
+	overrideSpecial, _ := overrideSpecialValue(m.OverrideSpecial, m.OverrideSpecialList)
+
+	return random.StringParams{
+		Length:              m.Length.ValueInt64(),
+		Upper:               m.Upper.ValueBool(),
+		MinUpper:            m.MinUpper.ValueInt64(),
+		Lower:               m.Lower.ValueBool(),
+		MinLower:            m.MinLower.ValueInt64(),
+		Numeric:             m.Numeric.ValueBool(),
+		MinNumeric:          m.MinNumeric.ValueInt64(),
+		Special:             m.Special.ValueBool(),
+		MinSpecial:          m.MinSpecial.ValueInt64(),
+		MinCharacterClasses: m.MinCharacterClasses.ValueInt64(),
+		OverrideSpecial:     normalizeOverrideSpecial(overrideSpecial.ValueString(), m.Normalization.ValueString()),
+		DNSLabel:            m.DNSLabel.ValueBool(),
+		Charset:             charsetPresetChars(m.CharsetPreset),
+	}
+}
+
+func (m stringModelV3) entropyBits() types.Float64 {
+	return types.Float64Value(random.StringEntropyBits(m.params()))
+}
+
+func (m stringModelV3) spec() types.Object {
+	return stringSpec(m.Length, m.params(), m.entropyBits())
 }
@@ -5,24 +5,34 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"math"
+	"math/rand"
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	res "github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/compare"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+
+	"github.com/terraform-providers/terraform-provider-random/internal/random"
 	"github.com/terraform-providers/terraform-provider-random/internal/randomtest"
 )
 
 func TestAccResourceString_Import(t *testing.T) {
 	resource.UnitTest(t, resource.TestCase{
-		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: `resource "random_string" "basic" {
@@ -33,9 +43,10 @@ func TestAccResourceString_Import(t *testing.T) {
 				},
 			},
 			{
-				ResourceName:      "random_string.basic",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "random_string.basic",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"created_at", "provider_version"},
 			},
 		},
 	})
@@ -43,7 +54,7 @@ func TestAccResourceString_Import(t *testing.T) {
 
 func TestAccResourceString_ImportWithoutKeepersProducesNoPlannedChanges(t *testing.T) {
 	resource.UnitTest(t, resource.TestCase{
-		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: `resource "random_string" "basic" {
@@ -64,6 +75,179 @@ func TestAccResourceString_ImportWithoutKeepersProducesNoPlannedChanges(t *testi
 	})
 }
 
+func TestAccResourceString_ImportJSON(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_string" "basic" {
+							length  = 12
+							special = false
+							upper   = false
+						}`,
+				ResourceName:       "random_string.basic",
+				ImportStateId:      `{"result": "zcbrje3ltgpd", "special": false, "upper": false}`,
+				ImportState:        true,
+				ImportStatePersist: true,
+			},
+			{
+				Config: `resource "random_string" "basic" {
+							length  = 12
+							special = false
+							upper   = false
+						}`,
+				PlanOnly: true,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_string.basic", tfjsonpath.New("result"), knownvalue.StringExact("zcbrje3ltgpd")),
+					statecheck.ExpectKnownValue("random_string.basic", tfjsonpath.New("id"), knownvalue.StringExact("zcbrje3ltgpd")),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceString_ImportJSON_InvalidValue(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_string" "basic" {
+							length = 12
+						}`,
+				ResourceName:  "random_string.basic",
+				ImportStateId: `{"result": "Z=:cbrJE?Ltg", "special": "no"}`,
+				ImportState:   true,
+				ExpectError:   regexp.MustCompile(`cannot unmarshal string into Go struct field`),
+			},
+		},
+	})
+}
+
+func TestStringImportJSON(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		id             string
+		expectedResult string
+		expectedLength int64
+		expectedError  string
+	}{
+		"result": {
+			id:             `{"result": "abc"}`,
+			expectedResult: "abc",
+			expectedLength: 3,
+		},
+		"result-with-comma": {
+			id:             `{"result": "a,b=c", "length": 5}`,
+			expectedResult: "a,b=c",
+			expectedLength: 5,
+		},
+		"multibyte-result": {
+			id:             `{"result": "äöü"}`,
+			expectedResult: "äöü",
+			expectedLength: 3,
+		},
+		"length-unit-bytes": {
+			id:             `{"result": "äöü", "length_unit": "bytes"}`,
+			expectedResult: "äöü",
+			expectedLength: 6,
+		},
+		"missing-result": {
+			id:            `{"length": 3}`,
+			expectedError: `the "result" key must be set to the string`,
+		},
+		"unknown-key": {
+			id:            `{"result": "abc", "lenght": 3}`,
+			expectedError: `json: unknown field "lenght"`,
+		},
+		"invalid-charset-preset": {
+			id:            `{"result": "abc", "charset_preset": "base64"}`,
+			expectedError: `the "charset_preset" key must be one of ["alphanumeric" "base32" "base58" "hex"], got: "base64"`,
+		},
+		"override-special-conflict": {
+			id:            `{"result": "abc", "override_special": "!", "override_special_list": ["!"]}`,
+			expectedError: `only one of the "override_special" and "override_special_list" keys can be set`,
+		},
+		"invalid-length-unit": {
+			id:            `{"result": "abc", "length_unit": "chars"}`,
+			expectedError: `the "length_unit" key must be one of ["bytes" "runes"], got: "chars"`,
+		},
+		"must-match-mismatch": {
+			id:            `{"result": "abc", "must_match": "^[0-9]"}`,
+			expectedError: `the "result" key must match the "must_match" regular expression`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var data stringImportJSON
+
+			err := data.decode(testCase.id)
+
+			if testCase.expectedError != "" {
+				if err == nil || err.Error() != testCase.expectedError {
+					t.Errorf("expected error %q, got: %v", testCase.expectedError, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var state stringModelV3
+
+			data.apply(&state)
+
+			if state.Result.ValueString() != testCase.expectedResult || state.ID.ValueString() != testCase.expectedResult {
+				t.Errorf("expected result and id %q, got: %q and %q", testCase.expectedResult, state.Result.ValueString(), state.ID.ValueString())
+			}
+
+			if state.Length.ValueInt64() != testCase.expectedLength {
+				t.Errorf("expected length %d, got: %d", testCase.expectedLength, state.Length.ValueInt64())
+			}
+		})
+	}
+}
+
+func TestStringImportJSON_Attributes(t *testing.T) {
+	t.Parallel()
+
+	var data stringImportJSON
+
+	err := data.decode(`{"result": "ab-c", "min_character_classes": 2, "override_special_list": ["-"], ` +
+		`"keepers": {"rotation": "1"}, "normalization": "NFC", "grow_in_place": true, "must_match": "^[a-z]"}`)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var state stringModelV3
+
+	data.apply(&state)
+
+	expected := stringModelV3{
+		stringSharedModel: stringSharedModel{
+			Result:              types.StringValue("ab-c"),
+			Length:              types.Int64Value(4),
+			MinCharacterClasses: types.Int64Value(2),
+			OverrideSpecialList: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("-")}),
+			Keepers:             types.MapValueMust(types.StringType, map[string]attr.Value{"rotation": types.StringValue("1")}),
+			KeepersHash:         keepersHash(types.MapValueMust(types.StringType, map[string]attr.Value{"rotation": types.StringValue("1")})),
+		},
+		ID:            types.StringValue("ab-c"),
+		Normalization: types.StringValue("NFC"),
+		GrowInPlace:   types.BoolValue(true),
+		MustMatch:     types.StringValue("^[a-z]"),
+	}
+
+	if diff := cmp.Diff(expected, state, cmp.AllowUnexported(stringModelV3{})); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
 func TestAccResourceString_Keepers_Keep_EmptyMap(t *testing.T) {
 	// The id attribute values should be the same between test steps
 	assertIdSame := statecheck.CompareValue(compare.ValuesSame())
@@ -71,7 +255,7 @@ func TestAccResourceString_Keepers_Keep_EmptyMap(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_string" "test" {
 					length = 12
 					keepers = {}
@@ -82,7 +266,7 @@ func TestAccResourceString_Keepers_Keep_EmptyMap(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_string" "test" {
 					length = 12
 					keepers = {}
@@ -102,7 +286,7 @@ func TestAccResourceString_Keepers_Keep_EmptyMapToNullValue(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_string" "test" {
 					length = 12
 					keepers = {}
@@ -113,7 +297,7 @@ func TestAccResourceString_Keepers_Keep_EmptyMapToNullValue(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_string" "test" {
 					length = 12
 					keepers = {
@@ -136,7 +320,7 @@ func TestAccResourceString_Keepers_Keep_NullMap(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_string" "test" {
 					length = 12
 				}`,
@@ -146,7 +330,7 @@ func TestAccResourceString_Keepers_Keep_NullMap(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_string" "test" {
 					length = 12
 				}`,
@@ -166,7 +350,7 @@ func TestAccResourceString_Keepers_Keep_NullMapToNullValue(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_string" "test" {
 					length = 12
 				}`,
@@ -176,7 +360,7 @@ func TestAccResourceString_Keepers_Keep_NullMapToNullValue(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_string" "test" {
 					length = 12
 					keepers = {
@@ -199,7 +383,7 @@ func TestAccResourceString_Keepers_Keep_NullValue(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_string" "test" {
 					length = 12
 					keepers = {
@@ -212,7 +396,7 @@ func TestAccResourceString_Keepers_Keep_NullValue(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_string" "test" {
 					length = 12
 					keepers = {
@@ -235,7 +419,7 @@ func TestAccResourceString_Keepers_Keep_NullValues(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_string" "test" {
 					length = 12
 					keepers = {
@@ -249,7 +433,7 @@ func TestAccResourceString_Keepers_Keep_NullValues(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_string" "test" {
 					length = 12
 					keepers = {
@@ -273,7 +457,7 @@ func TestAccResourceString_Keepers_Keep_Value(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_string" "test" {
 					length = 12
 					keepers = {
@@ -286,7 +470,7 @@ func TestAccResourceString_Keepers_Keep_Value(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_string" "test" {
 					length = 12
 					keepers = {
@@ -309,7 +493,7 @@ func TestAccResourceString_Keepers_Keep_Values(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_string" "test" {
 					length = 12
 					keepers = {
@@ -323,7 +507,7 @@ func TestAccResourceString_Keepers_Keep_Values(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_string" "test" {
 					length = 12
 					keepers = {
@@ -347,7 +531,7 @@ func TestAccResourceString_Keepers_Replace_EmptyMapToValue(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_string" "test" {
 					length = 12
 					keepers = {}
@@ -358,7 +542,7 @@ func TestAccResourceString_Keepers_Replace_EmptyMapToValue(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_string" "test" {
 					length = 12
 					keepers = {
@@ -381,7 +565,7 @@ func TestAccResourceString_Keepers_Replace_NullMapToValue(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_string" "test" {
 					length = 12
 				}`,
@@ -391,7 +575,7 @@ func TestAccResourceString_Keepers_Replace_NullMapToValue(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_string" "test" {
 					length = 12
 					keepers = {
@@ -414,7 +598,7 @@ func TestAccResourceString_Keepers_Replace_NullValueToValue(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_string" "test" {
 					length = 12
 					keepers = {
@@ -427,7 +611,7 @@ func TestAccResourceString_Keepers_Replace_NullValueToValue(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_string" "test" {
 					length = 12
 					keepers = {
@@ -450,7 +634,7 @@ func TestAccResourceString_Keepers_Replace_ValueToEmptyMap(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_string" "test" {
 					length = 12
 					keepers = {
@@ -463,7 +647,7 @@ func TestAccResourceString_Keepers_Replace_ValueToEmptyMap(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_string" "test" {
 					length = 12
 					keepers = {}
@@ -484,7 +668,7 @@ func TestAccResourceString_Keepers_Replace_ValueToNullMap(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_string" "test" {
 					length = 12
 					keepers = {
@@ -497,7 +681,7 @@ func TestAccResourceString_Keepers_Replace_ValueToNullMap(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_string" "test" {
 					length = 12
 				}`,
@@ -517,7 +701,7 @@ func TestAccResourceString_Keepers_Replace_ValueToNullValue(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_string" "test" {
 					length = 12
 					keepers = {
@@ -530,7 +714,7 @@ func TestAccResourceString_Keepers_Replace_ValueToNullValue(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_string" "test" {
 					length = 12
 					keepers = {
@@ -553,7 +737,7 @@ func TestAccResourceString_Keepers_Replace_ValueToNewValue(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_string" "test" {
 					length = 12
 					keepers = {
@@ -566,7 +750,7 @@ func TestAccResourceString_Keepers_Replace_ValueToNewValue(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_string" "test" {
 					length = 12
 					keepers = {
@@ -602,7 +786,7 @@ func TestAccResourceString_Keepers_FrameworkMigration_NullMapToNullValue(t *test
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_string" "test" {
 					length = 12
 					keepers = {
@@ -638,7 +822,7 @@ func TestAccResourceString_Keepers_FrameworkMigration_NullMapToValue(t *testing.
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_string" "test" {
 					length = 12
 					keepers = {
@@ -675,7 +859,7 @@ func TestAccResourceString_Keepers_FrameworkMigration_NullMapToMultipleNullValue
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_string" "test" {
 					length = 12
 					keepers = {
@@ -713,7 +897,7 @@ func TestAccResourceString_Keepers_FrameworkMigration_NullMapToMultipleValue(t *
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_string" "test" {
 					length = 12
 					keepers = {
@@ -751,7 +935,7 @@ func TestAccResourceString_Keepers_FrameworkMigration_NullMapValue(t *testing.T)
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_string" "test" {
 					length = 12
 					keepers = {
@@ -789,7 +973,7 @@ func TestAccResourceString_Keepers_FrameworkMigration_NullMapValueToValue(t *tes
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_string" "test" {
 					length = 12
 					keepers = {
@@ -808,7 +992,7 @@ func TestAccResourceString_Keepers_FrameworkMigration_NullMapValueToValue(t *tes
 
 func TestAccResourceString_Override(t *testing.T) {
 	resource.UnitTest(t, resource.TestCase{
-		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: `resource "random_string" "override" {
@@ -847,7 +1031,7 @@ func TestAccResourceString_OverrideSpecial_FromVersion3_3_2(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_string" "test" {
 					length = 12
 				}`,
@@ -881,7 +1065,7 @@ func TestAccResourceString_OverrideSpecial_FromVersion3_4_2(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_string" "test" {
 					length = 12
 				}`,
@@ -896,7 +1080,7 @@ func TestAccResourceString_OverrideSpecial_FromVersion3_4_2(t *testing.T) {
 
 func TestAccResourceString_Min(t *testing.T) {
 	resource.UnitTest(t, resource.TestCase{
-		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: `resource "random_string" "min" {
@@ -919,6 +1103,56 @@ func TestAccResourceString_Min(t *testing.T) {
 	})
 }
 
+func TestAccResourceString_MinCharacterClasses(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_string" "test" {
+							length                = 4
+							min_character_classes = 4
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_string.test", tfjsonpath.New("result"), randomtest.StringLengthExact(4)),
+					statecheck.ExpectKnownValue("random_string.test", tfjsonpath.New("result"), randomtest.StringMinCharacterClasses(4)),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceString_MinCharacterClasses_Invalid(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_string" "test" {
+							length                = 12
+							special               = false
+							min_character_classes = 4
+						}`,
+				ExpectError: regexp.MustCompile(`value must be at most the number of enabled character\s+classes of upper,\s+lower,\s+numeric and special,\s+which is 3,\s+got: 4`),
+			},
+			{
+				Config: `resource "random_string" "test" {
+							length                = 3
+							min_upper             = 2
+							min_character_classes = 3
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute length value must be at least the sum of the minimums of each class`),
+			},
+			{
+				Config: `resource "random_string" "test" {
+							length                = 12
+							charset_preset        = "hex"
+							min_character_classes = 2
+						}`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
+
 // TestAccResourceString_StateUpgradeV1toV2 covers the state upgrade from V1 to V2.
 // This includes the deprecation of `number` and the addition of `numeric` attributes.
 // v3.2.0 was used as this is the last version before `number` was deprecated and `numeric` attribute
@@ -1149,7 +1383,7 @@ func TestAccResourceString_StateUpgradeV1toV2(t *testing.T) {
 						ConfigStateChecks: c.beforeUpgradeStateChecks,
 					},
 					{
-						ProtoV5ProviderFactories: protoV5ProviderFactories(),
+						ProtoV6ProviderFactories: protoV6ProviderFactories(),
 						Config:                   c.configDuringUpgrade,
 						ConfigStateChecks:        c.afterUpgradeStateChecks,
 					},
@@ -1161,14 +1395,14 @@ func TestAccResourceString_StateUpgradeV1toV2(t *testing.T) {
 
 func TestAccResourceString_LengthErrors(t *testing.T) {
 	resource.UnitTest(t, resource.TestCase{
-		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: `resource "random_string" "invalid_length" {
   							length = 2
   							min_lower = 3
 						}`,
-				ExpectError: regexp.MustCompile(`.*Attribute length value must be at least sum of min_upper \+ min_lower \+\nmin_numeric \+ min_special, got: 2`),
+				ExpectError: regexp.MustCompile(`Attribute length value must be at least the sum of min_upper \(0\),\s+min_lower\s+\(3\),\s+min_numeric\s+\(0\)\s+and\s+min_special\s+\(0\),\s+which\s+is\s+3,\s+got:\s+2`),
 			},
 			{
 				Config: `resource "random_string" "invalid_length" {
@@ -1180,6 +1414,55 @@ func TestAccResourceString_LengthErrors(t *testing.T) {
 	})
 }
 
+func TestValidateLengthAtLeastMins(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		length        types.Int64
+		mins          []types.Int64
+		expectedError string
+	}{
+		"valid": {
+			length: types.Int64Value(6),
+			mins:   []types.Int64{types.Int64Value(1), types.Int64Value(2), types.Int64Value(3), types.Int64Null()},
+		},
+		"unknown-length": {
+			length: types.Int64Unknown(),
+			mins:   []types.Int64{types.Int64Value(1), types.Int64Value(2), types.Int64Value(3), types.Int64Value(4)},
+		},
+		"unknown-min": {
+			length: types.Int64Value(1),
+			mins:   []types.Int64{types.Int64Value(1), types.Int64Unknown(), types.Int64Value(3), types.Int64Value(4)},
+		},
+		"too-short": {
+			length: types.Int64Value(5),
+			mins:   []types.Int64{types.Int64Value(1), types.Int64Value(2), types.Int64Value(3), types.Int64Null()},
+			expectedError: "Attribute length value must be at least the sum of min_upper (1), min_lower (2), " +
+				"min_numeric (3) and min_special (0), which is 6, got: 5",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := validateLengthAtLeastMins(testCase.length, testCase.mins...)
+
+			if testCase.expectedError == "" {
+				if diags.HasError() {
+					t.Errorf("unexpected error: %v", diags)
+				}
+
+				return
+			}
+
+			if diags.ErrorsCount() != 1 || diags.Errors()[0].Detail() != testCase.expectedError {
+				t.Errorf("expected error %q, got: %v", testCase.expectedError, diags)
+			}
+		})
+	}
+}
+
 // TestAccResourceString_UpgradeFromVersion3_2_0 verifies behaviour when upgrading state from schema V1 to V2.
 func TestAccResourceString_UpgradeFromVersion3_2_0(t *testing.T) {
 	resource.Test(t, resource.TestCase{
@@ -1211,7 +1494,7 @@ func TestAccResourceString_UpgradeFromVersion3_2_0(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_string" "min" {
 							length = 12
 							override_special = "!#@"
@@ -1223,7 +1506,7 @@ func TestAccResourceString_UpgradeFromVersion3_2_0(t *testing.T) {
 				PlanOnly: true,
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_string" "min" {
 							length = 12
 							override_special = "!#@"
@@ -1285,7 +1568,7 @@ func TestAccResourceString_UpgradeFromVersion3_3_2(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_string" "min" {
 							length = 12
 							override_special = "!#@"
@@ -1297,7 +1580,7 @@ func TestAccResourceString_UpgradeFromVersion3_3_2(t *testing.T) {
 				PlanOnly: true,
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_string" "min" {
 							length = 12
 							override_special = "!#@"
@@ -1379,36 +1662,68 @@ func TestUpgradeStringStateV1toV3(t *testing.T) {
 		State: tfsdk.State{
 			Raw: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
-					"id":               tftypes.String,
-					"keepers":          tftypes.Map{ElementType: tftypes.String},
-					"length":           tftypes.Number,
-					"lower":            tftypes.Bool,
-					"min_lower":        tftypes.Number,
-					"min_numeric":      tftypes.Number,
-					"min_special":      tftypes.Number,
-					"min_upper":        tftypes.Number,
-					"number":           tftypes.Bool,
-					"numeric":          tftypes.Bool,
-					"override_special": tftypes.String,
-					"result":           tftypes.String,
-					"special":          tftypes.Bool,
-					"upper":            tftypes.Bool,
+					"created_at":               tftypes.String,
+					"keepers_hash":             tftypes.String,
+					"dns_label":                tftypes.Bool,
+					"grow_in_place":            tftypes.Bool,
+					"must_match":               tftypes.String,
+					"min_character_classes":    tftypes.Number,
+					"entropy_bits":             tftypes.Number,
+					"id":                       tftypes.String,
+					"keepers":                  tftypes.Map{ElementType: tftypes.String},
+					"length":                   tftypes.Number,
+					"lower":                    tftypes.Bool,
+					"min_lower":                tftypes.Number,
+					"min_numeric":              tftypes.Number,
+					"min_special":              tftypes.Number,
+					"min_upper":                tftypes.Number,
+					"number":                   tftypes.Bool,
+					"numeric":                  tftypes.Bool,
+					"override_special":         tftypes.String,
+					"provider_version":         tftypes.String,
+					"encrypted_result":         tftypes.String,
+					"override_special_list":    tftypes.List{ElementType: tftypes.String},
+					"charset_preset":           tftypes.String,
+					"spec":                     specTfType,
+					"length_unit":              tftypes.String,
+					"normalization":            tftypes.String,
+					"lifecycle_guard":          tftypes.Bool,
+					"allow_regeneration_token": tftypes.String,
+					"result":                   tftypes.String,
+					"special":                  tftypes.Bool,
+					"upper":                    tftypes.Bool,
 				},
 			}, map[string]tftypes.Value{
-				"id":               tftypes.NewValue(tftypes.String, "none"),
-				"keepers":          tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
-				"length":           tftypes.NewValue(tftypes.Number, 16),
-				"lower":            tftypes.NewValue(tftypes.Bool, true),
-				"min_lower":        tftypes.NewValue(tftypes.Number, 0),
-				"min_numeric":      tftypes.NewValue(tftypes.Number, 0),
-				"min_special":      tftypes.NewValue(tftypes.Number, 0),
-				"min_upper":        tftypes.NewValue(tftypes.Number, 0),
-				"number":           tftypes.NewValue(tftypes.Bool, true),
-				"numeric":          tftypes.NewValue(tftypes.Bool, true),
-				"override_special": tftypes.NewValue(tftypes.String, "!#$%\u0026*()-_=+[]{}\u003c\u003e:?"),
-				"result":           tftypes.NewValue(tftypes.String, "DZy_3*tnonj%Q%Yx"),
-				"special":          tftypes.NewValue(tftypes.Bool, true),
-				"upper":            tftypes.NewValue(tftypes.Bool, true),
+				"created_at":               tftypes.NewValue(tftypes.String, nil),
+				"keepers_hash":             tftypes.NewValue(tftypes.String, nil),
+				"dns_label":                tftypes.NewValue(tftypes.Bool, nil),
+				"grow_in_place":            tftypes.NewValue(tftypes.Bool, nil),
+				"must_match":               tftypes.NewValue(tftypes.String, nil),
+				"min_character_classes":    tftypes.NewValue(tftypes.Number, nil),
+				"entropy_bits":             tftypes.NewValue(tftypes.Number, nil),
+				"id":                       tftypes.NewValue(tftypes.String, "none"),
+				"keepers":                  tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"length":                   tftypes.NewValue(tftypes.Number, 16),
+				"lower":                    tftypes.NewValue(tftypes.Bool, true),
+				"min_lower":                tftypes.NewValue(tftypes.Number, 0),
+				"min_numeric":              tftypes.NewValue(tftypes.Number, 0),
+				"min_special":              tftypes.NewValue(tftypes.Number, 0),
+				"min_upper":                tftypes.NewValue(tftypes.Number, 0),
+				"number":                   tftypes.NewValue(tftypes.Bool, true),
+				"numeric":                  tftypes.NewValue(tftypes.Bool, true),
+				"override_special":         tftypes.NewValue(tftypes.String, "!#$%\u0026*()-_=+[]{}\u003c\u003e:?"),
+				"provider_version":         tftypes.NewValue(tftypes.String, nil),
+				"encrypted_result":         tftypes.NewValue(tftypes.String, nil),
+				"override_special_list":    tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+				"charset_preset":           tftypes.NewValue(tftypes.String, nil),
+				"spec":                     tftypes.NewValue(specTfType, nil),
+				"length_unit":              tftypes.NewValue(tftypes.String, nil),
+				"normalization":            tftypes.NewValue(tftypes.String, nil),
+				"lifecycle_guard":          tftypes.NewValue(tftypes.Bool, nil),
+				"allow_regeneration_token": tftypes.NewValue(tftypes.String, nil),
+				"result":                   tftypes.NewValue(tftypes.String, "DZy_3*tnonj%Q%Yx"),
+				"special":                  tftypes.NewValue(tftypes.Bool, true),
+				"upper":                    tftypes.NewValue(tftypes.Bool, true),
 			}),
 			Schema: stringSchemaV3(),
 		},
@@ -1473,36 +1788,68 @@ func TestUpgradeStringStateV1toV3_NullValues(t *testing.T) {
 		State: tfsdk.State{
 			Raw: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
-					"id":               tftypes.String,
-					"keepers":          tftypes.Map{ElementType: tftypes.String},
-					"length":           tftypes.Number,
-					"lower":            tftypes.Bool,
-					"min_lower":        tftypes.Number,
-					"min_numeric":      tftypes.Number,
-					"min_special":      tftypes.Number,
-					"min_upper":        tftypes.Number,
-					"number":           tftypes.Bool,
-					"numeric":          tftypes.Bool,
-					"override_special": tftypes.String,
-					"result":           tftypes.String,
-					"special":          tftypes.Bool,
-					"upper":            tftypes.Bool,
+					"created_at":               tftypes.String,
+					"keepers_hash":             tftypes.String,
+					"dns_label":                tftypes.Bool,
+					"grow_in_place":            tftypes.Bool,
+					"must_match":               tftypes.String,
+					"min_character_classes":    tftypes.Number,
+					"entropy_bits":             tftypes.Number,
+					"id":                       tftypes.String,
+					"keepers":                  tftypes.Map{ElementType: tftypes.String},
+					"length":                   tftypes.Number,
+					"lower":                    tftypes.Bool,
+					"min_lower":                tftypes.Number,
+					"min_numeric":              tftypes.Number,
+					"min_special":              tftypes.Number,
+					"min_upper":                tftypes.Number,
+					"number":                   tftypes.Bool,
+					"numeric":                  tftypes.Bool,
+					"override_special":         tftypes.String,
+					"provider_version":         tftypes.String,
+					"encrypted_result":         tftypes.String,
+					"override_special_list":    tftypes.List{ElementType: tftypes.String},
+					"charset_preset":           tftypes.String,
+					"spec":                     specTfType,
+					"length_unit":              tftypes.String,
+					"normalization":            tftypes.String,
+					"lifecycle_guard":          tftypes.Bool,
+					"allow_regeneration_token": tftypes.String,
+					"result":                   tftypes.String,
+					"special":                  tftypes.Bool,
+					"upper":                    tftypes.Bool,
 				},
 			}, map[string]tftypes.Value{
-				"id":               tftypes.NewValue(tftypes.String, "none"),
-				"keepers":          tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
-				"length":           tftypes.NewValue(tftypes.Number, 16),
-				"lower":            tftypes.NewValue(tftypes.Bool, true),
-				"min_lower":        tftypes.NewValue(tftypes.Number, 0),
-				"min_numeric":      tftypes.NewValue(tftypes.Number, 0),
-				"min_special":      tftypes.NewValue(tftypes.Number, 0),
-				"min_upper":        tftypes.NewValue(tftypes.Number, 0),
-				"number":           tftypes.NewValue(tftypes.Bool, true),
-				"numeric":          tftypes.NewValue(tftypes.Bool, true),
-				"override_special": tftypes.NewValue(tftypes.String, nil),
-				"result":           tftypes.NewValue(tftypes.String, "DZy_3*tnonj%Q%Yx"),
-				"special":          tftypes.NewValue(tftypes.Bool, true),
-				"upper":            tftypes.NewValue(tftypes.Bool, true),
+				"created_at":               tftypes.NewValue(tftypes.String, nil),
+				"keepers_hash":             tftypes.NewValue(tftypes.String, nil),
+				"dns_label":                tftypes.NewValue(tftypes.Bool, nil),
+				"grow_in_place":            tftypes.NewValue(tftypes.Bool, nil),
+				"must_match":               tftypes.NewValue(tftypes.String, nil),
+				"min_character_classes":    tftypes.NewValue(tftypes.Number, nil),
+				"entropy_bits":             tftypes.NewValue(tftypes.Number, nil),
+				"id":                       tftypes.NewValue(tftypes.String, "none"),
+				"keepers":                  tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"length":                   tftypes.NewValue(tftypes.Number, 16),
+				"lower":                    tftypes.NewValue(tftypes.Bool, true),
+				"min_lower":                tftypes.NewValue(tftypes.Number, 0),
+				"min_numeric":              tftypes.NewValue(tftypes.Number, 0),
+				"min_special":              tftypes.NewValue(tftypes.Number, 0),
+				"min_upper":                tftypes.NewValue(tftypes.Number, 0),
+				"number":                   tftypes.NewValue(tftypes.Bool, true),
+				"numeric":                  tftypes.NewValue(tftypes.Bool, true),
+				"override_special":         tftypes.NewValue(tftypes.String, nil),
+				"provider_version":         tftypes.NewValue(tftypes.String, nil),
+				"encrypted_result":         tftypes.NewValue(tftypes.String, nil),
+				"override_special_list":    tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+				"charset_preset":           tftypes.NewValue(tftypes.String, nil),
+				"spec":                     tftypes.NewValue(specTfType, nil),
+				"length_unit":              tftypes.NewValue(tftypes.String, nil),
+				"normalization":            tftypes.NewValue(tftypes.String, nil),
+				"lifecycle_guard":          tftypes.NewValue(tftypes.Bool, nil),
+				"allow_regeneration_token": tftypes.NewValue(tftypes.String, nil),
+				"result":                   tftypes.NewValue(tftypes.String, "DZy_3*tnonj%Q%Yx"),
+				"special":                  tftypes.NewValue(tftypes.Bool, true),
+				"upper":                    tftypes.NewValue(tftypes.Bool, true),
 			}),
 			Schema: stringSchemaV3(),
 		},
@@ -1567,36 +1914,68 @@ func TestUpgradeStringStateV2toV3(t *testing.T) {
 		State: tfsdk.State{
 			Raw: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
-					"id":               tftypes.String,
-					"keepers":          tftypes.Map{ElementType: tftypes.String},
-					"length":           tftypes.Number,
-					"lower":            tftypes.Bool,
-					"min_lower":        tftypes.Number,
-					"min_numeric":      tftypes.Number,
-					"min_special":      tftypes.Number,
-					"min_upper":        tftypes.Number,
-					"number":           tftypes.Bool,
-					"numeric":          tftypes.Bool,
-					"override_special": tftypes.String,
-					"result":           tftypes.String,
-					"special":          tftypes.Bool,
-					"upper":            tftypes.Bool,
+					"created_at":               tftypes.String,
+					"keepers_hash":             tftypes.String,
+					"dns_label":                tftypes.Bool,
+					"grow_in_place":            tftypes.Bool,
+					"must_match":               tftypes.String,
+					"min_character_classes":    tftypes.Number,
+					"entropy_bits":             tftypes.Number,
+					"id":                       tftypes.String,
+					"keepers":                  tftypes.Map{ElementType: tftypes.String},
+					"length":                   tftypes.Number,
+					"lower":                    tftypes.Bool,
+					"min_lower":                tftypes.Number,
+					"min_numeric":              tftypes.Number,
+					"min_special":              tftypes.Number,
+					"min_upper":                tftypes.Number,
+					"number":                   tftypes.Bool,
+					"numeric":                  tftypes.Bool,
+					"override_special":         tftypes.String,
+					"provider_version":         tftypes.String,
+					"encrypted_result":         tftypes.String,
+					"override_special_list":    tftypes.List{ElementType: tftypes.String},
+					"charset_preset":           tftypes.String,
+					"spec":                     specTfType,
+					"length_unit":              tftypes.String,
+					"normalization":            tftypes.String,
+					"lifecycle_guard":          tftypes.Bool,
+					"allow_regeneration_token": tftypes.String,
+					"result":                   tftypes.String,
+					"special":                  tftypes.Bool,
+					"upper":                    tftypes.Bool,
 				},
 			}, map[string]tftypes.Value{
-				"id":               tftypes.NewValue(tftypes.String, "none"),
-				"keepers":          tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
-				"length":           tftypes.NewValue(tftypes.Number, 16),
-				"lower":            tftypes.NewValue(tftypes.Bool, true),
-				"min_lower":        tftypes.NewValue(tftypes.Number, 0),
-				"min_numeric":      tftypes.NewValue(tftypes.Number, 0),
-				"min_special":      tftypes.NewValue(tftypes.Number, 0),
-				"min_upper":        tftypes.NewValue(tftypes.Number, 0),
-				"number":           tftypes.NewValue(tftypes.Bool, true),
-				"numeric":          tftypes.NewValue(tftypes.Bool, true),
-				"override_special": tftypes.NewValue(tftypes.String, "!#$%\u0026*()-_=+[]{}\u003c\u003e:?"),
-				"result":           tftypes.NewValue(tftypes.String, "DZy_3*tnonj%Q%Yx"),
-				"special":          tftypes.NewValue(tftypes.Bool, true),
-				"upper":            tftypes.NewValue(tftypes.Bool, true),
+				"created_at":               tftypes.NewValue(tftypes.String, nil),
+				"keepers_hash":             tftypes.NewValue(tftypes.String, nil),
+				"dns_label":                tftypes.NewValue(tftypes.Bool, nil),
+				"grow_in_place":            tftypes.NewValue(tftypes.Bool, nil),
+				"must_match":               tftypes.NewValue(tftypes.String, nil),
+				"min_character_classes":    tftypes.NewValue(tftypes.Number, nil),
+				"entropy_bits":             tftypes.NewValue(tftypes.Number, nil),
+				"id":                       tftypes.NewValue(tftypes.String, "none"),
+				"keepers":                  tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"length":                   tftypes.NewValue(tftypes.Number, 16),
+				"lower":                    tftypes.NewValue(tftypes.Bool, true),
+				"min_lower":                tftypes.NewValue(tftypes.Number, 0),
+				"min_numeric":              tftypes.NewValue(tftypes.Number, 0),
+				"min_special":              tftypes.NewValue(tftypes.Number, 0),
+				"min_upper":                tftypes.NewValue(tftypes.Number, 0),
+				"number":                   tftypes.NewValue(tftypes.Bool, true),
+				"numeric":                  tftypes.NewValue(tftypes.Bool, true),
+				"override_special":         tftypes.NewValue(tftypes.String, "!#$%\u0026*()-_=+[]{}\u003c\u003e:?"),
+				"provider_version":         tftypes.NewValue(tftypes.String, nil),
+				"encrypted_result":         tftypes.NewValue(tftypes.String, nil),
+				"override_special_list":    tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+				"charset_preset":           tftypes.NewValue(tftypes.String, nil),
+				"spec":                     tftypes.NewValue(specTfType, nil),
+				"length_unit":              tftypes.NewValue(tftypes.String, nil),
+				"normalization":            tftypes.NewValue(tftypes.String, nil),
+				"lifecycle_guard":          tftypes.NewValue(tftypes.Bool, nil),
+				"allow_regeneration_token": tftypes.NewValue(tftypes.String, nil),
+				"result":                   tftypes.NewValue(tftypes.String, "DZy_3*tnonj%Q%Yx"),
+				"special":                  tftypes.NewValue(tftypes.Bool, true),
+				"upper":                    tftypes.NewValue(tftypes.Bool, true),
 			}),
 			Schema: stringSchemaV3(),
 		},
@@ -1663,36 +2042,68 @@ func TestUpgradeStringStateV2toV3_NullValues(t *testing.T) {
 		State: tfsdk.State{
 			Raw: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
-					"id":               tftypes.String,
-					"keepers":          tftypes.Map{ElementType: tftypes.String},
-					"length":           tftypes.Number,
-					"lower":            tftypes.Bool,
-					"min_lower":        tftypes.Number,
-					"min_numeric":      tftypes.Number,
-					"min_special":      tftypes.Number,
-					"min_upper":        tftypes.Number,
-					"number":           tftypes.Bool,
-					"numeric":          tftypes.Bool,
-					"override_special": tftypes.String,
-					"result":           tftypes.String,
-					"special":          tftypes.Bool,
-					"upper":            tftypes.Bool,
+					"created_at":               tftypes.String,
+					"keepers_hash":             tftypes.String,
+					"dns_label":                tftypes.Bool,
+					"grow_in_place":            tftypes.Bool,
+					"must_match":               tftypes.String,
+					"min_character_classes":    tftypes.Number,
+					"entropy_bits":             tftypes.Number,
+					"id":                       tftypes.String,
+					"keepers":                  tftypes.Map{ElementType: tftypes.String},
+					"length":                   tftypes.Number,
+					"lower":                    tftypes.Bool,
+					"min_lower":                tftypes.Number,
+					"min_numeric":              tftypes.Number,
+					"min_special":              tftypes.Number,
+					"min_upper":                tftypes.Number,
+					"number":                   tftypes.Bool,
+					"numeric":                  tftypes.Bool,
+					"override_special":         tftypes.String,
+					"provider_version":         tftypes.String,
+					"encrypted_result":         tftypes.String,
+					"override_special_list":    tftypes.List{ElementType: tftypes.String},
+					"charset_preset":           tftypes.String,
+					"spec":                     specTfType,
+					"length_unit":              tftypes.String,
+					"normalization":            tftypes.String,
+					"lifecycle_guard":          tftypes.Bool,
+					"allow_regeneration_token": tftypes.String,
+					"result":                   tftypes.String,
+					"special":                  tftypes.Bool,
+					"upper":                    tftypes.Bool,
 				},
 			}, map[string]tftypes.Value{
-				"id":               tftypes.NewValue(tftypes.String, "none"),
-				"keepers":          tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
-				"length":           tftypes.NewValue(tftypes.Number, 16),
-				"lower":            tftypes.NewValue(tftypes.Bool, true),
-				"min_lower":        tftypes.NewValue(tftypes.Number, 0),
-				"min_numeric":      tftypes.NewValue(tftypes.Number, 0),
-				"min_special":      tftypes.NewValue(tftypes.Number, 0),
-				"min_upper":        tftypes.NewValue(tftypes.Number, 0),
-				"number":           tftypes.NewValue(tftypes.Bool, true),
-				"numeric":          tftypes.NewValue(tftypes.Bool, true),
-				"override_special": tftypes.NewValue(tftypes.String, nil),
-				"result":           tftypes.NewValue(tftypes.String, "DZy_3*tnonj%Q%Yx"),
-				"special":          tftypes.NewValue(tftypes.Bool, true),
-				"upper":            tftypes.NewValue(tftypes.Bool, true),
+				"created_at":               tftypes.NewValue(tftypes.String, nil),
+				"keepers_hash":             tftypes.NewValue(tftypes.String, nil),
+				"dns_label":                tftypes.NewValue(tftypes.Bool, nil),
+				"grow_in_place":            tftypes.NewValue(tftypes.Bool, nil),
+				"must_match":               tftypes.NewValue(tftypes.String, nil),
+				"min_character_classes":    tftypes.NewValue(tftypes.Number, nil),
+				"entropy_bits":             tftypes.NewValue(tftypes.Number, nil),
+				"id":                       tftypes.NewValue(tftypes.String, "none"),
+				"keepers":                  tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"length":                   tftypes.NewValue(tftypes.Number, 16),
+				"lower":                    tftypes.NewValue(tftypes.Bool, true),
+				"min_lower":                tftypes.NewValue(tftypes.Number, 0),
+				"min_numeric":              tftypes.NewValue(tftypes.Number, 0),
+				"min_special":              tftypes.NewValue(tftypes.Number, 0),
+				"min_upper":                tftypes.NewValue(tftypes.Number, 0),
+				"number":                   tftypes.NewValue(tftypes.Bool, true),
+				"numeric":                  tftypes.NewValue(tftypes.Bool, true),
+				"override_special":         tftypes.NewValue(tftypes.String, nil),
+				"provider_version":         tftypes.NewValue(tftypes.String, nil),
+				"encrypted_result":         tftypes.NewValue(tftypes.String, nil),
+				"override_special_list":    tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+				"charset_preset":           tftypes.NewValue(tftypes.String, nil),
+				"spec":                     tftypes.NewValue(specTfType, nil),
+				"length_unit":              tftypes.NewValue(tftypes.String, nil),
+				"normalization":            tftypes.NewValue(tftypes.String, nil),
+				"lifecycle_guard":          tftypes.NewValue(tftypes.Bool, nil),
+				"allow_regeneration_token": tftypes.NewValue(tftypes.String, nil),
+				"result":                   tftypes.NewValue(tftypes.String, "DZy_3*tnonj%Q%Yx"),
+				"special":                  tftypes.NewValue(tftypes.Bool, true),
+				"upper":                    tftypes.NewValue(tftypes.Bool, true),
 			}),
 			Schema: stringSchemaV3(),
 		},
@@ -1734,14 +2145,14 @@ func TestAccResourceString_Import_FromVersion3_1_3(t *testing.T) {
 				),
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_string" "test" {
 					length = 12
 				}`,
 				PlanOnly: true,
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_string" "test" {
 							length = 12
 						}`,
@@ -1800,7 +2211,7 @@ func TestAccResourceString_Import_FromVersion3_4_2(t *testing.T) {
 				),
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_string" "test" {
 							length = 12
 						}`,
@@ -1831,7 +2242,7 @@ func TestAccResourceString_NumericFalse(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_string" "test" {
 					length = 12
 					special = false
@@ -1849,7 +2260,7 @@ func TestAccResourceString_NumberFalse(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_string" "test" {
 					length = 12
 					special = false
@@ -1867,7 +2278,7 @@ func TestAccResourceString_NumericNumberFalse(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_string" "test" {
 					length = 12
 					special = false
@@ -1881,3 +2292,899 @@ func TestAccResourceString_NumericNumberFalse(t *testing.T) {
 		},
 	})
 }
+
+func TestAccResourceString_Spec(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_string" "test" {
+							length        = 8
+							special       = false
+							grow_in_place = true
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_string.test", tfjsonpath.New("spec").AtMapKey("length"), knownvalue.Int64Exact(8)),
+					statecheck.ExpectKnownValue("random_string.test", tfjsonpath.New("spec").AtMapKey("special"), knownvalue.Bool(false)),
+				},
+			},
+			{
+				Config: `resource "random_string" "test" {
+							length        = 12
+							special       = false
+							grow_in_place = true
+						}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("random_string.test", plancheck.ResourceActionUpdate),
+						plancheck.ExpectUnknownValue("random_string.test", tfjsonpath.New("spec")),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_string.test", tfjsonpath.New("spec").AtMapKey("length"), knownvalue.Int64Exact(12)),
+					statecheck.CompareValuePairs("random_string.test", tfjsonpath.New("spec").AtMapKey("entropy_bits"), "random_string.test", tfjsonpath.New("entropy_bits"), compare.ValuesSame()),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceString_GrowInPlace(t *testing.T) {
+	var first string
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_string" "test" {
+							length        = 8
+							grow_in_place = true
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_string.test", tfjsonpath.New("result"), knownvalue.StringFunc(func(value string) error {
+						first = value
+
+						return nil
+					})),
+				},
+			},
+			{
+				Config: `resource "random_string" "test" {
+							length        = 12
+							grow_in_place = true
+						}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("random_string.test", plancheck.ResourceActionUpdate),
+						plancheck.ExpectUnknownValue("random_string.test", tfjsonpath.New("result")),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_string.test", tfjsonpath.New("result"), knownvalue.StringFunc(func(value string) error {
+						if len(value) != 12 || !strings.HasPrefix(value, first) {
+							return fmt.Errorf("expected 12 characters beginning with %q, got: %q", first, value)
+						}
+
+						return nil
+					})),
+				},
+			},
+			{
+				Config: `resource "random_string" "test" {
+							length        = 10
+							grow_in_place = true
+						}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("random_string.test", plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceString_GrowInPlace_MinCharacterClasses(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_string" "test" {
+							length                = 8
+							min_character_classes = 3
+							grow_in_place         = true
+						}`,
+			},
+			{
+				Config: `resource "random_string" "test" {
+							length                = 9
+							min_character_classes = 3
+							grow_in_place         = true
+						}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("random_string.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_string.test", tfjsonpath.New("result"), randomtest.StringLengthExact(9)),
+				},
+			},
+		},
+	})
+}
+
+// TestGrowString grows results by fewer characters than the minimums, which
+// are satisfied by the prior result, and until they match the pattern.
+func TestGrowString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		prior   string
+		params  random.StringParams
+		pattern *regexp.Regexp
+	}{
+		"min-character-classes": {
+			prior: "aB3!",
+			params: random.StringParams{
+				Length:              5,
+				Upper:               true,
+				Lower:               true,
+				Numeric:             true,
+				Special:             true,
+				MinCharacterClasses: 3,
+			},
+		},
+		"min-character-classes-two": {
+			prior: "aB3!",
+			params: random.StringParams{
+				Length:              6,
+				Upper:               true,
+				Lower:               true,
+				Numeric:             true,
+				Special:             true,
+				MinCharacterClasses: 4,
+			},
+		},
+		"mins": {
+			prior: "aB3!",
+			params: random.StringParams{
+				Length:     5,
+				Upper:      true,
+				MinUpper:   1,
+				Lower:      true,
+				MinLower:   1,
+				Numeric:    true,
+				MinNumeric: 1,
+				Special:    true,
+				MinSpecial: 1,
+			},
+		},
+		"must-match": {
+			prior: "abcd",
+			params: random.StringParams{
+				Length:  8,
+				Lower:   true,
+				Numeric: true,
+			},
+			pattern: regexp.MustCompile(`^abcd[0-9]{2}`),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			result, matched, err := growString(random.NewSource(nil), testCase.prior, testCase.params, testCase.pattern)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !matched {
+				t.Fatal("expected a matching result")
+			}
+
+			if testCase.pattern != nil && !testCase.pattern.MatchString(result) {
+				t.Errorf("expected a result matching %q, got: %q", testCase.pattern, result)
+			}
+
+			if int64(len(result)) != testCase.params.Length || !strings.HasPrefix(result, testCase.prior) {
+				t.Errorf("expected %d characters beginning with %q, got: %q", testCase.params.Length, testCase.prior, result)
+			}
+		})
+	}
+}
+
+func TestAccResourceString_EncryptedResult(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `provider "random" {
+							result_encryption_key = "correct horse battery staple"
+						}
+
+						resource "random_string" "test" {
+							length        = 8
+							grow_in_place = true
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_string.test", tfjsonpath.New("encrypted_result"), knownvalue.StringRegexp(regexp.MustCompile(`^\$aes-256-gcm\$pbkdf2-sha256\$i=600000\$[A-Za-z0-9+/]{22}\$[A-Za-z0-9+/]{16}\$[A-Za-z0-9+/]{32}$`))),
+				},
+			},
+			{
+				Config: `provider "random" {
+							result_encryption_key = "correct horse battery staple"
+						}
+
+						resource "random_string" "test" {
+							length        = 12
+							grow_in_place = true
+						}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectUnknownValue("random_string.test", tfjsonpath.New("encrypted_result")),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_string.test", tfjsonpath.New("encrypted_result"), knownvalue.StringRegexp(regexp.MustCompile(`^\$aes-256-gcm\$pbkdf2-sha256\$i=600000\$[A-Za-z0-9+/]{22}\$[A-Za-z0-9+/]{16}\$[A-Za-z0-9+/]{38}$`))),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceString_MultiByteOverrideSpecial(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_string" "test" {
+							length           = 16
+							upper            = false
+							lower            = false
+							numeric          = false
+							override_special = "äöü€"
+							normalization    = "NFC"
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_string.test", tfjsonpath.New("result"), knownvalue.StringRegexp(regexp.MustCompile(`^[äöü€]{16}$`))),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceString_LengthUnitBytes_MultiByte(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_string" "test" {
+							length           = 16
+							override_special = "äö"
+							length_unit      = "bytes"
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute override_special must only contain ASCII characters`),
+			},
+		},
+	})
+}
+
+func TestAccResourceString_OverrideSpecialList(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_string" "test" {
+							length                = 16
+							upper                 = false
+							lower                 = false
+							numeric               = false
+							override_special_list = ["$", "{", "}", "€"]
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_string.test", tfjsonpath.New("result"), knownvalue.StringRegexp(regexp.MustCompile(`^[${}€]{16}$`))),
+				},
+			},
+			{
+				Config: `resource "random_string" "test" {
+							length                = 16
+							override_special_list = ["ä"]
+							length_unit           = "bytes"
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute override_special_list must only contain ASCII characters`),
+			},
+		},
+	})
+}
+
+func TestAccResourceString_DNSLabel(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_string" "test" {
+							length    = 63
+							dns_label = true
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_string.test", tfjsonpath.New("result"), knownvalue.StringRegexp(regexp.MustCompile(`^[a-z][a-z0-9-]{61}[a-z0-9]$`))),
+					statecheck.ExpectKnownValue("random_string.test", tfjsonpath.New("entropy_bits"), knownvalue.Float64Exact(math.Log2(26)+61*math.Log2(37)+math.Log2(36))),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceString_DNSLabel_Invalid(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_string" "test" {
+							length    = 64
+							dns_label = true
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute length value must be at most 63 when dns_label is true, got: 64`),
+			},
+			{
+				Config: `resource "random_string" "test" {
+							length    = 16
+							upper     = true
+							dns_label = true
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute upper cannot be configured when dns_label is true`),
+			},
+		},
+	})
+}
+
+func TestAccResourceString_CharsetPreset(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_string" "test" {
+							length         = 26
+							charset_preset = "base32"
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_string.test", tfjsonpath.New("result"), knownvalue.StringRegexp(regexp.MustCompile(`^[A-Z2-7]{26}$`))),
+					statecheck.ExpectKnownValue("random_string.test", tfjsonpath.New("entropy_bits"), knownvalue.Float64Exact(130)),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceString_CharsetPreset_Invalid(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_string" "test" {
+							length         = 16
+							upper          = false
+							charset_preset = "hex"
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute "upper" cannot be specified when "charset_preset" is specified`),
+			},
+			{
+				Config: `resource "random_string" "test" {
+							length         = 16
+							dns_label      = true
+							charset_preset = "hex"
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute charset_preset cannot be configured when dns_label is true`),
+			},
+		},
+	})
+}
+
+// TestCreateString_DNSLabel verifies that every generated DNS label is valid,
+// including those short enough for the first and last characters to overlap.
+func TestCreateString_DNSLabel(t *testing.T) {
+	t.Parallel()
+
+	entropy := random.NewSource(rand.New(rand.NewSource(1)))
+	label := regexp.MustCompile(`^[a-z]([a-z0-9-]*[a-z0-9])?$`)
+
+	for length := int64(1); length <= random.DNSLabelMaxLength; length++ {
+		for i := 0; i < 100; i++ {
+			result, err := entropy.CreateString(random.StringParams{
+				Length:   length,
+				DNSLabel: true,
+			})
+			if err != nil {
+				t.Fatalf("unexpected CreateString error: %s", err)
+			}
+
+			if int64(len(result)) != length || !label.Match(result) {
+				t.Fatalf("expected a valid DNS label of length %d, got: %q", length, result)
+			}
+		}
+	}
+
+	if _, err := entropy.CreateString(random.StringParams{Length: 64, DNSLabel: true}); err == nil {
+		t.Error("expected error for a DNS label longer than 63 characters")
+	}
+}
+
+func TestAccResourceString_EntropyBits(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_string" "test" {
+							length  = 16
+							special = false
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_string.test", tfjsonpath.New("entropy_bits"), knownvalue.Float64Exact(16*math.Log2(62))),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceString_EntropyBits_UpgradeFromVersion3_6_3(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				ExternalProviders: providerVersion363(),
+				Config: `resource "random_string" "test" {
+							length  = 16
+							upper   = false
+							special = false
+						}`,
+			},
+			{
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
+				Config: `resource "random_string" "test" {
+							length  = 16
+							upper   = false
+							special = false
+						}`,
+				PlanOnly: true,
+			},
+			{
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
+				Config: `resource "random_string" "test" {
+							length  = 16
+							upper   = false
+							special = false
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_string.test", tfjsonpath.New("entropy_bits"), knownvalue.Float64Exact(16*math.Log2(36))),
+				},
+			},
+		},
+	})
+}
+
+func TestStringEntropyBits(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		params   random.StringParams
+		expected float64
+	}{
+		"all-classes": {
+			params: random.StringParams{
+				Length:  16,
+				Upper:   true,
+				Lower:   true,
+				Numeric: true,
+				Special: true,
+			},
+			expected: 16 * math.Log2(83),
+		},
+		"numeric": {
+			params: random.StringParams{
+				Length:  6,
+				Numeric: true,
+			},
+			expected: 6 * math.Log2(10),
+		},
+		"override-special-duplicates": {
+			params: random.StringParams{
+				Length:          8,
+				Numeric:         true,
+				Special:         true,
+				OverrideSpecial: "--__",
+			},
+			expected: 8 * math.Log2(12),
+		},
+		"empty-character-set": {
+			params: random.StringParams{
+				Length: 8,
+			},
+			expected: 0,
+		},
+		"dns-label": {
+			params: random.StringParams{
+				Length:   8,
+				Upper:    true,
+				Special:  true,
+				DNSLabel: true,
+			},
+			expected: math.Log2(26) + 6*math.Log2(37) + math.Log2(36),
+		},
+		"dns-label-single-character": {
+			params: random.StringParams{
+				Length:   1,
+				DNSLabel: true,
+			},
+			expected: math.Log2(26),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := random.StringEntropyBits(testCase.params)
+
+			if diff := cmp.Diff(testCase.expected, got); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+// TestCreateString_Distribution guards against bias in character selection and
+// in the placement of the characters satisfying the minimums. A deterministic
+// source of entropy is used so that the test is repeatable.
+func TestCreateString_Distribution(t *testing.T) {
+	t.Parallel()
+
+	t.Run("characters", func(t *testing.T) {
+		t.Parallel()
+
+		entropy := random.NewSource(rand.New(rand.NewSource(1)))
+		params := random.StringParams{
+			Length:  83,
+			Upper:   true,
+			Lower:   true,
+			Numeric: true,
+			Special: true,
+		}
+
+		counts := make(map[byte]int)
+
+		for i := 0; i < 100; i++ {
+			result, err := entropy.CreateString(params)
+			if err != nil {
+				t.Fatalf("unexpected CreateString error: %s", err)
+			}
+
+			for _, c := range result {
+				counts[c]++
+			}
+		}
+
+		if len(counts) != 83 {
+			t.Fatalf("expected 83 distinct characters, got: %d", len(counts))
+		}
+
+		observed := make([]int, 0, len(counts))
+
+		for _, count := range counts {
+			observed = append(observed, count)
+		}
+
+		if err := randomtest.ChiSquaredUniform(observed); err != nil {
+			t.Error(err)
+		}
+	})
+
+	t.Run("minimum-positions", func(t *testing.T) {
+		t.Parallel()
+
+		entropy := random.NewSource(rand.New(rand.NewSource(2)))
+		params := random.StringParams{
+			Length:   6,
+			Lower:    true,
+			MinUpper: 1,
+		}
+
+		observed := make([]int, params.Length)
+
+		for i := 0; i < 6000; i++ {
+			result, err := entropy.CreateString(params)
+			if err != nil {
+				t.Fatalf("unexpected CreateString error: %s", err)
+			}
+
+			for position, c := range result {
+				if c >= 'A' && c <= 'Z' {
+					observed[position]++
+				}
+			}
+		}
+
+		if err := randomtest.ChiSquaredUniform(observed); err != nil {
+			t.Error(err)
+		}
+	})
+}
+
+func BenchmarkCreateString(b *testing.B) {
+	for _, length := range []int64{16, 256, 4096} {
+		params := random.StringParams{
+			Length:     length,
+			Upper:      true,
+			MinUpper:   2,
+			Lower:      true,
+			MinLower:   2,
+			Numeric:    true,
+			MinNumeric: 2,
+			Special:    true,
+			MinSpecial: 2,
+		}
+
+		b.Run(fmt.Sprintf("length-%d", length), func(b *testing.B) {
+			entropy := random.NewSource(nil)
+
+			b.ReportAllocs()
+
+			for i := 0; i < b.N; i++ {
+				if _, err := entropy.CreateString(params); err != nil {
+					b.Fatalf("unexpected CreateString error: %s", err)
+				}
+			}
+		})
+	}
+}
+
+func TestAccResourceString_MoveFromPassword(t *testing.T) {
+	assertResultSame := statecheck.CompareValue(compare.ValuesSame())
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_password" "test" {
+							length  = 16
+							special = false
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					assertResultSame.AddStateValue("random_password.test", tfjsonpath.New("result")),
+				},
+			},
+			{
+				Config: `moved {
+							from = random_password.test
+							to   = random_string.test
+						}
+
+						resource "random_string" "test" {
+							length  = 16
+							special = false
+						}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("random_string.test", plancheck.ResourceActionNoop),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					assertResultSame.AddStateValue("random_string.test", tfjsonpath.New("result")),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceString_LifecycleMetadata(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_string" "test" {
+							length = 12
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_string.test", tfjsonpath.New("created_at"), knownvalue.StringRegexp(regexp.MustCompile(`^\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}Z$`))),
+					statecheck.ExpectKnownValue("random_string.test", tfjsonpath.New("provider_version"), knownvalue.StringExact("test")),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceString_LifecycleMetadata_UpgradeFromVersion3_6_3(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				ExternalProviders: providerVersion363(),
+				Config: `resource "random_string" "test" {
+							length = 12
+						}`,
+			},
+			{
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
+				Config: `resource "random_string" "test" {
+							length = 12
+						}`,
+				PlanOnly: true,
+			},
+			{
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
+				Config: `resource "random_string" "test" {
+							length = 12
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_string.test", tfjsonpath.New("created_at"), knownvalue.Null()),
+					statecheck.ExpectKnownValue("random_string.test", tfjsonpath.New("provider_version"), knownvalue.Null()),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceString_LifecycleGuard(t *testing.T) {
+	// The id attribute values should differ only once the token is changed
+	assertIdDiffer := statecheck.CompareValue(compare.ValuesDiffer())
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_string" "test" {
+					length          = 12
+					lifecycle_guard = true
+					keepers = {
+						"key" = "123"
+					}
+				}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					assertIdDiffer.AddStateValue("random_string.test", tfjsonpath.New("id")),
+				},
+			},
+			{
+				Config: `resource "random_string" "test" {
+					length          = 12
+					lifecycle_guard = true
+					keepers = {
+						"key" = "456"
+					}
+				}`,
+				ExpectError: regexp.MustCompile(`(?s)Regeneration Prevented.*changes to keepers`),
+			},
+			{
+				Config: `resource "random_string" "test" {
+					length                   = 12
+					lifecycle_guard          = true
+					allow_regeneration_token = "CHG-1"
+					keepers = {
+						"key" = "456"
+					}
+				}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					assertIdDiffer.AddStateValue("random_string.test", tfjsonpath.New("id")),
+					statecheck.ExpectKnownValue("random_string.test", tfjsonpath.New("allow_regeneration_token"), knownvalue.StringExact("CHG-1")),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceString_Identity(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_string" "test" {
+							length = 12
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentity("random_string.test", map[string]knownvalue.Check{
+						"id": knownvalue.NotNull(),
+					}),
+					statecheck.ExpectIdentityValueMatchesState("random_string.test", tfjsonpath.New("id")),
+				},
+			},
+			{
+				ResourceName:    "random_string.test",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+		},
+	})
+}
+
+func TestAccResourceString_MustMatch(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_string" "test" {
+							length     = 12
+							special    = false
+							must_match = "^[a-z].*[0-9]$"
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_string.test", tfjsonpath.New("result"), knownvalue.StringRegexp(regexp.MustCompile(`^[a-z][a-zA-Z0-9]{10}[0-9]$`))),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceString_MustMatch_GrowInPlace(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_string" "test" {
+							length        = 8
+							special       = false
+							grow_in_place = true
+							must_match    = "[0-9]$"
+						}`,
+			},
+			{
+				Config: `resource "random_string" "test" {
+							length        = 12
+							special       = false
+							grow_in_place = true
+							must_match    = "[0-9]$"
+						}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("random_string.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_string.test", tfjsonpath.New("result"), knownvalue.StringRegexp(regexp.MustCompile(`^[a-zA-Z0-9]{11}[0-9]$`))),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceString_MustMatch_Invalid(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_string" "test" {
+							length     = 12
+							must_match = "[a-z"
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute must_match value must be a valid RE2 regular expression`),
+			},
+			{
+				Config: `resource "random_string" "test" {
+							length     = 12
+							upper      = false
+							lower      = false
+							special    = false
+							must_match = "[a-z]"
+						}`,
+				ExpectError: regexp.MustCompile(`Result Does Not Match`),
+			},
+		},
+	})
+}
+
+func TestGrowString_NoMatch(t *testing.T) {
+	t.Parallel()
+
+	// The prior result can never match, whatever is appended.
+	_, matched, err := growString(random.NewSource(nil), "abcd", random.StringParams{Length: 8, Lower: true}, regexp.MustCompile(`^[0-9]`))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if matched {
+		t.Error("expected no matching result")
+	}
+}
@@ -5,109 +5,256 @@ package provider
 
 import (
 	"context"
+	"encoding/base64"
+	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/terraform-providers/terraform-provider-random/internal/diagnostics"
+	listplanmodifiers "github.com/terraform-providers/terraform-provider-random/internal/planmodifiers/list"
 	mapplanmodifiers "github.com/terraform-providers/terraform-provider-random/internal/planmodifiers/map"
+	"github.com/terraform-providers/terraform-provider-random/internal/random"
+	"github.com/terraform-providers/terraform-provider-random/internal/validators"
 )
 
+// uuidMaxQuantity is the maximum number of UUIDs in results, which bounds the
+// memory used to generate them and the size of the state.
+const uuidMaxQuantity = 10000
+
 var (
-	_ resource.Resource                = (*uuidResource)(nil)
-	_ resource.ResourceWithImportState = (*uuidResource)(nil)
+	_ resource.Resource                 = (*uuidResource)(nil)
+	_ resource.ResourceWithConfigure    = (*uuidResource)(nil)
+	_ resource.ResourceWithIdentity     = (*uuidResource)(nil)
+	_ resource.ResourceWithImportState  = (*uuidResource)(nil)
+	_ resource.ResourceWithModifyPlan   = (*uuidResource)(nil)
+	_ resource.ResourceWithUpgradeState = (*uuidResource)(nil)
 )
 
 func NewUuidResource() resource.Resource {
 	return &uuidResource{}
 }
 
-type uuidResource struct{}
+type uuidResource struct {
+	providerVersion string
+	entropy         *random.Source
+	namespace       []byte
+}
 
 func (r *uuidResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_uuid"
 }
 
 func (r *uuidResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		Description: "The resource `random_uuid` generates a random uuid string that is intended to be " +
-			"used as a unique identifier for other resources.\n" +
-			"\n" +
-			"This resource uses [hashicorp/go-uuid](https://github.com/hashicorp/go-uuid) to generate a " +
-			"UUID-formatted string for use with services needing a unique string identifier.",
-		Attributes: map[string]schema.Attribute{
-			"keepers": schema.MapAttribute{
-				Description: "Arbitrary map of values that, when changed, will trigger recreation of " +
-					"resource. See [the main provider documentation](../index.html) for more information.",
-				ElementType: types.StringType,
-				Optional:    true,
-				PlanModifiers: []planmodifier.Map{
-					mapplanmodifiers.RequiresReplaceIfValuesNotNull(),
-				},
-			},
-			"result": schema.StringAttribute{
-				Description: "The generated uuid presented in string format.",
-				Computed:    true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.UseStateForUnknown(),
-				},
-			},
-			"id": schema.StringAttribute{
-				Description: "The generated uuid presented in string format.",
-				Computed:    true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.UseStateForUnknown(),
-				},
-			},
-		},
-	}
+	resp.Schema = uuidSchemaV1()
+}
+
+func (r *uuidResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identitySchema("The generated uuid presented in string format.")
+}
+
+func (r *uuidResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	r.providerVersion = providerVersion(req.ProviderData)
+	r.entropy = providerEntropy(req.ProviderData)
+	r.namespace = providerUUIDNamespace(req.ProviderData)
+}
+
+// ModifyPlan prevents the replacement of the resource when lifecycle_guard is
+// enabled, unless allow_regeneration_token is changed.
+func (r *uuidResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	guardRegeneration(ctx, req, resp)
 }
 
 func (r *uuidResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	result, err := uuid.GenerateUUID()
-	if err != nil {
-		resp.Diagnostics.AddError(
+	var plan uuidModelV1
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	quantity := int64(1)
+
+	if !plan.Quantity.IsNull() {
+		quantity = plan.Quantity.ValueInt64()
+	}
+
+	// The quantity is validated in the schema, but may not be known until
+	// apply.
+	if quantity < 1 || quantity > uuidMaxQuantity {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("quantity"),
 			"Create Random UUID error",
-			"There was an error during generation of a UUID.\n\n"+
-				diagnostics.RetryMsg+
-				fmt.Sprintf("Original Error: %s", err),
+			fmt.Sprintf("The quantity must be between 1 and %d, got: %d.", uuidMaxQuantity, quantity),
 		)
 		return
 	}
 
-	var plan uuidModelV0
+	var results []attr.Value
+
+	if plan.Deterministic.ValueBool() {
+		results, diags = r.createDeterministic(ctx, plan.Keepers)
+	} else {
+		results, diags = r.createRandom(ctx, quantity)
+	}
 
-	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	u := &uuidModelV0{
-		ID:      types.StringValue(result),
-		Result:  types.StringValue(result),
-		Keepers: plan.Keepers,
+	// The first generated UUID is used for the result and id attributes, so
+	// that configurations which do not set quantity are unaffected.
+	result := results[0].(types.String).ValueString()
+
+	resultsList, diags := types.ListValue(types.StringType, results)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
+	u := &uuidModelV1{
+		ID:                     types.StringValue(result),
+		Result:                 types.StringValue(result),
+		Results:                resultsList,
+		Quantity:               plan.Quantity,
+		Deterministic:          plan.Deterministic,
+		ExpiresAfter:           plan.ExpiresAfter,
+		Keepers:                plan.Keepers,
+		KeepersHash:            plan.KeepersHash,
+		LifecycleGuard:         plan.LifecycleGuard,
+		AllowRegenerationToken: plan.AllowRegenerationToken,
+	}
+
+	u.setForms()
+	u.CreatedAt, u.ProviderVersion = lifecycleValues(r.providerVersion)
+
 	diags = resp.State.Set(ctx, u)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, u.ID)...)
+}
+
+// createRandom returns quantity random version 4 UUIDs.
+func (r *uuidResource) createRandom(ctx context.Context, quantity int64) ([]attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	results := make([]attr.Value, quantity)
+
+	done := logGeneration(ctx, randomSourceCrypto, map[string]any{
+		"quantity": quantity,
+	})
+	defer done()
+
+	for i := range results {
+		value, err := uuid.GenerateUUIDWithReader(r.entropy)
+		if errors.Is(err, random.ErrEntropyUnavailable) {
+			diags.Append(diagnostics.EntropyUnavailableError(err)...)
+			return nil, diags
+		}
+		if err != nil {
+			diags.AddError(
+				"Create Random UUID error",
+				"There was an error during generation of a UUID.\n\n"+
+					diagnostics.RetryMsg+
+					fmt.Sprintf("Original Error: %s", err),
+			)
+			return nil, diags
+		}
+
+		results[i] = types.StringValue(value)
+	}
+
+	return results, diags
+}
+
+// createDeterministic returns the version 5 UUID of the keepers in the
+// namespace of the provider configuration.
+func (r *uuidResource) createDeterministic(ctx context.Context, keepers types.Map) ([]attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if r.namespace == nil {
+		diags.AddAttributeError(
+			path.Root("deterministic"),
+			"Missing UUID Namespace",
+			"A deterministic uuid is generated in the uuid_namespace of the provider configuration, which is "+
+				"not set. Set uuid_namespace to a UUID, such as one generated once for the organization, or "+
+				"disable deterministic.",
+		)
+		return nil, diags
+	}
+
+	done := logGeneration(ctx, randomSourceDerived, map[string]any{
+		"algorithm": "uuid-v5",
+	})
+	defer done()
+
+	value, err := deterministicUUID(r.namespace, keepers)
+	if err != nil {
+		diags.AddError(
+			"Create Random UUID error",
+			"There was an error during generation of a UUID.\n\n"+
+				diagnostics.RetryMsg+
+				fmt.Sprintf("Original Error: %s", err),
+		)
+		return nil, diags
+	}
+
+	return []attr.Value{types.StringValue(value)}, diags
 }
 
-// Read does not need to perform any operations as the state in ReadResourceResponse is already populated.
+// Read does not need to refresh the state as the state in ReadResourceResponse is already populated, other than to
+// populate result_compact, result_b64, the checksums and keepers_hash for resources created by earlier provider
+// versions. The identity is set from state, as those resources also do not have an identity.
 func (r *uuidResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var model uuidModelV1
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if (model.ResultCompact.IsNull() || model.SHA256.IsNull()) && !model.Result.IsNull() {
+		logRefresh(ctx, "result_compact", "result_b64", "crc32", "sha1", "sha256")
+
+		model.setForms()
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(refreshKeepersHash(ctx, &resp.State)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setIdentityFromState(ctx, resp.State, resp.Identity)...)
 }
 
-// Update ensures the plan value is copied to the state to complete the update.
+// Update ensures the plan value is copied to the state to complete the update. The result_compact, result_b64 and
+// checksum values are unknown in the plan if the state was not refreshed since upgrading from an earlier provider
+// version.
 func (r *uuidResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var model uuidModelV0
+	var model uuidModelV1
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
 
@@ -115,6 +262,10 @@ func (r *uuidResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
+	if model.ResultCompact.IsUnknown() || model.ResultB64.IsUnknown() || model.SHA256.IsUnknown() {
+		model.setForms()
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
 }
 
@@ -124,12 +275,18 @@ func (r *uuidResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 }
 
 func (r *uuidResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	bytes, err := uuid.ParseUUID(req.ID)
+	id, diags := importID(ctx, req)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bytes, err := uuid.ParseUUID(normalizeUUIDImport(id))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Import Random UUID Error",
-			"There was an error during the parsing of the UUID.\n\n"+
-				diagnostics.RetryMsg+
+			fmt.Sprintf("The import id %q could not be parsed as a UUID.\n\n", id)+
+				uuidImportUsage+"\n\n"+
 				fmt.Sprintf("Original Error: %s", err),
 		)
 		return
@@ -146,21 +303,293 @@ func (r *uuidResource) ImportState(ctx context.Context, req resource.ImportState
 		return
 	}
 
-	var state uuidModelV0
+	logImport(ctx, nil)
+
+	var state uuidModelV1
 
 	state.ID = types.StringValue(result)
 	state.Result = types.StringValue(result)
+	state.Results = types.ListValueMust(types.StringType, []attr.Value{types.StringValue(result)})
+	state.Quantity = types.Int64Null()
+	state.Deterministic = types.BoolNull()
+	state.setForms()
 	state.Keepers = types.MapNull(types.StringType)
+	state.KeepersHash = keepersHash(state.Keepers)
+	state.ExpiresAfter = types.StringNull()
+	state.CreatedAt = types.StringNull()
+	state.ProviderVersion = types.StringNull()
+	state.LifecycleGuard = types.BoolNull()
+	state.AllowRegenerationToken = types.StringNull()
 
-	diags := resp.State.Set(ctx, &state)
+	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	resp.Diagnostics.Append(setIdentity(ctx, resp.Identity, state.ID)...)
 }
 
-type uuidModelV0 struct {
-	ID      types.String `tfsdk:"id"`
-	Keepers types.Map    `tfsdk:"keepers"`
-	Result  types.String `tfsdk:"result"`
+func (r *uuidResource) UpgradeState(context.Context) map[int64]resource.StateUpgrader {
+	schemaV0 := uuidSchemaV0()
+
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema:   &schemaV0,
+			StateUpgrader: upgradeUuidStateV0toV1,
+		},
+	}
+}
+
+func upgradeUuidStateV0toV1(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	logStateUpgrade(ctx, 0, 1)
+
+	type modelV0 struct {
+		ID      types.String `tfsdk:"id"`
+		Keepers types.Map    `tfsdk:"keepers"`
+		Result  types.String `tfsdk:"result"`
+	}
+
+	var uuidDataV0 modelV0
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &uuidDataV0)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The creation time and provider version of resources created prior to
+	// schema version 1 are not known, so they are left as null.
+	uuidDataV1 := uuidModelV1{
+		ID:                     uuidDataV0.ID,
+		Keepers:                uuidDataV0.Keepers,
+		Quantity:               types.Int64Null(),
+		Deterministic:          types.BoolNull(),
+		Result:                 uuidDataV0.Result,
+		Results:                types.ListValueMust(types.StringType, []attr.Value{uuidDataV0.Result}),
+		ResultCompact:          types.StringNull(),
+		ResultB64:              types.StringNull(),
+		CRC32:                  types.StringNull(),
+		SHA1:                   types.StringNull(),
+		SHA256:                 types.StringNull(),
+		ExpiresAfter:           types.StringNull(),
+		KeepersHash:            types.StringNull(),
+		CreatedAt:              types.StringNull(),
+		ProviderVersion:        types.StringNull(),
+		LifecycleGuard:         types.BoolNull(),
+		AllowRegenerationToken: types.StringNull(),
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, uuidDataV1)...)
+}
+
+// requiresReplaceIfExpired returns a plan modifier for the expires_after
+// attribute which requires replacement once the duration has elapsed since the
+// created_at time recorded in state. Unlike the RequiresReplaceIf plan
+// modifiers, replacement may be required when the value has not changed.
+func requiresReplaceIfExpired() planmodifier.String {
+	return requiresReplaceIfExpiredModifier{}
+}
+
+type requiresReplaceIfExpiredModifier struct{}
+
+func (m requiresReplaceIfExpiredModifier) Description(ctx context.Context) string {
+	return m.MarkdownDescription(ctx)
+}
+
+func (m requiresReplaceIfExpiredModifier) MarkdownDescription(context.Context) string {
+	return "Requires replacement once the duration has elapsed since the resource was created."
+}
+
+func (m requiresReplaceIfExpiredModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	// Creation and destruction do not require replacement.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var createdAt types.String
+
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("created_at"), &createdAt)...)
+
+	if resp.Diagnostics.HasError() || createdAt.IsNull() {
+		return
+	}
+
+	created, err := time.Parse(time.RFC3339, createdAt.ValueString())
+	if err != nil {
+		return
+	}
+
+	// The value has been validated.
+	duration, err := time.ParseDuration(req.ConfigValue.ValueString())
+	if err != nil {
+		return
+	}
+
+	if !time.Now().Before(created.Add(duration)) {
+		resp.RequiresReplace = true
+	}
+}
+
+func uuidSchemaV1() schema.Schema {
+	return schema.Schema{
+		Version: 1,
+		Description: "The resource `random_uuid` generates a random uuid string that is intended to be " +
+			"used as a unique identifier for other resources.\n" +
+			"\n" +
+			"This resource uses [hashicorp/go-uuid](https://github.com/hashicorp/go-uuid) to generate a " +
+			"UUID-formatted string for use with services needing a unique string identifier.",
+		Attributes: map[string]schema.Attribute{
+			"keepers": schema.MapAttribute{
+				Description: "Arbitrary map of values that, when changed, will trigger recreation of " +
+					"resource. See [the main provider documentation](../index.html) for more information.",
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifiers.RequiresReplaceIfValuesNotNull(),
+				},
+			},
+			"quantity": schema.Int64Attribute{
+				Description: fmt.Sprintf("The number of UUIDs to generate in `results`, between 1 and %d. When "+
+					"omitted, a single UUID is generated. Use this in preference to `count` when a large number of "+
+					"UUIDs are required.", uuidMaxQuantity),
+				Optional: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(1, uuidMaxQuantity),
+				},
+			},
+			"result": schema.StringAttribute{
+				Description: "The generated uuid presented in string format. When `quantity` is set, this " +
+					"is the first element of `results`.",
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"result_compact": schema.StringAttribute{
+				Description: "The generated uuid `result` as 32 hexadecimal characters, without dashes.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"result_b64": schema.StringAttribute{
+				Description: "The 16 bytes of the generated uuid `result`, base64 encoded with padding.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"crc32":  checksumAttribute("CRC-32 (IEEE)", "`result`"),
+			"sha1":   checksumAttribute("SHA-1", "`result`"),
+			"sha256": checksumAttribute("SHA-256", "`result`"),
+			"results": schema.ListAttribute{
+				Description: "The generated uuids presented in string format. The number of elements is " +
+					"determined by `quantity` if set, otherwise a single element equal to `result`.",
+				ElementType: types.StringType,
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifiers.UseStateForUnknownIncludingNull(),
+				},
+			},
+			"deterministic": uuidDeterministicAttribute(),
+			"expires_after": schema.StringAttribute{
+				Description: "The duration after which the uuid is regenerated, such as `720h`, for identifiers " +
+					"which should not live forever. The age of the uuid is measured from `created_at`, and a new " +
+					"uuid is planned by the first plan after it expires. Valid time units are `s`, `m` and `h`, " +
+					"as accepted by Go's [time.ParseDuration](https://pkg.go.dev/time#ParseDuration). Imported " +
+					"uuids, and uuids created by provider versions which did not record `created_at`, are never " +
+					"regenerated. Changing this value does not regenerate the uuid, unless it has expired " +
+					"according to the new value.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					requiresReplaceIfExpired(),
+				},
+				Validators: []validator.String{
+					validators.PositiveDuration(),
+				},
+			},
+			"keepers_hash":             keepersHashAttribute(),
+			"created_at":               createdAtAttribute(),
+			"provider_version":         providerVersionAttribute(),
+			"lifecycle_guard":          lifecycleGuardAttribute(),
+			"allow_regeneration_token": allowRegenerationTokenAttribute(),
+			"id": schema.StringAttribute{
+				Description: "The generated uuid presented in string format.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func uuidSchemaV0() schema.Schema {
+	return schema.Schema{
+		Description: "The resource `random_uuid` generates a random uuid string that is intended to be " +
+			"used as a unique identifier for other resources.\n" +
+			"\n" +
+			"This resource uses [hashicorp/go-uuid](https://github.com/hashicorp/go-uuid) to generate a " +
+			"UUID-formatted string for use with services needing a unique string identifier.",
+		Attributes: map[string]schema.Attribute{
+			"keepers": schema.MapAttribute{
+				Description: "Arbitrary map of values that, when changed, will trigger recreation of " +
+					"resource. See [the main provider documentation](../index.html) for more information.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"result": schema.StringAttribute{
+				Description: "The generated uuid presented in string format.",
+				Computed:    true,
+			},
+			"id": schema.StringAttribute{
+				Description: "The generated uuid presented in string format.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+type uuidModelV1 struct {
+	ID                     types.String `tfsdk:"id"`
+	Keepers                types.Map    `tfsdk:"keepers"`
+	KeepersHash            types.String `tfsdk:"keepers_hash"`
+	Quantity               types.Int64  `tfsdk:"quantity"`
+	Deterministic          types.Bool   `tfsdk:"deterministic"`
+	Result                 types.String `tfsdk:"result"`
+	ResultCompact          types.String `tfsdk:"result_compact"`
+	ResultB64              types.String `tfsdk:"result_b64"`
+	CRC32                  types.String `tfsdk:"crc32"`
+	SHA1                   types.String `tfsdk:"sha1"`
+	SHA256                 types.String `tfsdk:"sha256"`
+	Results                types.List   `tfsdk:"results"`
+	ExpiresAfter           types.String `tfsdk:"expires_after"`
+	CreatedAt              types.String `tfsdk:"created_at"`
+	ProviderVersion        types.String `tfsdk:"provider_version"`
+	LifecycleGuard         types.Bool   `tfsdk:"lifecycle_guard"`
+	AllowRegenerationToken types.String `tfsdk:"allow_regeneration_token"`
+}
+
+// setForms sets result_compact and result_b64 from result, or null if result
+// is not a valid uuid, and the checksums of result.
+func (m *uuidModelV1) setForms() {
+	m.CRC32, m.SHA1, m.SHA256 = checksums([]byte(m.Result.ValueString()))
+
+	bytes, err := uuid.ParseUUID(m.Result.ValueString())
+	if err != nil {
+		m.ResultCompact = types.StringNull()
+		m.ResultB64 = types.StringNull()
+
+		return
+	}
+
+	m.ResultCompact = types.StringValue(strings.ReplaceAll(m.Result.ValueString(), "-", ""))
+	m.ResultB64 = types.StringValue(base64.StdEncoding.EncodeToString(bytes))
 }
@@ -6,17 +6,20 @@ package provider
 import (
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-testing/compare"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 func TestAccResourceUUID(t *testing.T) {
 	resource.UnitTest(t, resource.TestCase{
-		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: `resource "random_uuid" "basic" { 
@@ -26,9 +29,10 @@ func TestAccResourceUUID(t *testing.T) {
 				},
 			},
 			{
-				ResourceName:      "random_uuid.basic",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "random_uuid.basic",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"created_at", "provider_version"},
 			},
 		},
 	})
@@ -36,7 +40,7 @@ func TestAccResourceUUID(t *testing.T) {
 
 func TestAccResourceUUID_ImportWithoutKeepersProducesNoPlannedChanges(t *testing.T) {
 	resource.UnitTest(t, resource.TestCase{
-		ProtoV5ProviderFactories: protoV5ProviderFactories(),
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
 		Steps: []resource.TestStep{
 			{
 				Config: `resource "random_uuid" "basic" { 
@@ -55,6 +59,51 @@ func TestAccResourceUUID_ImportWithoutKeepersProducesNoPlannedChanges(t *testing
 	})
 }
 
+func TestAccResourceUUID_ImportNormalized(t *testing.T) {
+	for name, importID := range map[string]string{
+		"upper":  "6B0F8E7C-3EA6-4523-88A2-5A70419EE954",
+		"braced": "{6B0F8E7C-3EA6-4523-88A2-5A70419EE954}",
+		"urn":    "urn:uuid:6b0f8e7c-3ea6-4523-88a2-5a70419ee954",
+	} {
+		t.Run(name, func(t *testing.T) {
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
+				Steps: []resource.TestStep{
+					{
+						Config:             `resource "random_uuid" "basic" {}`,
+						ResourceName:       "random_uuid.basic",
+						ImportStateId:      importID,
+						ImportState:        true,
+						ImportStatePersist: true,
+						ConfigStateChecks: []statecheck.StateCheck{
+							statecheck.ExpectKnownValue("random_uuid.basic", tfjsonpath.New("result"), knownvalue.StringExact("6b0f8e7c-3ea6-4523-88a2-5a70419ee954")),
+						},
+					},
+					{
+						Config:   `resource "random_uuid" "basic" {}`,
+						PlanOnly: true,
+					},
+				},
+			})
+		})
+	}
+}
+
+func TestAccResourceUUID_Import_Invalid(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config:        `resource "random_uuid" "basic" {}`,
+				ResourceName:  "random_uuid.basic",
+				ImportStateId: "{6b0f8e7c-3ea6-4523-88a2-5a70419ee95}",
+				ImportState:   true,
+				ExpectError:   regexp.MustCompile(`could not be parsed as a UUID`),
+			},
+		},
+	})
+}
+
 func TestAccResourceUUID_Keepers_Keep_EmptyMap(t *testing.T) {
 	// The id attribute values should be the same between test steps
 	assertIdSame := statecheck.CompareValue(compare.ValuesSame())
@@ -62,7 +111,7 @@ func TestAccResourceUUID_Keepers_Keep_EmptyMap(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_uuid" "test" {
 					keepers = {}
 				}`,
@@ -72,7 +121,7 @@ func TestAccResourceUUID_Keepers_Keep_EmptyMap(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_uuid" "test" {
 					keepers = {}
 				}`,
@@ -92,7 +141,7 @@ func TestAccResourceUUID_Keepers_Keep_EmptyMapToNullValue(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_uuid" "test" {
 					keepers = {}
 				}`,
@@ -102,7 +151,7 @@ func TestAccResourceUUID_Keepers_Keep_EmptyMapToNullValue(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_uuid" "test" {
 					keepers = {
 						"key" = null
@@ -124,7 +173,7 @@ func TestAccResourceUUID_Keepers_Keep_NullMap(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_uuid" "test" {
 				}`,
 				ConfigStateChecks: []statecheck.StateCheck{
@@ -133,7 +182,7 @@ func TestAccResourceUUID_Keepers_Keep_NullMap(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_uuid" "test" {
 				}`,
 				ConfigStateChecks: []statecheck.StateCheck{
@@ -152,7 +201,7 @@ func TestAccResourceUUID_Keepers_Keep_NullMapToNullValue(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_uuid" "test" {
 				}`,
 				ConfigStateChecks: []statecheck.StateCheck{
@@ -161,7 +210,7 @@ func TestAccResourceUUID_Keepers_Keep_NullMapToNullValue(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_uuid" "test" {
 					keepers = {
 						"key" = null
@@ -183,7 +232,7 @@ func TestAccResourceUUID_Keepers_Keep_NullValue(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_uuid" "test" {
 					keepers = {
 						"key" = null
@@ -195,7 +244,7 @@ func TestAccResourceUUID_Keepers_Keep_NullValue(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_uuid" "test" {
 					keepers = {
 						"key" = null
@@ -217,7 +266,7 @@ func TestAccResourceUUID_Keepers_Keep_NullValues(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_uuid" "test" {
 					keepers = {
 						"key1" = null
@@ -230,7 +279,7 @@ func TestAccResourceUUID_Keepers_Keep_NullValues(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_uuid" "test" {
 					keepers = {
 						"key1" = null
@@ -253,7 +302,7 @@ func TestAccResourceUUID_Keepers_Keep_Value(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_uuid" "test" {
 					keepers = {
 						"key" = "123"
@@ -265,7 +314,7 @@ func TestAccResourceUUID_Keepers_Keep_Value(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_uuid" "test" {
 					keepers = {
 						"key" = "123"
@@ -287,7 +336,7 @@ func TestAccResourceUUID_Keepers_Keep_Values(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_uuid" "test" {
 					keepers = {
 						"key1" = "123"
@@ -300,7 +349,7 @@ func TestAccResourceUUID_Keepers_Keep_Values(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_uuid" "test" {
 					keepers = {
 						"key1" = "123"
@@ -323,7 +372,7 @@ func TestAccResourceUUID_Keepers_Replace_EmptyMapToValue(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_uuid" "test" {
 					keepers = {}
 				}`,
@@ -333,7 +382,7 @@ func TestAccResourceUUID_Keepers_Replace_EmptyMapToValue(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_uuid" "test" {
 					keepers = {
 						"key" = "123"
@@ -355,7 +404,7 @@ func TestAccResourceUUID_Keepers_Replace_NullMapToValue(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_uuid" "test" {
 				}`,
 				ConfigStateChecks: []statecheck.StateCheck{
@@ -364,7 +413,7 @@ func TestAccResourceUUID_Keepers_Replace_NullMapToValue(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_uuid" "test" {
 					keepers = {
 						"key" = "123"
@@ -386,7 +435,7 @@ func TestAccResourceUUID_Keepers_Replace_NullValueToValue(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_uuid" "test" {
 					keepers = {
 						"key" = null
@@ -398,7 +447,7 @@ func TestAccResourceUUID_Keepers_Replace_NullValueToValue(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_uuid" "test" {
 					keepers = {
 						"key" = "123"
@@ -420,7 +469,7 @@ func TestAccResourceUUID_Keepers_Replace_ValueToEmptyMap(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_uuid" "test" {
 					keepers = {
 						"key" = "123"
@@ -432,7 +481,7 @@ func TestAccResourceUUID_Keepers_Replace_ValueToEmptyMap(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_uuid" "test" {
 					keepers = {}
 				}`,
@@ -452,7 +501,7 @@ func TestAccResourceUUID_Keepers_Replace_ValueToNullMap(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_uuid" "test" {
 					keepers = {
 						"key" = "123"
@@ -464,7 +513,7 @@ func TestAccResourceUUID_Keepers_Replace_ValueToNullMap(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_uuid" "test" {
 				}`,
 				ConfigStateChecks: []statecheck.StateCheck{
@@ -483,7 +532,7 @@ func TestAccResourceUUID_Keepers_Replace_ValueToNullValue(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_uuid" "test" {
 					keepers = {
 						"key" = "123"
@@ -495,7 +544,7 @@ func TestAccResourceUUID_Keepers_Replace_ValueToNullValue(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_uuid" "test" {
 					keepers = {
 						"key" = null
@@ -517,7 +566,7 @@ func TestAccResourceUUID_Keepers_Replace_ValueToNewValue(t *testing.T) {
 	resource.ParallelTest(t, resource.TestCase{
 		Steps: []resource.TestStep{
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_uuid" "test" {
 					keepers = {
 						"key" = "123"
@@ -529,7 +578,7 @@ func TestAccResourceUUID_Keepers_Replace_ValueToNewValue(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_uuid" "test" {
 					keepers = {
 						"key" = "456"
@@ -544,6 +593,41 @@ func TestAccResourceUUID_Keepers_Replace_ValueToNewValue(t *testing.T) {
 	})
 }
 
+func TestAccResourceUUID_KeepersHash(t *testing.T) {
+	assertKeepersHashDiffer := statecheck.CompareValue(compare.ValuesDiffer())
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_uuid" "test" {
+					keepers = {
+						"key" = "123"
+					}
+				}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectKnownValue("random_uuid.test", tfjsonpath.New("keepers_hash"), knownvalue.StringExact("92adaebae35d6064079d4a295dc7104e101eb84b12fe22a3230c1eb541d3b667")),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					assertKeepersHashDiffer.AddStateValue("random_uuid.test", tfjsonpath.New("keepers_hash")),
+				},
+			},
+			{
+				Config: `resource "random_uuid" "test" {
+					keepers = {
+						"key" = "456"
+					}
+				}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					assertKeepersHashDiffer.AddStateValue("random_uuid.test", tfjsonpath.New("keepers_hash")),
+				},
+			},
+		},
+	})
+}
+
 func TestAccResourceUUID_Keepers_FrameworkMigration_NullMapToNullValue(t *testing.T) {
 	// The id attribute values should be the same between test steps
 	assertIdSame := statecheck.CompareValue(compare.ValuesSame())
@@ -563,7 +647,7 @@ func TestAccResourceUUID_Keepers_FrameworkMigration_NullMapToNullValue(t *testin
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_uuid" "test" {
 					keepers = {
 						"key" = null
@@ -597,7 +681,7 @@ func TestAccResourceUUID_Keepers_FrameworkMigration_NullMapToValue(t *testing.T)
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_uuid" "test" {
 					keepers = {
 						"key" = "123"
@@ -632,7 +716,7 @@ func TestAccResourceUUID_Keepers_FrameworkMigration_NullMapToMultipleNullValue(t
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_uuid" "test" {
 					keepers = {
 						"key1" = null
@@ -668,7 +752,7 @@ func TestAccResourceUUID_Keepers_FrameworkMigration_NullMapToMultipleValue(t *te
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_uuid" "test" {
 					keepers = {
 						"key1" = "123"
@@ -704,7 +788,7 @@ func TestAccResourceUUID_Keepers_FrameworkMigration_NullMapValue(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_uuid" "test" {
 					keepers = {
 						"key1" = "123"
@@ -740,7 +824,7 @@ func TestAccResourceUUID_Keepers_FrameworkMigration_NullMapValueToValue(t *testi
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_uuid" "test" {
 					keepers = {
 						"key1" = "123"
@@ -768,13 +852,13 @@ func TestAccResourceUUID_UpgradeFromVersion3_3_2(t *testing.T) {
 				},
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_uuid" "basic" { 
 						}`,
 				PlanOnly: true,
 			},
 			{
-				ProtoV5ProviderFactories: protoV5ProviderFactories(),
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
 				Config: `resource "random_uuid" "basic" { 
 						}`,
 				ConfigStateChecks: []statecheck.StateCheck{
@@ -784,3 +868,412 @@ func TestAccResourceUUID_UpgradeFromVersion3_3_2(t *testing.T) {
 		},
 	})
 }
+
+func TestAccResourceUUID_LifecycleMetadata(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_uuid" "test" {
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_uuid.test", tfjsonpath.New("created_at"), knownvalue.StringRegexp(regexp.MustCompile(`^\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}Z$`))),
+					statecheck.ExpectKnownValue("random_uuid.test", tfjsonpath.New("provider_version"), knownvalue.StringExact("test")),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceUUID_LifecycleMetadata_UpgradeFromVersion3_6_3(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				ExternalProviders: providerVersion363(),
+				Config: `resource "random_uuid" "test" {
+						}`,
+			},
+			{
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
+				Config: `resource "random_uuid" "test" {
+						}`,
+				PlanOnly: true,
+			},
+			{
+				ProtoV6ProviderFactories: protoV6ProviderFactories(),
+				Config: `resource "random_uuid" "test" {
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_uuid.test", tfjsonpath.New("created_at"), knownvalue.Null()),
+					statecheck.ExpectKnownValue("random_uuid.test", tfjsonpath.New("provider_version"), knownvalue.Null()),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceUUID_Forms(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_uuid" "test" {
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_uuid.test", tfjsonpath.New("result_compact"), knownvalue.StringRegexp(regexp.MustCompile(`^[\da-f]{32}$`))),
+					statecheck.ExpectKnownValue("random_uuid.test", tfjsonpath.New("result_b64"), knownvalue.StringRegexp(regexp.MustCompile(`^[A-Za-z0-9+/]{22}==$`))),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceUUID_Forms_Import(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_uuid" "test" {
+						}`,
+				ResourceName:       "random_uuid.test",
+				ImportStateId:      "6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+				ImportState:        true,
+				ImportStatePersist: true,
+			},
+			{
+				Config: `resource "random_uuid" "test" {
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_uuid.test", tfjsonpath.New("result_compact"), knownvalue.StringExact("6ba7b8109dad11d180b400c04fd430c8")),
+					statecheck.ExpectKnownValue("random_uuid.test", tfjsonpath.New("result_b64"), knownvalue.StringExact("a6e4EJ2tEdGAtADAT9QwyA==")),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceUUID_Checksums(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_uuid" "test" {
+						}`,
+				ResourceName:       "random_uuid.test",
+				ImportStateId:      "6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+				ImportState:        true,
+				ImportStatePersist: true,
+			},
+			{
+				Config: `resource "random_uuid" "test" {
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_uuid.test", tfjsonpath.New("crc32"), knownvalue.StringExact("51509e86")),
+					statecheck.ExpectKnownValue("random_uuid.test", tfjsonpath.New("sha1"), knownvalue.StringExact("5c05f80a4aec7d327a6a3641822b451d970c3a2e")),
+					statecheck.ExpectKnownValue("random_uuid.test", tfjsonpath.New("sha256"), knownvalue.StringExact("e5855ff48799c52c9ccf80b82bab9492c347a316876dbeaafef22b0bd4fac13d")),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceUUID_Quantity(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_uuid" "test" {
+							quantity = 3
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_uuid.test", tfjsonpath.New("results"), knownvalue.ListSizeExact(3)),
+					statecheck.ExpectKnownValue("random_uuid.test", tfjsonpath.New("results").AtSliceIndex(2), knownvalue.StringRegexp(regexp.MustCompile(`[\da-f]{8}-[\da-f]{4}-[\da-f]{4}-[\da-f]{4}-[\da-f]{12}`))),
+					statecheck.CompareValuePairs("random_uuid.test", tfjsonpath.New("result"), "random_uuid.test", tfjsonpath.New("results").AtSliceIndex(0), compare.ValuesSame()),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceUUID_Deterministic(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `provider "random" {
+							uuid_namespace = "6ba7b810-9dad-11d1-80b4-00c04fd430c8"
+						}
+
+						resource "random_uuid" "test" {
+							deterministic = true
+							keepers = {
+								env = "prod"
+							}
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_uuid.test", tfjsonpath.New("result"), knownvalue.StringExact("c35215d4-cceb-570d-9c42-a308a6beea57")),
+					statecheck.ExpectKnownValue("random_uuid.test", tfjsonpath.New("results"), knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.StringExact("c35215d4-cceb-570d-9c42-a308a6beea57"),
+					})),
+				},
+			},
+			{
+				Config: `provider "random" {
+							uuid_namespace = "6ba7b810-9dad-11d1-80b4-00c04fd430c8"
+						}
+
+						resource "random_uuid" "test" {
+							deterministic = true
+							keepers = {
+								env    = "prod"
+								region = "eu"
+							}
+						}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("random_uuid.test", plancheck.ResourceActionReplace),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_uuid.test", tfjsonpath.New("result"), knownvalue.StringExact("71a52a3f-8e6e-564e-a97c-97ea33171f16")),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceUUID_Deterministic_Invalid(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_uuid" "test" {
+							deterministic = true
+						}`,
+				ExpectError: regexp.MustCompile(`Missing UUID Namespace`),
+			},
+			{
+				Config: `provider "random" {
+							uuid_namespace = "not-a-uuid"
+						}
+
+						resource "random_uuid" "test" {
+							deterministic = true
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute uuid_namespace must be a UUID`),
+			},
+			{
+				Config: `resource "random_uuid" "test" {
+							deterministic = true
+							quantity      = 2
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute "quantity" cannot be specified when "deterministic" is specified`),
+			},
+		},
+	})
+}
+
+func TestAccResourceUUID_Quantity_Null(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_uuid" "test" {
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_uuid.test", tfjsonpath.New("quantity"), knownvalue.Null()),
+					statecheck.ExpectKnownValue("random_uuid.test", tfjsonpath.New("results"), knownvalue.ListSizeExact(1)),
+					statecheck.CompareValuePairs("random_uuid.test", tfjsonpath.New("result"), "random_uuid.test", tfjsonpath.New("results").AtSliceIndex(0), compare.ValuesSame()),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceUUID_Quantity_Replace(t *testing.T) {
+	// The result attribute values should differ between test steps
+	assertResultDiffer := statecheck.CompareValue(compare.ValuesDiffer())
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_uuid" "test" {
+							quantity = 2
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					assertResultDiffer.AddStateValue("random_uuid.test", tfjsonpath.New("result")),
+				},
+			},
+			{
+				Config: `resource "random_uuid" "test" {
+							quantity = 3
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					assertResultDiffer.AddStateValue("random_uuid.test", tfjsonpath.New("result")),
+					statecheck.ExpectKnownValue("random_uuid.test", tfjsonpath.New("results"), knownvalue.ListSizeExact(3)),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceUUID_Quantity_Invalid(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_uuid" "test" {
+							quantity = 0
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute quantity value must be between 1 and 10000, got: 0`),
+			},
+			{
+				Config: `resource "random_uuid" "test" {
+							quantity = 10001
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute quantity value must be between 1 and 10000, got: 10001`),
+			},
+		},
+	})
+}
+
+func TestAccResourceUUID_ExpiresAfter_Keep(t *testing.T) {
+	// The result attribute values should be the same between test steps
+	assertResultSame := statecheck.CompareValue(compare.ValuesSame())
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_uuid" "test" {
+							expires_after = "24h"
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					assertResultSame.AddStateValue("random_uuid.test", tfjsonpath.New("result")),
+				},
+			},
+			{
+				Config: `resource "random_uuid" "test" {
+							expires_after = "48h"
+						}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("random_uuid.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					assertResultSame.AddStateValue("random_uuid.test", tfjsonpath.New("result")),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceUUID_ExpiresAfter_Replace(t *testing.T) {
+	// The result attribute values should differ between test steps
+	assertResultDiffer := statecheck.CompareValue(compare.ValuesDiffer())
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_uuid" "test" {
+							expires_after = "1s"
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					assertResultDiffer.AddStateValue("random_uuid.test", tfjsonpath.New("result")),
+				},
+			},
+			{
+				// The created_at value has a resolution of one second.
+				PreConfig: func() {
+					time.Sleep(2 * time.Second)
+				},
+				Config: `resource "random_uuid" "test" {
+							expires_after = "1s"
+						}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("random_uuid.test", plancheck.ResourceActionReplace),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					assertResultDiffer.AddStateValue("random_uuid.test", tfjsonpath.New("result")),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceUUID_ExpiresAfter_Import(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_uuid" "test" {
+							expires_after = "1s"
+						}`,
+				ResourceName:       "random_uuid.test",
+				ImportStateId:      "6b0f8e7c-0da5-9fd0-1c52-3bbd5e2e4c2c",
+				ImportState:        true,
+				ImportStatePersist: true,
+			},
+			{
+				Config: `resource "random_uuid" "test" {
+							expires_after = "1s"
+						}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("random_uuid.test", plancheck.ResourceActionUpdate),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceUUID_ExpiresAfter_Invalid(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_uuid" "test" {
+							expires_after = "30d"
+						}`,
+				ExpectError: regexp.MustCompile(`value must be a positive duration`),
+			},
+			{
+				Config: `resource "random_uuid" "test" {
+							expires_after = "-1h"
+						}`,
+				ExpectError: regexp.MustCompile(`value must be a positive duration`),
+			},
+		},
+	})
+}
+
+func TestAccResourceUUID_Identity(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_uuid" "test" {}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentity("random_uuid.test", map[string]knownvalue.Check{
+						"id": knownvalue.NotNull(),
+					}),
+					statecheck.ExpectIdentityValueMatchesState("random_uuid.test", tfjsonpath.New("id")),
+				},
+			},
+			{
+				ResourceName:    "random_uuid.test",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+		},
+	})
+}
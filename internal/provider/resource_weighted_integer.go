@@ -0,0 +1,415 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/rand"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	mapplanmodifiers "github.com/terraform-providers/terraform-provider-random/internal/planmodifiers/map"
+	"github.com/terraform-providers/terraform-provider-random/internal/random"
+)
+
+var (
+	_ resource.Resource                   = (*weightedIntegerResource)(nil)
+	_ resource.ResourceWithConfigure      = (*weightedIntegerResource)(nil)
+	_ resource.ResourceWithModifyPlan     = (*weightedIntegerResource)(nil)
+	_ resource.ResourceWithValidateConfig = (*weightedIntegerResource)(nil)
+)
+
+// The distributions from which random_weighted_integer samples.
+const (
+	weightedIntegerUniform = "uniform"
+	weightedIntegerNormal  = "normal"
+	weightedIntegerZipf    = "zipf"
+)
+
+// weightedIntegerDefaultExponent is the default exponent of the zipf
+// distribution.
+const weightedIntegerDefaultExponent = 2
+
+func NewWeightedIntegerResource() resource.Resource {
+	return &weightedIntegerResource{}
+}
+
+type weightedIntegerResource struct {
+	providerVersion string
+	entropy         *random.Source
+}
+
+func (r *weightedIntegerResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_weighted_integer"
+}
+
+func (r *weightedIntegerResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = weightedIntegerSchemaV0()
+}
+
+func (r *weightedIntegerResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	r.providerVersion = providerVersion(req.ProviderData)
+	r.entropy = providerEntropy(req.ProviderData)
+}
+
+// ModifyPlan prevents the replacement of the resource when lifecycle_guard is
+// enabled, unless allow_regeneration_token is changed.
+func (r *weightedIntegerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	guardRegeneration(ctx, req, resp)
+}
+
+func (r *weightedIntegerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan weightedIntegerModelV0
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The configuration may have contained unknown values during validation.
+	resp.Diagnostics.Append(plan.validate()...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	seed := plan.Seed.ValueString()
+
+	done := logGeneration(ctx, pseudoRandomSource(seed), map[string]any{
+		"min":          plan.Min.ValueInt64(),
+		"max":          plan.Max.ValueInt64(),
+		"distribution": plan.Distribution.ValueString(),
+	})
+
+	result, err := sampleWeightedInteger(r.entropy, plan.distribution(), seed)
+	done()
+
+	if err != nil {
+		resp.Diagnostics.Append(entropyDiagnostics(err)...)
+		return
+	}
+
+	plan.Result = types.Int64Value(result)
+	plan.CreatedAt, plan.ProviderVersion = lifecycleValues(r.providerVersion)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read does not need to perform any operations as the state in ReadResourceResponse is already populated.
+func (r *weightedIntegerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+}
+
+// Update ensures the plan value is copied to the state to complete the update.
+func (r *weightedIntegerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var model weightedIntegerModelV0
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+// Delete does not need to explicitly call resp.State.RemoveResource() as this is automatically handled by the
+// [framework](https://github.com/hashicorp/terraform-plugin-framework/pull/301).
+func (r *weightedIntegerResource) Delete(context.Context, resource.DeleteRequest, *resource.DeleteResponse) {
+}
+
+// ValidateConfig ensures that min is not greater than max, and that the parameters of a distribution are only given
+// for that distribution and are within range.
+func (r *weightedIntegerResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config weightedIntegerModelV0
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(config.validate()...)
+}
+
+// validate returns error diagnostics for invalid combinations of arguments, ignoring any which are unknown. A null
+// distribution is treated as the default uniform distribution.
+func (m weightedIntegerModelV0) validate() diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !m.Min.IsNull() && !m.Min.IsUnknown() && !m.Max.IsNull() && !m.Max.IsUnknown() &&
+		m.Min.ValueInt64() > m.Max.ValueInt64() {
+		diags.AddAttributeError(
+			path.Root("min"),
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute min must be less than or equal to max (%d), got: %d",
+				m.Max.ValueInt64(), m.Min.ValueInt64()),
+		)
+	}
+
+	if m.Distribution.IsUnknown() {
+		return diags
+	}
+
+	distribution := m.Distribution.ValueString()
+	if distribution == "" {
+		distribution = weightedIntegerUniform
+	}
+
+	parameters := []struct {
+		name         string
+		value        types.Float64
+		distribution string
+	}{
+		{"mean", m.Mean, weightedIntegerNormal},
+		{"stddev", m.Stddev, weightedIntegerNormal},
+		{"exponent", m.Exponent, weightedIntegerZipf},
+	}
+
+	for _, parameter := range parameters {
+		if !parameter.value.IsNull() && distribution != parameter.distribution {
+			diags.AddAttributeError(
+				path.Root(parameter.name),
+				"Invalid Attribute Combination",
+				fmt.Sprintf("Attribute %s can only be set when distribution is %q, got: %q",
+					parameter.name, parameter.distribution, distribution),
+			)
+		}
+	}
+
+	if !m.Stddev.IsNull() && !m.Stddev.IsUnknown() && m.Stddev.ValueFloat64() <= 0 {
+		diags.AddAttributeError(
+			path.Root("stddev"),
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute stddev must be greater than 0, got: %g", m.Stddev.ValueFloat64()),
+		)
+	}
+
+	if !m.Exponent.IsNull() && !m.Exponent.IsUnknown() && m.Exponent.ValueFloat64() <= 1 {
+		diags.AddAttributeError(
+			path.Root("exponent"),
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute exponent must be greater than 1, got: %g", m.Exponent.ValueFloat64()),
+		)
+	}
+
+	return diags
+}
+
+// weightedIntegerDistribution is a distribution of integers between min and
+// max inclusive, with its parameters resolved to their default values.
+type weightedIntegerDistribution struct {
+	name     string
+	min      int64
+	max      int64
+	mean     float64
+	stddev   float64
+	exponent float64
+}
+
+// distribution returns the distribution of the model, with the defaults of
+// parameters which are not set.
+func (m weightedIntegerModelV0) distribution() weightedIntegerDistribution {
+	d := weightedIntegerDistribution{
+		name:     m.Distribution.ValueString(),
+		min:      m.Min.ValueInt64(),
+		max:      m.Max.ValueInt64(),
+		mean:     m.Mean.ValueFloat64(),
+		stddev:   m.Stddev.ValueFloat64(),
+		exponent: m.Exponent.ValueFloat64(),
+	}
+
+	// The defaults of the normal distribution place 99.7% of samples
+	// between min and max before clamping.
+	if m.Mean.IsNull() {
+		d.mean = (float64(d.min) + float64(d.max)) / 2
+	}
+
+	if m.Stddev.IsNull() {
+		d.stddev = (float64(d.max) - float64(d.min)) / 6
+	}
+
+	if m.Exponent.IsNull() {
+		d.exponent = weightedIntegerDefaultExponent
+	}
+
+	return d
+}
+
+// sampleWeightedInteger returns an integer sampled from the distribution. The
+// same integer is always sampled for the same non-empty seed.
+func sampleWeightedInteger(entropy *random.Source, d weightedIntegerDistribution, seed string) (int64, error) {
+	rng, err := entropy.NewRand(seed)
+	if err != nil {
+		return 0, err
+	}
+
+	return d.sample(rng), nil
+}
+
+// sample returns an integer sampled from the distribution with the random
+// number generator.
+func (d weightedIntegerDistribution) sample(rng *rand.Rand) int64 {
+	switch d.name {
+	case weightedIntegerNormal:
+		value := math.Round(rng.NormFloat64()*d.stddev + d.mean)
+
+		// Samples outside of the range are clamped to min or max, rather
+		// than resampled, so that a mean outside of the range is allowed.
+		switch {
+		case value <= float64(d.min):
+			return d.min
+		case value >= float64(d.max):
+			return d.max
+		default:
+			return int64(value)
+		}
+	case weightedIntegerZipf:
+		// The probability of min+k is proportional to 1/(k+1)^exponent, so
+		// that min is the most likely value.
+		zipf := rand.NewZipf(rng, d.exponent, 1, uint64(d.max-d.min))
+
+		return d.min + int64(zipf.Uint64())
+	default:
+		if span := d.max - d.min + 1; span > 0 {
+			return rng.Int63n(span) + d.min
+		}
+
+		// The range is too large to be counted by an int64, and so covers at
+		// least half of all int64 values, in which case values are sampled
+		// until one is within the range.
+		for {
+			if value := int64(rng.Uint64()); value >= d.min && value <= d.max {
+				return value
+			}
+		}
+	}
+}
+
+func weightedIntegerSchemaV0() schema.Schema {
+	return schema.Schema{
+		Description: "The resource `random_weighted_integer` samples an integer between `min` and `max` from a " +
+			"configurable distribution, such as request sizes or latencies which are usually close to a " +
+			"typical value, for load testing and chaos engineering configurations.\n" +
+			"\n" +
+			"This resource does not use a cryptographic random number generator.",
+		Attributes: map[string]schema.Attribute{
+			"keepers": schema.MapAttribute{
+				Description: "Arbitrary map of values that, when changed, will trigger recreation of " +
+					"resource. See [the main provider documentation](../index.html) for more information.",
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifiers.RequiresReplaceIfValuesNotNull(),
+				},
+			},
+			"seed": schema.StringAttribute{
+				Description: "Arbitrary string with which to seed the random number generator, in order to " +
+					"produce less-volatile integers.\n" +
+					"\n" +
+					"**Important:** Even with an identical seed, it is not guaranteed that the same integer " +
+					"will be sampled across different versions of Terraform. This argument causes the " +
+					"result to be *less volatile*, but not fixed for all time.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"min": schema.Int64Attribute{
+				Description: "The minimum inclusive value of the result.",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"max": schema.Int64Attribute{
+				Description: "The maximum inclusive value of the result.",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"distribution": schema.StringAttribute{
+				Description: "The distribution from which the result is sampled, which is one of `uniform`, in " +
+					"which every integer is equally likely, `normal`, in which integers close to `mean` are " +
+					"most likely, or `zipf`, in which the likelihood of each integer decreases from `min` " +
+					"according to `exponent`. Default value is `uniform`.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(weightedIntegerUniform),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(weightedIntegerUniform, weightedIntegerNormal, weightedIntegerZipf),
+				},
+			},
+			"mean": schema.Float64Attribute{
+				Description: "The mean of the `normal` distribution, which may be outside of `min` and `max`. " +
+					"Samples outside of `min` and `max` are clamped to the nearest of the two. Defaults to " +
+					"halfway between `min` and `max`.",
+				Optional: true,
+				PlanModifiers: []planmodifier.Float64{
+					float64planmodifier.RequiresReplace(),
+				},
+			},
+			"stddev": schema.Float64Attribute{
+				Description: "The standard deviation of the `normal` distribution, which must be greater than " +
+					"`0`. Defaults to a sixth of the difference between `min` and `max`.",
+				Optional: true,
+				PlanModifiers: []planmodifier.Float64{
+					float64planmodifier.RequiresReplace(),
+				},
+			},
+			"exponent": schema.Float64Attribute{
+				Description: "The exponent of the `zipf` distribution, which must be greater than `1`, such " +
+					"that the probability of `min` + `k` is proportional to 1 / (`k` + 1)^`exponent`. Larger " +
+					"exponents make integers close to `min` more likely. Defaults to `2`.",
+				Optional: true,
+				PlanModifiers: []planmodifier.Float64{
+					float64planmodifier.RequiresReplace(),
+				},
+			},
+			"keepers_hash":             keepersHashAttribute(),
+			"created_at":               createdAtAttribute(),
+			"provider_version":         providerVersionAttribute(),
+			"lifecycle_guard":          lifecycleGuardAttribute(),
+			"allow_regeneration_token": allowRegenerationTokenAttribute(),
+			"result": schema.Int64Attribute{
+				Description: "The sampled integer.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+type weightedIntegerModelV0 struct {
+	Keepers                types.Map     `tfsdk:"keepers"`
+	KeepersHash            types.String  `tfsdk:"keepers_hash"`
+	Seed                   types.String  `tfsdk:"seed"`
+	Min                    types.Int64   `tfsdk:"min"`
+	Max                    types.Int64   `tfsdk:"max"`
+	Distribution           types.String  `tfsdk:"distribution"`
+	Mean                   types.Float64 `tfsdk:"mean"`
+	Stddev                 types.Float64 `tfsdk:"stddev"`
+	Exponent               types.Float64 `tfsdk:"exponent"`
+	Result                 types.Int64   `tfsdk:"result"`
+	CreatedAt              types.String  `tfsdk:"created_at"`
+	ProviderVersion        types.String  `tfsdk:"provider_version"`
+	LifecycleGuard         types.Bool    `tfsdk:"lifecycle_guard"`
+	AllowRegenerationToken types.String  `tfsdk:"allow_regeneration_token"`
+}
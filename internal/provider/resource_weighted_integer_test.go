@@ -0,0 +1,236 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"math"
+	"math/rand"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	"github.com/terraform-providers/terraform-provider-random/internal/random"
+	"github.com/terraform-providers/terraform-provider-random/internal/randomtest"
+)
+
+func TestSampleWeightedInteger(t *testing.T) {
+	t.Parallel()
+
+	t.Run("seeded", func(t *testing.T) {
+		t.Parallel()
+
+		d := weightedIntegerDistribution{name: weightedIntegerNormal, min: 0, max: 100, mean: 50, stddev: 10}
+		first, err := sampleWeightedInteger(nil, d, "seed")
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		for i := 0; i < 10; i++ {
+			got, err := sampleWeightedInteger(nil, d, "seed")
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != first {
+				t.Fatalf("expected %d for the same seed, got: %d", first, got)
+			}
+		}
+	})
+
+	t.Run("uniform-distribution", func(t *testing.T) {
+		t.Parallel()
+
+		entropy := random.NewSource(rand.New(rand.NewSource(1)))
+		d := weightedIntegerDistribution{name: weightedIntegerUniform, min: -3, max: 2}
+		observed := make([]int, 6)
+
+		for i := 0; i < 6000; i++ {
+			result, err := sampleWeightedInteger(entropy, d, "")
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			observed[result-d.min]++
+		}
+
+		if err := randomtest.ChiSquaredUniform(observed); err != nil {
+			t.Error(err)
+		}
+	})
+
+	t.Run("uniform-full-range", func(t *testing.T) {
+		t.Parallel()
+
+		entropy := random.NewSource(rand.New(rand.NewSource(2)))
+		d := weightedIntegerDistribution{name: weightedIntegerUniform, min: math.MinInt64, max: math.MaxInt64}
+
+		// The result is not checked, as every int64 is within the range.
+		for i := 0; i < 100; i++ {
+			if _, err := sampleWeightedInteger(entropy, d, ""); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		}
+	})
+
+	t.Run("normal-mean", func(t *testing.T) {
+		t.Parallel()
+
+		entropy := random.NewSource(rand.New(rand.NewSource(3)))
+		d := weightedIntegerDistribution{name: weightedIntegerNormal, min: 0, max: 1000, mean: 300, stddev: 20}
+
+		var total int64
+
+		for i := 0; i < 1000; i++ {
+			result, err := sampleWeightedInteger(entropy, d, "")
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			total += result
+		}
+
+		if mean := float64(total) / 1000; math.Abs(mean-300) > 5 {
+			t.Errorf("expected a mean close to 300, got: %g", mean)
+		}
+	})
+
+	t.Run("normal-clamped", func(t *testing.T) {
+		t.Parallel()
+
+		entropy := random.NewSource(rand.New(rand.NewSource(4)))
+		d := weightedIntegerDistribution{name: weightedIntegerNormal, min: 0, max: 10, mean: 1000, stddev: 1}
+
+		for i := 0; i < 100; i++ {
+			got, err := sampleWeightedInteger(entropy, d, "")
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != 10 {
+				t.Fatalf("expected samples above max to be clamped to 10, got: %d", got)
+			}
+		}
+	})
+
+	t.Run("zipf-distribution", func(t *testing.T) {
+		t.Parallel()
+
+		entropy := random.NewSource(rand.New(rand.NewSource(5)))
+		d := weightedIntegerDistribution{name: weightedIntegerZipf, min: 10, max: 13, exponent: 2}
+		observed := make([]int, 4)
+
+		for i := 0; i < 6000; i++ {
+			got, err := sampleWeightedInteger(entropy, d, "")
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got < d.min || got > d.max {
+				t.Fatalf("expected a sample between 10 and 13, got: %d", got)
+			}
+
+			observed[got-d.min]++
+		}
+
+		weights := []float64{1, 1.0 / 4, 1.0 / 9, 1.0 / 16}
+
+		if err := randomtest.ChiSquaredWeighted(observed, weights); err != nil {
+			t.Error(err)
+		}
+	})
+}
+
+func TestAccResourceWeightedInteger(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_weighted_integer" "test" {
+							min = 5
+							max = 5
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_weighted_integer.test", tfjsonpath.New("distribution"), knownvalue.StringExact("uniform")),
+					statecheck.ExpectKnownValue("random_weighted_integer.test", tfjsonpath.New("result"), knownvalue.Int64Exact(5)),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceWeightedInteger_Normal(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_weighted_integer" "test" {
+							min          = 1
+							max          = 100
+							distribution = "normal"
+							mean         = 500
+							stddev       = 1
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_weighted_integer.test", tfjsonpath.New("result"), knownvalue.Int64Exact(100)),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceWeightedInteger_Zipf(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_weighted_integer" "test" {
+							min          = 1
+							max          = 100
+							distribution = "zipf"
+							exponent     = 1.5
+							seed         = "seed"
+						}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("random_weighted_integer.test", tfjsonpath.New("result"), knownvalue.NotNull()),
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceWeightedInteger_Validation(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "random_weighted_integer" "test" {
+							min = 10
+							max = 1
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute min must be less than or equal to max \(1\), got: 10`),
+			},
+			{
+				Config: `resource "random_weighted_integer" "test" {
+							min    = 1
+							max    = 10
+							stddev = 2
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute stddev can only be set when distribution is "normal"`),
+			},
+			{
+				Config: `resource "random_weighted_integer" "test" {
+							min          = 1
+							max          = 10
+							distribution = "zipf"
+							exponent     = 1
+						}`,
+				ExpectError: regexp.MustCompile(`Attribute exponent must be greater than 1, got: 1`),
+			},
+		},
+	})
+}
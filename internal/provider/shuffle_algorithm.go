@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+
+	"github.com/terraform-providers/terraform-provider-random/internal/random"
+)
+
+const (
+	// shuffleAlgorithmVersion is the version of the algorithm with which new
+	// random_shuffle resources choose their result, which is incremented
+	// whenever a change to the provider changes the result chosen for the
+	// same seed.
+	shuffleAlgorithmVersion = 1
+
+	// shuffleLegacyAlgorithmVersion is the version of the algorithm with which
+	// resources created by provider versions which did not record
+	// algorithm_version chose their result.
+	shuffleLegacyAlgorithmVersion = 1
+)
+
+// shuffleAlgorithm chooses resultCount elements from the input elements, with
+// a random number generator seeded by the seed if it is not empty.
+type shuffleAlgorithm func(entropy *random.Source, inputElements []attr.Value, resultCount int64, seed string) ([]attr.Value, error)
+
+// shuffleAlgorithms are the algorithms of each algorithm version, with and
+// without preserve_order. Earlier versions are retained when the algorithm
+// changes, so that seeded results remain reproducible.
+var shuffleAlgorithms = map[int64]struct {
+	shuffle shuffleAlgorithm
+	sample  shuffleAlgorithm
+}{
+	1: {shuffle: shuffleElements, sample: sampleElements},
+}
+
+// shuffleRand is a random number generator from which permutations are
+// chosen, which is implemented by both the generator of math/rand and
+// random.Rand.
+type shuffleRand interface {
+	Perm(n int) []int
+}
+
+// newShuffleRand returns the random number generator for the seed. A seed is
+// converted to a seed of the generator of math/rand, so that seeded results
+// are reproducible. Without a seed, permutations are chosen by rejection
+// sampling from the source of entropy, and shuffleRandErr must be checked once
+// they have been chosen.
+func newShuffleRand(entropy *random.Source, seed string) (shuffleRand, error) {
+	if seed == "" {
+		return entropy.Rand(), nil
+	}
+
+	return entropy.NewRand(seed)
+}
+
+// shuffleRandErr returns the first error reading the source of entropy of the
+// random number generator, if it reads one.
+func shuffleRandErr(rand shuffleRand) error {
+	if rand, ok := rand.(interface{ Err() error }); ok {
+		return rand.Err()
+	}
+
+	return nil
+}
@@ -0,0 +1,89 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"errors"
+	"testing"
+	"testing/iotest"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/terraform-providers/terraform-provider-random/internal/random"
+)
+
+// TestShuffleAlgorithms_Seeded guards against changes to the result chosen for
+// a seed by each algorithm version, which must instead increment
+// shuffleAlgorithmVersion.
+func TestShuffleAlgorithms_Seeded(t *testing.T) {
+	t.Parallel()
+
+	input := []attr.Value{
+		types.StringValue("a"),
+		types.StringValue("b"),
+		types.StringValue("c"),
+		types.StringValue("d"),
+		types.StringValue("e"),
+	}
+
+	testCases := map[string]struct {
+		algorithm   shuffleAlgorithm
+		resultCount int64
+		expected    []string
+	}{
+		"v1-shuffle": {
+			algorithm:   shuffleAlgorithms[1].shuffle,
+			resultCount: 7,
+			expected:    []string{"d", "a", "b", "c", "e", "d", "b"},
+		},
+		"v1-sample": {
+			algorithm:   shuffleAlgorithms[1].sample,
+			resultCount: 3,
+			expected:    []string{"a", "b", "d"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			result, err := testCase.algorithm(nil, input, testCase.resultCount, "reproducible")
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if len(result) != len(testCase.expected) {
+				t.Fatalf("expected %d elements, got: %v", len(testCase.expected), result)
+			}
+
+			for i, element := range result {
+				if !element.Equal(types.StringValue(testCase.expected[i])) {
+					t.Errorf("expected %v, got: %v", testCase.expected, result)
+					break
+				}
+			}
+		})
+	}
+}
+
+func TestShuffleAlgorithms_Unreadable(t *testing.T) {
+	t.Parallel()
+
+	errRead := errors.New("read error")
+	entropy := random.NewSource(iotest.ErrReader(errRead))
+
+	input := []attr.Value{
+		types.StringValue("a"),
+		types.StringValue("b"),
+	}
+
+	for version, algorithms := range shuffleAlgorithms {
+		for _, algorithm := range []shuffleAlgorithm{algorithms.shuffle, algorithms.sample} {
+			if _, err := algorithm(entropy, input, 2, ""); !errors.Is(err, errRead) {
+				t.Errorf("version %d: expected the error of the source, got: %v", version, err)
+			}
+		}
+	}
+}
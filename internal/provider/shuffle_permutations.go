@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	listplanmodifiers "github.com/terraform-providers/terraform-provider-random/internal/planmodifiers/list"
+	"github.com/terraform-providers/terraform-provider-random/internal/random"
+)
+
+// shuffleResultsType is the type of the results attribute of random_shuffle,
+// with one list of elements for each permutation.
+var shuffleResultsType = types.ListType{ElemType: types.StringType}
+
+func shufflePermutationsAttribute() schema.Int64Attribute {
+	return schema.Int64Attribute{
+		Description: "The number of independent permutations of the same input to generate in `results`, " +
+			"such as to assign a different order to each of several test groups from a single resource with a " +
+			"single set of `keepers`. Each permutation follows `result_count` and `preserve_order`. When set, " +
+			"`result` is the first element of `results`, and `result_map`, `result_values`, `result_objects`, " +
+			"`result_chunks` and `discarded` describe `result` only. With a `seed`, every permutation is " +
+			"reproducible, and the first is the permutation which the seed produces without `permutations`. " +
+			"The minimum value is 1.",
+		Optional: true,
+		PlanModifiers: []planmodifier.Int64{
+			int64planmodifier.RequiresReplace(),
+		},
+		Validators: []validator.Int64{
+			int64validator.AtLeast(1),
+		},
+	}
+}
+
+func shuffleResultsAttribute() schema.ListAttribute {
+	return schema.ListAttribute{
+		Description: "The independent permutations generated when `permutations` is set, each in the same form " +
+			"as `result`. Null if `permutations` is not set.",
+		ElementType: shuffleResultsType,
+		Computed:    true,
+		PlanModifiers: []planmodifier.List{
+			listplanmodifiers.UseStateForUnknownIncludingNull(),
+		},
+	}
+}
+
+// shufflePermutationSeed returns the seed of the permutation at the index. The
+// first permutation uses the seed itself, so that setting permutations does not
+// change result, and the index is appended to the seed of the others, after a
+// separator which cannot occur in the configured seed of earlier permutations
+// of the same resource. An empty seed remains empty, so that permutations
+// without a seed are chosen with the source of entropy.
+func shufflePermutationSeed(seed string, index int64) string {
+	if seed == "" || index == 0 {
+		return seed
+	}
+
+	return seed + "\x00" + strconv.FormatInt(index, 10)
+}
+
+// shufflePermutations returns the results value of the permutations, the first
+// of which is the elements already chosen for result, and the others chosen
+// with the algorithm.
+func shufflePermutations(algorithm shuffleAlgorithm, entropy *random.Source, inputElements, resultElements []attr.Value, resultCount int64, seed string, permutations int64) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	elements := make([]attr.Value, 0, permutations)
+
+	for i := int64(0); i < permutations; i++ {
+		permutation := resultElements
+
+		if i > 0 {
+			var err error
+
+			permutation, err = algorithm(entropy, inputElements, resultCount, shufflePermutationSeed(seed, i))
+			if err != nil {
+				diags.Append(entropyDiagnostics(err)...)
+				return types.ListNull(shuffleResultsType), diags
+			}
+		}
+
+		list, listDiags := types.ListValue(types.StringType, permutation)
+
+		diags.Append(listDiags...)
+
+		if diags.HasError() {
+			return types.ListNull(shuffleResultsType), diags
+		}
+
+		elements = append(elements, list)
+	}
+
+	return types.ListValue(shuffleResultsType, elements)
+}
@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestShufflePermutationSeed(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		seed     string
+		index    int64
+		expected string
+	}{
+		"first": {
+			seed:     "reproducible",
+			index:    0,
+			expected: "reproducible",
+		},
+		"second": {
+			seed:     "reproducible",
+			index:    1,
+			expected: "reproducible\x001",
+		},
+		"unseeded": {
+			seed:     "",
+			index:    2,
+			expected: "",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := shufflePermutationSeed(testCase.seed, testCase.index); got != testCase.expected {
+				t.Errorf("expected %q, got: %q", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestShufflePermutations(t *testing.T) {
+	t.Parallel()
+
+	input := []attr.Value{
+		types.StringValue("a"),
+		types.StringValue("b"),
+		types.StringValue("c"),
+		types.StringValue("d"),
+		types.StringValue("e"),
+	}
+
+	result, err := shuffleElements(nil, input, 5, "reproducible")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	results, diags := shufflePermutations(shuffleElements, nil, input, result, 5, "reproducible", 3)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	again, diags := shufflePermutations(shuffleElements, nil, input, result, 5, "reproducible", 3)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if !results.Equal(again) {
+		t.Errorf("expected seeded permutations to be reproducible, got: %s and %s", results, again)
+	}
+
+	elements := results.Elements()
+
+	if len(elements) != 3 {
+		t.Fatalf("expected 3 permutations, got: %s", results)
+	}
+
+	if !elements[0].Equal(types.ListValueMust(types.StringType, result)) {
+		t.Errorf("expected the first permutation to be the result %v, got: %s", result, elements[0])
+	}
+
+	if elements[0].Equal(elements[1]) && elements[1].Equal(elements[2]) {
+		t.Errorf("expected independent permutations, got: %s", results)
+	}
+}
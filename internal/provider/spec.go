@@ -0,0 +1,107 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/terraform-providers/terraform-provider-random/internal/random"
+)
+
+// The algorithm versions reported in spec, which are incremented whenever a
+// change to the provider changes how a result is generated from the same
+// parameters, so that policies can identify results generated before the
+// change.
+const (
+	stringAlgorithmVersion = 1
+	bytesAlgorithmVersion  = 1
+)
+
+var specAttrTypes = map[string]attr.Type{
+	"length":            types.Int64Type,
+	"upper":             types.BoolType,
+	"lower":             types.BoolType,
+	"numeric":           types.BoolType,
+	"special":           types.BoolType,
+	"min_upper":         types.Int64Type,
+	"min_lower":         types.Int64Type,
+	"min_numeric":       types.Int64Type,
+	"min_special":       types.Int64Type,
+	"entropy_bits":      types.Float64Type,
+	"algorithm_version": types.Int64Type,
+}
+
+// specAttribute returns the schema for the spec attribute, which summarizes
+// the parameters with which the result was generated, so that a policy can
+// be asserted in a check or postcondition block in the same way for every
+// resource. The value is calculated from the other attributes in state, so
+// resources created by earlier provider versions have the value populated
+// during refresh. It is an object attribute rather than a nested attribute,
+// which protocol version 5 cannot represent, so the attributes of the object
+// are described in its description.
+func specAttribute(resultName string, pools bool) schema.ObjectAttribute {
+	poolsDescription := "`upper`, `lower`, `numeric` and `special` are whether characters of each class may " +
+		"be chosen for the " + resultName + ", and `min_upper`, `min_lower`, `min_numeric` and `min_special` " +
+		"are the minimum numbers of characters of each class in the " + resultName + "."
+
+	if !pools {
+		poolsDescription = "`upper`, `lower`, `numeric`, `special`, `min_upper`, `min_lower`, `min_numeric` and " +
+			"`min_special` are always `null`, as the " + resultName + " are not composed of characters."
+	}
+
+	return schema.ObjectAttribute{
+		Description: "A summary of the parameters used to generate the " + resultName + ", with the same " +
+			"attributes on `random_bytes`, `random_password` and `random_string`, so that organizations can " +
+			"assert policy compliance in `check` or `postcondition` blocks without deriving the parameters of " +
+			"each resource. `length` is the length of the " + resultName + ". " + poolsDescription + " " +
+			"`entropy_bits` is the value of `entropy_bits`, and `algorithm_version` is the version of the " +
+			"algorithm used to generate the " + resultName + ", which is incremented whenever a provider " +
+			"release changes the algorithm.",
+		AttributeTypes: specAttrTypes,
+		Computed:       true,
+		PlanModifiers: []planmodifier.Object{
+			objectplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+// stringSpec returns the spec of a result generated with the parameters. The
+// effective parameters are used, such as the minimums of a preset, so the
+// spec may differ from the configured values.
+func stringSpec(length types.Int64, params random.StringParams, entropyBits types.Float64) types.Object {
+	return types.ObjectValueMust(specAttrTypes, map[string]attr.Value{
+		"length":            length,
+		"upper":             types.BoolValue(params.Upper),
+		"lower":             types.BoolValue(params.Lower),
+		"numeric":           types.BoolValue(params.Numeric),
+		"special":           types.BoolValue(params.Special),
+		"min_upper":         types.Int64Value(params.MinUpper),
+		"min_lower":         types.Int64Value(params.MinLower),
+		"min_numeric":       types.Int64Value(params.MinNumeric),
+		"min_special":       types.Int64Value(params.MinSpecial),
+		"entropy_bits":      entropyBits,
+		"algorithm_version": types.Int64Value(stringAlgorithmVersion),
+	})
+}
+
+// bytesSpec returns the spec of the given number of random bytes.
+func bytesSpec(length types.Int64, entropyBits types.Float64) types.Object {
+	return types.ObjectValueMust(specAttrTypes, map[string]attr.Value{
+		"length":            length,
+		"upper":             types.BoolNull(),
+		"lower":             types.BoolNull(),
+		"numeric":           types.BoolNull(),
+		"special":           types.BoolNull(),
+		"min_upper":         types.Int64Null(),
+		"min_lower":         types.Int64Null(),
+		"min_numeric":       types.Int64Null(),
+		"min_special":       types.Int64Null(),
+		"entropy_bits":      entropyBits,
+		"algorithm_version": types.Int64Value(bytesAlgorithmVersion),
+	})
+}
@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/terraform-providers/terraform-provider-random/internal/random"
+)
+
+// specTfType is the type of the spec attribute, for the expected values of
+// state upgrade tests.
+var specTfType = tftypes.Object{
+	AttributeTypes: map[string]tftypes.Type{
+		"length":            tftypes.Number,
+		"upper":             tftypes.Bool,
+		"lower":             tftypes.Bool,
+		"numeric":           tftypes.Bool,
+		"special":           tftypes.Bool,
+		"min_upper":         tftypes.Number,
+		"min_lower":         tftypes.Number,
+		"min_numeric":       tftypes.Number,
+		"min_special":       tftypes.Number,
+		"entropy_bits":      tftypes.Number,
+		"algorithm_version": tftypes.Number,
+	},
+}
+
+func TestStringSpec(t *testing.T) {
+	t.Parallel()
+
+	params := random.StringParams{
+		Length:     16,
+		Upper:      true,
+		MinUpper:   2,
+		Lower:      true,
+		Numeric:    true,
+		MinNumeric: 1,
+	}
+
+	expected := types.ObjectValueMust(specAttrTypes, map[string]attr.Value{
+		"length":            types.Int64Value(16),
+		"upper":             types.BoolValue(true),
+		"lower":             types.BoolValue(true),
+		"numeric":           types.BoolValue(true),
+		"special":           types.BoolValue(false),
+		"min_upper":         types.Int64Value(2),
+		"min_lower":         types.Int64Value(0),
+		"min_numeric":       types.Int64Value(1),
+		"min_special":       types.Int64Value(0),
+		"entropy_bits":      types.Float64Value(95.27),
+		"algorithm_version": types.Int64Value(stringAlgorithmVersion),
+	})
+
+	if got := stringSpec(types.Int64Value(16), params, types.Float64Value(95.27)); !got.Equal(expected) {
+		t.Errorf("expected %s, got %s", expected, got)
+	}
+}
+
+func TestBytesSpec(t *testing.T) {
+	t.Parallel()
+
+	got := bytesSpec(types.Int64Value(32), bytesEntropyBits(types.Int64Value(32)))
+
+	attributes := got.Attributes()
+
+	if !attributes["entropy_bits"].Equal(types.Float64Value(256)) {
+		t.Errorf("expected entropy_bits of 256, got %s", attributes["entropy_bits"])
+	}
+
+	for _, name := range []string{"upper", "lower", "numeric", "special", "min_upper", "min_lower", "min_numeric", "min_special"} {
+		if !attributes[name].IsNull() {
+			t.Errorf("expected %s to be null, got %s", name, attributes[name])
+		}
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// validateMinCharacterClasses returns an error diagnostic if min_character_classes is more than the number of enabled
+// character classes, or if the length attribute is less than the sum of the minimum number of characters of each class
+// plus one character of each further class required. The classes and minimums are given in the order of
+// stringMinAttributes, and a null class is enabled by default. Nothing is validated if any value is unknown.
+func validateMinCharacterClasses(minClasses, length types.Int64, lengthAttribute string, classes []types.Bool, mins []types.Int64) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if minClasses.IsNull() || minClasses.IsUnknown() {
+		return diags
+	}
+
+	var enabled, satisfied int64
+
+	for i, class := range classes {
+		if class.IsUnknown() || mins[i].IsUnknown() {
+			return diags
+		}
+
+		if !class.IsNull() && !class.ValueBool() {
+			continue
+		}
+
+		enabled++
+
+		if mins[i].ValueInt64() > 0 {
+			satisfied++
+		}
+	}
+
+	if minClasses.ValueInt64() > enabled {
+		diags.AddAttributeError(
+			path.Root("min_character_classes"),
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute min_character_classes value must be at most the number of enabled character "+
+				"classes of upper, lower, numeric and special, which is %d, got: %d", enabled, minClasses.ValueInt64()),
+		)
+
+		return diags
+	}
+
+	if length.IsNull() || length.IsUnknown() {
+		return diags
+	}
+
+	var sumOfMins int64
+
+	for _, m := range mins {
+		sumOfMins += m.ValueInt64()
+	}
+
+	required := sumOfMins + max(0, minClasses.ValueInt64()-satisfied)
+
+	if length.ValueInt64() < required {
+		diags.AddAttributeError(
+			path.Root(lengthAttribute),
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute %s value must be at least the sum of the minimums of each class plus one "+
+				"character of each further class required by min_character_classes, which is %d, got: %d",
+				lengthAttribute, required, length.ValueInt64()),
+		)
+	}
+
+	return diags
+}
+
+// characterClasses returns whether the uppercase, lowercase, numeric and special character classes are enabled, in the
+// order of stringMinAttributes. The deprecated number attribute is used if numeric is null.
+func characterClasses(upper, lower, number, numeric, special types.Bool) []types.Bool {
+	if numeric.IsNull() {
+		numeric = number
+	}
+
+	return []types.Bool{upper, lower, numeric, special}
+}
@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestValidateMinCharacterClasses(t *testing.T) {
+	t.Parallel()
+
+	enabled := []types.Bool{types.BoolNull(), types.BoolValue(true), types.BoolNull(), types.BoolNull()}
+	noMins := []types.Int64{types.Int64Value(0), types.Int64Value(0), types.Int64Value(0), types.Int64Value(0)}
+
+	testCases := map[string]struct {
+		minClasses    types.Int64
+		length        types.Int64
+		classes       []types.Bool
+		mins          []types.Int64
+		expectedError string
+	}{
+		"null": {
+			minClasses: types.Int64Null(),
+			length:     types.Int64Value(1),
+			classes:    enabled,
+			mins:       noMins,
+		},
+		"valid": {
+			minClasses: types.Int64Value(4),
+			length:     types.Int64Value(4),
+			classes:    enabled,
+			mins:       noMins,
+		},
+		"valid-mins": {
+			minClasses: types.Int64Value(3),
+			length:     types.Int64Value(4),
+			classes:    enabled,
+			mins:       []types.Int64{types.Int64Value(2), types.Int64Value(0), types.Int64Value(0), types.Int64Value(0)},
+		},
+		"unknown-class": {
+			minClasses: types.Int64Value(4),
+			length:     types.Int64Value(1),
+			classes:    []types.Bool{types.BoolUnknown(), types.BoolNull(), types.BoolNull(), types.BoolNull()},
+			mins:       noMins,
+		},
+		"unknown-length": {
+			minClasses: types.Int64Value(4),
+			length:     types.Int64Unknown(),
+			classes:    enabled,
+			mins:       noMins,
+		},
+		"too-few-classes": {
+			minClasses: types.Int64Value(4),
+			length:     types.Int64Value(12),
+			classes:    []types.Bool{types.BoolNull(), types.BoolNull(), types.BoolValue(false), types.BoolNull()},
+			mins:       noMins,
+			expectedError: "Attribute min_character_classes value must be at most the number of enabled character " +
+				"classes of upper, lower, numeric and special, which is 3, got: 4",
+		},
+		"too-short": {
+			minClasses: types.Int64Value(3),
+			length:     types.Int64Value(3),
+			classes:    enabled,
+			mins:       []types.Int64{types.Int64Value(2), types.Int64Value(0), types.Int64Value(0), types.Int64Value(0)},
+			expectedError: "Attribute length value must be at least the sum of the minimums of each class plus one " +
+				"character of each further class required by min_character_classes, which is 4, got: 3",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := validateMinCharacterClasses(testCase.minClasses, testCase.length, "length", testCase.classes, testCase.mins)
+
+			if testCase.expectedError == "" {
+				if diags.HasError() {
+					t.Errorf("unexpected error: %v", diags)
+				}
+
+				return
+			}
+
+			if diags.ErrorsCount() != 1 || diags.Errors()[0].Detail() != testCase.expectedError {
+				t.Errorf("expected error %q, got: %v", testCase.expectedError, diags)
+			}
+		})
+	}
+}
+
+func TestCharacterClasses(t *testing.T) {
+	t.Parallel()
+
+	classes := characterClasses(types.BoolValue(true), types.BoolValue(true), types.BoolValue(false), types.BoolNull(), types.BoolValue(true))
+
+	if !classes[2].Equal(types.BoolValue(false)) {
+		t.Errorf("expected number to be used when numeric is null, got: %v", classes)
+	}
+}
@@ -0,0 +1,165 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// isImportJSON returns true if the import identifier is a JSON object. A
+// random_password or random_string result which happens to be valid JSON, such
+// as "{}", is always treated as JSON, so such results must be imported using
+// the JSON form.
+func isImportJSON(id string) bool {
+	trimmed := strings.TrimSpace(id)
+
+	return strings.HasPrefix(trimmed, "{") && json.Valid([]byte(trimmed))
+}
+
+// decodeImportJSON decodes the import identifier into v, rejecting keys which
+// are not attributes which can be imported, so that a misspelt or unsupported
+// attribute is not silently ignored.
+func decodeImportJSON(id string, v any) error {
+	decoder := json.NewDecoder(strings.NewReader(id))
+	decoder.DisallowUnknownFields()
+
+	return decoder.Decode(v)
+}
+
+// importLength returns the length of an imported result which does not supply
+// the "length" key, which is measured in characters, as the result is
+// generated.
+func importLength(result string) int64 {
+	return int64(utf8.RuneCountInString(result))
+}
+
+// stringSharedImportJSON is the part of the JSON import identifier of
+// random_password and random_string which mirrors stringSharedModel. Keys
+// which are omitted retain the defaults used when importing a bare result.
+type stringSharedImportJSON struct {
+	Result              *string            `json:"result"`
+	Length              *int64             `json:"length"`
+	Special             *bool              `json:"special"`
+	Upper               *bool              `json:"upper"`
+	Lower               *bool              `json:"lower"`
+	Numeric             *bool              `json:"numeric"`
+	MinSpecial          *int64             `json:"min_special"`
+	MinUpper            *int64             `json:"min_upper"`
+	MinLower            *int64             `json:"min_lower"`
+	MinNumeric          *int64             `json:"min_numeric"`
+	MinCharacterClasses *int64             `json:"min_character_classes"`
+	OverrideSpecial     *string            `json:"override_special"`
+	OverrideSpecialList []string           `json:"override_special_list"`
+	CharsetPreset       *string            `json:"charset_preset"`
+	Keepers             map[string]*string `json:"keepers"`
+}
+
+// validate returns an error if the result is not set, naming it with the noun,
+// or the values of the keys are invalid.
+func (d *stringSharedImportJSON) validate(noun string) error {
+	if d.Result == nil || *d.Result == "" {
+		return fmt.Errorf(`the "result" key must be set to the %s`, noun)
+	}
+
+	if d.OverrideSpecial != nil && d.OverrideSpecialList != nil {
+		return errors.New(`only one of the "override_special" and "override_special_list" keys can be set`)
+	}
+
+	if d.CharsetPreset != nil {
+		if _, ok := charsetPresets[*d.CharsetPreset]; !ok {
+			names := make([]string, 0, len(charsetPresets))
+			for name := range charsetPresets {
+				names = append(names, name)
+			}
+
+			sort.Strings(names)
+
+			return fmt.Errorf(`the "charset_preset" key must be one of %q, got: %q`, names, *d.CharsetPreset)
+		}
+	}
+
+	return nil
+}
+
+func (d *stringSharedImportJSON) apply(state *stringSharedModel) {
+	state.Result = types.StringValue(*d.Result)
+	state.Length = types.Int64Value(importLength(*d.Result))
+
+	if d.Length != nil {
+		state.Length = types.Int64Value(*d.Length)
+	}
+
+	if d.Special != nil {
+		state.Special = types.BoolValue(*d.Special)
+	}
+
+	if d.Upper != nil {
+		state.Upper = types.BoolValue(*d.Upper)
+	}
+
+	if d.Lower != nil {
+		state.Lower = types.BoolValue(*d.Lower)
+	}
+
+	// The number attribute is deprecated and mirrors numeric.
+	if d.Numeric != nil {
+		state.Numeric = types.BoolValue(*d.Numeric)
+		state.Number = types.BoolValue(*d.Numeric)
+	}
+
+	if d.MinSpecial != nil {
+		state.MinSpecial = types.Int64Value(*d.MinSpecial)
+	}
+
+	if d.MinUpper != nil {
+		state.MinUpper = types.Int64Value(*d.MinUpper)
+	}
+
+	if d.MinLower != nil {
+		state.MinLower = types.Int64Value(*d.MinLower)
+	}
+
+	if d.MinNumeric != nil {
+		state.MinNumeric = types.Int64Value(*d.MinNumeric)
+	}
+
+	if d.MinCharacterClasses != nil {
+		state.MinCharacterClasses = types.Int64Value(*d.MinCharacterClasses)
+	}
+
+	if d.OverrideSpecial != nil {
+		state.OverrideSpecial = types.StringValue(*d.OverrideSpecial)
+	}
+
+	if d.OverrideSpecialList != nil {
+		chars := make([]attr.Value, len(d.OverrideSpecialList))
+		for i, char := range d.OverrideSpecialList {
+			chars[i] = types.StringValue(char)
+		}
+
+		state.OverrideSpecialList = types.ListValueMust(types.StringType, chars)
+	}
+
+	if d.CharsetPreset != nil {
+		state.CharsetPreset = types.StringValue(*d.CharsetPreset)
+	}
+
+	if d.Keepers != nil {
+		keepers := make(map[string]attr.Value, len(d.Keepers))
+		for key, value := range d.Keepers {
+			keepers[key] = types.StringPointerValue(value)
+		}
+
+		state.Keepers = types.MapValueMust(types.StringType, keepers)
+		state.KeepersHash = keepersHash(state.Keepers)
+	}
+}
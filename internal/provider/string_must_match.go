@@ -0,0 +1,100 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/terraform-providers/terraform-provider-random/internal/random"
+	"github.com/terraform-providers/terraform-provider-random/internal/validators"
+)
+
+// stringMustMatchAttempts is the maximum number of strings generated in an
+// attempt to generate one which matches must_match.
+const stringMustMatchAttempts = 1000
+
+func mustMatchAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: fmt.Sprintf("A regular expression, in RE2 syntax, which the result must match, such as "+
+			"`^[a-z]` for a result which begins with a lowercase letter, to meet a policy which cannot otherwise "+
+			"be configured. The expression is not anchored unless it begins with `^` and ends with `$`. Strings "+
+			"are generated until one matches, up to %d attempts, after which an error is returned. "+
+			"`entropy_bits` does not account for the strings which do not match. When `length` is increased "+
+			"with `grow_in_place`, the appended characters are generated until the whole result matches.",
+			stringMustMatchAttempts),
+		Optional: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+		Validators: []validator.String{
+			validators.RegularExpression(),
+		},
+	}
+}
+
+// createMatchingString returns a string generated from the params which
+// matches the pattern, or false if none of stringMustMatchAttempts strings
+// matched. A nil pattern matches every string.
+func createMatchingString(entropy *random.Source, params random.StringParams, pattern *regexp.Regexp) ([]byte, bool, error) {
+	for attempt := 1; attempt <= stringMustMatchAttempts; attempt++ {
+		result, err := entropy.CreateString(params)
+		if err != nil {
+			return nil, false, err
+		}
+
+		if pattern == nil || pattern.Match(result) {
+			return result, true, nil
+		}
+	}
+
+	return nil, false, nil
+}
+
+// mustMatchPattern returns the compiled must_match regular expression, or nil
+// if it is null.
+func mustMatchPattern(mustMatch types.String) (*regexp.Regexp, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if mustMatch.IsNull() {
+		return nil, diags
+	}
+
+	pattern, err := regexp.Compile(mustMatch.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("must_match"),
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute must_match value must be a valid RE2 regular expression: %s", err),
+		)
+		return nil, diags
+	}
+
+	return pattern, diags
+}
+
+// mustMatchError returns the error of a result which does not match
+// must_match after stringMustMatchAttempts attempts.
+func mustMatchError(mustMatch types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.AddAttributeError(
+		path.Root("must_match"),
+		"Result Does Not Match",
+		fmt.Sprintf("None of the %d strings generated matched the must_match regular expression %q. Check "+
+			"that the expression can match a string of the configured length and characters, or use "+
+			"arguments such as min_upper, min_numeric or override_special to make a matching string more "+
+			"likely.", stringMustMatchAttempts, mustMatch.ValueString()),
+	)
+
+	return diags
+}
@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-random/internal/random"
+)
+
+func TestCreateMatchingString(t *testing.T) {
+	t.Parallel()
+
+	params := random.StringParams{
+		Length:  8,
+		Lower:   true,
+		Numeric: true,
+	}
+
+	testCases := map[string]struct {
+		pattern         *regexp.Regexp
+		expectedMatched bool
+	}{
+		"nil": {
+			expectedMatched: true,
+		},
+		"likely": {
+			pattern:         regexp.MustCompile(`^[a-z]`),
+			expectedMatched: true,
+		},
+		"impossible": {
+			pattern: regexp.MustCompile(`[A-Z]`),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			result, matched, err := createMatchingString(random.NewSource(nil), params, testCase.pattern)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if matched != testCase.expectedMatched {
+				t.Fatalf("expected matched to be %t, got: %t", testCase.expectedMatched, matched)
+			}
+
+			if matched && testCase.pattern != nil && !testCase.pattern.Match(result) {
+				t.Errorf("expected %q to match %s", result, testCase.pattern)
+			}
+		})
+	}
+}
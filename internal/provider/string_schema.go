@@ -0,0 +1,302 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	boolplanmodifiers "github.com/terraform-providers/terraform-provider-random/internal/planmodifiers/bool"
+	mapplanmodifiers "github.com/terraform-providers/terraform-provider-random/internal/planmodifiers/map"
+	stringplanmodifiers "github.com/terraform-providers/terraform-provider-random/internal/planmodifiers/string"
+	"github.com/terraform-providers/terraform-provider-random/internal/validators"
+)
+
+// stringSchemaOptions are the differences between random_password and
+// random_string in the attributes they share.
+type stringSchemaOptions struct {
+	// Sensitive marks the result as sensitive.
+	Sensitive bool
+
+	// LengthPlanModifier replaces the resource when the length changes.
+	LengthPlanModifier planmodifier.Int64
+
+	// LengthAlternative is the attribute specific to the resource, if any,
+	// with which the length is chosen during apply rather than configured,
+	// which makes length optional and computed. LengthAlternativeDescription
+	// describes it.
+	LengthAlternative            *path.Expression
+	LengthAlternativeDescription string
+
+	// ResultPlanModifiers are applied to the result after
+	// UseStateForUnknown.
+	ResultPlanModifiers []planmodifier.String
+
+	// ConflictsWith are the attributes specific to the resource which
+	// conflict with override_special_list and charset_preset.
+	ConflictsWith []path.Expression
+}
+
+// stringSchemaAttributes returns the attributes of the current schema of
+// random_password or random_string, which are the attributes shared by both
+// resources, configured by the options, and the given attributes specific to
+// the resource. Attributes which configure the characters of the result
+// belong here, so that they behave consistently in both resources. The
+// attributes specific to the resource must not be named as a shared attribute,
+// which TestStringSchemaAttributes_Duplicates checks.
+func stringSchemaAttributes(opts stringSchemaOptions, attributes map[string]schema.Attribute) map[string]schema.Attribute {
+	resultPlanModifiers := append([]planmodifier.String{
+		stringplanmodifier.UseStateForUnknown(),
+	}, opts.ResultPlanModifiers...)
+
+	length := schema.Int64Attribute{
+		Description: "The length of the string desired. The minimum value for length is 1 and, length " +
+			"must also be >= (`min_upper` + `min_lower` + `min_numeric` + `min_special`).",
+		Required: true,
+		PlanModifiers: []planmodifier.Int64{
+			opts.LengthPlanModifier,
+		},
+		Validators: []validator.Int64{
+			int64validator.AtLeast(1),
+		},
+	}
+
+	if opts.LengthAlternative != nil {
+		length.Description += " " + opts.LengthAlternativeDescription
+		length.Required = false
+		length.Optional = true
+		length.Computed = true
+		length.PlanModifiers = []planmodifier.Int64{
+			int64planmodifier.UseStateForUnknown(),
+			opts.LengthPlanModifier,
+		}
+		length.Validators = append(length.Validators, int64validator.ExactlyOneOf(*opts.LengthAlternative))
+	}
+
+	shared := map[string]schema.Attribute{
+		"keepers": schema.MapAttribute{
+			Description: "Arbitrary map of values that, when changed, will trigger recreation of " +
+				"resource. See [the main provider documentation](../index.html) for more information.",
+			ElementType: types.StringType,
+			Optional:    true,
+			PlanModifiers: []planmodifier.Map{
+				mapplanmodifiers.RequiresReplaceIfValuesNotNull(),
+			},
+		},
+
+		"length": length,
+
+		"special": schema.BoolAttribute{
+			Description: "Include special characters in the result. These are `!@#$%&*()-_=+[]{}<>:?`. Default value is `true`.",
+			Optional:    true,
+			Computed:    true,
+			Default:     booldefault.StaticBool(true),
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.RequiresReplace(),
+			},
+		},
+
+		"upper": schema.BoolAttribute{
+			Description: "Include uppercase alphabet characters in the result. Default value is `true`.",
+			Optional:    true,
+			Computed:    true,
+			Default:     booldefault.StaticBool(true),
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.RequiresReplace(),
+			},
+		},
+
+		"lower": schema.BoolAttribute{
+			Description: "Include lowercase alphabet characters in the result. Default value is `true`.",
+			Optional:    true,
+			Computed:    true,
+			Default:     booldefault.StaticBool(true),
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.RequiresReplace(),
+			},
+		},
+
+		"number": schema.BoolAttribute{
+			Description: "Include numeric characters in the result. Default value is `true`. " +
+				"If `number`, `upper`, `lower`, and `special` are all configured, at least one " +
+				"of them must be set to `true`. " +
+				"**NOTE**: This is deprecated, use `numeric` instead.",
+			Optional: true,
+			Computed: true,
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifiers.NumberNumericAttributePlanModifier(),
+				boolplanmodifier.RequiresReplace(),
+			},
+			DeprecationMessage: "**NOTE**: This is deprecated, use `numeric` instead.",
+			Validators: []validator.Bool{
+				validators.AtLeastOneOfTrue(
+					path.MatchRoot("special"),
+					path.MatchRoot("upper"),
+					path.MatchRoot("lower"),
+				),
+			},
+		},
+
+		"numeric": schema.BoolAttribute{
+			Description: "Include numeric characters in the result. Default value is `true`. " +
+				"If `numeric`, `upper`, `lower`, and `special` are all configured, at least one " +
+				"of them must be set to `true`.",
+			Optional: true,
+			Computed: true,
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifiers.NumberNumericAttributePlanModifier(),
+				boolplanmodifier.RequiresReplace(),
+			},
+			Validators: []validator.Bool{
+				validators.AtLeastOneOfTrue(
+					path.MatchRoot("special"),
+					path.MatchRoot("upper"),
+					path.MatchRoot("lower"),
+				),
+			},
+		},
+
+		"min_numeric": schema.Int64Attribute{
+			Description: "Minimum number of numeric characters in the result. Default value is `0`.",
+			Optional:    true,
+			Computed:    true,
+			Default:     int64default.StaticInt64(0),
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.RequiresReplace(),
+			},
+		},
+
+		"min_upper": schema.Int64Attribute{
+			Description: "Minimum number of uppercase alphabet characters in the result. Default value is `0`.",
+			Optional:    true,
+			Computed:    true,
+			Default:     int64default.StaticInt64(0),
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.RequiresReplace(),
+			},
+		},
+
+		"min_lower": schema.Int64Attribute{
+			Description: "Minimum number of lowercase alphabet characters in the result. Default value is `0`.",
+			Optional:    true,
+			Computed:    true,
+			Default:     int64default.StaticInt64(0),
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.RequiresReplace(),
+			},
+		},
+
+		"min_special": schema.Int64Attribute{
+			Description: "Minimum number of special characters in the result. Default value is `0`.",
+			Optional:    true,
+			Computed:    true,
+			Default:     int64default.StaticInt64(0),
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.RequiresReplace(),
+			},
+		},
+
+		"min_character_classes": schema.Int64Attribute{
+			Description: "Minimum number of the enabled character classes, of uppercase, lowercase, numeric " +
+				"and special characters, which must each appear in the result, as a lighter-weight alternative " +
+				"to the minimums of each class for policies such as \"3 of 4 classes\". Classes with a " +
+				"minimum always appear, and the remaining classes are chosen at random. Must be at most the " +
+				"number of enabled classes. Cannot be combined with `charset_preset`.",
+			Optional: true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.RequiresReplace(),
+			},
+			Validators: []validator.Int64{
+				int64validator.Between(1, 4),
+				int64validator.ConflictsWith(path.MatchRoot("charset_preset")),
+			},
+		},
+
+		"override_special": schema.StringAttribute{
+			Description: "Supply your own list of special characters to use for string generation.  This " +
+				"overrides the default character list in the special argument.  The `special` argument must " +
+				"still be set to true for any overwritten characters to be used in generation.",
+			Optional: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplaceIf(
+					stringplanmodifiers.RequiresReplaceUnlessEmptyStringToNull(),
+					"Replace on modification unless updating from empty string (\"\") to null.",
+					"Replace on modification unless updating from empty string (`\"\"`) to `null`.",
+				),
+			},
+		},
+
+		"override_special_list": overrideSpecialListAttribute(opts.ConflictsWith...),
+
+		"charset_preset": charsetPresetAttribute(opts.ConflictsWith...),
+
+		"result": schema.StringAttribute{
+			Description:   "The generated random string.",
+			Computed:      true,
+			Sensitive:     opts.Sensitive,
+			PlanModifiers: resultPlanModifiers,
+		},
+
+		"keepers_hash": keepersHashAttribute(),
+
+		"created_at": createdAtAttribute(),
+
+		"provider_version": providerVersionAttribute(),
+
+		"lifecycle_guard": lifecycleGuardAttribute(),
+
+		"allow_regeneration_token": allowRegenerationTokenAttribute(),
+	}
+
+	for name, attribute := range attributes {
+		shared[name] = attribute
+	}
+
+	return shared
+}
+
+// stringSharedModel is the state of the attributes returned by
+// stringSchemaAttributes, which the models of random_password and
+// random_string embed, so that the shared attributes are read and written in
+// the same way by both resources, and copied as a whole when the state of one
+// is moved to the other.
+type stringSharedModel struct {
+	Keepers                types.Map    `tfsdk:"keepers"`
+	KeepersHash            types.String `tfsdk:"keepers_hash"`
+	Length                 types.Int64  `tfsdk:"length"`
+	Special                types.Bool   `tfsdk:"special"`
+	Upper                  types.Bool   `tfsdk:"upper"`
+	Lower                  types.Bool   `tfsdk:"lower"`
+	Number                 types.Bool   `tfsdk:"number"`
+	Numeric                types.Bool   `tfsdk:"numeric"`
+	MinNumeric             types.Int64  `tfsdk:"min_numeric"`
+	MinUpper               types.Int64  `tfsdk:"min_upper"`
+	MinLower               types.Int64  `tfsdk:"min_lower"`
+	MinSpecial             types.Int64  `tfsdk:"min_special"`
+	MinCharacterClasses    types.Int64  `tfsdk:"min_character_classes"`
+	OverrideSpecial        types.String `tfsdk:"override_special"`
+	OverrideSpecialList    types.List   `tfsdk:"override_special_list"`
+	CharsetPreset          types.String `tfsdk:"charset_preset"`
+	Result                 types.String `tfsdk:"result"`
+	CreatedAt              types.String `tfsdk:"created_at"`
+	ProviderVersion        types.String `tfsdk:"provider_version"`
+	LifecycleGuard         types.Bool   `tfsdk:"lifecycle_guard"`
+	AllowRegenerationToken types.String `tfsdk:"allow_regeneration_token"`
+}
+
+// classes returns whether the uppercase, lowercase, numeric and special
+// character classes are enabled, in the order of the values returned by the
+// mins method of the embedding model.
+func (m stringSharedModel) classes() []types.Bool {
+	return characterClasses(m.Upper, m.Lower, m.Number, m.Numeric, m.Special)
+}
@@ -0,0 +1,42 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+// TestStringSchemaAttributes_Duplicates guards against an attribute specific to
+// random_password or random_string replacing a shared attribute of the same
+// name.
+func TestStringSchemaAttributes_Duplicates(t *testing.T) {
+	t.Parallel()
+
+	shared := stringSchemaAttributes(stringSchemaOptions{}, nil)
+
+	testCases := map[string]struct {
+		attributes map[string]schema.Attribute
+	}{
+		"random_password": {
+			attributes: passwordAttributes(),
+		},
+		"random_string": {
+			attributes: stringAttributes(),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			for attributeName := range shared {
+				if _, ok := testCase.attributes[attributeName]; ok {
+					t.Errorf("expected %q to be defined only by the shared attributes", attributeName)
+				}
+			}
+		})
+	}
+}
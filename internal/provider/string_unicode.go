@@ -0,0 +1,109 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/text/unicode/norm"
+)
+
+// The units in which the length of a random_string is measured.
+const (
+	stringLengthUnitRunes = "runes"
+	stringLengthUnitBytes = "bytes"
+)
+
+// stringNormalizationForms are the Unicode normalization forms which can be
+// applied to override_special.
+var stringNormalizationForms = map[string]norm.Form{
+	"NFC":  norm.NFC,
+	"NFKC": norm.NFKC,
+}
+
+// normalizeOverrideSpecial returns the characters of override_special each
+// normalized to the given normalization form, or unchanged if the
+// normalization is empty or unknown.
+func normalizeOverrideSpecial(overrideSpecial, normalization string) string {
+	form, ok := stringNormalizationForms[normalization]
+	if !ok {
+		return overrideSpecial
+	}
+
+	var normalized []byte
+
+	for _, c := range overrideSpecial {
+		normalized = form.AppendString(normalized, string(c))
+	}
+
+	return string(normalized)
+}
+
+// validateOverrideSpecial returns an error diagnostic for each character of
+// override_special, or of override_special_list at the given path, which would not be generated as a single character of the
+// given length: characters which combine with the preceding character, such as
+// combining accents, characters which are not a single character once
+// normalized, and, when length is measured in bytes, characters outside of
+// ASCII. Nothing is validated if any value is unknown.
+func validateOverrideSpecial(overrideSpecial types.String, p path.Path, lengthUnit, normalization types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if overrideSpecial.IsUnknown() || lengthUnit.IsUnknown() || normalization.IsUnknown() {
+		return diags
+	}
+
+	value := overrideSpecial.ValueString()
+
+	if !utf8.ValidString(value) {
+		diags.AddAttributeError(
+			p,
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute %s must be valid UTF-8.", p),
+		)
+
+		return diags
+	}
+
+	form, normalize := stringNormalizationForms[normalization.ValueString()]
+
+	for _, c := range value {
+		if lengthUnit.ValueString() == stringLengthUnitBytes && c >= utf8.RuneSelf {
+			diags.AddAttributeError(
+				p,
+				"Invalid Attribute Value",
+				fmt.Sprintf("Attribute %s must only contain ASCII characters when length_unit is "+
+					"%q, so that the result is length bytes long, got: %q", p, stringLengthUnitBytes, c),
+			)
+
+			continue
+		}
+
+		if !norm.NFC.PropertiesString(string(c)).BoundaryBefore() {
+			diags.AddAttributeError(
+				p,
+				"Invalid Attribute Value",
+				fmt.Sprintf("Attribute %s must not contain characters which combine with the "+
+					"preceding character, such as combining accents, as they would not be generated as separate "+
+					"characters, got: %U", p, c),
+			)
+
+			continue
+		}
+
+		if normalize && utf8.RuneCountInString(form.String(string(c))) != 1 {
+			diags.AddAttributeError(
+				p,
+				"Invalid Attribute Value",
+				fmt.Sprintf("Attribute %s must only contain characters which remain a single "+
+					"character when normalized to %s, got: %q", p, normalization.ValueString(), c),
+			)
+		}
+	}
+
+	return diags
+}
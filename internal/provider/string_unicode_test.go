@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestNormalizeOverrideSpecial(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		overrideSpecial string
+		normalization   string
+		expected        string
+	}{
+		"none": {
+			overrideSpecial: "\u212b!",
+			expected:        "\u212b!",
+		},
+		"nfc": {
+			overrideSpecial: "\u212b!",
+			normalization:   "NFC",
+			expected:        "\u00c5!",
+		},
+		"nfkc": {
+			overrideSpecial: "\uff21\u00e9",
+			normalization:   "NFKC",
+			expected:        "A\u00e9",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := normalizeOverrideSpecial(testCase.overrideSpecial, testCase.normalization)
+
+			if got != testCase.expected {
+				t.Errorf("expected %q, got: %q", testCase.expected, got)
+			}
+		})
+	}
+}
+
+func TestValidateOverrideSpecial(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		overrideSpecial types.String
+		lengthUnit      types.String
+		normalization   types.String
+		expectedErrors  int
+	}{
+		"ascii-bytes": {
+			overrideSpecial: types.StringValue("!@#"),
+			lengthUnit:      types.StringValue(stringLengthUnitBytes),
+			normalization:   types.StringNull(),
+		},
+		"multi-byte-runes": {
+			overrideSpecial: types.StringValue("äöü€"),
+			lengthUnit:      types.StringNull(),
+			normalization:   types.StringValue("NFC"),
+		},
+		"multi-byte-bytes": {
+			overrideSpecial: types.StringValue("!äö"),
+			lengthUnit:      types.StringValue(stringLengthUnitBytes),
+			normalization:   types.StringNull(),
+			expectedErrors:  2,
+		},
+		"combining": {
+			overrideSpecial: types.StringValue("a\u0301"),
+			lengthUnit:      types.StringNull(),
+			normalization:   types.StringNull(),
+			expectedErrors:  1,
+		},
+		"decomposes": {
+			overrideSpecial: types.StringValue("ﬁ"),
+			lengthUnit:      types.StringNull(),
+			normalization:   types.StringValue("NFKC"),
+			expectedErrors:  1,
+		},
+		"invalid-utf8": {
+			overrideSpecial: types.StringValue("\xff"),
+			lengthUnit:      types.StringNull(),
+			normalization:   types.StringNull(),
+			expectedErrors:  1,
+		},
+		"unknown": {
+			overrideSpecial: types.StringUnknown(),
+			lengthUnit:      types.StringValue(stringLengthUnitBytes),
+			normalization:   types.StringNull(),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := validateOverrideSpecial(testCase.overrideSpecial, path.Root("override_special"), testCase.lengthUnit, testCase.normalization)
+
+			if diags.ErrorsCount() != testCase.expectedErrors {
+				t.Errorf("expected %d errors, got: %v", testCase.expectedErrors, diags)
+			}
+		})
+	}
+}
@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"crypto/sha1" //nolint:gosec // SHA-1 is required by version 5 UUIDs.
+
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func uuidDeterministicAttribute() schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Description: "Generate a version 5 UUID from the `uuid_namespace` of the provider configuration and the " +
+			"contents of `keepers`, rather than a random version 4 UUID, so that the same keepers produce the " +
+			"same UUID even if the state is lost. Changing `keepers` replaces the resource with a new UUID as " +
+			"usual. Keys with null values are ignored, and the name of the UUID is the JSON encoding of the " +
+			"keepers with sorted keys, such as `{\"env\":\"prod\"}`. The UUID is only as difficult to guess as " +
+			"the namespace and keepers. Conflicts with `quantity` and `expires_after`. Default value is `false`.",
+		Optional: true,
+		PlanModifiers: []planmodifier.Bool{
+			boolplanmodifier.RequiresReplace(),
+		},
+		Validators: []validator.Bool{
+			boolvalidator.ConflictsWith(
+				path.MatchRoot("quantity"),
+				path.MatchRoot("expires_after"),
+			),
+		},
+	}
+}
+
+// deterministicUUID returns the version 5 UUID of the JSON encoding of the
+// keepers in the namespace, as specified by RFC 9562.
+func deterministicUUID(namespace []byte, keepers types.Map) (string, error) {
+	name, err := keepersJSON(keepers)
+	if err != nil {
+		return "", err
+	}
+
+	h := sha1.New() //nolint:gosec // SHA-1 is required by version 5 UUIDs.
+	h.Write(namespace)
+	h.Write(name)
+
+	sum := h.Sum(nil)[:16]
+
+	// The version is in the high nibble of octet 6, and the variant in the
+	// high bits of octet 8.
+	sum[6] = sum[6]&0x0f | 0x50
+	sum[8] = sum[8]&0x3f | 0x80
+
+	return uuid.FormatUUID(sum)
+}
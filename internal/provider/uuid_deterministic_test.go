@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestDeterministicUUID(t *testing.T) {
+	t.Parallel()
+
+	// The DNS namespace of RFC 9562.
+	namespace, err := uuid.ParseUUID("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	testCases := map[string]struct {
+		keepers  types.Map
+		expected string
+	}{
+		"null": {
+			keepers:  types.MapNull(types.StringType),
+			expected: "f8cedbe4-72a8-53c3-922d-4cc8730f4c2d",
+		},
+		"one": {
+			keepers: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"env": types.StringValue("prod"),
+			}),
+			expected: "c35215d4-cceb-570d-9c42-a308a6beea57",
+		},
+		"null-value": {
+			keepers: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"env":   types.StringValue("prod"),
+				"owner": types.StringNull(),
+			}),
+			expected: "c35215d4-cceb-570d-9c42-a308a6beea57",
+		},
+		"sorted": {
+			keepers: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"region": types.StringValue("eu"),
+				"env":    types.StringValue("prod"),
+			}),
+			expected: "71a52a3f-8e6e-564e-a97c-97ea33171f16",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := deterministicUUID(namespace, testCase.keepers)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("expected %s, got %s", testCase.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,31 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"strings"
+)
+
+// uuidURNPrefix is the prefix of the URN form of a UUID, as defined by RFC 9562,
+// which is matched case-insensitively.
+const uuidURNPrefix = "urn:uuid:"
+
+// uuidImportUsage describes the accepted formats of a random_uuid import id.
+const uuidImportUsage = "Expected a UUID of 36 characters in the canonical form " +
+	"xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx, in either case, which may be enclosed in braces, such as " +
+	"{XXXXXXXX-XXXX-XXXX-XXXX-XXXXXXXXXXXX}, or prefixed with urn:uuid:."
+
+// normalizeUUIDImport returns the canonical form of the UUID of a random_uuid
+// import id, removing the braces or URN prefix with which Windows and Active
+// Directory tooling commonly exports UUIDs, and converting it to lowercase. The
+// result is not validated.
+func normalizeUUIDImport(id string) string {
+	if len(id) > len(uuidURNPrefix) && strings.EqualFold(id[:len(uuidURNPrefix)], uuidURNPrefix) {
+		id = id[len(uuidURNPrefix):]
+	} else if strings.HasPrefix(id, "{") && strings.HasSuffix(id, "}") {
+		id = id[1 : len(id)-1]
+	}
+
+	return strings.ToLower(id)
+}
@@ -0,0 +1,62 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+)
+
+func TestNormalizeUUIDImport(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		id       string
+		expected string
+	}{
+		"canonical": {
+			id:       "6b0f8e7c-3ea6-4523-88a2-5a70419ee954",
+			expected: "6b0f8e7c-3ea6-4523-88a2-5a70419ee954",
+		},
+		"upper": {
+			id:       "6B0F8E7C-3EA6-4523-88A2-5A70419EE954",
+			expected: "6b0f8e7c-3ea6-4523-88a2-5a70419ee954",
+		},
+		"braced": {
+			id:       "{6b0f8e7c-3ea6-4523-88a2-5a70419ee954}",
+			expected: "6b0f8e7c-3ea6-4523-88a2-5a70419ee954",
+		},
+		"braced-upper": {
+			id:       "{6B0F8E7C-3EA6-4523-88A2-5A70419EE954}",
+			expected: "6b0f8e7c-3ea6-4523-88a2-5a70419ee954",
+		},
+		"urn": {
+			id:       "urn:uuid:6b0f8e7c-3ea6-4523-88a2-5a70419ee954",
+			expected: "6b0f8e7c-3ea6-4523-88a2-5a70419ee954",
+		},
+		"urn-upper": {
+			id:       "URN:UUID:6B0F8E7C-3EA6-4523-88A2-5A70419EE954",
+			expected: "6b0f8e7c-3ea6-4523-88a2-5a70419ee954",
+		},
+		"unbalanced-brace": {
+			id:       "{6b0f8e7c-3ea6-4523-88a2-5a70419ee954",
+			expected: "{6b0f8e7c-3ea6-4523-88a2-5a70419ee954",
+		},
+		"urn-braced": {
+			id:       "urn:uuid:{6b0f8e7c-3ea6-4523-88a2-5a70419ee954}",
+			expected: "{6b0f8e7c-3ea6-4523-88a2-5a70419ee954}",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := normalizeUUIDImport(testCase.id)
+
+			if got != testCase.expected {
+				t.Errorf("expected %q, got %q", testCase.expected, got)
+			}
+		})
+	}
+}
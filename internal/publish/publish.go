@@ -0,0 +1,43 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package publish hands generated secrets to tooling which embeds the
+// provider, such as to store them in a secret manager, when a resource is
+// created and before the secret is written to state, so that the tooling does
+// not need to read the plaintext from state or outputs.
+//
+// The provider is constructed with a Publisher, which receives every secret
+// generated by resources which support publishing.
+package publish
+
+import (
+	"context"
+)
+
+// Secret is a generated secret and the metadata with which it is published.
+type Secret struct {
+	// ResourceType is the type of the resource which generated the secret,
+	// such as random_password.
+	ResourceType string `json:"resource_type"`
+
+	// Result is the generated secret.
+	Result string `json:"result"`
+
+	// Results are all the secrets generated by the resource, beginning with
+	// Result, such as when count_results is configured.
+	Results []string `json:"results"`
+}
+
+// Publisher publishes generated secrets. Publish is called before the secret
+// is written to state, and the resource is not created if it returns an error.
+type Publisher interface {
+	Publish(ctx context.Context, secret Secret) error
+}
+
+// PublisherFunc is a function which implements Publisher.
+type PublisherFunc func(ctx context.Context, secret Secret) error
+
+// Publish calls f(ctx, secret).
+func (f PublisherFunc) Publish(ctx context.Context, secret Secret) error {
+	return f(ctx, secret)
+}
@@ -0,0 +1,28 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package random
+
+import (
+	"errors"
+	"io"
+)
+
+// CreateBytes returns length bytes read from the source.
+//
+// The underlying reader may return fewer bytes than requested in a single
+// call, which is more likely for large lengths, so reads are repeated until
+// the buffer is filled. A short read is reported as io.ErrUnexpectedEOF.
+func (s *Source) CreateBytes(length int64) ([]byte, error) {
+	if length < 1 {
+		return nil, errors.New("the length must be at least 1")
+	}
+
+	bytes := make([]byte, length)
+
+	if _, err := io.ReadFull(s, bytes); err != nil {
+		return nil, err
+	}
+
+	return bytes, nil
+}
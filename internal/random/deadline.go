@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package random
+
+import (
+	"errors"
+	"time"
+)
+
+// DefaultMaxGenerationTime is the time within which a value must be generated
+// from a Source returned by WithTimeout, unless configured otherwise.
+const DefaultMaxGenerationTime = time.Minute
+
+// ErrGenerationTimeout is wrapped by the errors of reads from a Source
+// returned by WithTimeout once its timeout has elapsed.
+var ErrGenerationTimeout = errors.New("maximum generation time exceeded")
+
+// WithTimeout returns a Source which reads from s until the timeout elapses,
+// after which reads fail with an EntropyError wrapping ErrGenerationTimeout.
+// This bounds the time taken to generate a value from many reads, each of
+// which may be retried up to the timeout of a reader returned by
+// NewRetryReader. The deadline is checked before each read, so a single read
+// which blocks is bounded by the reader of s, such as a reader returned by
+// NewRetryReader, rather than by the timeout. A timeout of zero or less
+// returns s.
+func (s *Source) WithTimeout(timeout time.Duration) *Source {
+	if timeout <= 0 {
+		return s
+	}
+
+	start := time.Now()
+
+	return &Source{
+		reader: &deadlineReader{
+			source:   s,
+			start:    start,
+			deadline: start.Add(timeout),
+		},
+		iface: s.Interface(),
+	}
+}
+
+// deadlineReader reads from the source until the deadline.
+type deadlineReader struct {
+	source   *Source
+	start    time.Time
+	deadline time.Time
+}
+
+func (r *deadlineReader) Read(p []byte) (int, error) {
+	if !time.Now().Before(r.deadline) {
+		return 0, r.timeoutError(len(p))
+	}
+
+	return r.source.Read(p)
+}
+
+func (r *deadlineReader) timeoutError(bytesRequested int) error {
+	return &EntropyError{
+		BytesRequested: bytesRequested,
+		Waited:         time.Since(r.start),
+		Interface:      r.source.Interface(),
+		Err:            ErrGenerationTimeout,
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package random
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+// slowReader returns a single byte from every read after the duration.
+type slowReader time.Duration
+
+func (r slowReader) Read(p []byte) (int, error) {
+	time.Sleep(time.Duration(r))
+	p[0] = 1
+	return 1, nil
+}
+
+func TestSourceWithTimeout(t *testing.T) {
+	t.Parallel()
+
+	t.Run("within", func(t *testing.T) {
+		t.Parallel()
+
+		source := NewSource(&flakyReader{}).WithTimeout(time.Minute)
+
+		result, err := source.CreateBytes(4)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if string(result) != "\x01\x01\x01\x01" {
+			t.Errorf("expected bytes read from the source, got: %v", result)
+		}
+	})
+
+	t.Run("elapsed", func(t *testing.T) {
+		t.Parallel()
+
+		source := NewSource(slowReader(10 * time.Millisecond)).WithTimeout(50 * time.Millisecond)
+
+		_, err := source.CreateBytes(64)
+
+		if !errors.Is(err, ErrGenerationTimeout) || !errors.Is(err, ErrEntropyUnavailable) {
+			t.Fatalf("expected ErrGenerationTimeout wrapping ErrEntropyUnavailable, got: %v", err)
+		}
+
+		var entropyErr *EntropyError
+
+		if !errors.As(err, &entropyErr) || entropyErr.Waited < 50*time.Millisecond || entropyErr.BytesRequested == 0 {
+			t.Errorf("unexpected details: %v", err)
+		}
+	})
+
+	t.Run("blocked", func(t *testing.T) {
+		t.Parallel()
+
+		unblock := make(blockingReader)
+		defer close(unblock)
+
+		// A blocked read is bounded by the retry reader, not the timeout.
+		source := NewSource(NewRetryReader(unblock, 50*time.Millisecond)).WithTimeout(time.Minute)
+
+		_, err := source.CreateBytes(4)
+
+		if errors.Is(err, ErrGenerationTimeout) || !errors.Is(err, errReadBlocked) {
+			t.Fatalf("expected the blocked read to be abandoned by the retry reader, got: %v", err)
+		}
+	})
+
+	t.Run("zero", func(t *testing.T) {
+		t.Parallel()
+
+		source := NewSource(nil)
+
+		if source.WithTimeout(0) != source {
+			t.Error("expected the source to be returned without a timeout")
+		}
+	})
+}
@@ -0,0 +1,69 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package random
+
+// Rand chooses integers by rejection sampling from entropy read from a Source,
+// as Intn does, with the methods of the generator of math/rand which are used
+// to choose results which need not be reproducible. Unlike a generator seeded
+// from the Source, every integer is chosen from fresh entropy and no integer
+// is favoured.
+//
+// As its methods do not return errors, the first error reading the Source is
+// retained and returned by Err, after which every integer chosen is 0. Err
+// must be checked once the integers have been chosen.
+type Rand struct {
+	sampler *sampler
+	err     error
+}
+
+// Rand returns a Rand which reads entropy from the Source.
+func (s *Source) Rand() *Rand {
+	return &Rand{
+		sampler: newSampler(s),
+	}
+}
+
+// Err returns the first error reading the Source, if any.
+func (r *Rand) Err() error {
+	return r.err
+}
+
+// Int63n returns a uniformly distributed integer in the range [0, n). See
+// Source.Intn.
+func (r *Rand) Int63n(n int64) int64 {
+	if r.err != nil {
+		return 0
+	}
+
+	v, err := r.sampler.intn(n)
+	if err != nil {
+		r.err = err
+		return 0
+	}
+
+	return v
+}
+
+// Perm returns a uniformly random permutation of the integers in the range
+// [0, n).
+func (r *Rand) Perm(n int) []int {
+	m := make([]int, n)
+
+	for i := range m {
+		j := r.Int63n(int64(i) + 1)
+		m[i] = m[j]
+		m[j] = i
+	}
+
+	return m
+}
+
+// Shuffle randomly permutes n elements with the Fisher-Yates shuffle,
+// swapping each element from the last to the second with an element chosen by
+// Int63n from those before it or itself.
+func (r *Rand) Shuffle(n int, swap func(i, j int)) {
+	for i := n - 1; i > 0; i-- {
+		swap(i, int(r.Int63n(int64(i)+1)))
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package random
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+	"testing/iotest"
+
+	"github.com/terraform-providers/terraform-provider-random/internal/randomtest"
+)
+
+func TestRand_Perm(t *testing.T) {
+	t.Parallel()
+
+	r := NewSource(rand.New(rand.NewSource(1))).Rand()
+
+	// Each of the 6 permutations of 3 elements is equally likely.
+	permutations := map[[3]int]int{}
+
+	for i := 0; i < 6000; i++ {
+		perm := r.Perm(3)
+		permutations[[3]int(perm)]++
+	}
+
+	if err := r.Err(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(permutations) != 6 {
+		t.Fatalf("expected 6 permutations, got: %v", permutations)
+	}
+
+	observed := make([]int, 0, len(permutations))
+
+	for _, count := range permutations {
+		observed = append(observed, count)
+	}
+
+	if err := randomtest.ChiSquaredUniform(observed); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRand_Shuffle(t *testing.T) {
+	t.Parallel()
+
+	r := NewSource(rand.New(rand.NewSource(1))).Rand()
+
+	// Each element is equally likely to be shuffled into the first position.
+	first := make([]int, 5)
+
+	for i := 0; i < 5000; i++ {
+		values := []int{0, 1, 2, 3, 4}
+
+		r.Shuffle(len(values), func(i, j int) {
+			values[i], values[j] = values[j], values[i]
+		})
+
+		first[values[0]]++
+	}
+
+	if err := r.Err(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := randomtest.ChiSquaredUniform(first); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRand_Err(t *testing.T) {
+	t.Parallel()
+
+	errRead := errors.New("read error")
+	r := NewSource(iotest.ErrReader(errRead)).Rand()
+
+	if v := r.Int63n(10); v != 0 {
+		t.Errorf("expected 0, got: %d", v)
+	}
+
+	if v := r.Int63n(10); v != 0 {
+		t.Errorf("expected 0, got: %d", v)
+	}
+
+	if err := r.Err(); !errors.Is(err, errRead) {
+		t.Errorf("expected the error of the source, got: %v", err)
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package random
+
+import (
+	"errors"
+	"strings"
+)
+
+// RecoveryCodeChars are the characters of recovery codes, which are lowercase
+// so that codes can be read aloud and typed without ambiguity of case.
+const RecoveryCodeChars = lowerChars + numChars
+
+type RecoveryCodeParams struct {
+	Count       int64
+	GroupCount  int64
+	GroupLength int64
+	Separator   string
+}
+
+// CreateRecoveryCodes returns Count recovery codes, such as xxxxx-xxxxx, each
+// of GroupCount groups of GroupLength characters joined by Separator. Every
+// code is generated independently using entropy read from the source, and a
+// code which has already been generated is discarded, so that each code is
+// distinct and may be used once.
+func (s *Source) CreateRecoveryCodes(input RecoveryCodeParams) ([]string, error) {
+	if input.Count < 1 || input.GroupCount < 1 || input.GroupLength < 1 {
+		return nil, errors.New("the number of codes, groups and characters in each group must be at least 1")
+	}
+
+	codes := make([]string, 0, input.Count)
+	seen := make(map[string]bool, input.Count)
+
+	for int64(len(codes)) < input.Count {
+		chars, err := s.CreateString(StringParams{
+			Length:  input.GroupCount * input.GroupLength,
+			Charset: RecoveryCodeChars,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		groups := make([]string, input.GroupCount)
+
+		for i := range groups {
+			start := int64(i) * input.GroupLength
+			groups[i] = string(chars[start : start+input.GroupLength])
+		}
+
+		code := strings.Join(groups, input.Separator)
+
+		if seen[code] {
+			continue
+		}
+
+		seen[code] = true
+		codes = append(codes, code)
+	}
+
+	return codes, nil
+}
@@ -0,0 +1,190 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package random
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"runtime"
+	"syscall"
+	"time"
+)
+
+// DefaultEntropyTimeout is the time for which reads from the operating
+// system's random number generator are retried before they are abandoned.
+const DefaultEntropyTimeout = 10 * time.Second
+
+const (
+	retryInitialBackoff = 10 * time.Millisecond
+	retryMaxBackoff     = time.Second
+)
+
+// ErrEntropyUnavailable is wrapped by the errors of reads which did not
+// succeed within the timeout of a reader returned by NewRetryReader.
+var ErrEntropyUnavailable = errors.New("entropy unavailable")
+
+// EntropyError is returned by the readers of this package when entropy could
+// not be read in time, describing the read for diagnostics. It wraps
+// ErrEntropyUnavailable and the error of the last read.
+type EntropyError struct {
+	// BytesRequested is the number of bytes of the read.
+	BytesRequested int
+
+	// Waited is the time for which the read was attempted.
+	Waited time.Duration
+
+	// Interface is the interface of the operating system, or other reader,
+	// from which entropy was read, such as getrandom(2).
+	Interface string
+
+	// Err is the error of the last read.
+	Err error
+}
+
+func (e *EntropyError) Error() string {
+	return fmt.Sprintf("%s: no entropy was read from %s within %s: %s", ErrEntropyUnavailable, e.Interface,
+		e.Waited.Round(time.Millisecond), e.Err)
+}
+
+func (e *EntropyError) Unwrap() []error {
+	return []error{ErrEntropyUnavailable, e.Err}
+}
+
+// customEntropyInterface is the interface of readers other than the
+// cryptographic random number generator, such as those supplied in tests.
+const customEntropyInterface = "a custom reader"
+
+// SystemEntropyInterface returns the interface of the operating system from
+// which the cryptographic random number generator reads entropy, as
+// documented by crypto/rand.
+func SystemEntropyInterface() string {
+	switch runtime.GOOS {
+	case "linux", "android", "freebsd", "dragonfly", "solaris", "illumos":
+		return "getrandom(2)"
+	case "darwin", "ios", "openbsd":
+		return "arc4random_buf(3)"
+	case "netbsd":
+		return "the kern.arandom sysctl"
+	case "windows":
+		return "ProcessPrng"
+	case "js":
+		return "the Web Crypto API"
+	case "wasip1":
+		return "random_get"
+	default:
+		return "/dev/urandom"
+	}
+}
+
+// entropyInterface returns the interface from which the reader reads
+// entropy.
+func entropyInterface(reader io.Reader) string {
+	switch r := reader.(type) {
+	case nil:
+		return SystemEntropyInterface()
+	case interface{ Interface() string }:
+		return r.Interface()
+	default:
+		return customEntropyInterface
+	}
+}
+
+// errReadBlocked is returned when a read does not return within the timeout,
+// such as when getrandom blocks until the kernel's random number generator is
+// initialized early in the boot of a cloud image.
+var errReadBlocked = errors.New("read blocked")
+
+// NewRetryReader returns a reader which reads from the given reader, retrying
+// reads which fail with a transient error, such as EAGAIN, with exponential
+// backoff. Reads which do not succeed within the timeout, including those
+// which block, fail with an error wrapping ErrEntropyUnavailable. A blocked
+// read is abandoned rather than cancelled, so the reader must be safe for
+// concurrent use. If the reader is nil, the cryptographic random number
+// generator is used.
+func NewRetryReader(reader io.Reader, timeout time.Duration) io.Reader {
+	if reader == nil {
+		reader = NewSource(nil)
+	}
+
+	return &retryReader{
+		reader:  reader,
+		timeout: timeout,
+	}
+}
+
+type retryReader struct {
+	reader  io.Reader
+	timeout time.Duration
+}
+
+// Interface returns the interface from which the underlying reader reads
+// entropy.
+func (r *retryReader) Interface() string {
+	return entropyInterface(r.reader)
+}
+
+func (r *retryReader) Read(p []byte) (int, error) {
+	start := time.Now()
+	deadline := start.Add(r.timeout)
+	backoff := retryInitialBackoff
+
+	for {
+		n, err := readBefore(r.reader, p, deadline)
+		if n > 0 || err == nil || !retryable(err) {
+			return n, err
+		}
+
+		// The read is not retried if the deadline would pass before the
+		// backoff elapses.
+		if errors.Is(err, errReadBlocked) || backoff >= time.Until(deadline) {
+			return 0, &EntropyError{
+				BytesRequested: len(p),
+				Waited:         time.Since(start),
+				Interface:      r.Interface(),
+				Err:            err,
+			}
+		}
+
+		time.Sleep(backoff)
+		backoff = min(backoff*2, retryMaxBackoff)
+	}
+}
+
+// readBefore reads from the reader into p, returning errReadBlocked if the
+// read does not return before the deadline. The read is made into a separate
+// buffer, so that an abandoned read cannot later modify p.
+func readBefore(reader io.Reader, p []byte, deadline time.Time) (int, error) {
+	type result struct {
+		buf []byte
+		n   int
+		err error
+	}
+
+	done := make(chan result, 1)
+
+	go func() {
+		buf := make([]byte, len(p))
+		n, err := reader.Read(buf)
+		done <- result{buf: buf, n: n, err: err}
+	}()
+
+	timer := time.NewTimer(time.Until(deadline))
+	defer timer.Stop()
+
+	select {
+	case res := <-done:
+		return copy(p, res.buf[:res.n]), res.err
+	case <-timer.C:
+		return 0, errReadBlocked
+	}
+}
+
+// retryable returns whether a read which failed with the error may succeed
+// if retried.
+func retryable(err error) bool {
+	return errors.Is(err, errReadBlocked) ||
+		errors.Is(err, syscall.EAGAIN) ||
+		errors.Is(err, syscall.EINTR)
+}
@@ -0,0 +1,148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package random
+
+import (
+	"errors"
+	"io"
+	"syscall"
+	"testing"
+	"time"
+)
+
+// flakyReader fails each read with err until failures reads have failed, then
+// fills the buffer with ones.
+type flakyReader struct {
+	err      error
+	failures int
+}
+
+func (r *flakyReader) Read(p []byte) (int, error) {
+	if r.failures > 0 {
+		r.failures--
+		return 0, r.err
+	}
+
+	for i := range p {
+		p[i] = 1
+	}
+
+	return len(p), nil
+}
+
+// blockingReader blocks every read until the channel is closed.
+type blockingReader chan struct{}
+
+func (r blockingReader) Read(p []byte) (int, error) {
+	<-r
+	return len(p), nil
+}
+
+func TestRetryReader(t *testing.T) {
+	t.Parallel()
+
+	t.Run("transient", func(t *testing.T) {
+		t.Parallel()
+
+		reader := NewRetryReader(&flakyReader{err: syscall.EAGAIN, failures: 3}, time.Second)
+		buf := make([]byte, 4)
+
+		if _, err := io.ReadFull(reader, buf); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if string(buf) != "\x01\x01\x01\x01" {
+			t.Errorf("expected the buffer to be filled, got: %v", buf)
+		}
+	})
+
+	t.Run("transient-timeout", func(t *testing.T) {
+		t.Parallel()
+
+		reader := NewRetryReader(&flakyReader{err: syscall.EAGAIN, failures: 1000}, 50*time.Millisecond)
+
+		_, err := reader.Read(make([]byte, 4))
+
+		if !errors.Is(err, ErrEntropyUnavailable) || !errors.Is(err, syscall.EAGAIN) {
+			t.Errorf("expected ErrEntropyUnavailable wrapping EAGAIN, got: %v", err)
+		}
+
+		var entropyErr *EntropyError
+
+		if !errors.As(err, &entropyErr) {
+			t.Fatalf("expected an EntropyError, got: %v", err)
+		}
+
+		if entropyErr.BytesRequested != 4 || entropyErr.Waited <= 0 || entropyErr.Interface != customEntropyInterface {
+			t.Errorf("unexpected details: %+v", entropyErr)
+		}
+	})
+
+	t.Run("blocked", func(t *testing.T) {
+		t.Parallel()
+
+		unblock := make(blockingReader)
+		defer close(unblock)
+
+		reader := NewRetryReader(unblock, 50*time.Millisecond)
+
+		_, err := reader.Read(make([]byte, 4))
+
+		if !errors.Is(err, ErrEntropyUnavailable) {
+			t.Errorf("expected ErrEntropyUnavailable, got: %v", err)
+		}
+	})
+
+	t.Run("permanent", func(t *testing.T) {
+		t.Parallel()
+
+		permanent := errors.New("permanent")
+		reader := NewRetryReader(&flakyReader{err: permanent, failures: 1}, time.Second)
+
+		_, err := reader.Read(make([]byte, 4))
+
+		if !errors.Is(err, permanent) || errors.Is(err, ErrEntropyUnavailable) {
+			t.Errorf("expected the permanent error to be returned without retrying, got: %v", err)
+		}
+	})
+}
+
+func TestEntropyInterface(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		reader   io.Reader
+		expected string
+	}{
+		"nil": {
+			expected: SystemEntropyInterface(),
+		},
+		"nil-source": {
+			reader:   (*Source)(nil),
+			expected: SystemEntropyInterface(),
+		},
+		"retry-system": {
+			reader:   NewRetryReader(nil, time.Second),
+			expected: SystemEntropyInterface(),
+		},
+		"buffered-retry-system": {
+			reader:   NewBufferedSource(NewRetryReader(nil, time.Second), 64),
+			expected: SystemEntropyInterface(),
+		},
+		"custom": {
+			reader:   NewRetryReader(&flakyReader{}, time.Second),
+			expected: customEntropyInterface,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := entropyInterface(testCase.reader); got != testCase.expected {
+				t.Errorf("expected %q, got %q", testCase.expected, got)
+			}
+		})
+	}
+}
@@ -4,24 +4,81 @@
 package random
 
 import (
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
 	"hash/crc64"
+	"io"
 	"math/rand"
-	"time"
+
+	"golang.org/x/crypto/hkdf"
 )
 
+// MaxDerivedBytes is the maximum number of bytes which can be derived from a
+// seed by DeriveBytes, which is the maximum output length of HKDF-SHA256.
+const MaxDerivedBytes = 255 * sha256.Size
+
 // NewRand returns a seeded random number generator, using a seed derived
 // from the provided string.
 //
-// If the seed string is empty, the current time is used as a seed.
-func NewRand(seed string) *rand.Rand {
+// If the seed string is empty, the seed is read from the source, returning
+// the error of the read if the source cannot be read.
+func (s *Source) NewRand(seed string) (*rand.Rand, error) {
 	var seedInt int64
 	if seed != "" {
 		crcTable := crc64.MakeTable(crc64.ISO)
 		seedInt = int64(crc64.Checksum([]byte(seed), crcTable))
 	} else {
-		seedInt = time.Now().UnixNano()
+		var err error
+
+		seedInt, err = s.seed()
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	randSource := rand.NewSource(seedInt)
-	return rand.New(randSource)
+	return rand.New(randSource), nil
+}
+
+func (s *Source) seed() (int64, error) {
+	var b [8]byte
+
+	if _, err := io.ReadFull(s, b[:]); err != nil {
+		return 0, err
+	}
+
+	return int64(binary.BigEndian.Uint64(b[:])), nil
+}
+
+// NewDerivedSource returns a Source which reads bytes derived from the seed
+// string using HKDF-SHA256, as DeriveBytes does, so that values generated
+// from the Source are the same for the same seed and info. At most
+// MaxDerivedBytes can be read from the Source.
+func NewDerivedSource(seed, info string) *Source {
+	return NewSource(hkdf.New(sha256.New, []byte(seed), nil, []byte(info)))
+}
+
+// DeriveBytes returns length bytes derived from the seed string using
+// HKDF-SHA256, with the info string distinguishing the bytes derived for
+// different purposes from the same seed. The same bytes are always derived
+// for the same seed, info and length, so the result is not random, and is
+// only as difficult to guess as the seed itself.
+func DeriveBytes(seed, info string, length int64) ([]byte, error) {
+	if length < 1 || length > MaxDerivedBytes {
+		return nil, fmt.Errorf("the length must be between 1 and %d, got: %d", MaxDerivedBytes, length)
+	}
+
+	if seed == "" {
+		return nil, errors.New("the seed must not be empty")
+	}
+
+	bytes := make([]byte, length)
+
+	if _, err := io.ReadFull(hkdf.New(sha256.New, []byte(seed), nil, []byte(info)), bytes); err != nil {
+		return nil, err
+	}
+
+	return bytes, nil
 }
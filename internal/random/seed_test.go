@@ -0,0 +1,44 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package random
+
+import (
+	"errors"
+	"testing"
+	"testing/iotest"
+)
+
+func TestSourceNewRand(t *testing.T) {
+	t.Parallel()
+
+	errRead := errors.New("read error")
+	source := NewSource(iotest.ErrReader(errRead))
+
+	t.Run("seeded", func(t *testing.T) {
+		t.Parallel()
+
+		// A seeded generator does not read from the source.
+		first, err := source.NewRand("seed")
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		second, err := source.NewRand("seed")
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if a, b := first.Int63(), second.Int63(); a != b {
+			t.Errorf("expected the same value for the same seed, got: %d and %d", a, b)
+		}
+	})
+
+	t.Run("unreadable", func(t *testing.T) {
+		t.Parallel()
+
+		if _, err := source.NewRand(""); !errors.Is(err, errRead) {
+			t.Errorf("expected the error of the source, got: %v", err)
+		}
+	})
+}
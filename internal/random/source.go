@@ -0,0 +1,151 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package random
+
+import (
+	"bufio"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"io"
+	"math/bits"
+	"sync"
+)
+
+// Source is the source of entropy from which random values are generated. A
+// nil Source reads from the operating system's cryptographically secure random
+// number generator, which is used unless another reader is supplied, such as a
+// hardware random number generator or a deterministic reader in tests.
+type Source struct {
+	reader io.Reader
+	iface  string
+}
+
+// NewSource returns a Source which reads entropy from the given reader. If the
+// reader is nil, the cryptographic random number generator is used.
+func NewSource(reader io.Reader) *Source {
+	return &Source{
+		reader: reader,
+		iface:  entropyInterface(reader),
+	}
+}
+
+// NewBufferedSource returns a Source which reads entropy from the given reader
+// in batches of size bytes, reducing the number of reads when many values are
+// generated, such as when hundreds of resources are created in a single apply.
+// The Source is safe for concurrent use, provided the reader is only read by
+// the Source. If the reader is nil, the cryptographic random number generator
+// is used.
+func NewBufferedSource(reader io.Reader, size int) *Source {
+	iface := entropyInterface(reader)
+
+	if reader == nil {
+		reader = rand.Reader
+	}
+
+	return &Source{
+		reader: &lockedReader{
+			reader: bufio.NewReaderSize(reader, size),
+		},
+		iface: iface,
+	}
+}
+
+// lockedReader serializes reads from the underlying reader, which is not safe
+// for concurrent use when buffered.
+type lockedReader struct {
+	mu     sync.Mutex
+	reader io.Reader
+}
+
+func (r *lockedReader) Read(p []byte) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.reader.Read(p)
+}
+
+// Read fills p with entropy from the underlying reader, so that the Source can
+// be supplied to functions which accept an io.Reader.
+func (s *Source) Read(p []byte) (int, error) {
+	if s == nil || s.reader == nil {
+		return rand.Reader.Read(p)
+	}
+
+	return s.reader.Read(p)
+}
+
+// Interface returns the interface from which the Source reads entropy, such as
+// getrandom(2), for diagnostics.
+func (s *Source) Interface() string {
+	if s == nil || s.reader == nil {
+		return SystemEntropyInterface()
+	}
+
+	return s.iface
+}
+
+// Intn returns a uniformly distributed integer in the range [0, n), using
+// entropy read from the source.
+//
+// The integer is chosen by rejection sampling: the smallest number of bits
+// which can represent n-1 are read, and values outside the range are discarded
+// and read again. Reducing a random value modulo n is avoided, as that favours
+// smaller values whenever n does not evenly divide the range of the random
+// value. Fewer than two reads are required on average.
+func (s *Source) Intn(n int64) (int64, error) {
+	return newSampler(s).intn(n)
+}
+
+// sampler chooses integers by rejection sampling from entropy read from the
+// reader, reusing its buffers so that repeated calls do not allocate.
+type sampler struct {
+	reader   io.Reader
+	buf      [8]byte
+	batchBuf []byte
+}
+
+func newSampler(reader io.Reader) *sampler {
+	return &sampler{
+		reader: reader,
+	}
+}
+
+// intn returns a uniformly distributed integer in the range [0, n). See
+// Source.Intn.
+func (s *sampler) intn(n int64) (int64, error) {
+	if n < 1 {
+		return 0, errors.New("the upper bound must be at least 1")
+	}
+
+	if n == 1 {
+		return 0, nil
+	}
+
+	bitLen := bits.Len64(uint64(n - 1))
+	mask := uint64(1)<<bitLen - 1
+
+	s.buf = [8]byte{}
+	buf := s.buf[8-(bitLen+7)/8:]
+
+	for {
+		if _, err := io.ReadFull(s.reader, buf); err != nil {
+			return 0, err
+		}
+
+		if v := binary.BigEndian.Uint64(s.buf[:]) & mask; v < uint64(n) {
+			return int64(v), nil
+		}
+	}
+}
+
+// batch returns a buffer of n bytes, reusing the buffer of the previous batch
+// if it is large enough.
+func (s *sampler) batch(n int64) []byte {
+	if int64(cap(s.batchBuf)) < n {
+		s.batchBuf = make([]byte, n)
+	}
+
+	return s.batchBuf[:n]
+}
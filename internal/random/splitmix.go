@@ -0,0 +1,70 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package random
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+)
+
+// SplitMix64 is a seeded pseudo-random number generator implementing the
+// SplitMix64 algorithm, as described by Steele, Lea and Flood in "Fast
+// Splittable Pseudorandom Number Generators" (2014). Unlike the generators of
+// math/rand, whose output for a seed is an implementation detail of Go, every
+// value generated for a seed is fixed by this package, so that seeded results
+// never change when the provider is upgraded. It is not cryptographically
+// secure.
+type SplitMix64 struct {
+	state uint64
+}
+
+// NewSplitMix64 returns a SplitMix64 whose initial state is the first 8 bytes,
+// read as a big endian integer, of the SHA-256 hash of the seed string.
+func NewSplitMix64(seed string) *SplitMix64 {
+	sum := sha256.Sum256([]byte(seed))
+
+	return &SplitMix64{
+		state: binary.BigEndian.Uint64(sum[:8]),
+	}
+}
+
+// Uint64 returns the next 64 bit value of the sequence.
+func (s *SplitMix64) Uint64() uint64 {
+	s.state += 0x9e3779b97f4a7c15
+
+	z := s.state
+	z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+	z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+
+	return z ^ (z >> 31)
+}
+
+// Int63n returns a uniformly distributed integer in the range [0, n), which
+// panics if n is not positive.
+//
+// Values of the sequence below 2^64 mod n are rejected, so that the remaining
+// values are a multiple of n and reducing them modulo n favours no integer.
+func (s *SplitMix64) Int63n(n int64) int64 {
+	if n <= 0 {
+		panic("invalid argument to Int63n")
+	}
+
+	bound := uint64(n)
+	threshold := -bound % bound
+
+	for {
+		if v := s.Uint64(); v >= threshold {
+			return int64(v % bound)
+		}
+	}
+}
+
+// Shuffle pseudo-randomly permutes n elements with the Fisher-Yates shuffle,
+// swapping each element from the last to the second with an element chosen by
+// Int63n from those before it or itself.
+func (s *SplitMix64) Shuffle(n int, swap func(i, j int)) {
+	for i := n - 1; i > 0; i-- {
+		swap(i, int(s.Int63n(int64(i)+1)))
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package random
+
+import (
+	"testing"
+)
+
+func TestSplitMix64_Uint64(t *testing.T) {
+	t.Parallel()
+
+	// The reference output of SplitMix64 for an initial state of 1234567.
+	s := &SplitMix64{state: 1234567}
+	expected := []uint64{
+		6457827717110365317,
+		3203168211198807973,
+		9817491932198370423,
+		4593380528125082431,
+		16408922859458223821,
+	}
+
+	for i, want := range expected {
+		if got := s.Uint64(); got != want {
+			t.Errorf("value %d: expected %d, got %d", i, want, got)
+		}
+	}
+}
+
+// TestNewSplitMix64 guards against changes to the sequence generated for a
+// seed, which would change the results of resources with that seed.
+func TestNewSplitMix64(t *testing.T) {
+	t.Parallel()
+
+	s := NewSplitMix64("seed")
+
+	var got []int64
+
+	for i := 0; i < 5; i++ {
+		got = append(got, s.Int63n(100))
+	}
+
+	expected := []int64{96, 94, 28, 53, 32}
+
+	for i := range expected {
+		if got[i] != expected[i] {
+			t.Fatalf("expected %v, got %v", expected, got)
+		}
+	}
+}
+
+func TestSplitMix64_Shuffle(t *testing.T) {
+	t.Parallel()
+
+	s := NewSplitMix64("seed")
+	values := []int{0, 1, 2, 3, 4, 5, 6, 7}
+
+	s.Shuffle(len(values), func(i, j int) {
+		values[i], values[j] = values[j], values[i]
+	})
+
+	seen := make(map[int]bool, len(values))
+
+	for _, v := range values {
+		seen[v] = true
+	}
+
+	if len(seen) != 8 {
+		t.Errorf("expected a permutation, got: %v", values)
+	}
+}
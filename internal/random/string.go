@@ -4,107 +4,966 @@
 package random
 
 import (
-	"crypto/rand"
+	"bufio"
+	"bytes"
 	"errors"
-	"math/big"
-	"sort"
+	"fmt"
+	"io"
+	"math"
+	"math/bits"
+	"slices"
+	"sync"
+	"unicode/utf8"
 )
 
 type StringParams struct {
-	Length          int64
-	Upper           bool
-	MinUpper        int64
-	Lower           bool
-	MinLower        int64
-	Numeric         bool
-	MinNumeric      int64
-	Special         bool
-	MinSpecial      int64
+	Length     int64
+	Upper      bool
+	MinUpper   int64
+	Lower      bool
+	MinLower   int64
+	Numeric    bool
+	MinNumeric int64
+	Special    bool
+	MinSpecial int64
+	// MinCharacterClasses is the minimum number of the enabled character
+	// classes which must each appear at least once. Classes with a minimum
+	// count already appear, and the remainder are chosen at random.
+	MinCharacterClasses int64
+	// OverrideSpecial replaces the special characters. Characters outside of
+	// ASCII are each chosen as a single character, encoded as UTF-8.
 	OverrideSpecial string
+	// NoLeadingNumeric, NoLeadingSpecial, NoTrailingNumeric and
+	// NoTrailingSpecial prevent the string from beginning or ending with a
+	// numeric or special character, for systems which reject such strings.
+	// Special characters are those other than ASCII letters and digits.
+	NoLeadingNumeric  bool
+	NoLeadingSpecial  bool
+	NoTrailingNumeric bool
+	NoTrailingSpecial bool
+	// MaxRepeat is the maximum number of times a character may be repeated
+	// consecutively, or unlimited if zero.
+	MaxRepeat int64
+	// Distinct prevents any character from occurring more than once.
+	Distinct bool
+	// DNSLabel generates a valid DNS label as defined by RFC 1123, ignoring
+	// the character class parameters.
+	DNSLabel bool
+	// Charset replaces the character set, ignoring the character class
+	// parameters and minimums, such as for alphabets which exclude some
+	// letters or digits. Every character must be ASCII.
+	Charset string
 }
 
-func CreateString(input StringParams) ([]byte, error) {
-	const numChars = "0123456789"
-	const lowerChars = "abcdefghijklmnopqrstuvwxyz"
-	const upperChars = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
-	var specialChars = "!@#$%&*()-_=+[]{}<>:?"
+const (
+	numChars   = "0123456789"
+	lowerChars = "abcdefghijklmnopqrstuvwxyz"
+	upperChars = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+)
+
+const defaultSpecialChars = "!@#$%&*()-_=+[]{}<>:?"
+
+// DNSLabelMaxLength is the maximum length of a DNS label as defined by
+// RFC 1123.
+const DNSLabelMaxLength = 63
+
+// The character sets of the first, last and remaining characters of a DNS
+// label. The first character is always a letter, and the last is never a
+// hyphen.
+const (
+	dnsLabelFirstChars = lowerChars
+	dnsLabelLastChars  = lowerChars + numChars
+	dnsLabelChars      = lowerChars + numChars + "-"
+)
+
+// The number of bytes of entropy read from the source at a time when creating
+// a string, estimated as four bytes per character, to cover choosing the
+// character and its position in the shuffled result, including values
+// discarded during rejection sampling.
+const (
+	minStringReadSize      = 64
+	maxStringReadSize      = 64 * 1024
+	stringReadBytesPerChar = 4
+)
+
+// CreateString returns a string generated from the given parameters, with
+// characters chosen using entropy read from the source.
+//
+// Entropy is read from the source in batches rather than for each character,
+// and the result is built in a single allocation, so that long strings can be
+// generated efficiently.
+func (s *Source) CreateString(input StringParams) ([]byte, error) {
+	if input.DNSLabel {
+		return s.createDNSLabel(input.Length)
+	}
+
+	if !isASCII(input.OverrideSpecial) {
+		return s.createMultiByteString(input)
+	}
+
+	return s.createString(input)
+}
+
+// createString returns a string generated from the given parameters, where
+// every character is a single byte.
+func (s *Source) createString(input StringParams) ([]byte, error) {
 	var result []byte
 
-	if input.OverrideSpecial != "" {
-		specialChars = input.OverrideSpecial
+	chars := input.pool()
+
+	if chars == "" {
+		return nil, errors.New("the character set specified is empty")
 	}
 
-	var chars = ""
-	if input.Upper {
-		chars += upperChars
+	minimums := []characterClass{
+		{numChars, input.MinNumeric, input.Numeric},
+		{lowerChars, input.MinLower, input.Lower},
+		{upperChars, input.MinUpper, input.Upper},
+		{input.specialChars(), input.MinSpecial, input.Special},
 	}
-	if input.Lower {
-		chars += lowerChars
+
+	if input.Charset != "" {
+		minimums = nil
 	}
-	if input.Numeric {
-		chars += numChars
+
+	readSize := min(max(input.Length*stringReadBytesPerChar, minStringReadSize), maxStringReadSize)
+	sampler := newSampler(bufio.NewReaderSize(s, int(readSize)))
+
+	if err := sampler.requireClasses(minimums, input.MinCharacterClasses, input.Length); err != nil {
+		return nil, err
 	}
-	if input.Special {
-		chars += specialChars
+
+	result = make([]byte, 0, input.Length)
+
+	// The characters already chosen, when characters must be distinct.
+	var used *[256]bool
+
+	if input.Distinct {
+		used = new([256]bool)
 	}
 
-	if chars == "" {
-		return nil, errors.New("the character set specified is empty")
+	for _, minimum := range minimums {
+		var err error
+
+		result, err = sampler.appendCharsUnused(result, minimum.chars, minimum.count, used)
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	minMapping := map[string]int64{
-		numChars:     input.MinNumeric,
-		lowerChars:   input.MinLower,
-		upperChars:   input.MinUpper,
-		specialChars: input.MinSpecial,
+	minCount := len(result)
+
+	result, err := sampler.appendCharsUnused(result, chars, input.Length-int64(len(result)), used)
+	if err != nil {
+		return nil, err
 	}
 
-	result = make([]byte, 0, input.Length)
+	// Distinct characters are never repeated, so need no further checks.
+	limitRepeats := input.MaxRepeat > 0 && !input.Distinct
+
+	// The characters satisfying the minimums are tracked through the shuffle
+	// when the ends of the result are restricted or repeats are limited, so
+	// that they are not replaced when placing characters.
+	var required []bool
+
+	if input.restrictsEnds() || limitRepeats {
+		required = make([]bool, len(result))
+
+		for i := 0; i < minCount; i++ {
+			required[i] = true
+		}
+	}
+
+	if limitRepeats {
+		if err := sampler.arrange(result, required, chars, input); err != nil {
+			return nil, err
+		}
+	}
+
+	// The characters satisfying the minimums are at the start of the result,
+	// so the result is shuffled with a Fisher-Yates shuffle, which produces
+	// every permutation with equal probability.
+	for i := len(result) - 1; i > 0 && !limitRepeats; i-- {
+		j, err := sampler.intn(int64(i + 1))
+		if err != nil {
+			return nil, err
+		}
+
+		result[i], result[j] = result[j], result[i]
+
+		if required != nil {
+			required[i], required[j] = required[j], required[i]
+		}
+	}
+
+	if input.restrictsEnds() {
+		if err := sampler.placeEnds(result, required, chars, input); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// characterClass is a class of characters, with the minimum number of its
+// characters in the result, and whether the class is enabled.
+type characterClass struct {
+	chars   string
+	count   int64
+	enabled bool
+}
+
+// requireClasses raises the minimum count of randomly chosen enabled classes
+// without a minimum to one, so that at least minClasses of the enabled classes
+// appear in a result of the given length. Each class without a minimum is
+// equally likely to be chosen.
+func (s *sampler) requireClasses(classes []characterClass, minClasses, length int64) error {
+	var (
+		satisfied int64
+		sumOfMins int64
+		optional  []int
+	)
+
+	for i, class := range classes {
+		sumOfMins += class.count
+
+		switch {
+		case !class.enabled:
+		case class.count > 0:
+			satisfied++
+		default:
+			optional = append(optional, i)
+		}
+	}
+
+	required := minClasses - satisfied
+
+	if required <= 0 {
+		return nil
+	}
+
+	if required > int64(len(optional)) {
+		return fmt.Errorf("%d character classes were required, but only %d are enabled", minClasses, satisfied+int64(len(optional)))
+	}
+
+	if sumOfMins+required > length {
+		return fmt.Errorf("%d characters were required to satisfy the minimums and %d character classes, but the length is %d", sumOfMins+required, minClasses, length)
+	}
+
+	for ; required > 0; required-- {
+		idx, err := s.intn(int64(len(optional)))
+		if err != nil {
+			return err
+		}
+
+		classes[optional[idx]].count = 1
+
+		optional[idx] = optional[len(optional)-1]
+		optional = optional[:len(optional)-1]
+	}
+
+	return nil
+}
+
+// maxMultiByteChars is the maximum number of distinct characters outside of
+// ASCII in OverrideSpecial, which is the number of byte values outside of
+// ASCII which substitute for them.
+const maxMultiByteChars = 128
+
+// createMultiByteString returns a string generated as CreateString, where
+// OverrideSpecial contains characters outside of ASCII. Each of those
+// characters is substituted with a byte outside of ASCII while the string is
+// generated, so that every character is chosen as a single character, then
+// the substitutes are replaced with the UTF-8 encoding of the characters.
+func (s *Source) createMultiByteString(input StringParams) ([]byte, error) {
+	if !utf8.ValidString(input.OverrideSpecial) {
+		return nil, errors.New("the special characters specified are not valid UTF-8")
+	}
+
+	var multiByte []rune
+
+	substituted := make([]byte, 0, len(input.OverrideSpecial))
+
+	for _, c := range input.OverrideSpecial {
+		if c < utf8.RuneSelf {
+			substituted = append(substituted, byte(c))
+			continue
+		}
+
+		i := slices.Index(multiByte, c)
+
+		if i < 0 {
+			i = len(multiByte)
+			multiByte = append(multiByte, c)
+		}
+
+		if i >= maxMultiByteChars {
+			return nil, fmt.Errorf("the special characters specified contain more than %d distinct characters outside of ASCII", maxMultiByteChars)
+		}
+
+		substituted = append(substituted, byte(utf8.RuneSelf+i))
+	}
+
+	input.OverrideSpecial = string(substituted)
+
+	result, err := s.createString(input)
+	if err != nil {
+		return nil, err
+	}
+
+	encoded := make([]byte, 0, len(result))
+
+	for _, b := range result {
+		if b < utf8.RuneSelf {
+			encoded = append(encoded, b)
+			continue
+		}
+
+		encoded = utf8.AppendRune(encoded, multiByte[b-utf8.RuneSelf])
+	}
+
+	return encoded, nil
+}
+
+// isASCII returns whether every character of the string is ASCII.
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+
+	return true
+}
+
+// appendCharsUnused appends length characters chosen from the character set
+// to dst, as appendChars. If used is not nil, each character is chosen from
+// those not yet used, and is then marked as used.
+func (s *sampler) appendCharsUnused(dst []byte, charSet string, length int64, used *[256]bool) ([]byte, error) {
+	if used == nil {
+		return s.appendChars(dst, charSet, length)
+	}
+
+	if length < 1 {
+		return dst, nil
+	}
+
+	var available []byte
+
+	for i := 0; i < len(charSet); i++ {
+		if c := charSet[i]; !used[c] {
+			used[c] = true
+			available = append(available, c)
+		}
+	}
+
+	// The characters of the character set are marked as used while
+	// deduplicating them, so only those which are chosen remain marked.
+	for _, c := range available {
+		used[c] = false
+	}
 
-	for k, v := range minMapping {
-		s, err := generateRandomBytes(&k, v)
+	if int64(len(available)) < length {
+		return nil, fmt.Errorf("%d distinct characters were required, but only %d are available", length, len(available))
+	}
+
+	for i := int64(0); i < length; i++ {
+		idx, err := s.intn(int64(len(available)))
 		if err != nil {
 			return nil, err
 		}
-		result = append(result, s...)
+
+		c := available[idx]
+		used[c] = true
+		dst = append(dst, c)
+
+		available[idx] = available[len(available)-1]
+		available = available[:len(available)-1]
+	}
+
+	return dst, nil
+}
+
+// arrange arranges the characters of the result in a random order in which no
+// character is repeated consecutively more than MaxRepeat times, in place of
+// shuffling them.
+//
+// If a character occurs too often to be arranged, randomly chosen occurrences
+// which are not required to satisfy the minimums are first replaced with
+// other characters from the character set. The first character is chosen
+// from those allowed to begin the result.
+//
+// Each character is chosen from the remaining characters, with a probability
+// proportional to the number remaining, from those which do not exceed the
+// limit and leave remaining characters which can still be arranged. The
+// remaining characters can be arranged if every character occurs no more than
+// MaxRepeat times for each of the other characters, which separate its
+// repeats, and once more, less the repeats of the last chosen character.
+func (s *sampler) arrange(result []byte, required []bool, chars string, input StringParams) error {
+	maxRepeat := input.MaxRepeat
+
+	if err := s.balance(result, required, chars, maxRepeat); err != nil {
+		return err
+	}
+
+	var counts, requiredCounts [256]int64
+
+	var distinct []byte
+
+	for i, c := range result {
+		if counts[c] == 0 {
+			distinct = append(distinct, c)
+		}
+
+		counts[c]++
+
+		if required[i] {
+			requiredCounts[c]++
+		}
+	}
+
+	remaining := int64(len(result))
+
+	var (
+		last byte
+		run  int64
+	)
+
+	for i := range result {
+		remaining--
+
+		var (
+			candidates []byte
+			total      int64
+		)
+
+		for _, c := range distinct {
+			if counts[c] == 0 {
+				continue
+			}
+
+			repeats := int64(1)
+			if i > 0 && c == last {
+				repeats = run + 1
+			}
+
+			if repeats > maxRepeat {
+				continue
+			}
+
+			// The first character is chosen from those allowed to begin the
+			// result, as it is unlikely that one could be swapped into place
+			// without exceeding the limit later.
+			if i == 0 && !input.allowedAt(c, true) {
+				continue
+			}
+
+			counts[c]--
+
+			if arrangeable(&counts, distinct, remaining, c, repeats, maxRepeat) {
+				candidates = append(candidates, c)
+				total += counts[c] + 1
+			}
+
+			counts[c]++
+		}
+
+		if len(candidates) == 0 {
+			return fmt.Errorf("the characters cannot be arranged without repeating a character more than %d times", maxRepeat)
+		}
+
+		n, err := s.intn(total)
+		if err != nil {
+			return err
+		}
+
+		c := candidates[0]
+
+		for _, candidate := range candidates {
+			if n < counts[candidate] {
+				c = candidate
+				break
+			}
+
+			n -= counts[candidate]
+		}
+
+		result[i] = c
+		required[i] = requiredCounts[c] > 0
+		counts[c]--
+
+		if required[i] {
+			requiredCounts[c]--
+		}
+
+		if i > 0 && c == last {
+			run++
+		} else {
+			last, run = c, 1
+		}
+	}
+
+	return nil
+}
+
+// balance replaces occurrences of a character which occurs more than
+// MaxRepeat times for each of the other characters, and once more, until the
+// characters can be arranged.
+func (s *sampler) balance(result []byte, required []bool, chars string, maxRepeat int64) error {
+	var counts [256]int64
+
+	for _, c := range result {
+		counts[c]++
+	}
+
+	for {
+		var (
+			excess    byte
+			hasExcess bool
+		)
+
+		for _, c := range result {
+			if counts[c] > maxRepeat*(int64(len(result))-counts[c]+1) {
+				excess, hasExcess = c, true
+				break
+			}
+		}
+
+		if !hasExcess {
+			return nil
+		}
+
+		var (
+			replaceable []int
+			others      []byte
+		)
+
+		for i, c := range result {
+			if c == excess && !required[i] {
+				replaceable = append(replaceable, i)
+			}
+		}
+
+		for i := 0; i < len(chars); i++ {
+			if chars[i] != excess {
+				others = append(others, chars[i])
+			}
+		}
+
+		if len(replaceable) == 0 || len(others) == 0 {
+			return fmt.Errorf("the character %q cannot be repeated at most %d times, as it occurs too often", excess, maxRepeat)
+		}
+
+		idx, err := s.intn(int64(len(replaceable)))
+		if err != nil {
+			return err
+		}
+
+		replacement, err := s.appendChars(nil, string(others), 1)
+		if err != nil {
+			return err
+		}
+
+		result[replaceable[idx]] = replacement[0]
+		counts[excess]--
+		counts[replacement[0]]++
+	}
+}
+
+// arrangeable returns whether the remaining characters, given by their counts,
+// can be arranged after the last character, which has been repeated the given
+// number of times.
+func arrangeable(counts *[256]int64, distinct []byte, remaining int64, last byte, repeats, maxRepeat int64) bool {
+	for _, c := range distinct {
+		count := counts[c]
+		others := remaining - count
+
+		limit := maxRepeat * (others + 1)
+		if c == last {
+			limit = maxRepeat - repeats + maxRepeat*others
+		}
+
+		if count > limit {
+			return false
+		}
+	}
+
+	return true
+}
+
+// runLength returns the number of consecutive occurrences of the character at
+// index i of the result, including i.
+func runLength(result []byte, i int) int64 {
+	run := int64(1)
+
+	for j := i - 1; j >= 0 && result[j] == result[i]; j-- {
+		run++
+	}
+
+	for j := i + 1; j < len(result) && result[j] == result[i]; j++ {
+		run++
+	}
+
+	return run
+}
+
+// restrictsEnds returns whether the characters which may begin or end the
+// string are restricted.
+func (input StringParams) restrictsEnds() bool {
+	return input.NoLeadingNumeric || input.NoLeadingSpecial || input.NoTrailingNumeric || input.NoTrailingSpecial
+}
+
+// allowedAt returns whether the character may begin the string, if leading,
+// or otherwise end the string.
+func (input StringParams) allowedAt(c byte, leading bool) bool {
+	numeric := c >= '0' && c <= '9'
+	special := !numeric && !(c >= 'a' && c <= 'z') && !(c >= 'A' && c <= 'Z')
+
+	if leading {
+		return !(numeric && input.NoLeadingNumeric) && !(special && input.NoLeadingSpecial)
+	}
+
+	return !(numeric && input.NoTrailingNumeric) && !(special && input.NoTrailingSpecial)
+}
+
+// placeEnds ensures that the first and last characters of the result are
+// allowed by the parameters, without regenerating the result. A character
+// which is not allowed is swapped with a randomly chosen allowed character
+// elsewhere in the result. If there is none, a randomly chosen character
+// which is not required to satisfy the minimums is replaced with an allowed
+// character from the character set, and swapped into place, so that the
+// minimums remain satisfied.
+func (s *sampler) placeEnds(result []byte, required []bool, chars string, input StringParams) error {
+	if len(result) == 0 {
+		return nil
+	}
+
+	last := len(result) - 1
+	start := 0
+
+	if input.NoLeadingNumeric || input.NoLeadingSpecial {
+		// A single character both begins and ends the result.
+		allowed := func(c byte) bool {
+			return input.allowedAt(c, true) && (last > 0 || input.allowedAt(c, false))
+		}
+
+		if err := s.placeEnd(result, required, 0, 0, allowed, chars, input); err != nil {
+			return fmt.Errorf("the result cannot begin with a numeric or special character: %w", err)
+		}
+
+		start = 1
+	}
+
+	if (input.NoTrailingNumeric || input.NoTrailingSpecial) && last >= start {
+		allowed := func(c byte) bool {
+			return input.allowedAt(c, false)
+		}
+
+		if err := s.placeEnd(result, required, last, start, allowed, chars, input); err != nil {
+			return fmt.Errorf("the result cannot end with a numeric or special character: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// placeEnd places an allowed character at index end of the result, choosing
+// from the characters at index start and above, other than end, which can be
+// swapped without repeating either character more than MaxRepeat times.
+func (s *sampler) placeEnd(result []byte, required []bool, end, start int, allowed func(byte) bool, chars string, input StringParams) error {
+	if allowed(result[end]) {
+		return nil
+	}
+
+	var candidates []int
+
+	for i := start; i < len(result); i++ {
+		if i != end && allowed(result[i]) && input.swappable(result, i, end) {
+			candidates = append(candidates, i)
+		}
+	}
+
+	if len(candidates) == 0 {
+		return s.replaceEnd(result, required, end, start, allowed, chars, input)
+	}
+
+	idx, err := s.intn(int64(len(candidates)))
+	if err != nil {
+		return err
+	}
+
+	i := candidates[idx]
+
+	result[end], result[i] = result[i], result[end]
+	required[end], required[i] = required[i], required[end]
+
+	return nil
+}
+
+// replaceEnd places an allowed character at index end of the result when
+// there is none which can be swapped into place, by replacing a randomly
+// chosen character which is not required to satisfy the minimums with an
+// allowed character from the character set, and swapping it into place. If
+// Distinct, the replacement is not one of the characters of the result.
+func (s *sampler) replaceEnd(result []byte, required []bool, end, start int, allowed func(byte) bool, chars string, input StringParams) error {
+	type replacement struct {
+		index int
+		char  byte
+	}
+
+	var (
+		candidates []replacement
+		anyAllowed bool
+		anyFree    bool
+	)
+
+	for i := 0; i < len(chars); i++ {
+		c := chars[i]
+
+		if !allowed(c) || (input.Distinct && bytes.IndexByte(result, c) != -1) {
+			continue
+		}
+
+		anyAllowed = true
+
+		for j := start; j < len(result); j++ {
+			if required[j] {
+				continue
+			}
+
+			anyFree = true
+			replaced := result[j]
+			result[j] = c
+
+			if input.swappable(result, j, end) {
+				candidates = append(candidates, replacement{j, c})
+			}
+
+			result[j] = replaced
+		}
+	}
+
+	switch {
+	case !anyAllowed:
+		return errors.New("the character set specified contains no allowed characters")
+	case !anyFree:
+		return errors.New("every character is required to satisfy the minimum number of characters")
+	case len(candidates) == 0:
+		return fmt.Errorf("no allowed character can be placed without repeating a character more than %d times", input.MaxRepeat)
+	}
+
+	idx, err := s.intn(int64(len(candidates)))
+	if err != nil {
+		return err
+	}
+
+	r := candidates[idx]
+
+	result[r.index] = r.char
+	result[end], result[r.index] = result[r.index], result[end]
+	required[end], required[r.index] = required[r.index], required[end]
+
+	return nil
+}
+
+// swappable returns whether the characters at indexes i and j of the result
+// can be swapped without repeating either character consecutively more than
+// MaxRepeat times.
+func (input StringParams) swappable(result []byte, i, j int) bool {
+	if input.MaxRepeat == 0 || i == j {
+		return true
+	}
+
+	result[i], result[j] = result[j], result[i]
+	ok := runLength(result, i) <= input.MaxRepeat && runLength(result, j) <= input.MaxRepeat
+	result[i], result[j] = result[j], result[i]
+
+	return ok
+}
+
+// createDNSLabel returns a DNS label of the given length, consisting of
+// lowercase letters, digits and hyphens, which starts with a letter and does
+// not end with a hyphen.
+func (s *Source) createDNSLabel(length int64) ([]byte, error) {
+	if length < 1 || length > DNSLabelMaxLength {
+		return nil, fmt.Errorf("the length of a DNS label must be between 1 and %d, got: %d", DNSLabelMaxLength, length)
 	}
 
-	s, err := generateRandomBytes(&chars, input.Length-int64(len(result)))
+	sampler := newSampler(bufio.NewReaderSize(s, minStringReadSize))
+
+	result, err := sampler.appendChars(make([]byte, 0, length), dnsLabelFirstChars, 1)
 	if err != nil {
 		return nil, err
 	}
 
-	result = append(result, s...)
+	if length == 1 {
+		return result, nil
+	}
 
-	order := make([]byte, len(result))
-	if _, err := rand.Read(order); err != nil {
+	result, err = sampler.appendChars(result, dnsLabelChars, length-2)
+	if err != nil {
 		return nil, err
 	}
 
-	sort.Slice(result, func(i, j int) bool {
-		return order[i] < order[j]
-	})
+	return sampler.appendChars(result, dnsLabelLastChars, 1)
+}
 
-	return result, nil
+// StringEntropyBits returns the entropy, in bits, of a string generated with
+// the given parameters, assuming each character is chosen uniformly from the
+// distinct characters of the character set. The minimum number of characters
+// of each class slightly reduces the actual entropy, which is not accounted
+// for.
+func StringEntropyBits(input StringParams) float64 {
+	if input.DNSLabel {
+		return dnsLabelEntropyBits(input.Length)
+	}
+
+	distinct := make(map[rune]struct{})
+
+	for _, c := range input.pool() {
+		distinct[c] = struct{}{}
+	}
+
+	if len(distinct) == 0 || input.Length < 1 {
+		return 0
+	}
+
+	return float64(input.Length) * math.Log2(float64(len(distinct)))
 }
 
-func generateRandomBytes(charSet *string, length int64) ([]byte, error) {
-	if charSet == nil {
-		return nil, errors.New("charSet is nil")
+// dnsLabelEntropyBits returns the entropy, in bits, of a DNS label of the
+// given length generated by createDNSLabel.
+func dnsLabelEntropyBits(length int64) float64 {
+	if length < 1 {
+		return 0
+	}
+
+	bits := math.Log2(float64(len(dnsLabelFirstChars)))
+
+	if length > 1 {
+		bits += float64(length-2) * math.Log2(float64(len(dnsLabelChars)))
+		bits += math.Log2(float64(len(dnsLabelLastChars)))
 	}
 
-	if *charSet == "" && length > 0 {
+	return bits
+}
+
+func (input StringParams) specialChars() string {
+	if input.OverrideSpecial != "" {
+		return input.OverrideSpecial
+	}
+
+	return defaultSpecialChars
+}
+
+// chars returns the character set from which characters are chosen.
+func (input StringParams) chars() string {
+	var chars = ""
+	if input.Upper {
+		chars += upperChars
+	}
+	if input.Lower {
+		chars += lowerChars
+	}
+	if input.Numeric {
+		chars += numChars
+	}
+	if input.Special {
+		chars += input.specialChars()
+	}
+
+	return chars
+}
+
+// poolKey identifies the character set of string parameters.
+type poolKey struct {
+	upper           bool
+	lower           bool
+	numeric         bool
+	special         bool
+	overrideSpecial string
+}
+
+// pools caches the character sets built by chars, keyed by poolKey, so that
+// each character set is built once rather than on every call to CreateString.
+var pools sync.Map
+
+// pool returns the character set from which characters are chosen, from the
+// cache of character sets if it has been built before.
+func (input StringParams) pool() string {
+	if input.Charset != "" {
+		return input.Charset
+	}
+
+	key := poolKey{
+		upper:   input.Upper,
+		lower:   input.Lower,
+		numeric: input.Numeric,
+		special: input.Special,
+	}
+
+	if input.Special {
+		key.overrideSpecial = input.OverrideSpecial
+	}
+
+	if chars, ok := pools.Load(key); ok {
+		return chars.(string)
+	}
+
+	chars, _ := pools.LoadOrStore(key, input.chars())
+
+	return chars.(string)
+}
+
+// appendChars appends length characters chosen from the character set to dst.
+//
+// Character sets of up to 256 characters, which includes every built in
+// character set, are sampled from a batch of bytes read at once, one byte per
+// character. Each byte is masked to the smallest number of bits which can
+// index the character set, and bytes which do not index a character are
+// discarded, as in intn, so the same characters are chosen from the same
+// entropy as with intn.
+func (s *sampler) appendChars(dst []byte, charSet string, length int64) ([]byte, error) {
+	if charSet == "" && length > 0 {
 		return nil, errors.New("charSet is empty")
 	}
 
-	bytes := make([]byte, length)
-	setLen := big.NewInt(int64(len(*charSet)))
-	for i := range bytes {
-		idx, err := rand.Int(rand.Reader, setLen)
-		if err != nil {
+	setLen := len(charSet)
+
+	if setLen > 256 {
+		for i := int64(0); i < length; i++ {
+			idx, err := s.intn(int64(setLen))
+			if err != nil {
+				return nil, err
+			}
+			dst = append(dst, charSet[idx])
+		}
+		return dst, nil
+	}
+
+	if setLen == 1 {
+		for i := int64(0); i < length; i++ {
+			dst = append(dst, charSet[0])
+		}
+		return dst, nil
+	}
+
+	mask := byte(math.MaxUint8 >> (8 - bits.Len8(uint8(setLen-1))))
+
+	for length > 0 {
+		batch := s.batch(min(length, maxStringReadSize))
+
+		if _, err := io.ReadFull(s.reader, batch); err != nil {
 			return nil, err
 		}
-		bytes[i] = (*charSet)[idx.Int64()]
+
+		for _, b := range batch {
+			if idx := int(b & mask); idx < setLen {
+				dst = append(dst, charSet[idx])
+				length--
+			}
+		}
 	}
-	return bytes, nil
+
+	return dst, nil
 }
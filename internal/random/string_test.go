@@ -0,0 +1,532 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package random
+
+import (
+	"bytes"
+	"math/rand"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/terraform-providers/terraform-provider-random/internal/randomtest"
+)
+
+func TestAppendChars(t *testing.T) {
+	t.Parallel()
+
+	entropy := make([]byte, 4096)
+	rand.New(rand.NewSource(1)).Read(entropy)
+
+	for _, charSet := range []string{"a", "ab", lowerChars, lowerChars + upperChars + numChars + defaultSpecialChars, strings.Repeat("x", 256)} {
+		// The characters chosen from a batch must be the same as those chosen
+		// one at a time with intn.
+		var expected []byte
+
+		s := newSampler(bytes.NewReader(entropy))
+
+		for i := 0; i < 1000; i++ {
+			idx, err := s.intn(int64(len(charSet)))
+			if err != nil {
+				t.Fatalf("unexpected intn error: %s", err)
+			}
+
+			expected = append(expected, charSet[idx])
+		}
+
+		got, err := newSampler(bytes.NewReader(entropy)).appendChars(nil, charSet, 1000)
+		if err != nil {
+			t.Fatalf("unexpected appendChars error: %s", err)
+		}
+
+		if !bytes.Equal(got, expected) {
+			t.Errorf("expected the characters chosen with intn from a character set of %d characters, got: %s", len(charSet), got)
+		}
+	}
+}
+
+func BenchmarkCreateString(b *testing.B) {
+	benchmarks := map[string]StringParams{
+		"length-16": {
+			Length:  16,
+			Upper:   true,
+			Lower:   true,
+			Numeric: true,
+			Special: true,
+		},
+		"length-1024": {
+			Length:  1024,
+			Upper:   true,
+			Lower:   true,
+			Numeric: true,
+			Special: true,
+		},
+		"min-classes": {
+			Length:     32,
+			Upper:      true,
+			MinUpper:   4,
+			Lower:      true,
+			MinLower:   4,
+			Numeric:    true,
+			MinNumeric: 4,
+			Special:    true,
+			MinSpecial: 4,
+		},
+		"override-special": {
+			Length:          32,
+			Upper:           true,
+			Lower:           true,
+			Numeric:         true,
+			Special:         true,
+			OverrideSpecial: "!#$%&*()-_=+[]{}<>:?",
+		},
+		"dns-label": {
+			Length:   32,
+			DNSLabel: true,
+		},
+	}
+
+	for name, input := range benchmarks {
+		b.Run(name, func(b *testing.B) {
+			s := NewSource(nil)
+
+			b.ReportAllocs()
+
+			for i := 0; i < b.N; i++ {
+				if _, err := s.CreateString(input); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func TestCreateString_Ends(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input         StringParams
+		expectedError string
+	}{
+		"no-leading-numeric": {
+			input: StringParams{
+				Length:           8,
+				Lower:            true,
+				Numeric:          true,
+				MinNumeric:       6,
+				NoLeadingNumeric: true,
+			},
+		},
+		"no-leading-trailing-special": {
+			input: StringParams{
+				Length:            4,
+				Upper:             true,
+				Special:           true,
+				MinSpecial:        2,
+				NoLeadingSpecial:  true,
+				NoTrailingSpecial: true,
+			},
+		},
+		"no-trailing-numeric-special": {
+			input: StringParams{
+				Length:            16,
+				Lower:             true,
+				Numeric:           true,
+				Special:           true,
+				MinNumeric:        7,
+				MinSpecial:        7,
+				NoTrailingNumeric: true,
+				NoTrailingSpecial: true,
+			},
+		},
+		"length-one": {
+			input: StringParams{
+				Length:            1,
+				Lower:             true,
+				Numeric:           true,
+				Special:           true,
+				NoLeadingNumeric:  true,
+				NoTrailingSpecial: true,
+			},
+		},
+		"no-allowed-characters": {
+			input: StringParams{
+				Length:           8,
+				Numeric:          true,
+				NoLeadingNumeric: true,
+			},
+			expectedError: "the result cannot begin with a numeric or special character: the character set specified contains no allowed characters",
+		},
+		"minimums-fill-length": {
+			input: StringParams{
+				Length:            4,
+				Lower:             true,
+				Numeric:           true,
+				MinNumeric:        4,
+				NoTrailingNumeric: true,
+			},
+			expectedError: "the result cannot end with a numeric or special character: every character is required to satisfy the minimum number of characters",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			entropy := NewSource(rand.New(rand.NewSource(1)))
+
+			for i := 0; i < 1000; i++ {
+				result, err := entropy.CreateString(testCase.input)
+
+				if testCase.expectedError != "" {
+					if err == nil || err.Error() != testCase.expectedError {
+						t.Fatalf("expected error %q, got: %v", testCase.expectedError, err)
+					}
+
+					return
+				}
+
+				if err != nil {
+					t.Fatalf("unexpected CreateString error: %s", err)
+				}
+
+				if !testCase.input.allowedAt(result[0], true) {
+					t.Fatalf("expected an allowed first character, got: %s", result)
+				}
+
+				if !testCase.input.allowedAt(result[len(result)-1], false) {
+					t.Fatalf("expected an allowed last character, got: %s", result)
+				}
+
+				var numeric, special int64
+
+				for _, c := range result {
+					switch {
+					case strings.IndexByte(numChars, c) >= 0:
+						numeric++
+					case strings.IndexByte(defaultSpecialChars, c) >= 0:
+						special++
+					}
+				}
+
+				if numeric < testCase.input.MinNumeric || special < testCase.input.MinSpecial {
+					t.Fatalf("expected at least %d numeric and %d special characters, got: %s",
+						testCase.input.MinNumeric, testCase.input.MinSpecial, result)
+				}
+			}
+		})
+	}
+}
+
+func TestCreateString_Repeats(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input         StringParams
+		expectedError string
+	}{
+		"max-repeat": {
+			input: StringParams{
+				Length:          64,
+				Special:         true,
+				OverrideSpecial: "!@",
+				MaxRepeat:       1,
+			},
+		},
+		"max-repeat-minimums": {
+			input: StringParams{
+				Length:           12,
+				Numeric:          true,
+				Special:          true,
+				OverrideSpecial:  "!",
+				MinSpecial:       5,
+				MaxRepeat:        1,
+				NoLeadingSpecial: true,
+			},
+		},
+		"max-repeat-single-character": {
+			input: StringParams{
+				Length:          4,
+				Special:         true,
+				OverrideSpecial: "!",
+				MaxRepeat:       2,
+			},
+			expectedError: `the character '!' cannot be repeated at most 2 times, as it occurs too often`,
+		},
+		"distinct": {
+			input: StringParams{
+				Length:     36,
+				Lower:      true,
+				Numeric:    true,
+				MinNumeric: 10,
+				Distinct:   true,
+			},
+		},
+		"distinct-ends": {
+			input: StringParams{
+				Length:            12,
+				Upper:             true,
+				Numeric:           true,
+				MinNumeric:        10,
+				Distinct:          true,
+				NoLeadingNumeric:  true,
+				NoTrailingNumeric: true,
+			},
+		},
+		"distinct-too-long": {
+			input: StringParams{
+				Length:   11,
+				Numeric:  true,
+				Distinct: true,
+			},
+			expectedError: "11 distinct characters were required, but only 10 are available",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			entropy := NewSource(rand.New(rand.NewSource(1)))
+
+			for i := 0; i < 1000; i++ {
+				result, err := entropy.CreateString(testCase.input)
+
+				if testCase.expectedError != "" {
+					if err == nil || err.Error() != testCase.expectedError {
+						t.Fatalf("expected error %q, got: %v", testCase.expectedError, err)
+					}
+
+					return
+				}
+
+				if err != nil {
+					t.Fatalf("unexpected CreateString error: %s", err)
+				}
+
+				if int64(len(result)) != testCase.input.Length {
+					t.Fatalf("expected length %d, got: %s", testCase.input.Length, result)
+				}
+
+				seen := make(map[byte]bool)
+
+				for j, c := range result {
+					if testCase.input.Distinct && seen[c] {
+						t.Fatalf("expected distinct characters, got: %s", result)
+					}
+
+					seen[c] = true
+
+					if testCase.input.MaxRepeat > 0 && runLength(result, j) > testCase.input.MaxRepeat {
+						t.Fatalf("expected no character repeated more than %d times, got: %s", testCase.input.MaxRepeat, result)
+					}
+				}
+
+				if !testCase.input.allowedAt(result[0], true) || !testCase.input.allowedAt(result[len(result)-1], false) {
+					t.Fatalf("expected allowed first and last characters, got: %s", result)
+				}
+
+				if special := int64(strings.Count(string(result), "!")); special < testCase.input.MinSpecial {
+					t.Fatalf("expected at least %d special characters, got: %s", testCase.input.MinSpecial, result)
+				}
+			}
+		})
+	}
+}
+
+func TestCreateString_MultiByte(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]StringParams{
+		"special": {
+			Length:          64,
+			Special:         true,
+			OverrideSpecial: "äöü€!",
+		},
+		"minimums": {
+			Length:          16,
+			Lower:           true,
+			Special:         true,
+			OverrideSpecial: "äöü",
+			MinSpecial:      8,
+		},
+		"distinct": {
+			Length:          5,
+			Special:         true,
+			OverrideSpecial: "äöü€!",
+			Distinct:        true,
+		},
+	}
+
+	for name, input := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			result, err := NewSource(rand.New(rand.NewSource(1))).CreateString(input)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !utf8.Valid(result) {
+				t.Fatalf("expected valid UTF-8, got: %q", result)
+			}
+
+			runes := []rune(string(result))
+
+			if int64(len(runes)) != input.Length {
+				t.Errorf("expected %d characters, got %d: %s", input.Length, len(runes), result)
+			}
+
+			special := 0
+			seen := make(map[rune]bool)
+
+			for _, c := range runes {
+				if strings.ContainsRune(input.OverrideSpecial, c) {
+					special++
+				} else if !strings.ContainsRune(lowerChars, c) {
+					t.Errorf("unexpected character %q in: %s", c, result)
+				}
+
+				if input.Distinct && seen[c] {
+					t.Errorf("expected distinct characters, got: %s", result)
+				}
+
+				seen[c] = true
+			}
+
+			if int64(special) < input.MinSpecial {
+				t.Errorf("expected at least %d special characters, got: %s", input.MinSpecial, result)
+			}
+		})
+	}
+}
+
+func TestCreateString_Charset(t *testing.T) {
+	t.Parallel()
+
+	input := StringParams{
+		Length:           256,
+		Upper:            true,
+		MinUpper:         4,
+		Charset:          "0123456789abcdef",
+		NoLeadingNumeric: true,
+	}
+
+	result, err := NewSource(rand.New(rand.NewSource(1))).CreateString(input)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if int64(len(result)) != input.Length {
+		t.Errorf("expected %d characters, got %d: %s", input.Length, len(result), result)
+	}
+
+	for _, c := range result {
+		if !strings.ContainsRune(input.Charset, rune(c)) {
+			t.Errorf("unexpected character %q in: %s", c, result)
+		}
+	}
+
+	if result[0] >= '0' && result[0] <= '9' {
+		t.Errorf("expected the result not to begin with a numeric character, got: %s", result)
+	}
+
+	if bits := StringEntropyBits(input); bits != 1024 {
+		t.Errorf("expected 1024 entropy bits, got: %f", bits)
+	}
+}
+
+func TestCreateString_MinCharacterClasses(t *testing.T) {
+	t.Parallel()
+
+	source := NewSource(rand.New(rand.NewSource(1)))
+
+	input := StringParams{
+		Length:              3,
+		Upper:               true,
+		Lower:               true,
+		Numeric:             true,
+		Special:             true,
+		MinCharacterClasses: 3,
+	}
+
+	// Each result has exactly three classes, so the class which is missing is
+	// equally likely to be any of the four.
+	missing := make([]int, 4)
+
+	for i := 0; i < 2000; i++ {
+		result, err := source.CreateString(input)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		histogram := randomtest.CharacterClassHistogram(string(result))
+
+		var classes int
+
+		for class, count := range histogram {
+			if count == 0 {
+				missing[class]++
+			} else {
+				classes++
+			}
+		}
+
+		if classes != 3 {
+			t.Fatalf("expected 3 character classes, got %d: %s", classes, result)
+		}
+	}
+
+	if err := randomtest.ChiSquaredUniform(missing); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCreateString_MinCharacterClasses_Minimums(t *testing.T) {
+	t.Parallel()
+
+	source := NewSource(rand.New(rand.NewSource(1)))
+
+	input := StringParams{
+		Length:              3,
+		Upper:               true,
+		MinUpper:            2,
+		Numeric:             true,
+		Special:             true,
+		MinCharacterClasses: 2,
+	}
+
+	for i := 0; i < 100; i++ {
+		result, err := source.CreateString(input)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		histogram := randomtest.CharacterClassHistogram(string(result))
+
+		if histogram[randomtest.ClassUpper] < 2 || histogram[randomtest.ClassLower] != 0 {
+			t.Fatalf("unexpected characters: %s", result)
+		}
+
+		if histogram[randomtest.ClassNumeric]+histogram[randomtest.ClassSpecial] != 1 {
+			t.Fatalf("expected one numeric or special character, got: %s", result)
+		}
+	}
+}
+
+func TestCreateString_MinCharacterClasses_NotEnabled(t *testing.T) {
+	t.Parallel()
+
+	input := StringParams{
+		Length:              8,
+		Upper:               true,
+		Lower:               true,
+		MinCharacterClasses: 3,
+	}
+
+	_, err := NewSource(rand.New(rand.NewSource(1))).CreateString(input)
+	if err == nil || !strings.Contains(err.Error(), "3 character classes were required, but only 2 are enabled") {
+		t.Errorf("expected error for too few enabled classes, got: %v", err)
+	}
+}
@@ -0,0 +1,127 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package random
+
+import (
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"math"
+	"math/big"
+	"strings"
+)
+
+const base62Chars = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+
+const (
+	TokenChecksumCRC32 = "crc32"
+	TokenChecksumLuhn  = "luhn"
+)
+
+// crc32ChecksumLength is the number of base62 characters required to encode
+// any 32-bit value.
+const crc32ChecksumLength = 6
+
+type TokenParams struct {
+	Prefix            string
+	EntropyBytes      int64
+	ChecksumAlgorithm string
+}
+
+// CreateToken returns a token of the form prefix_<random>_<checksum>, where
+// the random segment is the base62 encoding of EntropyBytes bytes read from the
+// source and the checksum segment is calculated from the random segment. The
+// checksum allows tokens to be recognised and validated, for instance by
+// secret scanners, without access to the system which issued them.
+func (s *Source) CreateToken(input TokenParams) (string, error) {
+	bytes, err := s.CreateBytes(input.EntropyBytes)
+	if err != nil {
+		return "", err
+	}
+
+	secret := Base62Encode(bytes)
+
+	checksum, err := TokenChecksum(input.ChecksumAlgorithm, secret)
+	if err != nil {
+		return "", err
+	}
+
+	return input.Prefix + "_" + secret + "_" + checksum, nil
+}
+
+// TokenChecksum returns the checksum segment of a token for the given
+// algorithm.
+//
+// The crc32 algorithm produces the IEEE CRC-32 checksum of the value encoded
+// as six base62 characters. The luhn algorithm produces a single base62
+// character using the Luhn mod N algorithm.
+func TokenChecksum(algorithm string, value string) (string, error) {
+	switch algorithm {
+	case TokenChecksumCRC32:
+		return base62EncodeWidth(new(big.Int).SetUint64(uint64(crc32.ChecksumIEEE([]byte(value)))), crc32ChecksumLength), nil
+	case TokenChecksumLuhn:
+		return luhnModN(value)
+	default:
+		return "", fmt.Errorf("unsupported checksum algorithm: %q", algorithm)
+	}
+}
+
+// Base62Encode returns the base62 encoding of the bytes, left padded with
+// zeros to the number of characters required to encode any value of the same
+// number of bytes, so that the encoded length depends only on the input length.
+func Base62Encode(bytes []byte) string {
+	width := int(math.Ceil(float64(len(bytes)*8) / math.Log2(float64(len(base62Chars)))))
+
+	return base62EncodeWidth(new(big.Int).SetBytes(bytes), width)
+}
+
+func base62EncodeWidth(value *big.Int, width int) string {
+	base := big.NewInt(int64(len(base62Chars)))
+	remainder := new(big.Int)
+	value = new(big.Int).Set(value)
+
+	var result []byte
+
+	for value.Sign() > 0 {
+		value.DivMod(value, base, remainder)
+		result = append(result, base62Chars[remainder.Int64()])
+	}
+
+	for len(result) < width {
+		result = append(result, base62Chars[0])
+	}
+
+	for i, j := 0, len(result)-1; i < j; i, j = i+1, j-1 {
+		result[i], result[j] = result[j], result[i]
+	}
+
+	return string(result)
+}
+
+// luhnModN returns the Luhn mod N check character of the value, using the
+// base62 alphabet.
+func luhnModN(value string) (string, error) {
+	n := len(base62Chars)
+	factor := 2
+	sum := 0
+
+	for i := len(value) - 1; i >= 0; i-- {
+		codePoint := strings.IndexByte(base62Chars, value[i])
+		if codePoint < 0 {
+			return "", errors.New("the value contains characters outside of the base62 alphabet")
+		}
+
+		addend := factor * codePoint
+		addend = (addend / n) + (addend % n)
+		sum += addend
+
+		if factor == 2 {
+			factor = 1
+		} else {
+			factor = 2
+		}
+	}
+
+	return string(base62Chars[(n-(sum%n))%n]), nil
+}
@@ -0,0 +1,86 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package randomtest
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+)
+
+// The character classes counted by CharacterClassHistogram, in the order of
+// its counts.
+const (
+	ClassUpper = iota
+	ClassLower
+	ClassNumeric
+	ClassSpecial
+)
+
+// CharacterClassHistogram returns the number of uppercase, lowercase, numeric
+// and special characters of the string, in that order. Special characters are
+// those other than ASCII letters and digits.
+func CharacterClassHistogram(s string) [4]int {
+	var counts [4]int
+
+	for _, c := range s {
+		switch {
+		case c >= 'A' && c <= 'Z':
+			counts[ClassUpper]++
+		case c >= 'a' && c <= 'z':
+			counts[ClassLower]++
+		case c >= '0' && c <= '9':
+			counts[ClassNumeric]++
+		default:
+			counts[ClassSpecial]++
+		}
+	}
+
+	return counts
+}
+
+var _ knownvalue.Check = stringMinCharacterClasses{}
+
+type stringMinCharacterClasses struct {
+	classes int
+}
+
+// CheckValue determines whether the passed value is of type string, and
+// contains characters of at least the given number of character classes.
+func (v stringMinCharacterClasses) CheckValue(other any) error {
+	otherVal, ok := other.(string)
+
+	if !ok {
+		return fmt.Errorf("expected string value for StringMinCharacterClasses check, got: %T", other)
+	}
+
+	var classes int
+
+	for _, count := range CharacterClassHistogram(otherVal) {
+		if count > 0 {
+			classes++
+		}
+	}
+
+	if classes < v.classes {
+		return fmt.Errorf("expected string of at least %d character classes for StringMinCharacterClasses check, got: %d (value = %s)", v.classes, classes, otherVal)
+	}
+
+	return nil
+}
+
+// String returns the string representation of the value.
+func (v stringMinCharacterClasses) String() string {
+	return strconv.Itoa(v.classes)
+}
+
+// StringMinCharacterClasses returns a Check for asserting the minimum number
+// of character classes, as counted by CharacterClassHistogram, of the value
+// passed to the CheckValue method.
+func StringMinCharacterClasses(classes int) stringMinCharacterClasses {
+	return stringMinCharacterClasses{
+		classes: classes,
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package randomtest
+
+import (
+	"fmt"
+	"math"
+)
+
+// chiSquaredZ is the standard normal quantile for a significance level of
+// 0.0001, so that a uniform distribution is incorrectly rejected in fewer than
+// one in ten thousand runs.
+const chiSquaredZ = 3.719
+
+// ChiSquaredUniform returns an error if the observed counts are unlikely to
+// have been drawn from a uniform distribution, according to Pearson's
+// chi-squared test. Each count should be expected to be at least five for the
+// test to be reliable.
+func ChiSquaredUniform(counts []int) error {
+	weights := make([]float64, len(counts))
+
+	for i := range weights {
+		weights[i] = 1
+	}
+
+	return ChiSquaredWeighted(counts, weights)
+}
+
+// ChiSquaredWeighted returns an error if the observed counts are unlikely to
+// have been drawn from a distribution in which the probability of each
+// outcome is proportional to its weight, according to Pearson's chi-squared
+// test. Each count should be expected to be at least five for the test to be
+// reliable.
+func ChiSquaredWeighted(counts []int, weights []float64) error {
+	if len(counts) < 2 {
+		return fmt.Errorf("expected at least two counts for ChiSquaredWeighted, got: %d", len(counts))
+	}
+
+	if len(weights) != len(counts) {
+		return fmt.Errorf("expected one weight for each of the %d counts, got: %d", len(counts), len(weights))
+	}
+
+	var total, totalWeight float64
+
+	for i, count := range counts {
+		total += float64(count)
+		totalWeight += weights[i]
+	}
+
+	var statistic float64
+
+	for i, count := range counts {
+		expected := total * weights[i] / totalWeight
+		statistic += math.Pow(float64(count)-expected, 2) / expected
+	}
+
+	if critical := chiSquaredCritical(len(counts) - 1); statistic > critical {
+		return fmt.Errorf("expected distribution proportional to weights %v, got chi-squared statistic %.2f "+
+			"exceeding critical value %.2f (counts = %v)", weights, statistic, critical, counts)
+	}
+
+	return nil
+}
+
+// chiSquaredCritical returns the critical value of the chi-squared
+// distribution with the given degrees of freedom, using the Wilson-Hilferty
+// approximation.
+func chiSquaredCritical(degreesOfFreedom int) float64 {
+	k := float64(degreesOfFreedom)
+	v := 2 / (9 * k)
+
+	return k * math.Pow(1-v+chiSquaredZ*math.Sqrt(v), 3)
+}
@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package server serves the provider to Terraform with either protocol version
+// 5 or 6, so that it can be used with every Terraform version which supports
+// either of them. Terraform lists the protocol versions it supports when it
+// starts the provider, and the newest version supported by both is served.
+package server
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+)
+
+// DefaultProtocolVersion is the protocol version served when Terraform does
+// not list the versions it supports, such as when the provider is started for
+// debugging.
+const DefaultProtocolVersion = 6
+
+// protocolVersionsEnvVar is the environment variable in which go-plugin
+// clients, such as Terraform, list the protocol versions they support.
+const protocolVersionsEnvVar = "PLUGIN_PROTOCOL_VERSIONS"
+
+// Serve serves the provider returned by newProvider with the protocol version,
+// or with the newest protocol version supported by Terraform if it is zero,
+// and returns when Terraform stops the provider.
+func Serve(ctx context.Context, address string, newProvider func() provider.Provider, debug bool, protocolVersion int) error {
+	if protocolVersion == 0 {
+		protocolVersion = ProtocolVersion(os.Getenv(protocolVersionsEnvVar))
+	}
+
+	return providerserver.Serve(ctx, newProvider, providerserver.ServeOpts{
+		Address:         address,
+		Debug:           debug,
+		ProtocolVersion: protocolVersion,
+	})
+}
+
+// ProtocolVersion returns the newest of protocol versions 5 and 6 in the comma
+// separated list of versions supported by Terraform, or the
+// DefaultProtocolVersion if the list includes neither.
+func ProtocolVersion(versions string) int {
+	protocolVersion := 0
+
+	for _, s := range strings.Split(versions, ",") {
+		v, err := strconv.Atoi(strings.TrimSpace(s))
+		if err != nil || (v != 5 && v != 6) {
+			continue
+		}
+
+		protocolVersion = max(protocolVersion, v)
+	}
+
+	if protocolVersion == 0 {
+		return DefaultProtocolVersion
+	}
+
+	return protocolVersion
+}
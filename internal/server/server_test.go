@@ -0,0 +1,52 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package server
+
+import (
+	"testing"
+)
+
+func TestProtocolVersion(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		versions string
+		expected int
+	}{
+		"unset": {
+			versions: "",
+			expected: DefaultProtocolVersion,
+		},
+		"terraform-0.12": {
+			versions: "5",
+			expected: 5,
+		},
+		"terraform-1": {
+			versions: "5,6",
+			expected: 6,
+		},
+		"unordered": {
+			versions: "6, 5",
+			expected: 6,
+		},
+		"unsupported": {
+			versions: "4,7",
+			expected: DefaultProtocolVersion,
+		},
+		"invalid": {
+			versions: "five,5",
+			expected: 5,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := ProtocolVersion(testCase.versions); got != testCase.expected {
+				t.Errorf("expected %d, got: %d", testCase.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,48 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validators
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// PositiveDurationValidator is the underlying struct implementing PositiveDuration.
+type PositiveDurationValidator struct{}
+
+func (v PositiveDurationValidator) Description(ctx context.Context) string {
+	return v.MarkdownDescription(ctx)
+}
+
+func (v PositiveDurationValidator) MarkdownDescription(_ context.Context) string {
+	return "value must be a positive duration, such as `30m` or `720h`"
+}
+
+func (v PositiveDurationValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	duration, err := time.ParseDuration(req.ConfigValue.ValueString())
+
+	if err == nil && duration > 0 {
+		return
+	}
+
+	resp.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+		req.Path,
+		v.Description(ctx),
+		fmt.Sprintf("%q", req.ConfigValue.ValueString()),
+	))
+}
+
+// PositiveDuration returns a validator which ensures that the string is a
+// duration, as accepted by time.ParseDuration, which is greater than zero.
+func PositiveDuration() validator.String {
+	return PositiveDurationValidator{}
+}
@@ -0,0 +1,45 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validators
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/terraform-providers/terraform-provider-random/internal/encrypt"
+)
+
+// PublicKeyValidator is the underlying struct implementing PublicKey.
+type PublicKeyValidator struct{}
+
+func (v PublicKeyValidator) Description(ctx context.Context) string {
+	return v.MarkdownDescription(ctx)
+}
+
+func (v PublicKeyValidator) MarkdownDescription(_ context.Context) string {
+	return "value must be an age X25519 recipient or a PEM encoded RSA public key"
+}
+
+func (v PublicKeyValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := encrypt.ParsePublicKey(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Public Key",
+			fmt.Sprintf("The value must be an age X25519 recipient beginning with age1, or a PEM encoded RSA "+
+				"public key of at least %d bits.\n\nOriginal Error: %s", encrypt.MinPublicKeyBits, err),
+		)
+	}
+}
+
+// PublicKey returns a validator which ensures that the string is a public key
+// accepted by encrypt.ParsePublicKey.
+func PublicKey() validator.String {
+	return PublicKeyValidator{}
+}
@@ -0,0 +1,46 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validators
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// RegularExpressionValidator is the underlying struct implementing RegularExpression.
+type RegularExpressionValidator struct{}
+
+func (v RegularExpressionValidator) Description(ctx context.Context) string {
+	return v.MarkdownDescription(ctx)
+}
+
+func (v RegularExpressionValidator) MarkdownDescription(_ context.Context) string {
+	return "value must be a valid RE2 regular expression"
+}
+
+func (v RegularExpressionValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := regexp.Compile(req.ConfigValue.ValueString()); err == nil {
+		return
+	}
+
+	resp.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+		req.Path,
+		v.Description(ctx),
+		fmt.Sprintf("%q", req.ConfigValue.ValueString()),
+	))
+}
+
+// RegularExpression returns a validator which ensures that the string is a
+// regular expression, as accepted by regexp.Compile.
+func RegularExpression() validator.String {
+	return RegularExpressionValidator{}
+}
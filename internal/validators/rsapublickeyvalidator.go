@@ -0,0 +1,45 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validators
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/terraform-providers/terraform-provider-random/internal/encrypt"
+)
+
+// RSAPublicKeyValidator is the underlying struct implementing RSAPublicKey.
+type RSAPublicKeyValidator struct{}
+
+func (v RSAPublicKeyValidator) Description(ctx context.Context) string {
+	return v.MarkdownDescription(ctx)
+}
+
+func (v RSAPublicKeyValidator) MarkdownDescription(_ context.Context) string {
+	return "value must be a PEM encoded RSA public key"
+}
+
+func (v RSAPublicKeyValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := encrypt.ParseRSAPublicKey(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Public Key",
+			fmt.Sprintf("The value must be a PEM encoded RSA public key of at least %d bits.\n\nOriginal Error: %s",
+				encrypt.MinPublicKeyBits, err),
+		)
+	}
+}
+
+// RSAPublicKey returns a validator which ensures that the string is an RSA
+// public key accepted by encrypt.ParseRSAPublicKey.
+func RSAPublicKey() validator.String {
+	return RSAPublicKeyValidator{}
+}
@@ -8,23 +8,47 @@ import (
 	"flag"
 	"log"
 
-	"github.com/hashicorp/terraform-plugin-framework/providerserver"
-
+	"github.com/terraform-providers/terraform-provider-random/internal/metrics"
 	"github.com/terraform-providers/terraform-provider-random/internal/provider"
+	"github.com/terraform-providers/terraform-provider-random/internal/server"
 )
 
+const providerAddress = "registry.terraform.io/hashicorp/random"
+
+// Version is set to the provider version on release via goreleaser ldflags.
+var Version = "dev"
+
 func main() {
-	var debug bool
+	var (
+		debug           bool
+		metricsFile     string
+		protocolVersion int
+	)
 
 	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")
+	flag.StringVar(&metricsFile, "metrics-file", "", "path of a file to which counts of the random values "+
+		"generated, the entropy read and the time spent hashing with bcrypt are written as JSON when the provider "+
+		"stops, to profile large applies; requires -debug")
+	flag.IntVar(&protocolVersion, "protocol-version", 0, "protocol version, 5 or 6, with which the provider is "+
+		"served for debugging, as Terraform does not negotiate it when attaching to the provider; requires -debug "+
+		"(default 6)")
 	flag.Parse()
 
-	err := providerserver.Serve(context.Background(), provider.New, providerserver.ServeOpts{
-		Address:         "registry.terraform.io/hashicorp/random",
-		Debug:           debug,
-		ProtocolVersion: 5,
-	})
-	if err != nil {
+	if metricsFile != "" && !debug {
+		log.Fatal("-metrics-file requires -debug")
+	}
+
+	if protocolVersion != 0 && !debug {
+		log.Fatal("-protocol-version requires -debug")
+	}
+
+	if err := server.Serve(context.Background(), providerAddress, provider.New(Version), debug, protocolVersion); err != nil {
 		log.Fatal(err)
 	}
+
+	if metricsFile != "" {
+		if err := metrics.WriteFile(metricsFile); err != nil {
+			log.Fatal(err)
+		}
+	}
 }